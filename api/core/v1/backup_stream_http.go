@@ -0,0 +1,70 @@
+package v1
+
+import (
+	context "context"
+	io "io"
+	http "net/http"
+
+	go_restful "github.com/emicklei/go-restful"
+	errors "github.com/tkeel-io/kit/errors"
+	result "github.com/tkeel-io/kit/result"
+
+	transportHTTP "github.com/tkeel-io/kit/transport/http"
+)
+
+// BackupStreamHTTPServer streams archives over http, which generated handlers do not.
+type BackupStreamHTTPServer interface {
+	// StreamBackupTo writes the archive into w, respond is called before the archive is written.
+	StreamBackupTo(ctx context.Context, in *CreateBackupRequest, respond func() io.Writer) error
+}
+
+type BackupStreamHTTPHandler struct {
+	srv BackupStreamHTTPServer
+}
+
+func (h *BackupStreamHTTPHandler) StreamBackup(req *go_restful.Request, resp *go_restful.Response) {
+	in := CreateBackupRequest{}
+	if err := transportHTTP.GetBody(req, &in); err != nil {
+		resp.WriteHeaderAndJson(http.StatusBadRequest,
+			result.Set(errors.InternalError.Reason, err.Error(), nil), "application/json")
+		return
+	}
+
+	ctx := transportHTTP.ContextWithHeader(req.Request.Context(), req.Request.Header)
+
+	responded := false
+	err := h.srv.StreamBackupTo(ctx, &in, func() io.Writer {
+		responded = true
+		resp.AddHeader(go_restful.HEADER_ContentType, "application/gzip")
+		resp.AddHeader("Content-Disposition", `attachment; filename="backup.tar.gz"`)
+		resp.WriteHeader(http.StatusOK)
+		return resp
+	})
+	if err != nil && !responded {
+		tErr := errors.FromError(err)
+		httpCode := errors.GRPCToHTTPStatusCode(tErr.GRPCStatus().Code())
+		resp.WriteHeaderAndJson(httpCode,
+			result.Set(tErr.Reason, tErr.Message, nil), "application/json")
+	}
+}
+
+func RegisterBackupStreamHTTPServer(container *go_restful.Container, srv BackupStreamHTTPServer) {
+	var ws *go_restful.WebService
+	for _, v := range container.RegisteredWebServices() {
+		if v.RootPath() == "/v1" {
+			ws = v
+			break
+		}
+	}
+	if ws == nil {
+		ws = new(go_restful.WebService)
+		ws.ApiVersion("/v1")
+		ws.Path("/v1").Produces(go_restful.MIME_JSON)
+		container.Add(ws)
+	}
+
+	handler := &BackupStreamHTTPHandler{srv: srv}
+	ws.Route(ws.POST("/backups/stream").
+		To(handler.StreamBackup).
+		Produces("application/gzip", go_restful.MIME_JSON))
+}
@@ -29,12 +29,15 @@ type CreateEntityRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	From       string          `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	Source     string          `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
-	Owner      string          `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
-	Type       string          `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
-	Properties *structpb.Value `protobuf:"bytes,6,opt,name=properties,proto3" json:"properties,omitempty"`
+	Id              string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	From            string          `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Source          string          `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Owner           string          `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	Type            string          `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Properties      *structpb.Value `protobuf:"bytes,6,opt,name=properties,proto3" json:"properties,omitempty"`
+	TemplateVersion int64           `protobuf:"varint,7,opt,name=template_version,json=templateVersion,proto3" json:"template_version,omitempty"`
+	SchemaId        string          `protobuf:"bytes,8,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	SchemaVersion   int64           `protobuf:"varint,9,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
 }
 
 func (x *CreateEntityRequest) Reset() {
@@ -111,20 +114,42 @@ func (x *CreateEntityRequest) GetProperties() *structpb.Value {
 	return nil
 }
 
+func (x *CreateEntityRequest) GetTemplateVersion() int64 {
+	if x != nil {
+		return x.TemplateVersion
+	}
+	return 0
+}
+
+func (x *CreateEntityRequest) GetSchemaId() string {
+	if x != nil {
+		return x.SchemaId
+	}
+	return ""
+}
+
+func (x *CreateEntityRequest) GetSchemaVersion() int64 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
 // Update Entity Request.
 type UpdateEntityRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type        string          `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Source      string          `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
-	Owner       string          `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
-	TemplateId  string          `protobuf:"bytes,5,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
-	Description string          `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	Properties  *structpb.Value `protobuf:"bytes,15,opt,name=properties,proto3" json:"properties,omitempty"`
-	Configs     *structpb.Value `protobuf:"bytes,16,opt,name=configs,proto3" json:"configs,omitempty"`
+	Id              string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type            string          `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Source          string          `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Owner           string          `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	TemplateId      string          `protobuf:"bytes,5,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Description     string          `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	TemplateVersion int64           `protobuf:"varint,7,opt,name=template_version,json=templateVersion,proto3" json:"template_version,omitempty"`
+	Properties      *structpb.Value `protobuf:"bytes,15,opt,name=properties,proto3" json:"properties,omitempty"`
+	Configs         *structpb.Value `protobuf:"bytes,16,opt,name=configs,proto3" json:"configs,omitempty"`
 }
 
 func (x *UpdateEntityRequest) Reset() {
@@ -201,6 +226,13 @@ func (x *UpdateEntityRequest) GetDescription() string {
 	return ""
 }
 
+func (x *UpdateEntityRequest) GetTemplateVersion() int64 {
+	if x != nil {
+		return x.TemplateVersion
+	}
+	return 0
+}
+
 func (x *UpdateEntityRequest) GetProperties() *structpb.Value {
 	if x != nil {
 		return x.Properties
@@ -415,6 +447,206 @@ func (x *DeleteEntityResponse) GetStatus() string {
 	return ""
 }
 
+// Restore Entity Request.
+type RestoreEntityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Owner  string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (x *RestoreEntityRequest) Reset() {
+	*x = RestoreEntityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_core_v1_entity_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreEntityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreEntityRequest) ProtoMessage() {}
+
+func (x *RestoreEntityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_v1_entity_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreEntityRequest.ProtoReflect.Descriptor instead.
+func (*RestoreEntityRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RestoreEntityRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreEntityRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RestoreEntityRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *RestoreEntityRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+// Purge Entity Request.
+type PurgeEntityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Owner  string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (x *PurgeEntityRequest) Reset() {
+	*x = PurgeEntityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_core_v1_entity_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeEntityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeEntityRequest) ProtoMessage() {}
+
+func (x *PurgeEntityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_v1_entity_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeEntityRequest.ProtoReflect.Descriptor instead.
+func (*PurgeEntityRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PurgeEntityRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PurgeEntityRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PurgeEntityRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *PurgeEntityRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+// Purge Entity Response.
+type PurgeEntityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *PurgeEntityResponse) Reset() {
+	*x = PurgeEntityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_core_v1_entity_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeEntityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeEntityResponse) ProtoMessage() {}
+
+func (x *PurgeEntityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_v1_entity_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeEntityResponse.ProtoReflect.Descriptor instead.
+func (*PurgeEntityResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PurgeEntityResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PurgeEntityResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
 // Update(upsert) Entity Properties Request.
 type UpdateEntityPropsRequest struct {
 	state         protoimpl.MessageState
@@ -431,7 +663,7 @@ type UpdateEntityPropsRequest struct {
 func (x *UpdateEntityPropsRequest) Reset() {
 	*x = UpdateEntityPropsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[5]
+		mi := &file_api_core_v1_entity_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -444,7 +676,7 @@ func (x *UpdateEntityPropsRequest) String() string {
 func (*UpdateEntityPropsRequest) ProtoMessage() {}
 
 func (x *UpdateEntityPropsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[5]
+	mi := &file_api_core_v1_entity_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -457,7 +689,7 @@ func (x *UpdateEntityPropsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEntityPropsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateEntityPropsRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{5}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateEntityPropsRequest) GetId() string {
@@ -511,7 +743,7 @@ type PatchEntityPropsRequest struct {
 func (x *PatchEntityPropsRequest) Reset() {
 	*x = PatchEntityPropsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[6]
+		mi := &file_api_core_v1_entity_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -524,7 +756,7 @@ func (x *PatchEntityPropsRequest) String() string {
 func (*PatchEntityPropsRequest) ProtoMessage() {}
 
 func (x *PatchEntityPropsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[6]
+	mi := &file_api_core_v1_entity_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -537,7 +769,7 @@ func (x *PatchEntityPropsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PatchEntityPropsRequest.ProtoReflect.Descriptor instead.
 func (*PatchEntityPropsRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{6}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PatchEntityPropsRequest) GetId() string {
@@ -581,7 +813,7 @@ type GetEntityPropsRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id           string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	Source       string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
 	Owner        string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
 	Type         string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
@@ -591,7 +823,7 @@ type GetEntityPropsRequest struct {
 func (x *GetEntityPropsRequest) Reset() {
 	*x = GetEntityPropsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[7]
+		mi := &file_api_core_v1_entity_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -604,7 +836,7 @@ func (x *GetEntityPropsRequest) String() string {
 func (*GetEntityPropsRequest) ProtoMessage() {}
 
 func (x *GetEntityPropsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[7]
+	mi := &file_api_core_v1_entity_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -617,7 +849,7 @@ func (x *GetEntityPropsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEntityPropsRequest.ProtoReflect.Descriptor instead.
 func (*GetEntityPropsRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{7}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetEntityPropsRequest) GetId() string {
@@ -671,7 +903,7 @@ type RemoveEntityPropsRequest struct {
 func (x *RemoveEntityPropsRequest) Reset() {
 	*x = RemoveEntityPropsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[8]
+		mi := &file_api_core_v1_entity_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -684,7 +916,7 @@ func (x *RemoveEntityPropsRequest) String() string {
 func (*RemoveEntityPropsRequest) ProtoMessage() {}
 
 func (x *RemoveEntityPropsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[8]
+	mi := &file_api_core_v1_entity_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -697,7 +929,7 @@ func (x *RemoveEntityPropsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveEntityPropsRequest.ProtoReflect.Descriptor instead.
 func (*RemoveEntityPropsRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{8}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *RemoveEntityPropsRequest) GetId() string {
@@ -751,7 +983,7 @@ type UpdateEntityConfigsRequest struct {
 func (x *UpdateEntityConfigsRequest) Reset() {
 	*x = UpdateEntityConfigsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[9]
+		mi := &file_api_core_v1_entity_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -764,7 +996,7 @@ func (x *UpdateEntityConfigsRequest) String() string {
 func (*UpdateEntityConfigsRequest) ProtoMessage() {}
 
 func (x *UpdateEntityConfigsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[9]
+	mi := &file_api_core_v1_entity_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -777,7 +1009,7 @@ func (x *UpdateEntityConfigsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEntityConfigsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateEntityConfigsRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{9}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateEntityConfigsRequest) GetId() string {
@@ -831,7 +1063,7 @@ type PatchEntityConfigsRequest struct {
 func (x *PatchEntityConfigsRequest) Reset() {
 	*x = PatchEntityConfigsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[10]
+		mi := &file_api_core_v1_entity_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -844,7 +1076,7 @@ func (x *PatchEntityConfigsRequest) String() string {
 func (*PatchEntityConfigsRequest) ProtoMessage() {}
 
 func (x *PatchEntityConfigsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[10]
+	mi := &file_api_core_v1_entity_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -857,7 +1089,7 @@ func (x *PatchEntityConfigsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PatchEntityConfigsRequest.ProtoReflect.Descriptor instead.
 func (*PatchEntityConfigsRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{10}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PatchEntityConfigsRequest) GetId() string {
@@ -911,7 +1143,7 @@ type GetEntityConfigsRequest struct {
 func (x *GetEntityConfigsRequest) Reset() {
 	*x = GetEntityConfigsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[11]
+		mi := &file_api_core_v1_entity_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -924,7 +1156,7 @@ func (x *GetEntityConfigsRequest) String() string {
 func (*GetEntityConfigsRequest) ProtoMessage() {}
 
 func (x *GetEntityConfigsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[11]
+	mi := &file_api_core_v1_entity_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -937,7 +1169,7 @@ func (x *GetEntityConfigsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEntityConfigsRequest.ProtoReflect.Descriptor instead.
 func (*GetEntityConfigsRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{11}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetEntityConfigsRequest) GetId() string {
@@ -991,7 +1223,7 @@ type RemoveEntityConfigsRequest struct {
 func (x *RemoveEntityConfigsRequest) Reset() {
 	*x = RemoveEntityConfigsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[12]
+		mi := &file_api_core_v1_entity_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1004,7 +1236,7 @@ func (x *RemoveEntityConfigsRequest) String() string {
 func (*RemoveEntityConfigsRequest) ProtoMessage() {}
 
 func (x *RemoveEntityConfigsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[12]
+	mi := &file_api_core_v1_entity_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1017,7 +1249,7 @@ func (x *RemoveEntityConfigsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveEntityConfigsRequest.ProtoReflect.Descriptor instead.
 func (*RemoveEntityConfigsRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{12}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *RemoveEntityConfigsRequest) GetId() string {
@@ -1069,7 +1301,7 @@ type Mapper struct {
 func (x *Mapper) Reset() {
 	*x = Mapper{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[13]
+		mi := &file_api_core_v1_entity_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1082,7 +1314,7 @@ func (x *Mapper) String() string {
 func (*Mapper) ProtoMessage() {}
 
 func (x *Mapper) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[13]
+	mi := &file_api_core_v1_entity_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1095,7 +1327,7 @@ func (x *Mapper) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Mapper.ProtoReflect.Descriptor instead.
 func (*Mapper) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{13}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Mapper) GetId() string {
@@ -1142,7 +1374,7 @@ type AppendMapperRequest struct {
 func (x *AppendMapperRequest) Reset() {
 	*x = AppendMapperRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[14]
+		mi := &file_api_core_v1_entity_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1155,7 +1387,7 @@ func (x *AppendMapperRequest) String() string {
 func (*AppendMapperRequest) ProtoMessage() {}
 
 func (x *AppendMapperRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[14]
+	mi := &file_api_core_v1_entity_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1168,7 +1400,7 @@ func (x *AppendMapperRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppendMapperRequest.ProtoReflect.Descriptor instead.
 func (*AppendMapperRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{14}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *AppendMapperRequest) GetType() string {
@@ -1222,7 +1454,7 @@ type GetMapperRequest struct {
 func (x *GetMapperRequest) Reset() {
 	*x = GetMapperRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[15]
+		mi := &file_api_core_v1_entity_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1235,7 +1467,7 @@ func (x *GetMapperRequest) String() string {
 func (*GetMapperRequest) ProtoMessage() {}
 
 func (x *GetMapperRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[15]
+	mi := &file_api_core_v1_entity_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1248,7 +1480,7 @@ func (x *GetMapperRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMapperRequest.ProtoReflect.Descriptor instead.
 func (*GetMapperRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{15}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetMapperRequest) GetId() string {
@@ -1301,7 +1533,7 @@ type ListMapperRequest struct {
 func (x *ListMapperRequest) Reset() {
 	*x = ListMapperRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[16]
+		mi := &file_api_core_v1_entity_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1314,7 +1546,7 @@ func (x *ListMapperRequest) String() string {
 func (*ListMapperRequest) ProtoMessage() {}
 
 func (x *ListMapperRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[16]
+	mi := &file_api_core_v1_entity_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1327,7 +1559,7 @@ func (x *ListMapperRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMapperRequest.ProtoReflect.Descriptor instead.
 func (*ListMapperRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{16}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ListMapperRequest) GetType() string {
@@ -1374,7 +1606,7 @@ type RemoveMapperRequest struct {
 func (x *RemoveMapperRequest) Reset() {
 	*x = RemoveMapperRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[17]
+		mi := &file_api_core_v1_entity_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1387,7 +1619,7 @@ func (x *RemoveMapperRequest) String() string {
 func (*RemoveMapperRequest) ProtoMessage() {}
 
 func (x *RemoveMapperRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[17]
+	mi := &file_api_core_v1_entity_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1400,7 +1632,7 @@ func (x *RemoveMapperRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveMapperRequest.ProtoReflect.Descriptor instead.
 func (*RemoveMapperRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{17}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *RemoveMapperRequest) GetId() string {
@@ -1454,7 +1686,7 @@ type AppendMapperResponse struct {
 func (x *AppendMapperResponse) Reset() {
 	*x = AppendMapperResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[18]
+		mi := &file_api_core_v1_entity_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1467,7 +1699,7 @@ func (x *AppendMapperResponse) String() string {
 func (*AppendMapperResponse) ProtoMessage() {}
 
 func (x *AppendMapperResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[18]
+	mi := &file_api_core_v1_entity_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1480,7 +1712,7 @@ func (x *AppendMapperResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppendMapperResponse.ProtoReflect.Descriptor instead.
 func (*AppendMapperResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{18}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *AppendMapperResponse) GetType() string {
@@ -1534,7 +1766,7 @@ type RemoveMapperResponse struct {
 func (x *RemoveMapperResponse) Reset() {
 	*x = RemoveMapperResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[19]
+		mi := &file_api_core_v1_entity_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1547,7 +1779,7 @@ func (x *RemoveMapperResponse) String() string {
 func (*RemoveMapperResponse) ProtoMessage() {}
 
 func (x *RemoveMapperResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[19]
+	mi := &file_api_core_v1_entity_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1560,7 +1792,7 @@ func (x *RemoveMapperResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveMapperResponse.ProtoReflect.Descriptor instead.
 func (*RemoveMapperResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{19}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *RemoveMapperResponse) GetId() string {
@@ -1614,7 +1846,7 @@ type GetMapperResponse struct {
 func (x *GetMapperResponse) Reset() {
 	*x = GetMapperResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[20]
+		mi := &file_api_core_v1_entity_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1627,7 +1859,7 @@ func (x *GetMapperResponse) String() string {
 func (*GetMapperResponse) ProtoMessage() {}
 
 func (x *GetMapperResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[20]
+	mi := &file_api_core_v1_entity_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1640,7 +1872,7 @@ func (x *GetMapperResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMapperResponse.ProtoReflect.Descriptor instead.
 func (*GetMapperResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{20}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetMapperResponse) GetType() string {
@@ -1694,7 +1926,7 @@ type ListMapperResponse struct {
 func (x *ListMapperResponse) Reset() {
 	*x = ListMapperResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[21]
+		mi := &file_api_core_v1_entity_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1707,7 +1939,7 @@ func (x *ListMapperResponse) String() string {
 func (*ListMapperResponse) ProtoMessage() {}
 
 func (x *ListMapperResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[21]
+	mi := &file_api_core_v1_entity_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1720,7 +1952,7 @@ func (x *ListMapperResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMapperResponse.ProtoReflect.Descriptor instead.
 func (*ListMapperResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{21}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ListMapperResponse) GetType() string {
@@ -1773,7 +2005,7 @@ type Expression struct {
 func (x *Expression) Reset() {
 	*x = Expression{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[22]
+		mi := &file_api_core_v1_entity_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1786,7 +2018,7 @@ func (x *Expression) String() string {
 func (*Expression) ProtoMessage() {}
 
 func (x *Expression) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[22]
+	mi := &file_api_core_v1_entity_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1799,7 +2031,7 @@ func (x *Expression) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Expression.ProtoReflect.Descriptor instead.
 func (*Expression) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{22}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *Expression) GetPath() string {
@@ -1841,7 +2073,7 @@ type Expressions struct {
 func (x *Expressions) Reset() {
 	*x = Expressions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[23]
+		mi := &file_api_core_v1_entity_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1854,7 +2086,7 @@ func (x *Expressions) String() string {
 func (*Expressions) ProtoMessage() {}
 
 func (x *Expressions) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[23]
+	mi := &file_api_core_v1_entity_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1867,7 +2099,7 @@ func (x *Expressions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Expressions.ProtoReflect.Descriptor instead.
 func (*Expressions) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{23}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *Expressions) GetExpressions() []*Expression {
@@ -1892,7 +2124,7 @@ type AppendExpressionReq struct {
 func (x *AppendExpressionReq) Reset() {
 	*x = AppendExpressionReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[24]
+		mi := &file_api_core_v1_entity_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1905,7 +2137,7 @@ func (x *AppendExpressionReq) String() string {
 func (*AppendExpressionReq) ProtoMessage() {}
 
 func (x *AppendExpressionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[24]
+	mi := &file_api_core_v1_entity_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1918,7 +2150,7 @@ func (x *AppendExpressionReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppendExpressionReq.ProtoReflect.Descriptor instead.
 func (*AppendExpressionReq) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{24}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *AppendExpressionReq) GetSource() string {
@@ -1964,7 +2196,7 @@ type GetExpressionReq struct {
 func (x *GetExpressionReq) Reset() {
 	*x = GetExpressionReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[25]
+		mi := &file_api_core_v1_entity_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1977,7 +2209,7 @@ func (x *GetExpressionReq) String() string {
 func (*GetExpressionReq) ProtoMessage() {}
 
 func (x *GetExpressionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[25]
+	mi := &file_api_core_v1_entity_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1990,7 +2222,7 @@ func (x *GetExpressionReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExpressionReq.ProtoReflect.Descriptor instead.
 func (*GetExpressionReq) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{25}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetExpressionReq) GetPath() string {
@@ -2035,7 +2267,7 @@ type ListExpressionReq struct {
 func (x *ListExpressionReq) Reset() {
 	*x = ListExpressionReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[26]
+		mi := &file_api_core_v1_entity_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2048,7 +2280,7 @@ func (x *ListExpressionReq) String() string {
 func (*ListExpressionReq) ProtoMessage() {}
 
 func (x *ListExpressionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[26]
+	mi := &file_api_core_v1_entity_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2061,7 +2293,7 @@ func (x *ListExpressionReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListExpressionReq.ProtoReflect.Descriptor instead.
 func (*ListExpressionReq) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{26}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ListExpressionReq) GetSource() string {
@@ -2100,7 +2332,7 @@ type RemoveExpressionReq struct {
 func (x *RemoveExpressionReq) Reset() {
 	*x = RemoveExpressionReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[27]
+		mi := &file_api_core_v1_entity_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2113,7 +2345,7 @@ func (x *RemoveExpressionReq) String() string {
 func (*RemoveExpressionReq) ProtoMessage() {}
 
 func (x *RemoveExpressionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[27]
+	mi := &file_api_core_v1_entity_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2126,7 +2358,7 @@ func (x *RemoveExpressionReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveExpressionReq.ProtoReflect.Descriptor instead.
 func (*RemoveExpressionReq) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{27}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *RemoveExpressionReq) GetPaths() string {
@@ -2171,7 +2403,7 @@ type AppendExpressionResp struct {
 func (x *AppendExpressionResp) Reset() {
 	*x = AppendExpressionResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[28]
+		mi := &file_api_core_v1_entity_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2184,7 +2416,7 @@ func (x *AppendExpressionResp) String() string {
 func (*AppendExpressionResp) ProtoMessage() {}
 
 func (x *AppendExpressionResp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[28]
+	mi := &file_api_core_v1_entity_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2197,7 +2429,7 @@ func (x *AppendExpressionResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppendExpressionResp.ProtoReflect.Descriptor instead.
 func (*AppendExpressionResp) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{28}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *AppendExpressionResp) GetOwner() string {
@@ -2235,7 +2467,7 @@ type RemoveExpressionResp struct {
 func (x *RemoveExpressionResp) Reset() {
 	*x = RemoveExpressionResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[29]
+		mi := &file_api_core_v1_entity_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2248,7 +2480,7 @@ func (x *RemoveExpressionResp) String() string {
 func (*RemoveExpressionResp) ProtoMessage() {}
 
 func (x *RemoveExpressionResp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[29]
+	mi := &file_api_core_v1_entity_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2261,7 +2493,7 @@ func (x *RemoveExpressionResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveExpressionResp.ProtoReflect.Descriptor instead.
 func (*RemoveExpressionResp) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{29}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *RemoveExpressionResp) GetOwner() string {
@@ -2299,7 +2531,7 @@ type GetExpressionResp struct {
 func (x *GetExpressionResp) Reset() {
 	*x = GetExpressionResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[30]
+		mi := &file_api_core_v1_entity_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2312,7 +2544,7 @@ func (x *GetExpressionResp) String() string {
 func (*GetExpressionResp) ProtoMessage() {}
 
 func (x *GetExpressionResp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[30]
+	mi := &file_api_core_v1_entity_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2325,7 +2557,7 @@ func (x *GetExpressionResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExpressionResp.ProtoReflect.Descriptor instead.
 func (*GetExpressionResp) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{30}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetExpressionResp) GetOwner() string {
@@ -2363,7 +2595,7 @@ type ListExpressionResp struct {
 func (x *ListExpressionResp) Reset() {
 	*x = ListExpressionResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[31]
+		mi := &file_api_core_v1_entity_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2376,7 +2608,7 @@ func (x *ListExpressionResp) String() string {
 func (*ListExpressionResp) ProtoMessage() {}
 
 func (x *ListExpressionResp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[31]
+	mi := &file_api_core_v1_entity_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2389,7 +2621,7 @@ func (x *ListExpressionResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListExpressionResp.ProtoReflect.Descriptor instead.
 func (*ListExpressionResp) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{31}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ListExpressionResp) GetOwner() string {
@@ -2413,6 +2645,182 @@ func (x *ListExpressionResp) GetExpressions() []*Expression {
 	return nil
 }
 
+// Evaluate Expression Request.
+type EvaluateExpressionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source     string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Owner      string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	EntityId   string `protobuf:"bytes,3,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Path       string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	Expression string `protobuf:"bytes,5,opt,name=expression,proto3" json:"expression,omitempty"`
+}
+
+func (x *EvaluateExpressionReq) Reset() {
+	*x = EvaluateExpressionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_core_v1_entity_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluateExpressionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateExpressionReq) ProtoMessage() {}
+
+func (x *EvaluateExpressionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_v1_entity_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateExpressionReq.ProtoReflect.Descriptor instead.
+func (*EvaluateExpressionReq) Descriptor() ([]byte, []int) {
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *EvaluateExpressionReq) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *EvaluateExpressionReq) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *EvaluateExpressionReq) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *EvaluateExpressionReq) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *EvaluateExpressionReq) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+// Evaluate Expression Response.
+type EvaluateExpressionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner      string          `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	EntityId   string          `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Path       string          `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Expression string          `protobuf:"bytes,4,opt,name=expression,proto3" json:"expression,omitempty"`
+	Value      *structpb.Value `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	Inputs     *structpb.Value `protobuf:"bytes,6,opt,name=inputs,proto3" json:"inputs,omitempty"`
+	Error      string          `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *EvaluateExpressionResp) Reset() {
+	*x = EvaluateExpressionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_core_v1_entity_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluateExpressionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateExpressionResp) ProtoMessage() {}
+
+func (x *EvaluateExpressionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_v1_entity_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateExpressionResp.ProtoReflect.Descriptor instead.
+func (*EvaluateExpressionResp) Descriptor() ([]byte, []int) {
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *EvaluateExpressionResp) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *EvaluateExpressionResp) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *EvaluateExpressionResp) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *EvaluateExpressionResp) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *EvaluateExpressionResp) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *EvaluateExpressionResp) GetInputs() *structpb.Value {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *EvaluateExpressionResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 // List Entity Request.
 type ListEntityRequest struct {
 	state         protoimpl.MessageState
@@ -2432,7 +2840,7 @@ type ListEntityRequest struct {
 func (x *ListEntityRequest) Reset() {
 	*x = ListEntityRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[32]
+		mi := &file_api_core_v1_entity_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2445,7 +2853,7 @@ func (x *ListEntityRequest) String() string {
 func (*ListEntityRequest) ProtoMessage() {}
 
 func (x *ListEntityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[32]
+	mi := &file_api_core_v1_entity_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2458,7 +2866,7 @@ func (x *ListEntityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEntityRequest.ProtoReflect.Descriptor instead.
 func (*ListEntityRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{32}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ListEntityRequest) GetSource() string {
@@ -2532,7 +2940,7 @@ type ListEntityResponse struct {
 func (x *ListEntityResponse) Reset() {
 	*x = ListEntityResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[33]
+		mi := &file_api_core_v1_entity_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2545,7 +2953,7 @@ func (x *ListEntityResponse) String() string {
 func (*ListEntityResponse) ProtoMessage() {}
 
 func (x *ListEntityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[33]
+	mi := &file_api_core_v1_entity_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2558,7 +2966,7 @@ func (x *ListEntityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEntityResponse.ProtoReflect.Descriptor instead.
 func (*ListEntityResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{33}
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ListEntityResponse) GetTotal() int32 {
@@ -2590,41 +2998,36 @@ func (x *ListEntityResponse) GetItems() []*EntityResponse {
 }
 
 // Entity Response.
-type EntityResponse struct {
+// Transact Entities Request.
+type TransactEntity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Source      string          `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
-	Owner       string          `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
-	Type        string          `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
-	Version     int64           `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
-	LastTime    int64           `protobuf:"varint,7,opt,name=last_time,json=lastTime,proto3" json:"last_time,omitempty"`
-	TemplateId  string          `protobuf:"bytes,8,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
-	Description string          `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
-	Mappers     []*Mapper       `protobuf:"bytes,11,rep,name=mappers,proto3" json:"mappers,omitempty"`
-	Configs     *structpb.Value `protobuf:"bytes,12,opt,name=configs,proto3" json:"configs,omitempty"`
-	Properties  *structpb.Value `protobuf:"bytes,13,opt,name=properties,proto3" json:"properties,omitempty"`
+	Id         string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Source     string          `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Owner      string          `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Type       string          `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Properties *structpb.Value `protobuf:"bytes,5,opt,name=properties,proto3" json:"properties,omitempty"`
 }
 
-func (x *EntityResponse) Reset() {
-	*x = EntityResponse{}
+func (x *TransactEntity) Reset() {
+	*x = TransactEntity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_core_v1_entity_proto_msgTypes[34]
+		mi := &file_api_core_v1_entity_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EntityResponse) String() string {
+func (x *TransactEntity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EntityResponse) ProtoMessage() {}
+func (*TransactEntity) ProtoMessage() {}
 
-func (x *EntityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_v1_entity_proto_msgTypes[34]
+func (x *TransactEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_v1_entity_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2635,60 +3038,282 @@ func (x *EntityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EntityResponse.ProtoReflect.Descriptor instead.
-func (*EntityResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use TransactEntity.ProtoReflect.Descriptor instead.
+func (*TransactEntity) Descriptor() ([]byte, []int) {
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *EntityResponse) GetId() string {
+func (x *TransactEntity) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *EntityResponse) GetSource() string {
+func (x *TransactEntity) GetSource() string {
 	if x != nil {
 		return x.Source
 	}
 	return ""
 }
 
-func (x *EntityResponse) GetOwner() string {
+func (x *TransactEntity) GetOwner() string {
 	if x != nil {
 		return x.Owner
 	}
 	return ""
 }
 
-func (x *EntityResponse) GetType() string {
+func (x *TransactEntity) GetType() string {
 	if x != nil {
 		return x.Type
 	}
 	return ""
 }
 
-func (x *EntityResponse) GetVersion() int64 {
-	if x != nil {
-		return x.Version
-	}
-	return 0
-}
-
-func (x *EntityResponse) GetLastTime() int64 {
+func (x *TransactEntity) GetProperties() *structpb.Value {
 	if x != nil {
-		return x.LastTime
+		return x.Properties
 	}
-	return 0
+	return nil
 }
 
-func (x *EntityResponse) GetTemplateId() string {
+type TransactEntitiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entities []*TransactEntity `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+}
+
+func (x *TransactEntitiesRequest) Reset() {
+	*x = TransactEntitiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_core_v1_entity_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactEntitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactEntitiesRequest) ProtoMessage() {}
+
+func (x *TransactEntitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_v1_entity_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactEntitiesRequest.ProtoReflect.Descriptor instead.
+func (*TransactEntitiesRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TransactEntitiesRequest) GetEntities() []*TransactEntity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+type TransactEntitiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status       string            `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	FailedEntity string            `protobuf:"bytes,3,opt,name=failed_entity,json=failedEntity,proto3" json:"failed_entity,omitempty"`
+	Reason       string            `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Entities     []*EntityResponse `protobuf:"bytes,5,rep,name=entities,proto3" json:"entities,omitempty"`
+}
+
+func (x *TransactEntitiesResponse) Reset() {
+	*x = TransactEntitiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_core_v1_entity_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactEntitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactEntitiesResponse) ProtoMessage() {}
+
+func (x *TransactEntitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_v1_entity_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactEntitiesResponse.ProtoReflect.Descriptor instead.
+func (*TransactEntitiesResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TransactEntitiesResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TransactEntitiesResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TransactEntitiesResponse) GetFailedEntity() string {
+	if x != nil {
+		return x.FailedEntity
+	}
+	return ""
+}
+
+func (x *TransactEntitiesResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TransactEntitiesResponse) GetEntities() []*EntityResponse {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+type EntityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Source          string          `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Owner           string          `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	Type            string          `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Version         int64           `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	LastTime        int64           `protobuf:"varint,7,opt,name=last_time,json=lastTime,proto3" json:"last_time,omitempty"`
+	TemplateId      string          `protobuf:"bytes,8,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	TemplateVersion int64           `protobuf:"varint,9,opt,name=template_version,json=templateVersion,proto3" json:"template_version,omitempty"`
+	Description     string          `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	Mappers         []*Mapper       `protobuf:"bytes,11,rep,name=mappers,proto3" json:"mappers,omitempty"`
+	Configs         *structpb.Value `protobuf:"bytes,12,opt,name=configs,proto3" json:"configs,omitempty"`
+	Properties      *structpb.Value `protobuf:"bytes,13,opt,name=properties,proto3" json:"properties,omitempty"`
+	RequestId       string          `protobuf:"bytes,14,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	SchemaId        string          `protobuf:"bytes,15,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	SchemaVersion   int64           `protobuf:"varint,16,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+}
+
+func (x *EntityResponse) Reset() {
+	*x = EntityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_core_v1_entity_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityResponse) ProtoMessage() {}
+
+func (x *EntityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_v1_entity_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityResponse.ProtoReflect.Descriptor instead.
+func (*EntityResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_v1_entity_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *EntityResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EntityResponse) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *EntityResponse) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *EntityResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EntityResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *EntityResponse) GetLastTime() int64 {
+	if x != nil {
+		return x.LastTime
+	}
+	return 0
+}
+
+func (x *EntityResponse) GetTemplateId() string {
 	if x != nil {
 		return x.TemplateId
 	}
 	return ""
 }
 
+func (x *EntityResponse) GetTemplateVersion() int64 {
+	if x != nil {
+		return x.TemplateVersion
+	}
+	return 0
+}
+
 func (x *EntityResponse) GetDescription() string {
 	if x != nil {
 		return x.Description
@@ -2717,6 +3342,27 @@ func (x *EntityResponse) GetProperties() *structpb.Value {
 	return nil
 }
 
+func (x *EntityResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *EntityResponse) GetSchemaId() string {
+	if x != nil {
+		return x.SchemaId
+	}
+	return ""
+}
+
+func (x *EntityResponse) GetSchemaVersion() int64 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
 var File_api_core_v1_entity_proto protoreflect.FileDescriptor
 
 var file_api_core_v1_entity_proto_rawDesc = []byte{
@@ -2732,799 +3378,983 @@ var file_api_core_v1_entity_proto_rawDesc = []byte{
 	0x31, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61,
 	0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb2,
-	0x02, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20,
-	0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0x74, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x69, 0x64, 0x52, 0x04, 0x66,
-	0x72, 0x6f, 0x6d, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x20, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0a, 0x32,
-	0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20, 0x69, 0x64, 0xe0, 0x41, 0x02, 0x52, 0x05, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x74,
-	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x58, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
-	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x20, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x2c, 0x20, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x69, 0x65, 0x73, 0x22, 0xa7, 0x03, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x20, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x74, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x69,
-	0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e,
-	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x31,
-	0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
-	0x74, 0x65, 0x20, 0x69, 0x64, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49,
-	0x64, 0x12, 0x32, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x74, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x20, 0x69, 0x64, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x69, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70,
-	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42,
-	0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x73, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0xa5, 0x01,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e,
-	0x92, 0x41, 0x0b, 0x32, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x69, 0x64, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x74, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52, 0x05,
-	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0xa8, 0x01, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d,
-	0x32, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x74, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x20, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32,
-	0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x22, 0x5b, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x20, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xfd, 0x01,
-	0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72,
-	0x6f, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x20, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x74, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x26, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x69, 0x64,
-	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x4e, 0x0a,
-	0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x16, 0x92, 0x41, 0x13, 0x32, 0x11,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
-	0x73, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0xfc, 0x01,
-	0x0a, 0x17, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f,
-	0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x20, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52,
-	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x20, 0x74, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x4e, 0x0a, 0x0a,
-	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x16, 0x92, 0x41, 0x13, 0x32, 0x11, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
-	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0xfc, 0x01, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20,
-	0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x20, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x26,
-	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92,
-	0x41, 0x0a, 0x32, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20, 0x69, 0x64, 0xe0, 0x41, 0x02, 0x52,
-	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x20, 0x74, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x4d, 0x0a, 0x0d,
-	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x28, 0x92, 0x41, 0x25, 0x32, 0x23, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x20, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x20, 0x69, 0x64, 0x73, 0x2c, 0x20, 0x65,
-	0x67, 0x3a, 0x20, 0x70, 0x69, 0x64, 0x73, 0x3d, 0x31, 0x2c, 0x32, 0x2c, 0x33, 0x52, 0x0c, 0x70,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x18,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x70,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x20, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x20, 0x74, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x23,
-	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92,
-	0x41, 0x0a, 0x32, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x20, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x70,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x15, 0x32, 0x13, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20,
-	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x20, 0x69, 0x64, 0x73, 0x52, 0x0c, 0x70, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xef, 0x01, 0x0a, 0x1a, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x20, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x20, 0x74, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d,
-	0x92, 0x41, 0x0a, 0x32, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52, 0x05, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x20, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x07,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0c, 0x92, 0x41, 0x09, 0x32, 0x07, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x73, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0xf7, 0x01, 0x0a,
-	0x19, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x20, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x74, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52, 0x05,
-	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x20, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x47, 0x0a,
-	0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x87,
+	0x04, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69,
+	0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0xa8, 0xa1, 0xe7, 0x89, 0x88,
+	0x69, 0x64, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6,
+	0x9d, 0xa5, 0xe6, 0xba, 0x90, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x27, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11,
+	0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0xe2, 0x41, 0x01,
+	0x02, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e,
+	0xe4, 0xbd, 0x93, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x54, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x1c, 0x92, 0x41, 0x19,
+	0x32, 0x17, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe5, 0xb1, 0x9e, 0xe6, 0x80, 0xa7, 0x2c, 0x20,
+	0xe5, 0x8f, 0xaf, 0xe9, 0x80, 0x89, 0xe7, 0x9a, 0x84, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x10, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x2c, 0x92, 0x41, 0x29, 0x32, 0x27, 0xe6, 0xa8, 0xa1, 0xe7, 0x89, 0x88, 0xe7, 0x89, 0x88, 0xe6,
+	0x9c, 0xac, 0x2c, 0x20, 0xe4, 0xb8, 0xba, 0x30, 0xe6, 0x97, 0xb6, 0xe4, 0xbd, 0xbf, 0xe7, 0x94,
+	0xa8, 0xe6, 0x9c, 0x80, 0xe6, 0x96, 0xb0, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x0f, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33,
+	0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x16, 0x92, 0x41, 0x13, 0x32, 0x11, 0xe7, 0xbb, 0x91, 0xe5, 0xae, 0x9a, 0xe7, 0x9a,
+	0x84, 0xe6, 0xa8, 0xa1, 0xe5, 0xbc, 0x8f, 0x69, 0x64, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x49, 0x64, 0x12, 0x53, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x42, 0x2c, 0x92, 0x41, 0x29,
+	0x32, 0x27, 0xe6, 0xa8, 0xa1, 0xe5, 0xbc, 0x8f, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x2c, 0x20,
+	0xe4, 0xb8, 0xba, 0x30, 0xe6, 0x97, 0xb6, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe6, 0x9c, 0x80,
+	0xe6, 0x96, 0xb0, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xef, 0x03, 0x0a, 0x13, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41,
+	0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92,
+	0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9d, 0xa5,
+	0xe6, 0xba, 0x90, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41,
+	0x0a, 0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x12, 0x2e, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0xa8,
+	0xa1, 0xe7, 0x89, 0x88, 0x69, 0x64, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x49, 0x64, 0x12, 0x2d, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe6, 0x8f,
+	0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x57, 0x0a, 0x10, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x2c, 0x92, 0x41, 0x29,
+	0x32, 0x27, 0xe6, 0xa8, 0xa1, 0xe7, 0x89, 0x88, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x2c, 0x20,
+	0xe4, 0xb8, 0xba, 0x30, 0xe6, 0x97, 0xb6, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe6, 0x9c, 0x80,
+	0xe6, 0x96, 0xb0, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x0f, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x15, 0x92, 0x41, 0x12, 0x32, 0x10, 0x70, 0x72, 0x6f,
-	0x70, 0x65, 0x72, 0x74, 0x79, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x07, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0xeb, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x45, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e,
-	0x92, 0x41, 0x0b, 0x32, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x69, 0x64, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x74, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52, 0x05,
-	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x79, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41,
-	0x15, 0x32, 0x13, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
-	0x74, 0x79, 0x20, 0x69, 0x64, 0x73, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
-	0x4b, 0x65, 0x79, 0x73, 0x22, 0xee, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x69, 0x64, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x74,
-	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x26,
-	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e,
-	0x92, 0x41, 0x0b, 0x32, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x69, 0x64, 0x52, 0x06,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
-	0x74, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92,
-	0x41, 0x15, 0x32, 0x13, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x70, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x79, 0x20, 0x69, 0x64, 0x73, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x79, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x06, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72,
-	0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41,
-	0x0b, 0x32, 0x09, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x24, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10,
-	0x92, 0x41, 0x0d, 0x32, 0x0b, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x20, 0x6e, 0x61, 0x6d, 0x65,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x03, 0x74, 0x71, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72,
-	0x20, 0x74, 0x71, 0x6c, 0x20, 0x74, 0x65, 0x78, 0x74, 0x52, 0x03, 0x74, 0x71, 0x6c, 0x12, 0x39,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72,
-	0x20, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xfb, 0x01, 0x0a, 0x13, 0x41, 0x70,
-	0x70, 0x65, 0x6e, 0x64, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x74, 0x79, 0x70,
-	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x20, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
-	0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d,
-	0x92, 0x41, 0x0a, 0x32, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52, 0x05, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x20, 0x69, 0x64, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49,
-	0x64, 0x12, 0x44, 0x0a, 0x06, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e,
+	0xe4, 0xbd, 0x93, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x11,
+	0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe9, 0x85, 0x8d, 0xe7, 0xbd,
+	0xae, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0xa4, 0x01, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9d, 0xa5, 0xe6,
+	0xba, 0x90, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x22, 0xa7, 0x01, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4,
+	0xbd, 0x93, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e,
+	0xe4, 0xbd, 0x93, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x25, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9d, 0xa5, 0xe6, 0xba, 0x90, 0x69, 0x64, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6,
+	0x88, 0xb7, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x60, 0x0a, 0x14, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7,
+	0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa8, 0x01,
+	0x0a, 0x14, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69,
+	0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93,
+	0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41,
+	0x0a, 0x32, 0x08, 0xe6, 0x9d, 0xa5, 0xe6, 0xba, 0x90, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69,
+	0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0xa6, 0x01, 0x0a, 0x12, 0x50, 0x75, 0x72,
+	0x67, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9d, 0xa5, 0xe6,
+	0xba, 0x90, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x22, 0x5f, 0x0a, 0x13, 0x50, 0x75, 0x72, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd,
+	0x93, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x93,
+	0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0xf7, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9d, 0xa5, 0xe6,
+	0xba, 0x90, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x49, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x11, 0x92,
+	0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe5, 0xb1, 0x9e, 0xe6, 0x80, 0xa7,
+	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0xf6, 0x01, 0x0a,
+	0x17, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x70,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd,
+	0x93, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9d,
+	0xa5, 0xe6, 0xba, 0x90, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92,
+	0x41, 0x0a, 0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0xb1,
+	0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e,
+	0xe4, 0xbd, 0x93, 0xe5, 0xb1, 0x9e, 0xe6, 0x80, 0xa7, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0xfb, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d,
+	0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9d, 0xa5, 0xe6, 0xba, 0x90, 0x69, 0x64, 0x52, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88,
+	0xb7, 0x69, 0x64, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x25,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x79, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0x92, 0x41,
+	0x24, 0x32, 0x22, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe5, 0xb1, 0x9e, 0xe6, 0x80, 0xa7, 0xe5,
+	0xad, 0x97, 0xe6, 0xae, 0xb5, 0x2c, 0x20, 0x65, 0x67, 0x3a, 0x20, 0x70, 0x69, 0x64, 0x73, 0x3d,
+	0x31, 0x2c, 0x32, 0x2c, 0x33, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4b,
+	0x65, 0x79, 0x73, 0x22, 0xea, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41,
+	0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92,
+	0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6,
+	0x88, 0xb7, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe6, 0x9d, 0xa5, 0xe6, 0xba, 0x90, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x5f, 0x6b,
+	0x65, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12,
+	0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe5, 0xb1, 0x9e, 0xe6, 0x80, 0xa7, 0xe5, 0xad, 0x97, 0xe6,
+	0xae, 0xb5, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x73,
+	0x22, 0xf3, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88,
+	0xb7, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32,
+	0x08, 0xe6, 0x9d, 0xa5, 0xe6, 0xba, 0x90, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x43, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32,
+	0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0xf2, 0x01, 0x0a, 0x19, 0x50, 0x61, 0x74, 0x63, 0x68,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0xb1,
+	0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08,
+	0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12,
+	0x25, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9d, 0xa5, 0xe6, 0xba, 0x90, 0x69, 0x64, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42,
+	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe9, 0x85, 0x8d, 0xe7,
+	0xbd, 0xae, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0xe9, 0x01, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93,
+	0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd,
+	0x93, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92,
+	0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9d, 0xa5, 0xe6, 0xba, 0x90, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7,
+	0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x0d, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe5, 0xb1, 0x9e,
+	0xe6, 0x80, 0xa7, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69,
+	0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93,
+	0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9d, 0xa5, 0xe6, 0xba, 0x90, 0x69, 0x64,
+	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe5, 0xb1, 0x9e, 0xe6,
+	0x80, 0xa7, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x79, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x06, 0x4d, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92,
+	0x41, 0x0a, 0x32, 0x08, 0xe6, 0x98, 0xa0, 0xe5, 0xb0, 0x84, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11,
+	0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x98, 0xa0, 0xe5, 0xb0, 0x84, 0xe5, 0x90, 0x8d, 0xe7, 0xa7,
+	0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x03, 0x74, 0x71, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe6, 0x98, 0xa0, 0xe5, 0xb0,
+	0x84, 0x74, 0x71, 0x6c, 0xe8, 0xaf, 0xad, 0xe5, 0x8f, 0xa5, 0x52, 0x03, 0x74, 0x71, 0x6c, 0x12,
+	0x33, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x98, 0xa0, 0xe5, 0xb0,
+	0x84, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf4, 0x01, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x4d,
+	0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32,
+	0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9d, 0xa5, 0xe6, 0xba, 0x90,
+	0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08,
+	0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12,
+	0x2a, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69,
+	0x64, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x6d,
+	0x61, 0x70, 0x70, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x98, 0xa0, 0xe5, 0xb0, 0x84, 0xe4, 0xbf, 0xa1,
+	0xe6, 0x81, 0xaf, 0x52, 0x06, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x22, 0xd0, 0x01, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41,
+	0x0a, 0x32, 0x08, 0xe6, 0x98, 0xa0, 0xe5, 0xb0, 0x84, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92,
+	0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6,
+	0x88, 0xb7, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe6, 0x9d, 0xa5, 0xe6, 0xba, 0x90, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4,
+	0xbd, 0x93, 0x69, 0x64, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x22, 0xb2,
+	0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7,
+	0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe6, 0x9d, 0xa5, 0xe6, 0xba, 0x90, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64,
+	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32,
+	0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x49, 0x64, 0x22, 0xd3, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61,
+	0x70, 0x70, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x98,
+	0xa0, 0xe5, 0xb0, 0x84, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5,
+	0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x52,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9d, 0xa5,
+	0xe6, 0xba, 0x90, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2a, 0x0a,
+	0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0x52,
+	0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x22, 0xf5, 0x01, 0x0a, 0x14, 0x41, 0x70,
+	0x70, 0x65, 0x6e, 0x64, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0xb1, 0xbb,
+	0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7,
+	0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x25,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d,
+	0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9d, 0xa5, 0xe6, 0xba, 0x90, 0x69, 0x64, 0x52, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5,
+	0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49,
+	0x64, 0x12, 0x3e, 0x0a, 0x06, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0x6d, 0x61, 0x70,
-	0x70, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x06, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x22, 0xd2, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d,
-	0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x6d,
-	0x61, 0x70, 0x70, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32,
-	0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x74, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20, 0x69, 0x64,
-	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x20, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
-	0x2b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20,
-	0x69, 0x64, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x22, 0xb3, 0x01, 0x0a,
-	0x11, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x74, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x98, 0xa0,
+	0xe5, 0xb0, 0x84, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x06, 0x6d, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x22, 0xd4, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x98, 0xa0,
+	0xe5, 0xb0, 0x84, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae,
+	0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
 	0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52, 0x05,
-	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x69, 0x64, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x49, 0x64, 0x22, 0xd5, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x70,
-	0x70, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x6d, 0x61, 0x70,
-	0x70, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x74, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52, 0x05,
-	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x20, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2b, 0x0a,
-	0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x69, 0x64,
-	0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x22, 0xfc, 0x01, 0x0a, 0x14, 0x41,
-	0x70, 0x70, 0x65, 0x6e, 0x64, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x74,
-	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x26,
-	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e,
-	0x92, 0x41, 0x0b, 0x32, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x69, 0x64, 0x52, 0x06,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x69, 0x64, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x06, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0x6d,
-	0x61, 0x70, 0x70, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x06, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x22, 0xd6, 0x01, 0x0a, 0x14, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e,
-	0x92, 0x41, 0x0b, 0x32, 0x09, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x74, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x26, 0x0a,
-	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92,
-	0x41, 0x0b, 0x32, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x69, 0x64, 0x52, 0x06, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
-	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x69, 0x64, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x49, 0x64, 0x22, 0xf9, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x20, 0x74, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x26,
-	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e,
-	0x92, 0x41, 0x0b, 0x32, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x69, 0x64, 0x52, 0x06,
+	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9d, 0xa5, 0xe6,
+	0xba, 0x90, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x09,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0x52, 0x08,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x22, 0xf2, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9d, 0xa5, 0xe6,
+	0xba, 0x90, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x2a, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd,
+	0x93, 0x69, 0x64, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x3e, 0x0a,
+	0x06, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x98, 0xa0, 0xe5, 0xb0, 0x84, 0xe4,
+	0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x06, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x22, 0xf5, 0x01,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7,
+	0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe6, 0x9d, 0xa5, 0xe6, 0xba, 0x90, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64,
+	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32,
+	0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x07, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
+	0xe6, 0x98, 0xa0, 0xe5, 0xb0, 0x84, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x61,
+	0x70, 0x70, 0x65, 0x72, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe7, 0x9b, 0xae, 0xe6, 0xa0, 0x87, 0xe5,
+	0xb1, 0x9e, 0xe6, 0x80, 0xa7, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f,
+	0xe8, 0xa1, 0xa8, 0xe8, 0xbe, 0xbe, 0xe5, 0xbc, 0x8f, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09,
+	0xe8, 0xa1, 0xa8, 0xe8, 0xbe, 0xbe, 0xe5, 0xbc, 0x8f, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32,
+	0x0f, 0xe8, 0xa1, 0xa8, 0xe8, 0xbe, 0xbe, 0xe5, 0xbc, 0x8f, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x48, 0x0a,
+	0x0b, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x0b,
+	0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xdf, 0x01, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x65,
+	0x6e, 0x64, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12,
+	0x25, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9d, 0xa5, 0xe6, 0xba, 0x90, 0x69, 0x64, 0x52, 0x06,
 	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x6f, 0x77, 0x6e, 0x65,
-	0x72, 0x20, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x09, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e,
-	0x92, 0x41, 0x0b, 0x32, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x69, 0x64, 0x52, 0x08,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x06, 0x6d, 0x61, 0x70, 0x70,
-	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x42, 0x17, 0x92,
-	0x41, 0x14, 0x32, 0x12, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x22, 0xfd,
-	0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x20, 0x74, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b,
-	0x32, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20, 0x69,
-	0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b,
-	0x32, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x69, 0x64, 0x52, 0x08, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x07, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x42, 0x18, 0x92, 0x41, 0x15,
-	0x32, 0x13, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x22, 0xda,
-	0x01, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0x92, 0x41, 0x16,
-	0x32, 0x14, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x79, 0x20, 0x70, 0x61, 0x74, 0x68, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32,
-	0x0f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x6e, 0x61, 0x6d, 0x65,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32,
-	0x0f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x65, 0x78, 0x74,
-	0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x1b, 0x92, 0x41, 0x18, 0x32, 0x16, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x20, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x48, 0x0a, 0x0b, 0x45,
-	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x65, 0x78,
-	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
-	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xe8, 0x01, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64,
-	0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a,
-	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92,
-	0x41, 0x0b, 0x32, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x69, 0x64, 0x52, 0x06, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x20, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x09, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92,
-	0x41, 0x0b, 0x32, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x69, 0x64, 0x52, 0x08, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x57, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x72, 0x65,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6,
+	0x88, 0xb7, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x09, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d,
+	0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0x52, 0x08, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x50, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x72, 0x65,
 	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61,
 	0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x1b, 0x92, 0x41, 0x18, 0x32, 0x16, 0x65, 0x78, 0x70,
-	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0xbb, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x2d, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x19, 0x92, 0x41, 0x16, 0x32, 0x14, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x20, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x20, 0x70, 0x61, 0x74, 0x68, 0x52, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20,
-	0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x12, 0x2b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x20, 0x69, 0x64, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x22, 0x8d,
-	0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x20, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x05,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe8, 0xa1, 0xa8,
+	0xe8, 0xbe, 0xbe, 0xe5, 0xbc, 0x8f, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x0b, 0x65, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x25,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe7, 0x9b, 0xae, 0xe6, 0xa0, 0x87, 0xe5, 0xb1, 0x9e, 0xe6, 0x80, 0xa7, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88,
+	0xb7, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32,
+	0x08, 0xe6, 0x9d, 0xa5, 0xe6, 0xba, 0x90, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x2a, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd,
+	0x93, 0x69, 0x64, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x22, 0x8b, 0x01,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9d, 0xa5, 0xe6, 0xba, 0x90,
+	0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08,
+	0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12,
+	0x2a, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69,
+	0x64, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x22, 0xb6, 0x01, 0x0a, 0x13,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe7, 0x9b, 0xae, 0xe6, 0xa0, 0x87, 0xe5,
+	0xb1, 0x9e, 0xe6, 0x80, 0xa7, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x23, 0x0a, 0x05,
 	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
-	0x32, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
-	0x72, 0x12, 0x2b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x20, 0x69, 0x64, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x22, 0xc2,
-	0x01, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x31, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0x92, 0x41, 0x18, 0x32, 0x16, 0x65, 0x78, 0x70, 0x72,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x20, 0x70, 0x61,
-	0x74, 0x68, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x26,
-	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e,
-	0x92, 0x41, 0x0b, 0x32, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x69, 0x64, 0x52, 0x06,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x69, 0x64, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x49, 0x64, 0x22, 0x91, 0x01, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x45, 0x78,
-	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x23, 0x0a, 0x05,
-	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
-	0x32, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
-	0x72, 0x12, 0x2b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x20, 0x69, 0x64, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x27,
+	0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9d, 0xa5, 0xe6, 0xba, 0x90, 0x69, 0x64,
+	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x49, 0x64, 0x22, 0x90, 0x01, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x45,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x23, 0x0a,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41,
+	0x0a, 0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x12, 0x2a, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4,
+	0xbd, 0x93, 0x69, 0x64, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x27,
 	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0x92,
-	0x41, 0x0e, 0x32, 0x0c, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f,
+	0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f,
 	0x76, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
 	0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52, 0x05,
-	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x69, 0x64, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x49, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x20, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xbb, 0x01, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52,
-	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x69, 0x64, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x49, 0x64, 0x12, 0x54, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x42, 0x1b, 0x92, 0x41, 0x18, 0x32, 0x16, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x20, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65,
-	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xbf, 0x01, 0x0a, 0x12, 0x4c, 0x69,
-	0x73, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5,
+	0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49,
+	0x64, 0x12, 0x27, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0xe6, 0x95, 0xb0,
+	0xe9, 0x87, 0x8f, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb3, 0x01, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
 	0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52, 0x05,
-	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x69, 0x64, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x49, 0x64, 0x12, 0x57, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x42, 0x1c, 0x92, 0x41, 0x19, 0x32, 0x17, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x20, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b,
-	0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xfe, 0x03, 0x0a, 0x11,
-	0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x69,
-	0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x20, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x29,
-	0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0x92,
-	0x41, 0x10, 0x32, 0x0e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x6b, 0x65, 0x79, 0x77, 0x6f,
-	0x72, 0x64, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x52, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
-	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x16, 0x92, 0x41, 0x13, 0x32,
-	0x11, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a,
-	0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x42,
-	0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe8, 0xae, 0xb0, 0xe5, 0xbd, 0x95, 0xe5, 0xbc, 0x80, 0xe5,
-	0xa7, 0x8b, 0xe4, 0xbd, 0x8d, 0xe7, 0xbd, 0xae, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75,
-	0x6d, 0x12, 0x34, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x05, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1,
-	0xb5, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x9d, 0xa1, 0xe6, 0x95, 0xb0, 0x52, 0x08, 0x70,
-	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x5f, 0x62, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
-	0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x07, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x59, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x73, 0x63,
-	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x42, 0x34, 0x92, 0x41,
-	0x31, 0x32, 0x2f, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe9, 0x80, 0x86, 0xe5, 0xba, 0x8f, 0xef,
-	0xbc, 0x8c, 0x20, 0x66, 0x61, 0x6c, 0x73, 0x65, 0xef, 0xbc, 0x9a, 0xe4, 0xb8, 0x8d, 0xe9, 0x80,
-	0x86, 0xe5, 0xba, 0x8f, 0xef, 0xbc, 0x8c, 0x74, 0x72, 0x75, 0x65, 0x3a, 0xe9, 0x80, 0x86, 0xe5,
-	0xba, 0x8f, 0x52, 0x0c, 0x69, 0x73, 0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x3a, 0x2a, 0x92, 0x41, 0x27, 0x0a, 0x25, 0x2a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74,
-	0x69, 0x74, 0x69, 0x65, 0x73, 0x32, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x45, 0x6e, 0x74, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x20, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xfb, 0x01, 0x0a,
+	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5,
+	0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49,
+	0x64, 0x12, 0x4d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x14,
+	0x92, 0x41, 0x11, 0x32, 0x0f, 0xe8, 0xa1, 0xa8, 0xe8, 0xbe, 0xbe, 0xe5, 0xbc, 0x8f, 0xe4, 0xbf,
+	0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0xb6, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0x94, 0xa8,
+	0xe6, 0x88, 0xb7, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x09,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0x52, 0x08,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x4f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe8, 0xa1, 0xa8,
+	0xe8, 0xbe, 0xbe, 0xe5, 0xbc, 0x8f, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x0b, 0x65, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xfc, 0x01, 0x0a, 0x15, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9d, 0xa5, 0xe6, 0xba, 0x90,
+	0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08,
+	0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12,
+	0x38, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1b, 0x92, 0x41, 0x18, 0x32, 0x16, 0xe7, 0x9b, 0xae, 0xe6, 0xa0, 0x87, 0xe5,
+	0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0x28, 0xe5, 0x8f, 0xaf, 0xe9, 0x80, 0x89, 0x29, 0x52,
+	0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0x92, 0x41, 0x16, 0x32, 0x14, 0xe7, 0x9b,
+	0xae, 0xe6, 0xa0, 0x87, 0xe5, 0xb1, 0x9e, 0xe6, 0x80, 0xa7, 0x28, 0xe5, 0x8f, 0xaf, 0xe9, 0x80,
+	0x89, 0x29, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41,
+	0x0b, 0x32, 0x09, 0xe8, 0xa1, 0xa8, 0xe8, 0xbe, 0xbe, 0xe5, 0xbc, 0x8f, 0x52, 0x0a, 0x65, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x88, 0x03, 0x0a, 0x16, 0x45, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69,
+	0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0x92, 0x41, 0x10,
+	0x32, 0x0e, 0xe7, 0x9b, 0xae, 0xe6, 0xa0, 0x87, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64,
+	0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe7,
+	0x9b, 0xae, 0xe6, 0xa0, 0x87, 0xe5, 0xb1, 0x9e, 0xe6, 0x80, 0xa7, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x3d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe9, 0xa2, 0x84, 0xe5,
+	0xa4, 0x84, 0xe7, 0x90, 0x86, 0xe5, 0x90, 0x8e, 0xe7, 0x9a, 0x84, 0xe8, 0xa1, 0xa8, 0xe8, 0xbe,
+	0xbe, 0xe5, 0xbc, 0x8f, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x3f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae,
+	0xa1, 0xe7, 0xae, 0x97, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x47, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12,
+	0xe8, 0xa7, 0xa3, 0xe6, 0x9e, 0x90, 0xe5, 0x88, 0xb0, 0xe7, 0x9a, 0x84, 0xe8, 0xbe, 0x93, 0xe5,
+	0x85, 0xa5, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
+	0xe8, 0xae, 0xa1, 0xe7, 0xae, 0x97, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0xf6, 0x03, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08,
+	0xe6, 0x9d, 0xa5, 0xe6, 0xba, 0x90, 0x69, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe6, 0x90, 0x9c, 0xe7, 0xb4,
+	0xa2, 0xe5, 0x85, 0xb3, 0xe9, 0x94, 0xae, 0xe8, 0xaf, 0x8d, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x4d, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x90, 0x9c, 0xe7, 0xb4, 0xa2, 0xe6,
+	0x9d, 0xa1, 0xe4, 0xbb, 0xb6, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x32, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe8, 0xae, 0xb0, 0xe5, 0xbd, 0x95, 0xe5,
+	0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0xe4, 0xbd, 0x8d, 0xe7, 0xbd, 0xae, 0x52, 0x07, 0x70, 0x61, 0x67,
+	0x65, 0x4e, 0x75, 0x6d, 0x12, 0x34, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe6, 0xaf,
+	0x8f, 0xe9, 0xa1, 0xb5, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x9d, 0xa1, 0xe6, 0x95, 0xb0,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x59, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x64,
+	0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x34, 0x92, 0x41, 0x31, 0x32, 0x2f, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe9, 0x80, 0x86, 0xe5,
+	0xba, 0x8f, 0xef, 0xbc, 0x8c, 0x20, 0x66, 0x61, 0x6c, 0x73, 0x65, 0xef, 0xbc, 0x9a, 0xe4, 0xb8,
+	0x8d, 0xe9, 0x80, 0x86, 0xe5, 0xba, 0x8f, 0xef, 0xbc, 0x8c, 0x74, 0x72, 0x75, 0x65, 0x3a, 0xe9,
+	0x80, 0x86, 0xe5, 0xba, 0x8f, 0x52, 0x0c, 0x69, 0x73, 0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x3a, 0x27, 0x92, 0x41, 0x24, 0x0a, 0x22, 0x2a, 0x0c, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x32, 0x12, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96,
+	0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x22, 0xed, 0x01, 0x0a,
 	0x12, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x20, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x08, 0x70,
-	0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x17, 0x92,
-	0x41, 0x14, 0x32, 0x12, 0xe8, 0xae, 0xb0, 0xe5, 0xbd, 0x95, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b,
-	0xe4, 0xbd, 0x8d, 0xe7, 0xbd, 0xae, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12,
-	0x34, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1, 0xb5, 0xe9,
-	0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x9d, 0xa1, 0xe6, 0x95, 0xb0, 0x52, 0x08, 0x70, 0x61, 0x67,
-	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x43, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe6,
+	0x80, 0xbb, 0xe6, 0x95, 0xb0, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x17,
+	0x92, 0x41, 0x14, 0x32, 0x12, 0xe8, 0xae, 0xb0, 0xe5, 0xbd, 0x95, 0xe5, 0xbc, 0x80, 0xe5, 0xa7,
+	0x8b, 0xe4, 0xbd, 0x8d, 0xe7, 0xbd, 0xae, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d,
+	0x12, 0x34, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1, 0xb5,
+	0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0xe6, 0x9d, 0xa1, 0xe6, 0x95, 0xb0, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x44, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe5,
+	0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xfa, 0x01, 0x0a,
+	0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d,
+	0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9d, 0xa5, 0xe6, 0xba, 0x90, 0x69, 0x64, 0x52, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88,
+	0xb7, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5,
+	0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x56, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x1e, 0x92,
+	0x41, 0x1b, 0x32, 0x19, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe5, 0xb1, 0x9e, 0xe6, 0x80, 0xa7,
+	0x20, 0x70, 0x61, 0x74, 0x63, 0x68, 0x20, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x0a, 0x70,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x6b, 0x0a, 0x17, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x50, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe4, 0xba, 0x8b, 0xe5, 0x8a, 0xa1,
+	0xe4, 0xb8, 0xad, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x52, 0x08, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0xd4, 0x02, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xba, 0x8b, 0xe5, 0x8a, 0xa1, 0x69, 0x64, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x4a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x32, 0x92, 0x41, 0x2f, 0x32, 0x2d, 0xe4, 0xba, 0x8b, 0xe5, 0x8a, 0xa1, 0xe7,
+	0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x2c, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64,
+	0xe3, 0x80, 0x81, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x20, 0xe6, 0x88, 0x96, 0x20, 0x75,
+	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x47,
+	0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0x92, 0x41, 0x1f, 0x32, 0x1d, 0xe5, 0xaf, 0xbc, 0xe8,
+	0x87, 0xb4, 0xe4, 0xba, 0x8b, 0xe5, 0x8a, 0xa1, 0xe4, 0xb8, 0xad, 0xe6, 0xad, 0xa2, 0xe7, 0x9a,
+	0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe4, 0xba,
+	0x8b, 0xe5, 0x8a, 0xa1, 0xe4, 0xb8, 0xad, 0xe6, 0xad, 0xa2, 0xe7, 0x9a, 0x84, 0xe5, 0x8e, 0x9f,
+	0xe5, 0x9b, 0xa0, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x50, 0x0a, 0x08, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32,
+	0x12, 0xe6, 0x8f, 0x90, 0xe4, 0xba, 0xa4, 0xe5, 0x90, 0x8e, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e,
+	0xe4, 0xbd, 0x93, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0xca, 0x06,
+	0x0a, 0x0e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41,
+	0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x25, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9d, 0xa5, 0xe6, 0xba, 0x90, 0x69, 0x64, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0x94, 0xa8, 0xe6,
+	0x88, 0xb7, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
+	0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93,
+	0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x34, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe4, 0xb8, 0x8a, 0xe6, 0xac, 0xa1, 0xe4,
+	0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x08, 0x6c, 0x61, 0x73,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32,
+	0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe6, 0xa8, 0xa1, 0xe7, 0x89, 0x88, 0x52, 0x0a, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x10, 0x74, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93,
+	0xe6, 0xa8, 0xa1, 0xe7, 0x89, 0x88, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x0f, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe6,
+	0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x07, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5,
+	0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe6, 0x98, 0xa0, 0xe5, 0xb0, 0x84, 0x52, 0x07, 0x6d, 0x61, 0x70,
+	0x70, 0x65, 0x72, 0x73, 0x12, 0x43, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x11, 0x92,
+	0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x49, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe4,
+	0xbd, 0x93, 0xe5, 0xb1, 0x9e, 0xe6, 0x80, 0xa7, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0x92, 0x41, 0x2a, 0x32, 0x28, 0xe5,
+	0xbc, 0x82, 0xe6, 0xad, 0xa5, 0xe5, 0x86, 0x99, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0x69, 0x64,
+	0x2c, 0x20, 0xe5, 0x8f, 0xaf, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1,
+	0x82, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x33, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0x92, 0x41, 0x13, 0x32, 0x11, 0xe7, 0xbb, 0x91, 0xe5,
+	0xae, 0x9a, 0xe7, 0x9a, 0x84, 0xe6, 0xa8, 0xa1, 0xe5, 0xbc, 0x8f, 0x69, 0x64, 0x52, 0x08, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe7, 0xbb, 0x91, 0xe5, 0xae, 0x9a, 0xe7, 0x9a, 0x84, 0xe6,
+	0xa8, 0xa1, 0xe5, 0xbc, 0x8f, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x0d, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0xad, 0x28, 0x0a, 0x06, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0xa0, 0x01, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x92, 0x41, 0x31, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93,
+	0x2a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4a, 0x0b,
+	0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x17, 0x22, 0x09, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x3a, 0x0a, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x9c, 0x01, 0x0a, 0x0c, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x92, 0x41, 0x31, 0x0a, 0x06, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x0c, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe5, 0xae, 0x9e,
+	0xe4, 0xbd, 0x93, 0x2a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x13, 0x1a, 0x0e, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x96, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
 	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x6c,
-	0x69, 0x73, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xdf, 0x04, 0x0a, 0x0e, 0x45,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a,
-	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92,
-	0x41, 0x0b, 0x32, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x69, 0x64, 0x52, 0x06, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x20, 0x69, 0x64, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x74, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x2d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x3e, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x03, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x32, 0x1c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20,
-	0x6c, 0x61, 0x73, 0x74, 0x20, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x20, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x35, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x20, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0x92, 0x41, 0x14,
-	0x32, 0x12, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x42, 0x0a, 0x07, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x20, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x52, 0x07, 0x6d, 0x61,
-	0x70, 0x70, 0x65, 0x72, 0x73, 0x12, 0x45, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x13,
-	0x92, 0x41, 0x10, 0x32, 0x0e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x73, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x4e, 0x0a, 0x0a,
-	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x16, 0x92, 0x41, 0x13, 0x32, 0x11, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
-	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x32, 0x9b, 0x22, 0x0a,
-	0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0xa3, 0x01, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x22,
-	0x09, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x3a, 0x0a, 0x70, 0x72, 0x6f, 0x70,
-	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x92, 0x41, 0x34, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x12, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x61, 0x20, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x2a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0x9d, 0x01,
-	0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x20,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x13, 0x1a, 0x0e, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
-	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x32, 0x0a, 0x06, 0x45, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x12, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x45, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x2a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0x90, 0x01,
-	0x0a, 0x09, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12,
-	0x0e, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92,
-	0x41, 0x2e, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x0c, 0x47, 0x65, 0x74, 0x20,
-	0x61, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2a, 0x09, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b,
-	0x12, 0xa2, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x65, 0x22, 0x4d, 0x92, 0x41, 0x34, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x12,
+	0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe8, 0xaf, 0xa6, 0xe6,
+	0x83, 0x85, 0x2a, 0x09, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4a, 0x0b, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10,
+	0x12, 0x0e, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x12, 0x9f, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
 	0x79, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
 	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
 	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x2a, 0x0e,
-	0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41,
-	0x34, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x20, 0x61, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2a, 0x0c, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12,
-	0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xcb, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a, 0x92, 0x41, 0x31, 0x0a, 0x06, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x0c, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe5, 0xae, 0x9e, 0xe4, 0xbd,
+	0x93, 0x2a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4a,
+	0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x10, 0x2a, 0x0e, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x12, 0xad, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x92, 0x41, 0x3b, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x15, 0xe6, 0x81, 0xa2, 0xe5, 0xa4, 0x8d, 0xe5, 0xb7, 0xb2, 0xe5, 0x88, 0xa0, 0xe9,
+	0x99, 0xa4, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x2a, 0x0d, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04,
+	0x0a, 0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x16, 0x2f, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x12, 0xb0, 0x01, 0x0a, 0x0b, 0x50, 0x75, 0x72, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e, 0x92, 0x41, 0x3f, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x1b, 0xe5, 0xbd, 0xbb, 0xe5, 0xba, 0x95, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4,
+	0xe5, 0xb7, 0xb2, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x2a,
+	0x0b, 0x50, 0x75, 0x72, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4a, 0x0b, 0x0a, 0x03,
+	0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x2a,
+	0x14, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f,
+	0x70, 0x75, 0x72, 0x67, 0x65, 0x12, 0xcd, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
 	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x25, 0x2e, 0x61, 0x70,
 	0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
 	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
 	0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x1a, 0x19, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x3a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x92, 0x41,
-	0x42, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x69, 0x65, 0x73, 0x2a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a,
-	0x02, 0x4f, 0x4b, 0x12, 0xbc, 0x01, 0x0a, 0x10, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1c, 0x32, 0x0e, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b,
-	0x69, 0x64, 0x7d, 0x3a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x92,
-	0x41, 0x40, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x17, 0x50, 0x61, 0x74, 0x63,
-	0x68, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x69, 0x65, 0x73, 0x2a, 0x10, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x50, 0x72, 0x6f, 0x70, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02,
-	0x4f, 0x4b, 0x12, 0xc4, 0x01, 0x0a, 0x11, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x5a, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6c, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x22, 0x1a, 0x14, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b,
-	0x69, 0x64, 0x7d, 0x2f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x3a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x69, 0x65, 0x73, 0x92, 0x41, 0x41, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x12, 0x17, 0x50, 0x61, 0x74, 0x63, 0x68, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x70,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x2a, 0x11, 0x50, 0x61, 0x74, 0x63, 0x68,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x5a, 0x4a, 0x0b, 0x0a, 0x03,
-	0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xb3, 0x01, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x22, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e,
+	0x74, 0x92, 0x41, 0x44, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0xe6, 0x9b,
+	0xb4, 0xe6, 0x96, 0xb0, 0x28, 0xe6, 0x8f, 0x92, 0xe5, 0x85, 0xa5, 0x29, 0xe5, 0xae, 0x9e, 0xe4,
+	0xbd, 0x93, 0xe5, 0xb1, 0x9e, 0xe6, 0x80, 0xa7, 0x2a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32,
+	0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x1a, 0x19,
+	0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x70,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x3a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0xbd, 0x01, 0x0a, 0x10, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e,
 	0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x60, 0x82,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x66, 0x92,
+	0x41, 0x41, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0xe6, 0x89, 0xb9, 0xe9,
+	0x87, 0x8f, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe5, 0xb1,
+	0x9e, 0xe6, 0x80, 0xa7, 0x2a, 0x10, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a,
+	0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x32, 0x0e, 0x2f, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0xc5, 0x01, 0x0a, 0x11, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x5a, 0x12, 0x24, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6d,
+	0x92, 0x41, 0x42, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0xe6, 0x89, 0xb9,
+	0xe9, 0x87, 0x8f, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe5,
+	0xb1, 0x9e, 0xe6, 0x80, 0xa7, 0x2a, 0x11, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x5a, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12,
+	0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x1a, 0x14, 0x2f, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x61, 0x74, 0x63,
+	0x68, 0x3a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0xb0, 0x01,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73,
+	0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x5d, 0x92, 0x41, 0x39, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x12,
+	0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe5, 0xb1, 0x9e, 0xe6,
+	0x80, 0xa7, 0x2a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f,
+	0x70, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x82,
 	0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
 	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
-	0x92, 0x41, 0x3c, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x15, 0x47, 0x65, 0x74,
-	0x20, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x2a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f,
-	0x70, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12,
-	0xbf, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x50, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x66, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1b, 0x2a, 0x19, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64,
-	0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x92, 0x41, 0x42, 0x0a,
-	0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x20,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
-	0x73, 0x2a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50,
+	0x12, 0xbf, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x66, 0x92, 0x41, 0x42, 0x0a,
+	0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe5,
+	0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe5, 0xb1, 0x9e, 0xe6, 0x80,
+	0xa7, 0x2a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50,
 	0x72, 0x6f, 0x70, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f,
-	0x4b, 0x12, 0xc9, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x6c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x1a, 0x16, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x3a,
-	0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x92, 0x41, 0x42, 0x0a, 0x06, 0x45, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x12, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x2a, 0x13, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
-	0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xc4, 0x01,
-	0x0a, 0x12, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x73, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x21, 0x32, 0x16, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x2a, 0x19, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0xcd, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x70, 0x92, 0x41, 0x46, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0xe6,
+	0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0x28, 0xe6, 0x8f, 0x92, 0xe5, 0x85, 0xa5, 0x29, 0xe5, 0xae, 0x9e,
+	0xe4, 0xbd, 0x93, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x2a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x4a, 0x0b,
+	0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x21, 0x1a, 0x16, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64,
 	0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x3a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x73, 0x92, 0x41, 0x3f, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x70,
-	0x61, 0x74, 0x63, 0x68, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x73, 0x2a, 0x12, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04,
-	0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xcc, 0x01, 0x0a, 0x13, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x5a, 0x12, 0x26, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x70, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x1a, 0x1c, 0x2f, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x73, 0x2f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x3a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
-	0x92, 0x41, 0x40, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x70, 0x61, 0x74,
-	0x63, 0x68, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x73, 0x2a, 0x13, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f,
+	0x67, 0x73, 0x12, 0xc8, 0x01, 0x0a, 0x12, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6d,
+	0x92, 0x41, 0x43, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0xe6, 0x89, 0xb9,
+	0xe9, 0x87, 0x8f, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe9,
+	0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x2a, 0x12, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30,
+	0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x32, 0x16, 0x2f, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x73, 0x3a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0xd0, 0x01,
+	0x0a, 0x13, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x73, 0x5a, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x74, 0x92, 0x41, 0x44, 0x0a,
+	0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6,
+	0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe9, 0x85, 0x8d, 0xe7, 0xbd,
+	0xae, 0x2a, 0x13, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x73, 0x5a, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a,
-	0x02, 0x4f, 0x4b, 0x12, 0xbf, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x62, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x2a, 0x16, 0x2f, 0x65, 0x6e, 0x74, 0x69,
+	0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x1a, 0x1c, 0x2f, 0x65, 0x6e, 0x74, 0x69,
 	0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x73, 0x92, 0x41, 0x41, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x15, 0x72, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x73, 0x2a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12,
-	0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xb5, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74,
+	0x73, 0x2f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x3a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
+	0x12, 0xc2, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65,
+	0x92, 0x41, 0x44, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0xe6, 0x89, 0xb9,
+	0xe9, 0x87, 0x8f, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe9,
+	0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x2a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30,
+	0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x2a, 0x16, 0x2f,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0xb3, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74,
 	0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69,
 	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69,
 	0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
-	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x92, 0x41, 0x3d,
-	0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x71, 0x75, 0x65, 0x72, 0x79, 0x20,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2a, 0x10,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x92,
+	0x41, 0x3b, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x12, 0xe6, 0x9f, 0xa5, 0xe8,
+	0xaf, 0xa2, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x2a, 0x10,
 	0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
-	0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xca, 0x01,
-	0x0a, 0x0c, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x20,
+	0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0xc4, 0x01, 0x0a, 0x0c,
+	0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x6e,
+	0x64, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
 	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70,
-	0x65, 0x6e, 0x64, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x70, 0x70, 0x65, 0x6e, 0x64, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x75, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x22, 0x1d, 0x2f, 0x65, 0x6e,
+	0x65, 0x6e, 0x64, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x6f, 0x92, 0x41, 0x3f, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x0a, 0x06,
+	0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x12, 0xe6, 0xb7, 0xbb, 0xe5, 0x8a, 0xa0, 0xe5, 0xae,
+	0x9e, 0xe4, 0xbd, 0x93, 0xe6, 0x98, 0xa0, 0xe5, 0xb0, 0x84, 0x2a, 0x0c, 0x41, 0x70, 0x70, 0x65,
+	0x6e, 0x64, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12,
+	0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x22, 0x1d, 0x2f, 0x65, 0x6e,
 	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69,
 	0x64, 0x7d, 0x2f, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x3a, 0x06, 0x6d, 0x61, 0x70, 0x70,
-	0x65, 0x72, 0x92, 0x41, 0x45, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x0a, 0x06, 0x4d,
-	0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x18, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x20, 0x6d, 0x61,
-	0x70, 0x70, 0x65, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2a,
-	0x0c, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x4a, 0x0b, 0x0a,
-	0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xb3, 0x01, 0x0a, 0x09, 0x47,
-	0x65, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12,
-	0x22, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2f, 0x7b,
-	0x69, 0x64, 0x7d, 0x92, 0x41, 0x3a, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x0a, 0x06,
-	0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x10, 0x67, 0x65, 0x74, 0x20, 0x6d, 0x61, 0x70, 0x70,
-	0x65, 0x72, 0x20, 0x62, 0x79, 0x20, 0x69, 0x64, 0x2a, 0x09, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x70,
+	0x65, 0x72, 0x12, 0xb5, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x69, 0x92, 0x41, 0x3c, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x0a, 0x06, 0x4d, 0x61,
+	0x70, 0x70, 0x65, 0x72, 0x12, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0xae, 0x9e, 0xe4,
+	0xbd, 0x93, 0xe6, 0x98, 0xa0, 0xe5, 0xb0, 0x84, 0x2a, 0x09, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x70,
 	0x70, 0x65, 0x72, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b,
-	0x12, 0xae, 0x01, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12,
-	0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x5f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x61,
+	0x70, 0x70, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xba, 0x01, 0x0a, 0x0a, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6b, 0x92, 0x41, 0x43, 0x0a,
+	0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x0a, 0x06, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12,
+	0x18, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe6, 0x98, 0xa0,
+	0xe5, 0xb0, 0x84, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x2a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x61, 0x70, 0x70, 0x65, 0x72, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02,
+	0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74,
 	0x69, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
-	0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x92, 0x41, 0x37, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x0a, 0x06, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x0c, 0x6c, 0x69, 0x73, 0x74,
-	0x20, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61,
+	0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x12, 0xbc, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x70,
+	0x70, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d,
+	0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0x92,
+	0x41, 0x3f, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x0a, 0x06, 0x4d, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0x12, 0x12, 0xe7, 0xa7, 0xbb, 0xe9, 0x99, 0xa4, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93,
+	0xe6, 0x98, 0xa0, 0xe5, 0xb0, 0x84, 0x2a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61,
 	0x70, 0x70, 0x65, 0x72, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f,
-	0x4b, 0x12, 0xc4, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x70, 0x70,
-	0x65, 0x72, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x2a,
-	0x1d, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x92, 0x41,
-	0x47, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x0a, 0x06, 0x4d, 0x61, 0x70, 0x70, 0x65,
-	0x72, 0x12, 0x1a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x20, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72,
-	0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2a, 0x0c, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x4a, 0x0b, 0x0a, 0x03, 0x32,
-	0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xe5, 0x01, 0x0a, 0x10, 0x41, 0x70, 0x70,
-	0x65, 0x6e, 0x64, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e,
+	0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x2a, 0x1d, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d,
+	0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x12, 0xdd, 0x01, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x65, 0x6e,
+	0x64, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64,
+	0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e,
 	0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x65,
-	0x6e, 0x64, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a,
-	0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70,
-	0x70, 0x65, 0x6e, 0x64, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x22, 0x8b, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x22, 0x21, 0x2f, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69,
-	0x64, 0x7d, 0x2f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x0b,
-	0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x92, 0x41, 0x52, 0x0a, 0x06,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x1d, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x20, 0x65, 0x78, 0x70, 0x72, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x2a, 0x10, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x6e, 0x64, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x83, 0x01, 0x92, 0x41, 0x4a, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x0a, 0x0a,
+	0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0xe6, 0xb7, 0xbb, 0xe5,
+	0x8a, 0xa0, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe8, 0xa1, 0xa8, 0xe8, 0xbe, 0xbe, 0xe5, 0xbc,
+	0x8f, 0x2a, 0x10, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
 	0x69, 0x6f, 0x6e, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b,
-	0x12, 0xc9, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x79, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x65, 0x6e, 0x74, 0x69,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x22, 0x21, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x0b, 0x65, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xca, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x7a, 0x92, 0x41, 0x47, 0x0a, 0x06, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x15, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe8, 0xa1,
+	0xa8, 0xe8, 0xbe, 0xbe, 0xe5, 0xbc, 0x8f, 0x2a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a,
+	0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x65, 0x6e, 0x74, 0x69,
 	0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x7d,
 	0x2f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x70, 0x61,
-	0x74, 0x68, 0x7d, 0x92, 0x41, 0x46, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x0a, 0x0a,
-	0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x67, 0x65, 0x74, 0x20,
-	0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x62, 0x79, 0x20, 0x69, 0x64,
-	0x2a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4a,
-	0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xc1, 0x01, 0x0a,
-	0x0e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a,
-	0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x22, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x69, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
-	0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x92, 0x41, 0x42, 0x0a, 0x06,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x0f, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x2a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b,
-	0x12, 0xd7, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x78, 0x70, 0x72,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x7e, 0x82, 0xd3, 0xe4, 0x93,
+	0x74, 0x68, 0x7d, 0x12, 0xcd, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x7a, 0x92, 0x41, 0x4e, 0x0a, 0x06, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1b, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe8, 0xa1,
+	0xa8, 0xe8, 0xbe, 0xbe, 0xe5, 0xbc, 0x8f, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x2a, 0x0e, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4a, 0x0b, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23,
+	0x12, 0x21, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0xcf, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x76, 0x92,
+	0x41, 0x4a, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0xe7, 0xa7, 0xbb, 0xe9, 0x99, 0xa4, 0xe5, 0xae,
+	0x9e, 0xe4, 0xbd, 0x93, 0xe8, 0xa1, 0xa8, 0xe8, 0xbe, 0xbe, 0xe5, 0xbc, 0x8f, 0x2a, 0x10, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4a,
+	0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93,
 	0x02, 0x23, 0x2a, 0x21, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x65,
 	0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x92, 0x41, 0x52, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x0a, 0x0a, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x72, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x20, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20,
-	0x66, 0x72, 0x6f, 0x6d, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2a, 0x10, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4a, 0x0b, 0x0a,
-	0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0x9d, 0x01, 0x0a, 0x0a, 0x4c,
-	0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x15, 0x22, 0x10, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x30, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x12, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
-	0x73, 0x2a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4a, 0x0b, 0x0a,
-	0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x42, 0x38, 0x0a, 0x0b, 0x61, 0x70,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xcb, 0x01, 0x0a, 0x12, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x6c, 0x92, 0x41, 0x49, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0xe8, 0xaf, 0x95, 0xe8, 0xbf, 0x90, 0xe8, 0xa1, 0x8c, 0xe8, 0xa1, 0xa8, 0xe8, 0xbe, 0xbe, 0xe5,
+	0xbc, 0x8f, 0x2a, 0x12, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a,
+	0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x65, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65,
+	0x3a, 0x01, 0x2a, 0x12, 0xa2, 0x01, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x53, 0x92, 0x41, 0x35, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe5, 0x88,
+	0x97, 0xe8, 0xa1, 0xa8, 0x2a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x15, 0x22, 0x10, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x3a, 0x01, 0x2a, 0x12, 0xcf, 0x01, 0x0a, 0x10, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x24, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6e, 0x92, 0x41, 0x4a, 0x0a,
+	0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x21, 0xe4, 0xba, 0x8b, 0xe5, 0x8a, 0xa1, 0xe6,
+	0x80, 0xa7, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe5, 0xa4, 0x9a, 0xe4, 0xb8, 0xaa, 0xe5, 0xae,
+	0x9e, 0xe4, 0xbd, 0x93, 0xe5, 0xb1, 0x9e, 0xe6, 0x80, 0xa7, 0x2a, 0x10, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x4a, 0x0b, 0x0a, 0x03,
+	0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22,
+	0x16, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x42, 0x38, 0x0a, 0x0b, 0x61, 0x70,
 	0x69, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x27, 0x67, 0x69, 0x74,
 	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2d, 0x69, 0x6f,
 	0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76,
@@ -3543,118 +4373,139 @@ func file_api_core_v1_entity_proto_rawDescGZIP() []byte {
 	return file_api_core_v1_entity_proto_rawDescData
 }
 
-var file_api_core_v1_entity_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_api_core_v1_entity_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_api_core_v1_entity_proto_goTypes = []interface{}{
 	(*CreateEntityRequest)(nil),        // 0: api.core.v1.CreateEntityRequest
 	(*UpdateEntityRequest)(nil),        // 1: api.core.v1.UpdateEntityRequest
 	(*GetEntityRequest)(nil),           // 2: api.core.v1.GetEntityRequest
 	(*DeleteEntityRequest)(nil),        // 3: api.core.v1.DeleteEntityRequest
 	(*DeleteEntityResponse)(nil),       // 4: api.core.v1.DeleteEntityResponse
-	(*UpdateEntityPropsRequest)(nil),   // 5: api.core.v1.UpdateEntityPropsRequest
-	(*PatchEntityPropsRequest)(nil),    // 6: api.core.v1.PatchEntityPropsRequest
-	(*GetEntityPropsRequest)(nil),      // 7: api.core.v1.GetEntityPropsRequest
-	(*RemoveEntityPropsRequest)(nil),   // 8: api.core.v1.RemoveEntityPropsRequest
-	(*UpdateEntityConfigsRequest)(nil), // 9: api.core.v1.UpdateEntityConfigsRequest
-	(*PatchEntityConfigsRequest)(nil),  // 10: api.core.v1.PatchEntityConfigsRequest
-	(*GetEntityConfigsRequest)(nil),    // 11: api.core.v1.GetEntityConfigsRequest
-	(*RemoveEntityConfigsRequest)(nil), // 12: api.core.v1.RemoveEntityConfigsRequest
-	(*Mapper)(nil),                     // 13: api.core.v1.Mapper
-	(*AppendMapperRequest)(nil),        // 14: api.core.v1.AppendMapperRequest
-	(*GetMapperRequest)(nil),           // 15: api.core.v1.GetMapperRequest
-	(*ListMapperRequest)(nil),          // 16: api.core.v1.ListMapperRequest
-	(*RemoveMapperRequest)(nil),        // 17: api.core.v1.RemoveMapperRequest
-	(*AppendMapperResponse)(nil),       // 18: api.core.v1.AppendMapperResponse
-	(*RemoveMapperResponse)(nil),       // 19: api.core.v1.RemoveMapperResponse
-	(*GetMapperResponse)(nil),          // 20: api.core.v1.GetMapperResponse
-	(*ListMapperResponse)(nil),         // 21: api.core.v1.ListMapperResponse
-	(*Expression)(nil),                 // 22: api.core.v1.Expression
-	(*Expressions)(nil),                // 23: api.core.v1.Expressions
-	(*AppendExpressionReq)(nil),        // 24: api.core.v1.AppendExpressionReq
-	(*GetExpressionReq)(nil),           // 25: api.core.v1.GetExpressionReq
-	(*ListExpressionReq)(nil),          // 26: api.core.v1.ListExpressionReq
-	(*RemoveExpressionReq)(nil),        // 27: api.core.v1.RemoveExpressionReq
-	(*AppendExpressionResp)(nil),       // 28: api.core.v1.AppendExpressionResp
-	(*RemoveExpressionResp)(nil),       // 29: api.core.v1.RemoveExpressionResp
-	(*GetExpressionResp)(nil),          // 30: api.core.v1.GetExpressionResp
-	(*ListExpressionResp)(nil),         // 31: api.core.v1.ListExpressionResp
-	(*ListEntityRequest)(nil),          // 32: api.core.v1.ListEntityRequest
-	(*ListEntityResponse)(nil),         // 33: api.core.v1.ListEntityResponse
-	(*EntityResponse)(nil),             // 34: api.core.v1.EntityResponse
-	(*structpb.Value)(nil),             // 35: google.protobuf.Value
-	(*SearchCondition)(nil),            // 36: api.core.v1.SearchCondition
+	(*RestoreEntityRequest)(nil),       // 5: api.core.v1.RestoreEntityRequest
+	(*PurgeEntityRequest)(nil),         // 6: api.core.v1.PurgeEntityRequest
+	(*PurgeEntityResponse)(nil),        // 7: api.core.v1.PurgeEntityResponse
+	(*UpdateEntityPropsRequest)(nil),   // 8: api.core.v1.UpdateEntityPropsRequest
+	(*PatchEntityPropsRequest)(nil),    // 9: api.core.v1.PatchEntityPropsRequest
+	(*GetEntityPropsRequest)(nil),      // 10: api.core.v1.GetEntityPropsRequest
+	(*RemoveEntityPropsRequest)(nil),   // 11: api.core.v1.RemoveEntityPropsRequest
+	(*UpdateEntityConfigsRequest)(nil), // 12: api.core.v1.UpdateEntityConfigsRequest
+	(*PatchEntityConfigsRequest)(nil),  // 13: api.core.v1.PatchEntityConfigsRequest
+	(*GetEntityConfigsRequest)(nil),    // 14: api.core.v1.GetEntityConfigsRequest
+	(*RemoveEntityConfigsRequest)(nil), // 15: api.core.v1.RemoveEntityConfigsRequest
+	(*Mapper)(nil),                     // 16: api.core.v1.Mapper
+	(*AppendMapperRequest)(nil),        // 17: api.core.v1.AppendMapperRequest
+	(*GetMapperRequest)(nil),           // 18: api.core.v1.GetMapperRequest
+	(*ListMapperRequest)(nil),          // 19: api.core.v1.ListMapperRequest
+	(*RemoveMapperRequest)(nil),        // 20: api.core.v1.RemoveMapperRequest
+	(*AppendMapperResponse)(nil),       // 21: api.core.v1.AppendMapperResponse
+	(*RemoveMapperResponse)(nil),       // 22: api.core.v1.RemoveMapperResponse
+	(*GetMapperResponse)(nil),          // 23: api.core.v1.GetMapperResponse
+	(*ListMapperResponse)(nil),         // 24: api.core.v1.ListMapperResponse
+	(*Expression)(nil),                 // 25: api.core.v1.Expression
+	(*Expressions)(nil),                // 26: api.core.v1.Expressions
+	(*AppendExpressionReq)(nil),        // 27: api.core.v1.AppendExpressionReq
+	(*GetExpressionReq)(nil),           // 28: api.core.v1.GetExpressionReq
+	(*ListExpressionReq)(nil),          // 29: api.core.v1.ListExpressionReq
+	(*RemoveExpressionReq)(nil),        // 30: api.core.v1.RemoveExpressionReq
+	(*AppendExpressionResp)(nil),       // 31: api.core.v1.AppendExpressionResp
+	(*RemoveExpressionResp)(nil),       // 32: api.core.v1.RemoveExpressionResp
+	(*GetExpressionResp)(nil),          // 33: api.core.v1.GetExpressionResp
+	(*ListExpressionResp)(nil),         // 34: api.core.v1.ListExpressionResp
+	(*EvaluateExpressionReq)(nil),      // 35: api.core.v1.EvaluateExpressionReq
+	(*EvaluateExpressionResp)(nil),     // 36: api.core.v1.EvaluateExpressionResp
+	(*ListEntityRequest)(nil),          // 37: api.core.v1.ListEntityRequest
+	(*ListEntityResponse)(nil),         // 38: api.core.v1.ListEntityResponse
+	(*TransactEntity)(nil),             // 39: api.core.v1.TransactEntity
+	(*TransactEntitiesRequest)(nil),    // 40: api.core.v1.TransactEntitiesRequest
+	(*TransactEntitiesResponse)(nil),   // 41: api.core.v1.TransactEntitiesResponse
+	(*EntityResponse)(nil),             // 42: api.core.v1.EntityResponse
+	(*structpb.Value)(nil),             // 43: google.protobuf.Value
+	(*SearchCondition)(nil),            // 44: api.core.v1.SearchCondition
 }
 var file_api_core_v1_entity_proto_depIdxs = []int32{
-	35, // 0: api.core.v1.CreateEntityRequest.properties:type_name -> google.protobuf.Value
-	35, // 1: api.core.v1.UpdateEntityRequest.properties:type_name -> google.protobuf.Value
-	35, // 2: api.core.v1.UpdateEntityRequest.configs:type_name -> google.protobuf.Value
-	35, // 3: api.core.v1.UpdateEntityPropsRequest.properties:type_name -> google.protobuf.Value
-	35, // 4: api.core.v1.PatchEntityPropsRequest.properties:type_name -> google.protobuf.Value
-	35, // 5: api.core.v1.UpdateEntityConfigsRequest.configs:type_name -> google.protobuf.Value
-	35, // 6: api.core.v1.PatchEntityConfigsRequest.configs:type_name -> google.protobuf.Value
-	13, // 7: api.core.v1.AppendMapperRequest.mapper:type_name -> api.core.v1.Mapper
-	13, // 8: api.core.v1.AppendMapperResponse.mapper:type_name -> api.core.v1.Mapper
-	13, // 9: api.core.v1.GetMapperResponse.mapper:type_name -> api.core.v1.Mapper
-	13, // 10: api.core.v1.ListMapperResponse.mappers:type_name -> api.core.v1.Mapper
-	22, // 11: api.core.v1.Expressions.expressions:type_name -> api.core.v1.Expression
-	23, // 12: api.core.v1.AppendExpressionReq.expressions:type_name -> api.core.v1.Expressions
-	22, // 13: api.core.v1.GetExpressionResp.expression:type_name -> api.core.v1.Expression
-	22, // 14: api.core.v1.ListExpressionResp.expressions:type_name -> api.core.v1.Expression
-	36, // 15: api.core.v1.ListEntityRequest.condition:type_name -> api.core.v1.SearchCondition
-	34, // 16: api.core.v1.ListEntityResponse.items:type_name -> api.core.v1.EntityResponse
-	13, // 17: api.core.v1.EntityResponse.mappers:type_name -> api.core.v1.Mapper
-	35, // 18: api.core.v1.EntityResponse.configs:type_name -> google.protobuf.Value
-	35, // 19: api.core.v1.EntityResponse.properties:type_name -> google.protobuf.Value
-	0,  // 20: api.core.v1.Entity.CreateEntity:input_type -> api.core.v1.CreateEntityRequest
-	1,  // 21: api.core.v1.Entity.UpdateEntity:input_type -> api.core.v1.UpdateEntityRequest
-	2,  // 22: api.core.v1.Entity.GetEntity:input_type -> api.core.v1.GetEntityRequest
-	3,  // 23: api.core.v1.Entity.DeleteEntity:input_type -> api.core.v1.DeleteEntityRequest
-	5,  // 24: api.core.v1.Entity.UpdateEntityProps:input_type -> api.core.v1.UpdateEntityPropsRequest
-	6,  // 25: api.core.v1.Entity.PatchEntityProps:input_type -> api.core.v1.PatchEntityPropsRequest
-	6,  // 26: api.core.v1.Entity.PatchEntityPropsZ:input_type -> api.core.v1.PatchEntityPropsRequest
-	7,  // 27: api.core.v1.Entity.GetEntityProps:input_type -> api.core.v1.GetEntityPropsRequest
-	8,  // 28: api.core.v1.Entity.RemoveEntityProps:input_type -> api.core.v1.RemoveEntityPropsRequest
-	9,  // 29: api.core.v1.Entity.UpdateEntityConfigs:input_type -> api.core.v1.UpdateEntityConfigsRequest
-	10, // 30: api.core.v1.Entity.PatchEntityConfigs:input_type -> api.core.v1.PatchEntityConfigsRequest
-	10, // 31: api.core.v1.Entity.PatchEntityConfigsZ:input_type -> api.core.v1.PatchEntityConfigsRequest
-	12, // 32: api.core.v1.Entity.RemoveEntityConfigs:input_type -> api.core.v1.RemoveEntityConfigsRequest
-	11, // 33: api.core.v1.Entity.GetEntityConfigs:input_type -> api.core.v1.GetEntityConfigsRequest
-	14, // 34: api.core.v1.Entity.AppendMapper:input_type -> api.core.v1.AppendMapperRequest
-	15, // 35: api.core.v1.Entity.GetMapper:input_type -> api.core.v1.GetMapperRequest
-	16, // 36: api.core.v1.Entity.ListMapper:input_type -> api.core.v1.ListMapperRequest
-	17, // 37: api.core.v1.Entity.RemoveMapper:input_type -> api.core.v1.RemoveMapperRequest
-	24, // 38: api.core.v1.Entity.AppendExpression:input_type -> api.core.v1.AppendExpressionReq
-	25, // 39: api.core.v1.Entity.GetExpression:input_type -> api.core.v1.GetExpressionReq
-	26, // 40: api.core.v1.Entity.ListExpression:input_type -> api.core.v1.ListExpressionReq
-	27, // 41: api.core.v1.Entity.RemoveExpression:input_type -> api.core.v1.RemoveExpressionReq
-	32, // 42: api.core.v1.Entity.ListEntity:input_type -> api.core.v1.ListEntityRequest
-	34, // 43: api.core.v1.Entity.CreateEntity:output_type -> api.core.v1.EntityResponse
-	34, // 44: api.core.v1.Entity.UpdateEntity:output_type -> api.core.v1.EntityResponse
-	34, // 45: api.core.v1.Entity.GetEntity:output_type -> api.core.v1.EntityResponse
-	4,  // 46: api.core.v1.Entity.DeleteEntity:output_type -> api.core.v1.DeleteEntityResponse
-	34, // 47: api.core.v1.Entity.UpdateEntityProps:output_type -> api.core.v1.EntityResponse
-	34, // 48: api.core.v1.Entity.PatchEntityProps:output_type -> api.core.v1.EntityResponse
-	34, // 49: api.core.v1.Entity.PatchEntityPropsZ:output_type -> api.core.v1.EntityResponse
-	34, // 50: api.core.v1.Entity.GetEntityProps:output_type -> api.core.v1.EntityResponse
-	34, // 51: api.core.v1.Entity.RemoveEntityProps:output_type -> api.core.v1.EntityResponse
-	34, // 52: api.core.v1.Entity.UpdateEntityConfigs:output_type -> api.core.v1.EntityResponse
-	34, // 53: api.core.v1.Entity.PatchEntityConfigs:output_type -> api.core.v1.EntityResponse
-	34, // 54: api.core.v1.Entity.PatchEntityConfigsZ:output_type -> api.core.v1.EntityResponse
-	34, // 55: api.core.v1.Entity.RemoveEntityConfigs:output_type -> api.core.v1.EntityResponse
-	34, // 56: api.core.v1.Entity.GetEntityConfigs:output_type -> api.core.v1.EntityResponse
-	18, // 57: api.core.v1.Entity.AppendMapper:output_type -> api.core.v1.AppendMapperResponse
-	20, // 58: api.core.v1.Entity.GetMapper:output_type -> api.core.v1.GetMapperResponse
-	21, // 59: api.core.v1.Entity.ListMapper:output_type -> api.core.v1.ListMapperResponse
-	19, // 60: api.core.v1.Entity.RemoveMapper:output_type -> api.core.v1.RemoveMapperResponse
-	28, // 61: api.core.v1.Entity.AppendExpression:output_type -> api.core.v1.AppendExpressionResp
-	30, // 62: api.core.v1.Entity.GetExpression:output_type -> api.core.v1.GetExpressionResp
-	31, // 63: api.core.v1.Entity.ListExpression:output_type -> api.core.v1.ListExpressionResp
-	29, // 64: api.core.v1.Entity.RemoveExpression:output_type -> api.core.v1.RemoveExpressionResp
-	33, // 65: api.core.v1.Entity.ListEntity:output_type -> api.core.v1.ListEntityResponse
-	43, // [43:66] is the sub-list for method output_type
-	20, // [20:43] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	43, // 0: api.core.v1.CreateEntityRequest.properties:type_name -> google.protobuf.Value
+	43, // 1: api.core.v1.UpdateEntityRequest.properties:type_name -> google.protobuf.Value
+	43, // 2: api.core.v1.UpdateEntityRequest.configs:type_name -> google.protobuf.Value
+	43, // 3: api.core.v1.UpdateEntityPropsRequest.properties:type_name -> google.protobuf.Value
+	43, // 4: api.core.v1.PatchEntityPropsRequest.properties:type_name -> google.protobuf.Value
+	43, // 5: api.core.v1.UpdateEntityConfigsRequest.configs:type_name -> google.protobuf.Value
+	43, // 6: api.core.v1.PatchEntityConfigsRequest.configs:type_name -> google.protobuf.Value
+	16, // 7: api.core.v1.AppendMapperRequest.mapper:type_name -> api.core.v1.Mapper
+	16, // 8: api.core.v1.AppendMapperResponse.mapper:type_name -> api.core.v1.Mapper
+	16, // 9: api.core.v1.GetMapperResponse.mapper:type_name -> api.core.v1.Mapper
+	16, // 10: api.core.v1.ListMapperResponse.mappers:type_name -> api.core.v1.Mapper
+	25, // 11: api.core.v1.Expressions.expressions:type_name -> api.core.v1.Expression
+	26, // 12: api.core.v1.AppendExpressionReq.expressions:type_name -> api.core.v1.Expressions
+	25, // 13: api.core.v1.GetExpressionResp.expression:type_name -> api.core.v1.Expression
+	25, // 14: api.core.v1.ListExpressionResp.expressions:type_name -> api.core.v1.Expression
+	43, // 15: api.core.v1.EvaluateExpressionResp.value:type_name -> google.protobuf.Value
+	43, // 16: api.core.v1.EvaluateExpressionResp.inputs:type_name -> google.protobuf.Value
+	44, // 17: api.core.v1.ListEntityRequest.condition:type_name -> api.core.v1.SearchCondition
+	42, // 18: api.core.v1.ListEntityResponse.items:type_name -> api.core.v1.EntityResponse
+	43, // 19: api.core.v1.TransactEntity.properties:type_name -> google.protobuf.Value
+	39, // 20: api.core.v1.TransactEntitiesRequest.entities:type_name -> api.core.v1.TransactEntity
+	42, // 21: api.core.v1.TransactEntitiesResponse.entities:type_name -> api.core.v1.EntityResponse
+	16, // 22: api.core.v1.EntityResponse.mappers:type_name -> api.core.v1.Mapper
+	43, // 23: api.core.v1.EntityResponse.configs:type_name -> google.protobuf.Value
+	43, // 24: api.core.v1.EntityResponse.properties:type_name -> google.protobuf.Value
+	0,  // 25: api.core.v1.Entity.CreateEntity:input_type -> api.core.v1.CreateEntityRequest
+	1,  // 26: api.core.v1.Entity.UpdateEntity:input_type -> api.core.v1.UpdateEntityRequest
+	2,  // 27: api.core.v1.Entity.GetEntity:input_type -> api.core.v1.GetEntityRequest
+	3,  // 28: api.core.v1.Entity.DeleteEntity:input_type -> api.core.v1.DeleteEntityRequest
+	5,  // 29: api.core.v1.Entity.RestoreEntity:input_type -> api.core.v1.RestoreEntityRequest
+	6,  // 30: api.core.v1.Entity.PurgeEntity:input_type -> api.core.v1.PurgeEntityRequest
+	8,  // 31: api.core.v1.Entity.UpdateEntityProps:input_type -> api.core.v1.UpdateEntityPropsRequest
+	9,  // 32: api.core.v1.Entity.PatchEntityProps:input_type -> api.core.v1.PatchEntityPropsRequest
+	9,  // 33: api.core.v1.Entity.PatchEntityPropsZ:input_type -> api.core.v1.PatchEntityPropsRequest
+	10, // 34: api.core.v1.Entity.GetEntityProps:input_type -> api.core.v1.GetEntityPropsRequest
+	11, // 35: api.core.v1.Entity.RemoveEntityProps:input_type -> api.core.v1.RemoveEntityPropsRequest
+	12, // 36: api.core.v1.Entity.UpdateEntityConfigs:input_type -> api.core.v1.UpdateEntityConfigsRequest
+	13, // 37: api.core.v1.Entity.PatchEntityConfigs:input_type -> api.core.v1.PatchEntityConfigsRequest
+	13, // 38: api.core.v1.Entity.PatchEntityConfigsZ:input_type -> api.core.v1.PatchEntityConfigsRequest
+	15, // 39: api.core.v1.Entity.RemoveEntityConfigs:input_type -> api.core.v1.RemoveEntityConfigsRequest
+	14, // 40: api.core.v1.Entity.GetEntityConfigs:input_type -> api.core.v1.GetEntityConfigsRequest
+	17, // 41: api.core.v1.Entity.AppendMapper:input_type -> api.core.v1.AppendMapperRequest
+	18, // 42: api.core.v1.Entity.GetMapper:input_type -> api.core.v1.GetMapperRequest
+	19, // 43: api.core.v1.Entity.ListMapper:input_type -> api.core.v1.ListMapperRequest
+	20, // 44: api.core.v1.Entity.RemoveMapper:input_type -> api.core.v1.RemoveMapperRequest
+	27, // 45: api.core.v1.Entity.AppendExpression:input_type -> api.core.v1.AppendExpressionReq
+	28, // 46: api.core.v1.Entity.GetExpression:input_type -> api.core.v1.GetExpressionReq
+	29, // 47: api.core.v1.Entity.ListExpression:input_type -> api.core.v1.ListExpressionReq
+	30, // 48: api.core.v1.Entity.RemoveExpression:input_type -> api.core.v1.RemoveExpressionReq
+	35, // 49: api.core.v1.Entity.EvaluateExpression:input_type -> api.core.v1.EvaluateExpressionReq
+	37, // 50: api.core.v1.Entity.ListEntity:input_type -> api.core.v1.ListEntityRequest
+	40, // 51: api.core.v1.Entity.TransactEntities:input_type -> api.core.v1.TransactEntitiesRequest
+	42, // 52: api.core.v1.Entity.CreateEntity:output_type -> api.core.v1.EntityResponse
+	42, // 53: api.core.v1.Entity.UpdateEntity:output_type -> api.core.v1.EntityResponse
+	42, // 54: api.core.v1.Entity.GetEntity:output_type -> api.core.v1.EntityResponse
+	4,  // 55: api.core.v1.Entity.DeleteEntity:output_type -> api.core.v1.DeleteEntityResponse
+	42, // 56: api.core.v1.Entity.RestoreEntity:output_type -> api.core.v1.EntityResponse
+	7,  // 57: api.core.v1.Entity.PurgeEntity:output_type -> api.core.v1.PurgeEntityResponse
+	42, // 58: api.core.v1.Entity.UpdateEntityProps:output_type -> api.core.v1.EntityResponse
+	42, // 59: api.core.v1.Entity.PatchEntityProps:output_type -> api.core.v1.EntityResponse
+	42, // 60: api.core.v1.Entity.PatchEntityPropsZ:output_type -> api.core.v1.EntityResponse
+	42, // 61: api.core.v1.Entity.GetEntityProps:output_type -> api.core.v1.EntityResponse
+	42, // 62: api.core.v1.Entity.RemoveEntityProps:output_type -> api.core.v1.EntityResponse
+	42, // 63: api.core.v1.Entity.UpdateEntityConfigs:output_type -> api.core.v1.EntityResponse
+	42, // 64: api.core.v1.Entity.PatchEntityConfigs:output_type -> api.core.v1.EntityResponse
+	42, // 65: api.core.v1.Entity.PatchEntityConfigsZ:output_type -> api.core.v1.EntityResponse
+	42, // 66: api.core.v1.Entity.RemoveEntityConfigs:output_type -> api.core.v1.EntityResponse
+	42, // 67: api.core.v1.Entity.GetEntityConfigs:output_type -> api.core.v1.EntityResponse
+	21, // 68: api.core.v1.Entity.AppendMapper:output_type -> api.core.v1.AppendMapperResponse
+	23, // 69: api.core.v1.Entity.GetMapper:output_type -> api.core.v1.GetMapperResponse
+	24, // 70: api.core.v1.Entity.ListMapper:output_type -> api.core.v1.ListMapperResponse
+	22, // 71: api.core.v1.Entity.RemoveMapper:output_type -> api.core.v1.RemoveMapperResponse
+	31, // 72: api.core.v1.Entity.AppendExpression:output_type -> api.core.v1.AppendExpressionResp
+	33, // 73: api.core.v1.Entity.GetExpression:output_type -> api.core.v1.GetExpressionResp
+	34, // 74: api.core.v1.Entity.ListExpression:output_type -> api.core.v1.ListExpressionResp
+	32, // 75: api.core.v1.Entity.RemoveExpression:output_type -> api.core.v1.RemoveExpressionResp
+	36, // 76: api.core.v1.Entity.EvaluateExpression:output_type -> api.core.v1.EvaluateExpressionResp
+	38, // 77: api.core.v1.Entity.ListEntity:output_type -> api.core.v1.ListEntityResponse
+	41, // 78: api.core.v1.Entity.TransactEntities:output_type -> api.core.v1.TransactEntitiesResponse
+	52, // [52:79] is the sub-list for method output_type
+	25, // [25:52] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_api_core_v1_entity_proto_init() }
@@ -3725,7 +4576,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateEntityPropsRequest); i {
+			switch v := v.(*RestoreEntityRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3737,7 +4588,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PatchEntityPropsRequest); i {
+			switch v := v.(*PurgeEntityRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3749,7 +4600,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEntityPropsRequest); i {
+			switch v := v.(*PurgeEntityResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3761,7 +4612,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveEntityPropsRequest); i {
+			switch v := v.(*UpdateEntityPropsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3773,7 +4624,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateEntityConfigsRequest); i {
+			switch v := v.(*PatchEntityPropsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3785,7 +4636,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PatchEntityConfigsRequest); i {
+			switch v := v.(*GetEntityPropsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3797,7 +4648,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEntityConfigsRequest); i {
+			switch v := v.(*RemoveEntityPropsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3809,7 +4660,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveEntityConfigsRequest); i {
+			switch v := v.(*UpdateEntityConfigsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3821,7 +4672,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Mapper); i {
+			switch v := v.(*PatchEntityConfigsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3833,7 +4684,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppendMapperRequest); i {
+			switch v := v.(*GetEntityConfigsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3845,7 +4696,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMapperRequest); i {
+			switch v := v.(*RemoveEntityConfigsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3857,7 +4708,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMapperRequest); i {
+			switch v := v.(*Mapper); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3869,7 +4720,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveMapperRequest); i {
+			switch v := v.(*AppendMapperRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3881,7 +4732,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppendMapperResponse); i {
+			switch v := v.(*GetMapperRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3893,7 +4744,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveMapperResponse); i {
+			switch v := v.(*ListMapperRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3905,7 +4756,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMapperResponse); i {
+			switch v := v.(*RemoveMapperRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3917,7 +4768,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMapperResponse); i {
+			switch v := v.(*AppendMapperResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3929,7 +4780,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Expression); i {
+			switch v := v.(*RemoveMapperResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3941,7 +4792,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Expressions); i {
+			switch v := v.(*GetMapperResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3953,7 +4804,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppendExpressionReq); i {
+			switch v := v.(*ListMapperResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3965,7 +4816,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetExpressionReq); i {
+			switch v := v.(*Expression); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3977,7 +4828,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListExpressionReq); i {
+			switch v := v.(*Expressions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3989,7 +4840,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveExpressionReq); i {
+			switch v := v.(*AppendExpressionReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4001,7 +4852,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppendExpressionResp); i {
+			switch v := v.(*GetExpressionReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4013,7 +4864,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveExpressionResp); i {
+			switch v := v.(*ListExpressionReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4025,7 +4876,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetExpressionResp); i {
+			switch v := v.(*RemoveExpressionReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4037,7 +4888,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListExpressionResp); i {
+			switch v := v.(*AppendExpressionResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4049,7 +4900,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListEntityRequest); i {
+			switch v := v.(*RemoveExpressionResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4061,7 +4912,7 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListEntityResponse); i {
+			switch v := v.(*GetExpressionResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4073,6 +4924,102 @@ func file_api_core_v1_entity_proto_init() {
 			}
 		}
 		file_api_core_v1_entity_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExpressionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_core_v1_entity_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluateExpressionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_core_v1_entity_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluateExpressionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_core_v1_entity_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEntityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_core_v1_entity_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEntityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_core_v1_entity_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactEntity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_core_v1_entity_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactEntitiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_core_v1_entity_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactEntitiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_core_v1_entity_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EntityResponse); i {
 			case 0:
 				return &v.state
@@ -4091,7 +5038,7 @@ func file_api_core_v1_entity_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_core_v1_entity_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   35,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
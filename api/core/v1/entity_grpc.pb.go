@@ -22,6 +22,8 @@ type EntityClient interface {
 	UpdateEntity(ctx context.Context, in *UpdateEntityRequest, opts ...grpc.CallOption) (*EntityResponse, error)
 	GetEntity(ctx context.Context, in *GetEntityRequest, opts ...grpc.CallOption) (*EntityResponse, error)
 	DeleteEntity(ctx context.Context, in *DeleteEntityRequest, opts ...grpc.CallOption) (*DeleteEntityResponse, error)
+	RestoreEntity(ctx context.Context, in *RestoreEntityRequest, opts ...grpc.CallOption) (*EntityResponse, error)
+	PurgeEntity(ctx context.Context, in *PurgeEntityRequest, opts ...grpc.CallOption) (*PurgeEntityResponse, error)
 	UpdateEntityProps(ctx context.Context, in *UpdateEntityPropsRequest, opts ...grpc.CallOption) (*EntityResponse, error)
 	PatchEntityProps(ctx context.Context, in *PatchEntityPropsRequest, opts ...grpc.CallOption) (*EntityResponse, error)
 	PatchEntityPropsZ(ctx context.Context, in *PatchEntityPropsRequest, opts ...grpc.CallOption) (*EntityResponse, error)
@@ -41,7 +43,9 @@ type EntityClient interface {
 	GetExpression(ctx context.Context, in *GetExpressionReq, opts ...grpc.CallOption) (*GetExpressionResp, error)
 	ListExpression(ctx context.Context, in *ListExpressionReq, opts ...grpc.CallOption) (*ListExpressionResp, error)
 	RemoveExpression(ctx context.Context, in *RemoveExpressionReq, opts ...grpc.CallOption) (*RemoveExpressionResp, error)
+	EvaluateExpression(ctx context.Context, in *EvaluateExpressionReq, opts ...grpc.CallOption) (*EvaluateExpressionResp, error)
 	ListEntity(ctx context.Context, in *ListEntityRequest, opts ...grpc.CallOption) (*ListEntityResponse, error)
+	TransactEntities(ctx context.Context, in *TransactEntitiesRequest, opts ...grpc.CallOption) (*TransactEntitiesResponse, error)
 }
 
 type entityClient struct {
@@ -88,6 +92,24 @@ func (c *entityClient) DeleteEntity(ctx context.Context, in *DeleteEntityRequest
 	return out, nil
 }
 
+func (c *entityClient) RestoreEntity(ctx context.Context, in *RestoreEntityRequest, opts ...grpc.CallOption) (*EntityResponse, error) {
+	out := new(EntityResponse)
+	err := c.cc.Invoke(ctx, "/api.core.v1.Entity/RestoreEntity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entityClient) PurgeEntity(ctx context.Context, in *PurgeEntityRequest, opts ...grpc.CallOption) (*PurgeEntityResponse, error) {
+	out := new(PurgeEntityResponse)
+	err := c.cc.Invoke(ctx, "/api.core.v1.Entity/PurgeEntity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *entityClient) UpdateEntityProps(ctx context.Context, in *UpdateEntityPropsRequest, opts ...grpc.CallOption) (*EntityResponse, error) {
 	out := new(EntityResponse)
 	err := c.cc.Invoke(ctx, "/api.core.v1.Entity/UpdateEntityProps", in, out, opts...)
@@ -250,6 +272,15 @@ func (c *entityClient) RemoveExpression(ctx context.Context, in *RemoveExpressio
 	return out, nil
 }
 
+func (c *entityClient) EvaluateExpression(ctx context.Context, in *EvaluateExpressionReq, opts ...grpc.CallOption) (*EvaluateExpressionResp, error) {
+	out := new(EvaluateExpressionResp)
+	err := c.cc.Invoke(ctx, "/api.core.v1.Entity/EvaluateExpression", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *entityClient) ListEntity(ctx context.Context, in *ListEntityRequest, opts ...grpc.CallOption) (*ListEntityResponse, error) {
 	out := new(ListEntityResponse)
 	err := c.cc.Invoke(ctx, "/api.core.v1.Entity/ListEntity", in, out, opts...)
@@ -259,6 +290,15 @@ func (c *entityClient) ListEntity(ctx context.Context, in *ListEntityRequest, op
 	return out, nil
 }
 
+func (c *entityClient) TransactEntities(ctx context.Context, in *TransactEntitiesRequest, opts ...grpc.CallOption) (*TransactEntitiesResponse, error) {
+	out := new(TransactEntitiesResponse)
+	err := c.cc.Invoke(ctx, "/api.core.v1.Entity/TransactEntities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EntityServer is the server API for Entity service.
 // All implementations must embed UnimplementedEntityServer
 // for forward compatibility
@@ -267,6 +307,8 @@ type EntityServer interface {
 	UpdateEntity(context.Context, *UpdateEntityRequest) (*EntityResponse, error)
 	GetEntity(context.Context, *GetEntityRequest) (*EntityResponse, error)
 	DeleteEntity(context.Context, *DeleteEntityRequest) (*DeleteEntityResponse, error)
+	RestoreEntity(context.Context, *RestoreEntityRequest) (*EntityResponse, error)
+	PurgeEntity(context.Context, *PurgeEntityRequest) (*PurgeEntityResponse, error)
 	UpdateEntityProps(context.Context, *UpdateEntityPropsRequest) (*EntityResponse, error)
 	PatchEntityProps(context.Context, *PatchEntityPropsRequest) (*EntityResponse, error)
 	PatchEntityPropsZ(context.Context, *PatchEntityPropsRequest) (*EntityResponse, error)
@@ -286,7 +328,9 @@ type EntityServer interface {
 	GetExpression(context.Context, *GetExpressionReq) (*GetExpressionResp, error)
 	ListExpression(context.Context, *ListExpressionReq) (*ListExpressionResp, error)
 	RemoveExpression(context.Context, *RemoveExpressionReq) (*RemoveExpressionResp, error)
+	EvaluateExpression(context.Context, *EvaluateExpressionReq) (*EvaluateExpressionResp, error)
 	ListEntity(context.Context, *ListEntityRequest) (*ListEntityResponse, error)
+	TransactEntities(context.Context, *TransactEntitiesRequest) (*TransactEntitiesResponse, error)
 	mustEmbedUnimplementedEntityServer()
 }
 
@@ -306,6 +350,12 @@ func (UnimplementedEntityServer) GetEntity(context.Context, *GetEntityRequest) (
 func (UnimplementedEntityServer) DeleteEntity(context.Context, *DeleteEntityRequest) (*DeleteEntityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEntity not implemented")
 }
+func (UnimplementedEntityServer) RestoreEntity(context.Context, *RestoreEntityRequest) (*EntityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreEntity not implemented")
+}
+func (UnimplementedEntityServer) PurgeEntity(context.Context, *PurgeEntityRequest) (*PurgeEntityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeEntity not implemented")
+}
 func (UnimplementedEntityServer) UpdateEntityProps(context.Context, *UpdateEntityPropsRequest) (*EntityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateEntityProps not implemented")
 }
@@ -360,9 +410,15 @@ func (UnimplementedEntityServer) ListExpression(context.Context, *ListExpression
 func (UnimplementedEntityServer) RemoveExpression(context.Context, *RemoveExpressionReq) (*RemoveExpressionResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveExpression not implemented")
 }
+func (UnimplementedEntityServer) EvaluateExpression(context.Context, *EvaluateExpressionReq) (*EvaluateExpressionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateExpression not implemented")
+}
 func (UnimplementedEntityServer) ListEntity(context.Context, *ListEntityRequest) (*ListEntityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListEntity not implemented")
 }
+func (UnimplementedEntityServer) TransactEntities(context.Context, *TransactEntitiesRequest) (*TransactEntitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransactEntities not implemented")
+}
 func (UnimplementedEntityServer) mustEmbedUnimplementedEntityServer() {}
 
 // UnsafeEntityServer may be embedded to opt out of forward compatibility for this service.
@@ -448,6 +504,42 @@ func _Entity_DeleteEntity_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Entity_RestoreEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreEntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityServer).RestoreEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.core.v1.Entity/RestoreEntity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityServer).RestoreEntity(ctx, req.(*RestoreEntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Entity_PurgeEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeEntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityServer).PurgeEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.core.v1.Entity/PurgeEntity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityServer).PurgeEntity(ctx, req.(*PurgeEntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Entity_UpdateEntityProps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateEntityPropsRequest)
 	if err := dec(in); err != nil {
@@ -772,6 +864,24 @@ func _Entity_RemoveExpression_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Entity_EvaluateExpression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateExpressionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityServer).EvaluateExpression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.core.v1.Entity/EvaluateExpression",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityServer).EvaluateExpression(ctx, req.(*EvaluateExpressionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Entity_ListEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListEntityRequest)
 	if err := dec(in); err != nil {
@@ -790,6 +900,24 @@ func _Entity_ListEntity_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Entity_TransactEntities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactEntitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityServer).TransactEntities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.core.v1.Entity/TransactEntities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityServer).TransactEntities(ctx, req.(*TransactEntitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Entity_ServiceDesc is the grpc.ServiceDesc for Entity service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -813,6 +941,14 @@ var Entity_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteEntity",
 			Handler:    _Entity_DeleteEntity_Handler,
 		},
+		{
+			MethodName: "RestoreEntity",
+			Handler:    _Entity_RestoreEntity_Handler,
+		},
+		{
+			MethodName: "PurgeEntity",
+			Handler:    _Entity_PurgeEntity_Handler,
+		},
 		{
 			MethodName: "UpdateEntityProps",
 			Handler:    _Entity_UpdateEntityProps_Handler,
@@ -885,10 +1021,18 @@ var Entity_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveExpression",
 			Handler:    _Entity_RemoveExpression_Handler,
 		},
+		{
+			MethodName: "EvaluateExpression",
+			Handler:    _Entity_EvaluateExpression_Handler,
+		},
 		{
 			MethodName: "ListEntity",
 			Handler:    _Entity_ListEntity_Handler,
 		},
+		{
+			MethodName: "TransactEntities",
+			Handler:    _Entity_TransactEntities_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/core/v1/entity.proto",
@@ -29,6 +29,10 @@ const (
 	MetaResponseStatus  = "x-msg-response-status"
 	MetaResponseErrCode = "x-msg-response-errcode"
 	MetaPathConstructor = "x-msg-path-constructor"
+	MetaAsync           = "x-msg-async"
+	MetaIdempotencyKey  = "x-msg-idempotency-key"
+	MetaTxID            = "x-msg-tx-id"
+	MetaTxPhase         = "x-msg-tx-phase"
 )
 
 type PathConstructor string 
@@ -40,6 +44,15 @@ const (
 
 
 
+// TxPhase is the phase of a transaction patching multiple entities.
+type TxPhase string
+
+const (
+	TxPrepare TxPhase = "prepare"
+	TxCommit  TxPhase = "commit"
+	TxAbort   TxPhase = "abort"
+)
+
 type EventType string
 
 const (
@@ -53,8 +66,12 @@ const (
 type SystemOp string
 
 const (
-	OpCreate SystemOp = "core.event.System.Create"
-	OpDelete SystemOp = "core.event.System.Delete"
+	OpCreate  SystemOp = "core.event.System.Create"
+	OpDelete  SystemOp = "core.event.System.Delete"
+	OpRestore SystemOp = "core.event.System.Restore"
+	OpPurge   SystemOp = "core.event.System.Purge"
+	// OpImport replaces the state of the entity, states restored from backups are imported.
+	OpImport SystemOp = "core.event.System.Import"
 )
 
 type Attribution interface {
@@ -0,0 +1,161 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/tkeel-io/core/pkg/backup"
+	"github.com/tkeel-io/core/pkg/config"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/core/pkg/resource/search"
+	"github.com/tkeel-io/kit/log"
+)
+
+const _backupCmdExample = `backup all data of the deployment configured in config.yml:
+core backup -c config.yml -o core.tar.gz
+
+backup data of owners or tenants:
+core backup -o core.tar.gz --owner admin --tenant tenant1
+
+restore an archive, existing data is kept unless --overwrite:
+core restore -c config.yml -i core.tar.gz --overwrite
+`
+
+type backupFlags struct {
+	file      string
+	owners    []string
+	tenants   []string
+	overwrite bool
+}
+
+func newBackupCmd() *cobra.Command {
+	flags := &backupFlags{}
+	cmd := &cobra.Command{
+		Use:     "backup",
+		Short:   "Backup entities, templates, trash, expressions, subscriptions, alarm rules and schemas into an archive",
+		Example: _backupCmdExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runBackup(flags)
+		},
+	}
+
+	cmd.Flags().StringVarP(&flags.file, "output", "o", fmt.Sprintf("core-backup-%d.tar.gz", time.Now().Unix()), "archive file path.")
+	cmd.Flags().StringSliceVar(&flags.owners, "owner", nil, "backup data of the owners only.")
+	cmd.Flags().StringSliceVar(&flags.tenants, "tenant", nil, "backup data of the tenants only.")
+	return cmd
+}
+
+func newRestoreCmd() *cobra.Command {
+	flags := &backupFlags{}
+	cmd := &cobra.Command{
+		Use:     "restore",
+		Short:   "Restore entities, templates, trash, expressions, subscriptions, alarm rules and schemas from an archive, while core is stopped",
+		Example: _backupCmdExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRestore(flags)
+		},
+	}
+
+	cmd.Flags().StringVarP(&flags.file, "input", "i", "", "archive file path.")
+	cmd.Flags().StringSliceVar(&flags.owners, "owner", nil, "restore data of the owners only.")
+	cmd.Flags().StringSliceVar(&flags.tenants, "tenant", nil, "restore data of the tenants only.")
+	cmd.Flags().BoolVar(&flags.overwrite, "overwrite", false, "overwrite existing data.")
+	cmd.MarkFlagRequired("input")
+	return cmd
+}
+
+// newBackupService connects the state store, etcd and search engine of the configured deployment.
+func newBackupService(ctx context.Context) (*backup.Service, dao.IDao, error) {
+	config.Init(_cfgFile)
+	if err := search.Init(config.Get().Components.SearchEngine); nil != err {
+		return nil, nil, errors.Wrap(err, "initialize search engine")
+	}
+
+	coreDao, err := dao.New(ctx, config.Get().Components.Store,
+		config.Get().Components.Metadata, config.Get().Components.Etcd)
+	if nil != err {
+		return nil, nil, errors.Wrap(err, "initialize repository")
+	}
+	// the deployment is stopped, states are written into the state store directly.
+	return backup.NewService(repository.New(coreDao), search.GlobalService, nil), coreDao, nil
+}
+
+func runBackup(flags *backupFlags) error {
+	ctx := context.Background()
+	backups, coreDao, err := newBackupService(ctx)
+	if nil != err {
+		return err
+	}
+	defer coreDao.Close()
+
+	f, err := os.Create(flags.file)
+	if nil != err {
+		return errors.Wrap(err, "create archive file")
+	}
+	defer f.Close()
+
+	manifest, err := backups.Backup(ctx, f, &backup.Filter{Owners: flags.owners, Tenants: flags.tenants})
+	if nil != err {
+		os.Remove(flags.file)
+		return errors.Wrap(err, "backup")
+	} else if err = f.Sync(); nil != err {
+		return errors.Wrap(err, "sync archive file")
+	}
+
+	for _, section := range manifest.Sections {
+		log.InfoStatusEvent(os.Stdout, "%s: %d records, sha256 %s", section.Name, section.Count, section.SHA256)
+	}
+	log.SuccessStatusEvent(os.Stdout, "backup written to %s, revision %d", flags.file, manifest.Revision)
+	return nil
+}
+
+func runRestore(flags *backupFlags) error {
+	ctx := context.Background()
+	backups, coreDao, err := newBackupService(ctx)
+	if nil != err {
+		return err
+	}
+	defer coreDao.Close()
+
+	f, err := os.Open(flags.file)
+	if nil != err {
+		return errors.Wrap(err, "open archive file")
+	}
+	defer f.Close()
+
+	ret, err := backups.Restore(ctx, f, backup.RestoreOptions{
+		Filter:    &backup.Filter{Owners: flags.owners, Tenants: flags.tenants},
+		Overwrite: flags.overwrite,
+	})
+	if nil != err {
+		return errors.Wrap(err, "restore")
+	}
+
+	for _, section := range ret.Manifest.Sections {
+		log.InfoStatusEvent(os.Stdout, "%s: %d restored, %d skipped",
+			section.Name, ret.Restored[section.Name], ret.Skipped[section.Name])
+	}
+	log.SuccessStatusEvent(os.Stdout, "restored from %s, revision %d", flags.file, ret.Manifest.Revision)
+	return nil
+}
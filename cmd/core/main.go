@@ -19,8 +19,10 @@ package main
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"os/signal"
+	"reflect"
 	"strconv"
 	"strings"
 	"syscall"
@@ -29,12 +31,16 @@ import (
 	corev1 "github.com/tkeel-io/core/api/core/v1"
 	metricsv1 "github.com/tkeel-io/core/api/metrics/v1"
 	opsv1 "github.com/tkeel-io/core/api/ops/v1"
+	"github.com/tkeel-io/core/pkg/auth"
+	"github.com/tkeel-io/core/pkg/backup"
 	"github.com/tkeel-io/core/pkg/config"
 	"github.com/tkeel-io/core/pkg/dispatch"
 	logf "github.com/tkeel-io/core/pkg/logfield"
 	apim "github.com/tkeel-io/core/pkg/manager"
+	"github.com/tkeel-io/core/pkg/mapper/function"
 	metrics "github.com/tkeel-io/core/pkg/metrics"
 	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/ratelimit"
 	"github.com/tkeel-io/core/pkg/repository"
 	"github.com/tkeel-io/core/pkg/repository/dao"
 	"github.com/tkeel-io/core/pkg/resource"
@@ -46,8 +52,10 @@ import (
 	_ "github.com/tkeel-io/core/pkg/resource/rawdata/noop"
 	"github.com/tkeel-io/core/pkg/resource/search"
 	_ "github.com/tkeel-io/core/pkg/resource/store/dapr"
+	_ "github.com/tkeel-io/core/pkg/resource/store/embedded"
 	_ "github.com/tkeel-io/core/pkg/resource/store/memory"
 	_ "github.com/tkeel-io/core/pkg/resource/store/noop"
+	_ "github.com/tkeel-io/core/pkg/resource/store/redis"
 	"github.com/tkeel-io/core/pkg/resource/tseries"
 	_ "github.com/tkeel-io/core/pkg/resource/tseries/builder"
 	_ "github.com/tkeel-io/core/pkg/resource/tseries/influxdb"
@@ -56,6 +64,7 @@ import (
 	"github.com/tkeel-io/core/pkg/service"
 	"github.com/tkeel-io/core/pkg/types"
 	"github.com/tkeel-io/core/pkg/util"
+	"github.com/tkeel-io/core/pkg/util/dapr"
 	"github.com/tkeel-io/core/pkg/util/discovery"
 	_ "github.com/tkeel-io/core/pkg/util/transport"
 	"github.com/tkeel-io/core/pkg/version"
@@ -123,7 +132,7 @@ func main() {
 
 	{
 		// Subcommand register here.
-		cmd.AddCommand()
+		cmd.AddCommand(newBackupCmd(), newRestoreCmd())
 	}
 
 	cobra.OnInitialize(func() {
@@ -150,6 +159,7 @@ func core(cmd *cobra.Command, args []string) {
 
 	// new servers.
 	httpSrv := http.NewServer(config.Get().Server.HTTPAddr)
+	httpSrv.Container.Filter(ratelimit.Filter)
 	grpcSrv := grpc.NewServer(config.Get().Server.GRPCAddr)
 	serverList := []transport.Server{httpSrv, grpcSrv}
 
@@ -181,39 +191,55 @@ func core(cmd *cobra.Command, args []string) {
 	// wait sidecar ready.
 	time.Sleep(1 * time.Second)
 
-	// register core service.
+	// register core service, single node deployments run without discovery.
 	var err error
 	var discoveryEnd *discovery.Discovery
-	if discoveryEnd, err = discovery.New(discovery.Config{
-		Endpoints:   config.Get().Discovery.Endpoints,
-		HeartTime:   config.Get().Discovery.HeartTime,
-		DialTimeout: config.Get().Discovery.DialTimeout,
-	}); nil != err {
+	if len(config.Get().Discovery.Endpoints) > 0 {
+		if discoveryEnd, err = discovery.New(discovery.Config{
+			Endpoints:   config.Get().Discovery.Endpoints,
+			HeartTime:   config.Get().Discovery.HeartTime,
+			DialTimeout: config.Get().Discovery.DialTimeout,
+		}); nil != err {
+			log.Fatal(err)
+		}
+
+		// register service.
+		if err = discoveryEnd.Register(
+			context.Background(),
+			discovery.Service{
+				Name:  config.Get().Server.Name,
+				AppID: config.Get().Server.AppID,
+				Port:  getPort(config.Get().Server.GRPCAddr),
+				Host:  util.ResolveAddr(),
+				Metadata: map[string]interface{}{
+					"http_port":       getPort(config.Get().Server.HTTPAddr),
+					"grpc_port":       getPort(config.Get().Server.GRPCAddr),
+					"proxy_http_port": config.Get().Proxy.HTTPPort,
+					"proxy_grpc_port": config.Get().Proxy.GRPCPort,
+				},
+			}); nil != err {
+			log.Fatal(err)
+		}
+	} else {
+		log.L().Info("service discovery disabled, no discovery endpoints")
+	}
+
+	// create message dispatcher.
+	if err = loadDispatcher(context.Background()); nil != err {
 		log.Fatal(err)
 	}
 
-	// register service.
-	if err = discoveryEnd.Register(
-		context.Background(),
-		discovery.Service{
-			Name:  config.Get().Server.Name,
-			AppID: config.Get().Server.AppID,
-			Port:  getPort(config.Get().Server.GRPCAddr),
-			Host:  util.ResolveAddr(),
-			Metadata: map[string]interface{}{
-				"http_port":       getPort(config.Get().Server.HTTPAddr),
-				"grpc_port":       getPort(config.Get().Server.GRPCAddr),
-				"proxy_http_port": config.Get().Proxy.HTTPPort,
-				"proxy_grpc_port": config.Get().Proxy.GRPCPort,
-			},
-		}); nil != err {
+	// load expression function plugins.
+	if err = function.LoadPlugins(config.Get().Expression.Plugins); nil != err {
 		log.Fatal(err)
 	}
 
-	// create message dispatcher.
-	if err = loadDispatcher(context.Background()); nil != err {
+	// initialize api authorizer.
+	var authorizer auth.Authorizer
+	if authorizer, err = auth.NewAuthorizer(config.Get().Auth); nil != err {
 		log.Fatal(err)
 	}
+	service.SetAuthorizer(authorizer)
 
 	// initialize search engine.
 	if err = search.Init(config.Get().Components.SearchEngine); nil != err {
@@ -221,12 +247,15 @@ func core(cmd *cobra.Command, args []string) {
 	}
 
 	var coreDao dao.IDao
-	if coreDao, err = dao.New(ctx, config.Get().Components.Store, config.Get().Components.Etcd); nil != err {
+	if coreDao, err = dao.New(ctx, config.Get().Components.Store,
+		config.Get().Components.Metadata, config.Get().Components.Etcd); nil != err {
 		log.Fatal(err)
 	}
 
 	coreRepo := repository.New(coreDao)
-	nodeInstance := runtime.NewNode(context.Background(), newResourceManager(coreRepo), _dispatcher, config.Get().Components.SearchModel)
+	service.SetOwnerResolver(coreRepo.GetEntityOwner)
+	resourceManager := newResourceManager(coreRepo)
+	nodeInstance := runtime.NewNode(context.Background(), resourceManager, _dispatcher, config.Get().Components.SearchModel)
 	if _apiManager, err = apim.New(context.Background(), coreRepo, _dispatcher); nil != err {
 		log.Fatal(err)
 	}
@@ -238,13 +267,71 @@ func core(cmd *cobra.Command, args []string) {
 
 	// initialize core services.
 	initialzeService(_apiManager, search.GlobalService)
+	// initialize backup service.
+	_backupSrv.Init(backup.NewService(coreRepo, search.GlobalService, _apiManager))
+	// initialize schema service.
+	_schemaSrv.Init(_apiManager, search.GlobalService)
+	// initialize time series, rawdata and metrics services.
+	_tsSrv.Init(_apiManager, resourceManager)
+	_rawdataSrv.Init(resourceManager)
+	_metricsSrv.Init(resourceManager)
+	// initialize probe service.
+	_probeSrv.Init(nodeInstance, probeDependencies(nodeInstance, coreDao, resourceManager),
+		time.Duration(config.Get().Server.ProbeTimeout)*time.Millisecond)
+
+	// apply changes of the config file.
+	config.OnChange(func(prev, cur config.Configuration) {
+		reload(prev, cur, resourceManager)
+		_probeSrv.SetDependencies(probeDependencies(nodeInstance, coreDao, resourceManager))
+	})
+
+	// resume background jobs.
+	if err = _apiManager.Start(); nil != err {
+		log.L().Error("start api manager", logf.Error(err))
+	}
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGTERM, os.Interrupt)
 	<-stop
 
-	if err = coreApp.Stop(context.TODO()); err != nil {
-		log.Fatal(err)
+	shutdown(coreApp, nodeInstance, discoveryEnd, coreDao)
+}
+
+// shutdown stops the core within the shutdown deadline:
+// 1. stop consuming, drain runtimes, flush states and batched writes, commit offsets.
+// 2. close the dispatcher.
+// 3. deregister from discovery.
+// 4. stop servers and close the state store.
+func shutdown(coreApp *app.App, node *runtime.Node, discoveryEnd *discovery.Discovery, coreDao dao.IDao) {
+	timeout := time.Duration(config.Get().Server.ShutdownTimeout) * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	log.L().Info("shutting down core", logf.Any("timeout", timeout.String()))
+	// 1. drain node.
+	if err := node.Stop(ctx); nil != err {
+		log.L().Error("stop node", logf.Error(err))
+	}
+
+	// 2. close dispatcher.
+	if closer, ok := _dispatcher.(io.Closer); ok {
+		if err := closer.Close(); nil != err {
+			log.L().Error("close dispatcher", logf.Error(err))
+		}
+	}
+
+	// 3. deregister service.
+	if nil != discoveryEnd {
+		if err := discoveryEnd.Deregister(ctx); nil != err {
+			log.L().Error("deregister service", logf.Error(err))
+		}
+	}
+
+	// 4. stop servers.
+	if err := coreApp.Stop(ctx); err != nil {
+		log.L().Error("stop servers", logf.Error(err))
 	}
+	coreDao.Close()
+	log.L().Info("core stopped")
 }
 
 func initialzeService(apiManager apim.APIManager, searchClient corev1.SearchHTTPServer) {
@@ -252,14 +339,18 @@ func initialzeService(apiManager apim.APIManager, searchClient corev1.SearchHTTP
 	_entitySrv.Init(apiManager, searchClient)
 	// initialize subscription service.
 	_subscriptionSrv.Init(apiManager)
+	// initialize alarm service.
+	_alarmSrv.Init(apiManager)
+	// initialize template service.
+	_templateSrv.Init(apiManager)
+	// initialize request service.
+	_requestSrv.Init(apiManager)
 	// initialize topic service.
 	_topicSrv.Init(apiManager)
 	// initialize search service.
 	_searchSrv.Init(searchClient)
 	// initialize proxy service.
 	_proxySrv.Init(apiManager)
-	// initialize ts service.
-	_tsSrv.Init(apiManager)
 }
 
 var (
@@ -269,9 +360,16 @@ var (
 	_entitySrv       *service.EntityService
 	_searchSrv       *service.SearchService
 	_subscriptionSrv *service.SubscriptionService
+	_alarmSrv        *service.AlarmService
+	_backupSrv       *service.BackupService
+	_templateSrv     *service.TemplateService
+	_schemaSrv       *service.SchemaService
+	_requestSrv      *service.RequestService
 	_rawdataSrv      *service.RawdataService
 	_metricsSrv      *service.MetricsService
 	_gopsSrv         *service.GOPSService
+	_probeSrv        *service.ProbeService
+	_configSrv       *service.ConfigService
 )
 
 // serviceRegisterToCoreV1 register your services here.
@@ -291,6 +389,42 @@ func serviceRegisterToCoreV1(ctx context.Context, httpSrv *http.Server, grpcSrv
 	corev1.RegisterSubscriptionHTTPServer(httpSrv.Container, _subscriptionSrv)
 	corev1.RegisterSubscriptionServer(grpcSrv.GetServe(), _subscriptionSrv)
 
+	// register alarm service.
+	if _alarmSrv, err = service.NewAlarmService(ctx); nil != err {
+		log.Fatal(err)
+	}
+	corev1.RegisterAlarmHTTPServer(httpSrv.Container, _alarmSrv)
+	corev1.RegisterAlarmServer(grpcSrv.GetServe(), _alarmSrv)
+
+	// register backup service.
+	if _backupSrv, err = service.NewBackupService(ctx); nil != err {
+		log.Fatal(err)
+	}
+	corev1.RegisterBackupHTTPServer(httpSrv.Container, _backupSrv)
+	corev1.RegisterBackupStreamHTTPServer(httpSrv.Container, _backupSrv)
+	corev1.RegisterBackupServer(grpcSrv.GetServe(), _backupSrv)
+
+	// register template service.
+	if _templateSrv, err = service.NewTemplateService(ctx); nil != err {
+		log.Fatal(err)
+	}
+	corev1.RegisterTemplateHTTPServer(httpSrv.Container, _templateSrv)
+	corev1.RegisterTemplateServer(grpcSrv.GetServe(), _templateSrv)
+
+	// register schema service.
+	if _schemaSrv, err = service.NewSchemaService(ctx); nil != err {
+		log.Fatal(err)
+	}
+	corev1.RegisterSchemaHTTPServer(httpSrv.Container, _schemaSrv)
+	corev1.RegisterSchemaServer(grpcSrv.GetServe(), _schemaSrv)
+
+	// register request service.
+	if _requestSrv, err = service.NewRequestService(ctx); nil != err {
+		log.Fatal(err)
+	}
+	corev1.RegisterRequestHTTPServer(httpSrv.Container, _requestSrv)
+	corev1.RegisterRequestServer(grpcSrv.GetServe(), _requestSrv)
+
 	// register topic service.
 	if _topicSrv, err = service.NewTopicService(ctx); nil != err {
 		log.Fatal(err)
@@ -298,6 +432,20 @@ func serviceRegisterToCoreV1(ctx context.Context, httpSrv *http.Server, grpcSrv
 	corev1.RegisterTopicHTTPServer(httpSrv.Container, _topicSrv)
 	corev1.RegisterTopicServer(grpcSrv.GetServe(), _topicSrv)
 
+	// register probe service.
+	if _probeSrv, err = service.NewProbeService(ctx); nil != err {
+		log.Fatal(err)
+	}
+	corev1.RegisterProbeHTTPServer(httpSrv.Container, _probeSrv)
+	corev1.RegisterProbeServer(grpcSrv.GetServe(), _probeSrv)
+
+	// register config service.
+	if _configSrv, err = service.NewConfigService(ctx); nil != err {
+		log.Fatal(err)
+	}
+	corev1.RegisterConfigHTTPServer(httpSrv.Container, _configSrv)
+	corev1.RegisterConfigServer(grpcSrv.GetServe(), _configSrv)
+
 	// register search service.
 	_searchSrv = service.NewSearchService()
 	corev1.RegisterSearchHTTPServer(httpSrv.Container, _searchSrv)
@@ -351,6 +499,121 @@ func newResourceManager(coreRepo repository.IRepository) types.ResourceManager {
 	return types.NewResources(search.GlobalService, tsdbClient, rawdataClient, coreRepo)
 }
 
+// probeDependencies returns dependencies checked on readiness probes, events are not served without
+// the state store, metadata and sources, which are critical, others degrade features only.
+func probeDependencies(node *runtime.Node, coreDao dao.IDao, resourceManager types.ResourceManager) map[string]service.Dependency {
+	deps := map[string]service.Dependency{
+		"state_store":   {Check: coreDao.CheckStore, Critical: true},
+		"search_engine": {Check: search.GlobalService.Ping},
+	}
+
+	metadataName := dao.MetadataEtcd
+	if name := resource.ParseFrom(config.Get().Components.Metadata).Name; name != "" && name != dao.MetadataEtcd {
+		metadataName = "metadata." + name
+	}
+	deps[metadataName] = service.Dependency{Check: coreDao.CheckMetadata, Critical: true}
+
+	// time series and rawdata are looked up on checks, since they are replaced on config changes.
+	deps["tsdb"] = service.Dependency{Check: func(ctx context.Context) error {
+		return ping(ctx, resourceManager.TSDB())
+	}}
+	deps["rawdata"] = service.Dependency{Check: func(ctx context.Context) error {
+		return ping(ctx, resourceManager.RawData())
+	}}
+
+	// kafka sources and sinks.
+	for name, check := range node.Checks() {
+		deps[name] = service.Dependency{Check: check, Critical: true}
+	}
+	if checker, ok := _dispatcher.(interface {
+		Checks() map[string]func(context.Context) error
+	}); ok {
+		for name, check := range checker.Checks() {
+			deps[name] = service.Dependency{Check: check}
+		}
+	}
+
+	if dapr.SidecarEnabled() {
+		deps["dapr_sidecar"] = service.Dependency{Check: dapr.Ping}
+	}
+	return deps
+}
+
+// ping checks connections of the resource, resources without connections are always healthy.
+func ping(ctx context.Context, res interface{}) error {
+	if pinger, ok := res.(interface{ Ping(context.Context) error }); ok {
+		return errors.Wrap(pinger.Ping(ctx), "ping")
+	}
+	return nil
+}
+
+// retireGrace is the time requests in flight are given to finish with replaced resources.
+const retireGrace = 5 * time.Second
+
+// reload applies changes of the search engine, time series and rawdata,
+// resources are kept if new ones can not be created.
+func reload(prev, cur config.Configuration, resourceManager types.ResourceManager) {
+	timeout := time.Duration(cur.Server.ShutdownTimeout) * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if prev.Components.SearchEngine != cur.Components.SearchEngine {
+		if engines, err := search.GlobalService.Reload(cur.Components.SearchEngine); nil != err {
+			log.L().Error("reload search engine", logf.Error(err))
+		} else {
+			retire("search engine", engines, timeout)
+			// the new search engine may be another cluster, which has no mappings of schemas.
+			if err = _schemaSrv.PutMappings(ctx); nil != err {
+				log.L().Error("put schema search mappings", logf.Error(err))
+			}
+			log.L().Info("search engine reloaded")
+		}
+	}
+
+	if !reflect.DeepEqual(prev.Components.TimeSeries, cur.Components.TimeSeries) {
+		metadata := resource.ParseFrom(cur.Components.TimeSeries)
+		tsdbClient := tseries.NewTimeSerier(metadata.Name)
+		if err := tsdbClient.Init(metadata); nil != err {
+			log.L().Error("reload time series", logf.Name(metadata.Name), logf.Error(err))
+		} else {
+			retire("time series", resourceManager.SetTSDB(tsdbClient), timeout)
+			log.L().Info("time series reloaded", logf.Name(metadata.Name))
+		}
+	}
+
+	if !reflect.DeepEqual(prev.Components.Rawdata, cur.Components.Rawdata) {
+		metadata := resource.ParseFrom(cur.Components.Rawdata)
+		rawdataClient := rawdata.NewRawDataService(metadata.Name)
+		if err := rawdataClient.Init(metadata); nil != err {
+			log.L().Error("reload rawdata", logf.Name(metadata.Name), logf.Error(err))
+		} else {
+			retire("rawdata", resourceManager.SetRawData(rawdataClient), timeout)
+			log.L().Info("rawdata reloaded", logf.Name(metadata.Name))
+		}
+	}
+}
+
+// retire flushes and closes the replaced resource in background after retireGrace,
+// so that requests which got the resource before the replacement are not failed.
+func retire(name string, res interface{}, timeout time.Duration) {
+	go func() {
+		time.Sleep(retireGrace)
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		if flusher, ok := res.(interface{ Flush(context.Context) error }); ok {
+			if err := flusher.Flush(ctx); nil != err {
+				log.L().Error("flush replaced resource", logf.Name(name), logf.Error(err))
+			}
+		}
+		if closer, ok := res.(io.Closer); ok {
+			if err := closer.Close(); nil != err {
+				log.L().Error("close replaced resource", logf.Name(name), logf.Error(err))
+			}
+		}
+	}()
+}
+
 func loadDispatcher(ctx context.Context) error {
 	log.L().Info("load dispatcher...")
 	dispatcher := dispatch.New(ctx)
@@ -3,6 +3,7 @@ module github.com/tkeel-io/core
 go 1.17
 
 require (
+	github.com/DataDog/zstd v1.4.6-0.20210211175136-c6db21d202f4
 	github.com/Shopify/sarama v1.23.1
 	github.com/cloudevents/sdk-go v1.2.0
 	github.com/dapr/go-sdk v1.3.0
@@ -35,6 +36,7 @@ require (
 	google.golang.org/genproto v0.0.0-20220505152158-f39f71e6c8f3
 	google.golang.org/grpc v1.46.0
 	google.golang.org/protobuf v1.28.0
+	gopkg.in/yaml.v3 v3.0.0-20210107192922-496545a6307b
 )
 
 require (
@@ -45,10 +47,10 @@ require (
 	github.com/prometheus/client_golang v1.11.0
 	github.com/tkeel-io/kit v0.0.0-20220516081405-657ecd52268a
 	github.com/valyala/fastrand v1.1.0
+	golang.org/x/sys v0.0.0-20220429233432-b5fbb4746d32
 )
 
 require (
-	github.com/DataDog/zstd v1.4.6-0.20210211175136-c6db21d202f4 // indirect
 	github.com/antlr/antlr4/runtime/Go/antlr v0.0.0-20211026222012-6af4c774c47b // indirect
 	github.com/beorn7/perks v1.0.1 // indirect
 	github.com/cenkalti/backoff/v4 v4.1.1 // indirect
@@ -100,7 +102,6 @@ require (
 	go.opentelemetry.io/otel/trace v1.7.0 // indirect
 	go.uber.org/multierr v1.7.0 // indirect
 	golang.org/x/crypto v0.0.0-20210921155107-089bfa567519 // indirect
-	golang.org/x/sys v0.0.0-20220429233432-b5fbb4746d32 // indirect
 	golang.org/x/text v0.3.7 // indirect
 	gopkg.in/ini.v1 v1.63.2 // indirect
 	gopkg.in/jcmturner/aescts.v1 v1.0.1 // indirect
@@ -108,7 +109,6 @@ require (
 	gopkg.in/jcmturner/gokrb5.v7 v7.3.0 // indirect
 	gopkg.in/jcmturner/rpc.v1 v1.1.0 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
-	gopkg.in/yaml.v3 v3.0.0-20210107192922-496545a6307b // indirect
 )
 
 replace github.com/antlr/antlr4 => github.com/antlr/antlr4/runtime/Go/antlr v0.0.0-20211221011931-643d94fcab96
@@ -0,0 +1,120 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core/pkg/config"
+	transportHTTP "github.com/tkeel-io/kit/transport/http"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	HeaderOwner         = "Owner"
+	HeaderAuthorization = "Authorization"
+	bearerPrefix        = "Bearer "
+)
+
+var registeredAuthorizers = make(map[string]Generator)
+
+type Action int
+
+const (
+	ActionRead Action = iota + 1
+	ActionWrite
+	ActionAdmin
+)
+
+func (a Action) String() string {
+	switch a {
+	case ActionRead:
+		return "read"
+	case ActionWrite:
+		return "write"
+	case ActionAdmin:
+		return "admin"
+	default:
+		return "unknown"
+	}
+}
+
+func ParseAction(action string) (Action, error) {
+	switch strings.ToLower(action) {
+	case "read":
+		return ActionRead, nil
+	case "write":
+		return ActionWrite, nil
+	case "admin":
+		return ActionAdmin, nil
+	default:
+		return 0, errors.Errorf("invalid action %s", action)
+	}
+}
+
+// Principal is the authenticated caller.
+type Principal struct {
+	Subject string
+	Tenant  string
+	Roles   []string
+}
+
+// Resource is the object an action is performed on.
+type Resource struct {
+	Owner string
+	Type  string
+	ID    string
+}
+
+type Authorizer interface {
+	// Authenticate resolves the caller of the request.
+	Authenticate(ctx context.Context) (*Principal, error)
+	// Authorize checks whether the caller may perform the action on the resource.
+	Authorize(ctx context.Context, principal *Principal, action Action, res *Resource) error
+}
+
+type Generator func(config.AuthConfig) (Authorizer, error)
+
+func NewAuthorizer(cfg config.AuthConfig) (Authorizer, error) {
+	if cfg.Type == "" {
+		return NewNoop(), nil
+	}
+
+	generator, has := registeredAuthorizers[cfg.Type]
+	if !has {
+		return nil, errors.Errorf("authorizer %s not registered", cfg.Type)
+	}
+
+	authorizer, err := generator(cfg)
+	return authorizer, errors.Wrap(err, "new authorizer")
+}
+
+func Register(name string, generator Generator) {
+	registeredAuthorizers[name] = generator
+}
+
+// HeaderFrom returns headers of http requests or metadata of grpc requests.
+func HeaderFrom(ctx context.Context) http.Header {
+	if header := transportHTTP.HeaderFromContext(ctx); nil != header {
+		return header
+	}
+
+	header := make(http.Header)
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for key, values := range md {
+			for _, value := range values {
+				header.Add(key, value)
+			}
+		}
+	}
+	return header
+}
+
+func bearerToken(header http.Header) string {
+	token := header.Get(HeaderAuthorization)
+	if len(token) > len(bearerPrefix) && strings.EqualFold(token[:len(bearerPrefix)], bearerPrefix) {
+		return token[len(bearerPrefix):]
+	}
+	return ""
+}
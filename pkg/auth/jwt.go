@@ -0,0 +1,291 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"hash"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+)
+
+const AuthorizerJWT = "jwt"
+
+func init() {
+	Register(AuthorizerJWT, func(cfg config.AuthConfig) (Authorizer, error) {
+		return NewJWT(cfg)
+	})
+}
+
+// JSONWebKey is a public key of JWKS, RSA and EC keys are supported.
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+type jwtAuthorizer struct {
+	cfg  config.AuthConfig
+	keys map[string]crypto.PublicKey
+	rbac *RBAC
+	now  func() time.Time
+}
+
+// NewJWT returns an authorizer which verifies bearer tokens with keys of a local JWKS file,
+// and checks actions with RBAC policies.
+func NewJWT(cfg config.AuthConfig) (Authorizer, error) {
+	bytes, err := os.ReadFile(cfg.JWKSFile)
+	if nil != err {
+		return nil, errors.Wrap(err, "read jwks file")
+	}
+
+	keys, err := ParseJWKS(bytes)
+	if nil != err {
+		return nil, errors.Wrap(err, "parse jwks file")
+	}
+
+	rbac, err := NewRBAC(cfg.Policies)
+	if nil != err {
+		return nil, errors.Wrap(err, "new rbac")
+	}
+
+	return &jwtAuthorizer{cfg: cfg, keys: keys, rbac: rbac, now: time.Now}, nil
+}
+
+// ParseJWKS returns public keys of the JWKS indexed by key id.
+func ParseJWKS(bytes []byte) (map[string]crypto.PublicKey, error) {
+	var jwks struct {
+		Keys []JSONWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(bytes, &jwks); nil != err {
+		return nil, errors.Wrap(err, "unmarshal jwks")
+	}
+
+	keys := make(map[string]crypto.PublicKey)
+	for _, jwk := range jwks.Keys {
+		key, err := jwk.PublicKey()
+		if nil != err {
+			return nil, errors.Wrapf(err, "parse key %s", jwk.Kid)
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, nil
+}
+
+func (k *JSONWebKey) PublicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if nil != err {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if nil != err {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errors.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if nil != err {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if nil != err {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, errors.Errorf("unsupported key type %s", k.Kty)
+	}
+}
+
+func (j *jwtAuthorizer) Authenticate(ctx context.Context) (*Principal, error) {
+	token := bearerToken(HeaderFrom(ctx))
+	if token == "" {
+		return nil, errors.Wrap(xerrors.ErrUnauthenticated, "bearer token required")
+	}
+
+	claims, err := j.verify(token)
+	if nil != err {
+		return nil, errors.Wrap(xerrors.ErrUnauthenticated, err.Error())
+	}
+
+	principal := &Principal{}
+	principal.Subject, _ = claims["sub"].(string)
+	principal.Tenant, _ = claims[j.cfg.TenantClaim].(string)
+	switch roles := claims[j.cfg.RolesClaim].(type) {
+	case string:
+		principal.Roles = strings.Fields(roles)
+	case []interface{}:
+		for _, role := range roles {
+			if r, ok := role.(string); ok {
+				principal.Roles = append(principal.Roles, r)
+			}
+		}
+	}
+	return principal, nil
+}
+
+func (j *jwtAuthorizer) Authorize(ctx context.Context, principal *Principal, action Action, res *Resource) error {
+	if !j.rbac.Allow(principal, action, res) {
+		return errors.Wrapf(xerrors.ErrPermissionDenied,
+			"%s %s of tenant %s", action, res.ID, res.Owner)
+	}
+	return nil
+}
+
+// verify checks signature, expiry, issuer and audience of the token, returns its claims.
+func (j *jwtAuthorizer) verify(token string) (map[string]interface{}, error) {
+	segments := strings.Split(token, ".")
+	if len(segments) != 3 {
+		return nil, errors.New("malformed token")
+	}
+
+	var header jwtHeader
+	if err := decodeSegment(segments[0], &header); nil != err {
+		return nil, errors.Wrap(err, "decode token header")
+	}
+
+	key, has := j.keys[header.Kid]
+	if !has {
+		return nil, errors.Errorf("unknown key %s", header.Kid)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(segments[2])
+	if nil != err {
+		return nil, errors.Wrap(err, "decode token signature")
+	}
+	if err = verifySignature(header.Alg, key, segments[0]+"."+segments[1], signature); nil != err {
+		return nil, err
+	}
+
+	var claims map[string]interface{}
+	if err = decodeSegment(segments[1], &claims); nil != err {
+		return nil, errors.Wrap(err, "decode token claims")
+	}
+
+	now := float64(j.now().Unix())
+	if exp, ok := claims["exp"].(float64); ok && now >= exp {
+		return nil, errors.New("token expired")
+	}
+	if nbf, ok := claims["nbf"].(float64); ok && now < nbf {
+		return nil, errors.New("token not valid yet")
+	}
+	if iss, _ := claims["iss"].(string); j.cfg.Issuer != "" && iss != j.cfg.Issuer {
+		return nil, errors.Errorf("invalid issuer %s", iss)
+	}
+	if j.cfg.Audience != "" && !hasAudience(claims["aud"], j.cfg.Audience) {
+		return nil, errors.New("invalid audience")
+	}
+	return claims, nil
+}
+
+func verifySignature(alg string, key crypto.PublicKey, signed string, signature []byte) error {
+	if len(alg) != 5 {
+		return errors.Errorf("unsupported algorithm %s", alg)
+	}
+
+	var (
+		h      hash.Hash
+		hashID crypto.Hash
+	)
+	switch alg[2:] {
+	case "256":
+		h, hashID = sha256.New(), crypto.SHA256
+	case "384":
+		h, hashID = sha512.New384(), crypto.SHA384
+	case "512":
+		h, hashID = sha512.New(), crypto.SHA512
+	default:
+		return errors.Errorf("unsupported algorithm %s", alg)
+	}
+	h.Write([]byte(signed))
+	digest := h.Sum(nil)
+
+	switch pub := key.(type) {
+	case *rsa.PublicKey:
+		if !strings.HasPrefix(alg, "RS") {
+			return errors.Errorf("algorithm %s mismatch key", alg)
+		}
+		return errors.Wrap(rsa.VerifyPKCS1v15(pub, hashID, digest, signature), "verify signature")
+	case *ecdsa.PublicKey:
+		if !strings.HasPrefix(alg, "ES") {
+			return errors.Errorf("algorithm %s mismatch key", alg)
+		}
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return errors.New("invalid signature")
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(pub, digest, r, s) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	default:
+		return errors.New("unsupported key")
+	}
+}
+
+func hasAudience(aud interface{}, audience string) bool {
+	switch a := aud.(type) {
+	case string:
+		return a == audience
+	case []interface{}:
+		for _, item := range a {
+			if item == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	bytes, err := base64.RawURLEncoding.DecodeString(segment)
+	if nil != err {
+		return errors.Wrap(err, "decode segment")
+	}
+	return errors.Wrap(json.Unmarshal(bytes, v), "unmarshal segment")
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	bytes, err := base64.RawURLEncoding.DecodeString(s)
+	if nil != err {
+		return nil, errors.Wrap(err, "decode key")
+	}
+	return new(big.Int).SetBytes(bytes), nil
+}
@@ -0,0 +1,129 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	transportHTTP "github.com/tkeel-io/kit/transport/http"
+)
+
+func signToken(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "kid": kid})
+	payload, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	assert.Nil(t, err)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func newTestJWT(t *testing.T) (*jwtAuthorizer, *rsa.PrivateKey) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err)
+
+	jwks, _ := json.Marshal(map[string]interface{}{
+		"keys": []JSONWebKey{{
+			Kty: "RSA",
+			Kid: "key1",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	})
+	keys, err := ParseJWKS(jwks)
+	assert.Nil(t, err)
+
+	rbac, err := NewRBAC([]config.AuthPolicy{
+		{Role: "viewer", Action: "read", Tenants: []string{TenantSelf}},
+		{Role: "operator", Action: "write", EntityTypes: []string{"device"}},
+		{Role: "root", Action: "admin", Tenants: []string{TenantAny}},
+	})
+	assert.Nil(t, err)
+
+	cfg := config.AuthConfig{Issuer: "tkeel", TenantClaim: "tenant", RolesClaim: "roles"}
+	return &jwtAuthorizer{cfg: cfg, keys: keys, rbac: rbac, now: time.Now}, key
+}
+
+func withToken(token string) context.Context {
+	header := make(http.Header)
+	header.Set(HeaderAuthorization, "Bearer "+token)
+	return transportHTTP.ContextWithHeader(context.Background(), header)
+}
+
+func TestJWT_Authenticate(t *testing.T) {
+	authorizer, key := newTestJWT(t)
+
+	_, err := authorizer.Authenticate(context.Background())
+	assert.ErrorIs(t, err, xerrors.ErrUnauthenticated)
+
+	exp := float64(time.Now().Add(time.Hour).Unix())
+	token := signToken(t, key, "key1", map[string]interface{}{
+		"sub": "user1", "iss": "tkeel", "exp": exp, "tenant": "tenant1", "roles": []string{"viewer"}})
+	principal, err := authorizer.Authenticate(withToken(token))
+	assert.Nil(t, err)
+	assert.Equal(t, &Principal{Subject: "user1", Tenant: "tenant1", Roles: []string{"viewer"}}, principal)
+
+	// expired.
+	token = signToken(t, key, "key1", map[string]interface{}{
+		"sub": "user1", "iss": "tkeel", "exp": float64(time.Now().Add(-time.Hour).Unix())})
+	_, err = authorizer.Authenticate(withToken(token))
+	assert.ErrorIs(t, err, xerrors.ErrUnauthenticated)
+
+	// invalid issuer.
+	token = signToken(t, key, "key1", map[string]interface{}{"sub": "user1", "iss": "other", "exp": exp})
+	_, err = authorizer.Authenticate(withToken(token))
+	assert.ErrorIs(t, err, xerrors.ErrUnauthenticated)
+
+	// unknown key.
+	token = signToken(t, key, "key2", map[string]interface{}{"sub": "user1", "iss": "tkeel", "exp": exp})
+	_, err = authorizer.Authenticate(withToken(token))
+	assert.ErrorIs(t, err, xerrors.ErrUnauthenticated)
+
+	// tampered claims.
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err)
+	token = signToken(t, other, "key1", map[string]interface{}{"sub": "user1", "iss": "tkeel", "exp": exp})
+	_, err = authorizer.Authenticate(withToken(token))
+	assert.ErrorIs(t, err, xerrors.ErrUnauthenticated)
+}
+
+func TestJWT_Authorize(t *testing.T) {
+	authorizer, _ := newTestJWT(t)
+	ctx := context.Background()
+
+	viewer := &Principal{Tenant: "tenant1", Roles: []string{"viewer"}}
+	assert.Nil(t, authorizer.Authorize(ctx, viewer, ActionRead, &Resource{Owner: "tenant1"}))
+	assert.ErrorIs(t, authorizer.Authorize(ctx, viewer, ActionWrite, &Resource{Owner: "tenant1"}), xerrors.ErrPermissionDenied)
+	assert.ErrorIs(t, authorizer.Authorize(ctx, viewer, ActionRead, &Resource{Owner: "tenant2"}), xerrors.ErrPermissionDenied)
+
+	operator := &Principal{Tenant: "tenant1", Roles: []string{"operator"}}
+	assert.Nil(t, authorizer.Authorize(ctx, operator, ActionWrite, &Resource{Owner: "tenant1", Type: "device"}))
+	assert.Nil(t, authorizer.Authorize(ctx, operator, ActionRead, &Resource{Owner: "tenant1", Type: "device"}))
+	assert.ErrorIs(t, authorizer.Authorize(ctx, operator, ActionWrite, &Resource{Owner: "tenant1", Type: "group"}), xerrors.ErrPermissionDenied)
+	assert.ErrorIs(t, authorizer.Authorize(ctx, operator, ActionAdmin, &Resource{Owner: "tenant1", Type: "device"}), xerrors.ErrPermissionDenied)
+
+	root := &Principal{Tenant: "tenant1", Roles: []string{"root"}}
+	assert.Nil(t, authorizer.Authorize(ctx, root, ActionAdmin, &Resource{Owner: "tenant2"}))
+}
+
+func TestRBAC_Default(t *testing.T) {
+	rbac, err := NewRBAC(nil)
+	assert.Nil(t, err)
+	principal := &Principal{Tenant: "tenant1"}
+	assert.True(t, rbac.Allow(principal, ActionAdmin, &Resource{Owner: "tenant1"}))
+	assert.False(t, rbac.Allow(principal, ActionRead, &Resource{Owner: "tenant2"}))
+
+	_, err = NewRBAC([]config.AuthPolicy{{Role: "viewer", Action: "delete"}})
+	assert.NotNil(t, err)
+}
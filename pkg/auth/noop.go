@@ -0,0 +1,21 @@
+package auth
+
+import (
+	"context"
+)
+
+type noop struct{}
+
+// NewNoop returns an authorizer which trusts the Owner header and allows any action.
+func NewNoop() Authorizer {
+	return &noop{}
+}
+
+func (n *noop) Authenticate(ctx context.Context) (*Principal, error) {
+	owner := HeaderFrom(ctx).Get(HeaderOwner)
+	return &Principal{Subject: owner, Tenant: owner}, nil
+}
+
+func (n *noop) Authorize(context.Context, *Principal, Action, *Resource) error {
+	return nil
+}
@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core/pkg/config"
+)
+
+const (
+	TenantAny  = "*"
+	TenantSelf = "$self"
+)
+
+// Policy grants an action on tenants and entity types to a role.
+type Policy struct {
+	Role        string
+	Action      Action
+	Tenants     []string
+	EntityTypes []string
+}
+
+// RBAC checks actions against role policies.
+type RBAC struct {
+	policies map[string][]Policy
+}
+
+// NewRBAC returns RBAC of the policies,
+// callers have admin access to their own tenant if no policy configured.
+func NewRBAC(policies []config.AuthPolicy) (*RBAC, error) {
+	rbac := &RBAC{policies: make(map[string][]Policy)}
+	for _, p := range policies {
+		action, err := ParseAction(p.Action)
+		if nil != err {
+			return nil, errors.Wrapf(err, "parse policy of role %s", p.Role)
+		}
+		rbac.policies[p.Role] = append(rbac.policies[p.Role],
+			Policy{Role: p.Role, Action: action, Tenants: p.Tenants, EntityTypes: p.EntityTypes})
+	}
+	return rbac, nil
+}
+
+// Allow reports whether any role of the principal allows the action on the resource.
+func (r *RBAC) Allow(principal *Principal, action Action, res *Resource) bool {
+	if len(r.policies) == 0 {
+		return res.Owner == principal.Tenant
+	}
+
+	for _, role := range principal.Roles {
+		for _, policy := range r.policies[role] {
+			if policy.Action >= action &&
+				policy.matchTenant(principal, res.Owner) &&
+				policy.matchType(res.Type) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (p *Policy) matchTenant(principal *Principal, owner string) bool {
+	if len(p.Tenants) == 0 {
+		return owner == principal.Tenant
+	}
+
+	for _, tenant := range p.Tenants {
+		switch tenant {
+		case TenantAny:
+			return true
+		case TenantSelf:
+			if owner == principal.Tenant {
+				return true
+			}
+		default:
+			if owner == tenant {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchType matches any type if no type configured,
+// otherwise the type of the resource must be known.
+func (p *Policy) matchType(typ string) bool {
+	if len(p.EntityTypes) == 0 {
+		return true
+	}
+
+	for _, entityType := range p.EntityTypes {
+		if entityType == typ {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,363 @@
+package backup
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository"
+)
+
+// ArchiveVersion is the version of archives written, archives of newer versions are rejected.
+// version 2 adds templates, template instances, trash and alarm rules.
+const ArchiveVersion = 2
+
+const (
+	SectionEntities          = "entities"
+	SectionExpressions       = "expressions"
+	SectionSubscriptions     = "subscriptions"
+	SectionSchemas           = "schemas"
+	SectionTemplates         = "templates"
+	SectionTemplateInstances = "template_instances"
+	SectionTrash             = "trash"
+	SectionAlarmRules        = "alarm_rules"
+
+	manifestFile  = "manifest.json"
+	sectionSuffix = ".jsonl"
+)
+
+// sectionNames are in restore order, records refer records of sections before them.
+var sectionNames = []string{
+	SectionSchemas, SectionTemplates, SectionEntities, SectionTemplateInstances,
+	SectionTrash, SectionExpressions, SectionSubscriptions, SectionAlarmRules,
+}
+
+// Manifest describes an archive, it is the first file of the archive.
+type Manifest struct {
+	Version   int        `json:"version"`
+	CreatedAt int64      `json:"created_at"`
+	Revision  int64      `json:"revision"`
+	Owners    []string   `json:"owners,omitempty"`
+	Tenants   []string   `json:"tenants,omitempty"`
+	Sections  []*Section `json:"sections"`
+}
+
+// Section is a file of the archive, records are encoded as JSON lines.
+type Section struct {
+	Name   string `json:"name"`
+	Count  int    `json:"count"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// EntityRecord is the state of an entity and its search index document.
+type EntityRecord struct {
+	ID     string              `json:"id"`
+	Owner  string              `json:"owner"`
+	Tenant string              `json:"tenant,omitempty"`
+	State  []byte              `json:"state"`
+	Index  jsoniter.RawMessage `json:"index,omitempty"`
+}
+
+// TemplateInstanceRecord indexes an entity as an instance of a template version.
+type TemplateInstanceRecord struct {
+	Owner      string `json:"owner"`
+	TemplateID string `json:"template_id"`
+	EntityID   string `json:"entity_id"`
+	Version    int64  `json:"version"`
+}
+
+// Dataset is the content of an archive.
+type Dataset struct {
+	Entities          []*EntityRecord
+	Expressions       []*repository.Expression
+	Subscriptions     []*repository.Subscription
+	Schemas           []*repository.Schema
+	Templates         []*repository.Template
+	TemplateInstances []*TemplateInstanceRecord
+	Trash             []*repository.TrashEntity
+	AlarmRules        []*repository.AlarmRule
+}
+
+// records returns the pointer to records of the section.
+func (ds *Dataset) records(name string) interface{} {
+	switch name {
+	case SectionEntities:
+		return &ds.Entities
+	case SectionExpressions:
+		return &ds.Expressions
+	case SectionSubscriptions:
+		return &ds.Subscriptions
+	case SectionSchemas:
+		return &ds.Schemas
+	case SectionTemplates:
+		return &ds.Templates
+	case SectionTemplateInstances:
+		return &ds.TemplateInstances
+	case SectionTrash:
+		return &ds.Trash
+	case SectionAlarmRules:
+		return &ds.AlarmRules
+	}
+	return nil
+}
+
+func decodeSection(data []byte, records interface{}) (int, error) {
+	var count int
+	items := reflect.ValueOf(records).Elem()
+	itemType := items.Type().Elem().Elem()
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 64*1024), len(data)+1)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		item := reflect.New(itemType)
+		if err := json.Unmarshal(line, item.Interface()); nil != err {
+			return count, errors.Wrap(err, "decode section")
+		}
+		items.Set(reflect.Append(items, item))
+		count++
+	}
+	return count, errors.Wrap(scanner.Err(), "decode section")
+}
+
+// sectionWriter spools records of a section into a temporary file.
+type sectionWriter struct {
+	file    *os.File
+	writer  *bufio.Writer
+	hash    hash.Hash
+	encoder *jsoniter.Encoder
+	section *Section
+}
+
+// archiveWriter spools sections into temporary files, so that the manifest with checksums of
+// sections is written first, without holding sections in memory.
+type archiveWriter struct {
+	dir      string
+	sections map[string]*sectionWriter
+}
+
+func newArchiveWriter() (*archiveWriter, error) {
+	dir, err := os.MkdirTemp("", "core-backup-")
+	if nil != err {
+		return nil, errors.Wrap(err, "create archive spool")
+	}
+
+	w := &archiveWriter{dir: dir, sections: make(map[string]*sectionWriter)}
+	for _, name := range sectionNames {
+		f, err := os.Create(filepath.Join(dir, name+sectionSuffix))
+		if nil != err {
+			w.close()
+			return nil, errors.Wrapf(err, "create archive spool of section %s", name)
+		}
+
+		sw := &sectionWriter{file: f, writer: bufio.NewWriter(f), hash: sha256.New(), section: &Section{Name: name}}
+		sw.encoder = json.NewEncoder(io.MultiWriter(sw.writer, sw.hash))
+		w.sections[name] = sw
+	}
+	return w, nil
+}
+
+// add appends the record to the section.
+func (w *archiveWriter) add(name string, record interface{}) error {
+	sw := w.sections[name]
+	if err := sw.encoder.Encode(record); nil != err {
+		return errors.Wrapf(err, "encode section %s", name)
+	}
+	sw.section.Count++
+	return nil
+}
+
+// count returns records added to the section.
+func (w *archiveWriter) count(name string) int {
+	return w.sections[name].section.Count
+}
+
+// seal completes sections, and records them in the manifest.
+func (w *archiveWriter) seal(manifest *Manifest) error {
+	manifest.Version = ArchiveVersion
+	if manifest.CreatedAt == 0 {
+		manifest.CreatedAt = time.Now().UnixMilli()
+	}
+
+	manifest.Sections = nil
+	for _, name := range sectionNames {
+		sw := w.sections[name]
+		if err := sw.writer.Flush(); nil != err {
+			return errors.Wrapf(err, "write archive section %s", name)
+		}
+		size, err := sw.file.Seek(0, io.SeekCurrent)
+		if nil != err {
+			return errors.Wrapf(err, "write archive section %s", name)
+		}
+		sw.section.Size = size
+		sw.section.SHA256 = hex.EncodeToString(sw.hash.Sum(nil))
+		manifest.Sections = append(manifest.Sections, sw.section)
+	}
+	return nil
+}
+
+// writeTo writes the gzipped tar archive of the sealed manifest.
+func (w *archiveWriter) writeTo(out io.Writer, manifest *Manifest) error {
+	raw, err := json.MarshalIndent(manifest, "", "  ")
+	if nil != err {
+		return errors.Wrap(err, "encode archive manifest")
+	}
+
+	gzipWriter := gzip.NewWriter(out)
+	tarWriter := tar.NewWriter(gzipWriter)
+	modTime := time.UnixMilli(manifest.CreatedAt)
+	if err = writeFile(tarWriter, manifestFile, raw, modTime); nil != err {
+		return err
+	}
+	for _, name := range sectionNames {
+		sw := w.sections[name]
+		if _, err = sw.file.Seek(0, io.SeekStart); nil != err {
+			return errors.Wrapf(err, "write archive file %s", name+sectionSuffix)
+		}
+		if err = writeHeader(tarWriter, name+sectionSuffix, sw.section.Size, modTime); nil != err {
+			return err
+		}
+		if _, err = io.CopyN(tarWriter, sw.file, sw.section.Size); nil != err {
+			return errors.Wrapf(err, "write archive file %s", name+sectionSuffix)
+		}
+	}
+
+	if err = tarWriter.Close(); nil != err {
+		return errors.Wrap(err, "close archive")
+	}
+	return errors.Wrap(gzipWriter.Close(), "close archive")
+}
+
+// close removes spooled sections.
+func (w *archiveWriter) close() {
+	for _, sw := range w.sections {
+		sw.file.Close()
+	}
+	os.RemoveAll(w.dir)
+}
+
+// WriteArchive writes the dataset as a gzipped tar archive, the manifest records checksums of sections.
+func WriteArchive(w io.Writer, manifest *Manifest, ds *Dataset) error {
+	aw, err := newArchiveWriter()
+	if nil != err {
+		return errors.Wrap(err, "write archive")
+	}
+	defer aw.close()
+
+	for _, name := range sectionNames {
+		items := reflect.ValueOf(ds.records(name)).Elem()
+		for index := 0; index < items.Len(); index++ {
+			if err = aw.add(name, items.Index(index).Interface()); nil != err {
+				return errors.Wrap(err, "write archive")
+			}
+		}
+	}
+	if err = aw.seal(manifest); nil != err {
+		return errors.Wrap(err, "write archive")
+	}
+	return aw.writeTo(w, manifest)
+}
+
+func writeHeader(tarWriter *tar.Writer, name string, size int64, modTime time.Time) error {
+	err := tarWriter.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    size,
+		ModTime: modTime,
+	})
+	return errors.Wrapf(err, "write archive file %s", name)
+}
+
+func writeFile(tarWriter *tar.Writer, name string, content []byte, modTime time.Time) error {
+	if err := writeHeader(tarWriter, name, int64(len(content)), modTime); nil != err {
+		return err
+	}
+	_, err := tarWriter.Write(content)
+	return errors.Wrapf(err, "write archive file %s", name)
+}
+
+// ReadArchive reads an archive, verifies its version and the checksums of all sections before returning it.
+func ReadArchive(r io.Reader) (*Manifest, *Dataset, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if nil != err {
+		return nil, nil, errors.Wrap(xerrors.ErrBackupCorrupted, err.Error())
+	}
+	defer gzipReader.Close()
+
+	var manifest *Manifest
+	contents := make(map[string][]byte)
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if nil != err {
+			return nil, nil, errors.Wrap(xerrors.ErrBackupCorrupted, err.Error())
+		}
+
+		content, err := io.ReadAll(tarReader)
+		if nil != err {
+			return nil, nil, errors.Wrap(xerrors.ErrBackupCorrupted, err.Error())
+		}
+
+		if manifest == nil {
+			if header.Name != manifestFile {
+				return nil, nil, errors.Wrapf(xerrors.ErrBackupCorrupted, "archive starts with %s", header.Name)
+			}
+			manifest = &Manifest{}
+			if err = json.Unmarshal(content, manifest); nil != err {
+				return nil, nil, errors.Wrap(xerrors.ErrBackupCorrupted, err.Error())
+			} else if manifest.Version <= 0 || manifest.Version > ArchiveVersion {
+				return nil, nil, errors.Wrapf(xerrors.ErrBackupVersion, "archive version %d", manifest.Version)
+			}
+			continue
+		}
+		contents[header.Name] = content
+	}
+
+	if manifest == nil {
+		return nil, nil, errors.Wrap(xerrors.ErrBackupCorrupted, "manifest missing")
+	}
+
+	ds := &Dataset{}
+	for _, section := range manifest.Sections {
+		content, ok := contents[section.Name+sectionSuffix]
+		records := ds.records(section.Name)
+		if !ok || records == nil {
+			return nil, nil, errors.Wrapf(xerrors.ErrBackupCorrupted, "section %s missing", section.Name)
+		}
+		// each section is decoded once.
+		delete(contents, section.Name+sectionSuffix)
+
+		sum := sha256.Sum256(content)
+		if int64(len(content)) != section.Size || hex.EncodeToString(sum[:]) != section.SHA256 {
+			return nil, nil, errors.Wrapf(xerrors.ErrBackupCorrupted, "section %s checksum mismatch", section.Name)
+		}
+
+		count, err := decodeSection(content, records)
+		if nil != err {
+			return nil, nil, errors.Wrapf(xerrors.ErrBackupCorrupted, "section %s, %s", section.Name, err.Error())
+		} else if count != section.Count {
+			return nil, nil, errors.Wrapf(xerrors.ErrBackupCorrupted,
+				"section %s has %d records, expected %d", section.Name, count, section.Count)
+		}
+	}
+
+	return manifest, ds, nil
+}
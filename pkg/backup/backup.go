@@ -0,0 +1,629 @@
+package backup
+
+import (
+	"context"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/tenant"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	searchPageSize = 200
+
+	// fieldTenant is the tenant of entity states.
+	fieldTenant = "properties.sysField._tenantId"
+)
+
+// Searcher is the search index of entities, entities are enumerated by it.
+type Searcher interface {
+	Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error)
+	IndexBytes(ctx context.Context, id string, jsonData []byte) (*pb.IndexResponse, error)
+}
+
+// Importer replaces states of entities through runtimes holding them, so that runtimes apply
+// restored states instead of overwriting them with states they hold.
+type Importer interface {
+	ImportEntity(ctx context.Context, id string, state []byte) error
+}
+
+// Filter selects the data of owners and tenants, empty lists select all.
+// expressions, subscriptions, trash, alarm rules and template instances follow their entities,
+// schemas and templates follow owners of the entities.
+type Filter struct {
+	Owners  []string
+	Tenants []string
+}
+
+func (f *Filter) empty() bool {
+	return f == nil || (len(f.Owners) == 0 && len(f.Tenants) == 0)
+}
+
+func (f *Filter) byTenants() bool {
+	return !f.empty() && len(f.Tenants) > 0
+}
+
+func (f *Filter) matchOwner(owner string) bool {
+	return f.empty() || len(f.Owners) == 0 || contains(f.Owners, owner)
+}
+
+func (f *Filter) matchEntity(owner, tenantID string) bool {
+	if f.empty() {
+		return true
+	}
+	return f.matchOwner(owner) && (len(f.Tenants) == 0 || contains(f.Tenants, tenantID))
+}
+
+// selection selects records by the filter, records following entities are selected
+// by the entities selected before them.
+type selection struct {
+	filter   *Filter
+	entities map[string]bool
+	owners   map[string]bool
+}
+
+func (f *Filter) selection() *selection {
+	return &selection{filter: f, entities: make(map[string]bool), owners: make(map[string]bool)}
+}
+
+func (sel *selection) entity(en *EntityRecord) bool {
+	if !sel.filter.matchEntity(en.Owner, en.Tenant) {
+		return false
+	}
+	sel.entities[en.ID] = true
+	sel.owners[en.Owner] = true
+	return true
+}
+
+// followEntity selects records of the owner which follow the entity.
+func (sel *selection) followEntity(owner, entityID string) bool {
+	return sel.filter.matchOwner(owner) && (!sel.filter.byTenants() || sel.entities[entityID])
+}
+
+// followOwner selects records of the owner which follow owners of entities.
+func (sel *selection) followOwner(owner string) bool {
+	return sel.filter.matchOwner(owner) && (!sel.filter.byTenants() || sel.owners[owner])
+}
+
+// apply returns the data of the dataset selected by the filter.
+func (f *Filter) apply(ds *Dataset) *Dataset {
+	if f.empty() {
+		return ds
+	}
+
+	ret := &Dataset{}
+	sel := f.selection()
+	for _, en := range ds.Entities {
+		if sel.entity(en) {
+			ret.Entities = append(ret.Entities, en)
+		}
+	}
+
+	for _, expr := range ds.Expressions {
+		if sel.followEntity(expr.Owner, expr.EntityID) {
+			ret.Expressions = append(ret.Expressions, expr)
+		}
+	}
+	for _, sub := range ds.Subscriptions {
+		if sel.followEntity(sub.Owner, sub.SourceEntityID) {
+			ret.Subscriptions = append(ret.Subscriptions, sub)
+		}
+	}
+	for _, trash := range ds.Trash {
+		if sel.followEntity(trash.Owner, trash.ID) {
+			ret.Trash = append(ret.Trash, trash)
+		}
+	}
+	for _, rule := range ds.AlarmRules {
+		if sel.followEntity(rule.Owner, rule.EntityID) {
+			ret.AlarmRules = append(ret.AlarmRules, rule)
+		}
+	}
+	for _, instance := range ds.TemplateInstances {
+		if sel.followEntity(instance.Owner, instance.EntityID) {
+			ret.TemplateInstances = append(ret.TemplateInstances, instance)
+		}
+	}
+	for _, schema := range ds.Schemas {
+		if sel.followOwner(schema.Owner) {
+			ret.Schemas = append(ret.Schemas, schema)
+		}
+	}
+	for _, tpl := range ds.Templates {
+		if sel.followOwner(tpl.Owner) {
+			ret.Templates = append(ret.Templates, tpl)
+		}
+	}
+	return ret
+}
+
+func contains(items []string, item string) bool {
+	for index := range items {
+		if items[index] == item {
+			return true
+		}
+	}
+	return false
+}
+
+// RestoreOptions controls restore, existing data is kept unless overwrite.
+type RestoreOptions struct {
+	Filter    *Filter
+	Overwrite bool
+}
+
+// RestoreResult counts restored and skipped records of sections.
+type RestoreResult struct {
+	Manifest *Manifest
+	Restored map[string]int
+	Skipped  map[string]int
+}
+
+// Service backups and restores entities, expressions, subscriptions, schemas, templates, trash and alarm rules.
+// tenant usage and entity locators are not archived, restoring states of entities rebuilds them.
+// states are exported from the state store, which lags changes coalesced by runtimes by up to state.flush_interval.
+type Service struct {
+	repo     repository.IRepository
+	searcher Searcher
+	importer Importer
+}
+
+// NewService returns a backup service, states of entities are restored by the importer,
+// or written into the state store directly if nil, while the deployment is stopped.
+func NewService(repo repository.IRepository, searcher Searcher, importer Importer) *Service {
+	return &Service{repo: repo, searcher: searcher, importer: importer}
+}
+
+// Archive is an exported archive spooled into temporary files, which are removed by Close.
+type Archive struct {
+	Manifest *Manifest
+	writer   *archiveWriter
+}
+
+// Stream writes the archive into w.
+func (a *Archive) Stream(w io.Writer) error {
+	return a.writer.writeTo(w, a.Manifest)
+}
+
+// Close removes the spooled archive.
+func (a *Archive) Close() {
+	a.writer.close()
+}
+
+// Backup writes an archive of the data selected by the filter, and returns the manifest of the archive.
+func (s *Service) Backup(ctx context.Context, w io.Writer, filter *Filter) (*Manifest, error) {
+	archive, err := s.Export(ctx, filter)
+	if nil != err {
+		return nil, errors.Wrap(err, "backup")
+	}
+	defer archive.Close()
+
+	if err = archive.Stream(w); nil != err {
+		return nil, errors.Wrap(err, "backup")
+	}
+	return archive.Manifest, nil
+}
+
+// Export spools the data selected by the filter, records of the metadata store are read at one revision,
+// states of entities are read as they are while exporting.
+func (s *Service) Export(ctx context.Context, filter *Filter) (*Archive, error) {
+	aw, err := newArchiveWriter()
+	if nil != err {
+		return nil, errors.Wrap(err, "export")
+	}
+
+	manifest := &Manifest{Revision: s.repo.GetLastRevision(ctx)}
+	if filter != nil {
+		manifest.Owners, manifest.Tenants = filter.Owners, filter.Tenants
+	}
+
+	if err = s.export(ctx, manifest.Revision, filter.selection(), aw); nil == err {
+		err = aw.seal(manifest)
+	}
+	if nil != err {
+		aw.close()
+		return nil, errors.Wrap(err, "export")
+	}
+
+	counts := make(map[string]int)
+	for _, section := range manifest.Sections {
+		counts[section.Name] = section.Count
+	}
+	log.L().Info("backup exported", logf.Int64("revision", manifest.Revision),
+		logf.Any("owners", manifest.Owners), logf.Any("tenants", manifest.Tenants), logf.Any("sections", counts))
+	return &Archive{Manifest: manifest, writer: aw}, nil
+}
+
+func (s *Service) export(ctx context.Context, rev int64, sel *selection, aw *archiveWriter) error {
+	// entities first, records following entities are selected by them.
+	if err := s.exportEntities(ctx, sel, aw); nil != err {
+		return errors.Wrap(err, "export entities")
+	}
+
+	var err error
+	add := func(section string, record interface{}) {
+		if nil == err {
+			err = aw.add(section, record)
+		}
+	}
+	s.repo.RangeSchema(ctx, rev, func(schemas []*repository.Schema) {
+		for _, schema := range schemas {
+			if sel.followOwner(schema.Owner) {
+				add(SectionSchemas, schema)
+			}
+		}
+	})
+	s.repo.RangeExpression(ctx, rev, func(exprs []*repository.Expression) {
+		for _, expr := range exprs {
+			if sel.followEntity(expr.Owner, expr.EntityID) {
+				add(SectionExpressions, expr)
+			}
+		}
+	})
+	s.repo.RangeSubscription(ctx, rev, func(subs []*repository.Subscription) {
+		for _, sub := range subs {
+			if sel.followEntity(sub.Owner, sub.SourceEntityID) {
+				add(SectionSubscriptions, sub)
+			}
+		}
+	})
+	s.repo.RangeAlarmRule(ctx, rev, func(rules []*repository.AlarmRule) {
+		for _, rule := range rules {
+			if sel.followEntity(rule.Owner, rule.EntityID) {
+				add(SectionAlarmRules, rule)
+			}
+		}
+	})
+	if nil != err {
+		return errors.Wrap(err, "export")
+	}
+
+	trashes, err := s.repo.ListTrash(ctx, rev, &repository.ListTrashReq{})
+	if nil != err {
+		return errors.Wrap(err, "export trash")
+	}
+	for _, trash := range trashes {
+		if sel.followEntity(trash.Owner, trash.ID) {
+			add(SectionTrash, trash)
+		}
+	}
+	if nil != err {
+		return errors.Wrap(err, "export trash")
+	}
+
+	if err = s.exportTemplates(ctx, rev, sel, aw); nil != err {
+		return errors.Wrap(err, "export templates")
+	}
+	return errors.Wrap(ctx.Err(), "export")
+}
+
+// exportTemplates exports versions of templates and their instances.
+func (s *Service) exportTemplates(ctx context.Context, rev int64, sel *selection, aw *archiveWriter) error {
+	tpls, err := s.repo.ListTemplate(ctx, rev, &repository.ListTemplateReq{})
+	if nil != err {
+		return errors.Wrap(err, "list templates")
+	}
+
+	for index, tpl := range tpls {
+		if !sel.followOwner(tpl.Owner) {
+			continue
+		} else if err = aw.add(SectionTemplates, tpl); nil != err {
+			return err
+		}
+
+		// versions of a template are listed in a row.
+		if next := index + 1; next < len(tpls) && tpls[next].Owner == tpl.Owner && tpls[next].ID == tpl.ID {
+			continue
+		}
+		instances, err := s.repo.ListTemplateInstance(ctx, tpl.Owner, tpl.ID)
+		if nil != err {
+			return errors.Wrapf(err, "list instances of template %s", tpl.ID)
+		}
+		entityIDs := make([]string, 0, len(instances))
+		for entityID := range instances {
+			entityIDs = append(entityIDs, entityID)
+		}
+		sort.Strings(entityIDs)
+		for _, entityID := range entityIDs {
+			if !sel.followEntity(tpl.Owner, entityID) {
+				continue
+			}
+			if err = aw.add(SectionTemplateInstances, &TemplateInstanceRecord{Owner: tpl.Owner,
+				TemplateID: tpl.ID, EntityID: entityID, Version: instances[entityID]}); nil != err {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// exportEntities exports entities of the search index with their documents, then entities located
+// by tenants which are not indexed, such as entities in trash. entities stored before tenant isolation
+// are not located, they are exported if indexed. owners and tenants are read from states.
+func (s *Service) exportEntities(ctx context.Context, sel *selection, aw *archiveWriter) error {
+	exported := make(map[string]bool)
+	if err := s.exportIndexedEntities(ctx, sel, aw, exported); nil != err {
+		return err
+	}
+
+	err := s.repo.ScanEntity(ctx, func(eid, owner string) error {
+		if exported[eid] || !sel.filter.matchOwner(owner) {
+			return nil
+		}
+		exported[eid] = true
+		return s.exportEntity(ctx, sel, aw, &EntityRecord{ID: eid, Owner: owner})
+	})
+	if errors.Is(err, xerrors.ErrScanUnsupported) {
+		log.L().Warn("export entities, state store does not scan, only indexed entities are exported")
+		return nil
+	}
+	return errors.Wrap(err, "scan entities")
+}
+
+func (s *Service) exportIndexedEntities(ctx context.Context, sel *selection, aw *archiveWriter, exported map[string]bool) error {
+	req := &pb.SearchRequest{PageSize: searchPageSize, OrderBy: "id.keyword"}
+	if filter := sel.filter; filter != nil && len(filter.Owners) == 1 {
+		req.Owner = filter.Owners[0]
+		req.Condition = append(req.Condition, &pb.SearchCondition{
+			Field: "owner", Operator: "$eq", Value: structpb.NewStringValue(req.Owner)})
+	}
+
+	for fetched := int64(0); ; {
+		req.PageNum++
+		resp, err := s.searcher.Search(ctx, req)
+		if nil != err {
+			return errors.Wrap(err, "search entities")
+		} else if len(resp.Items) == 0 {
+			return nil
+		}
+
+		for _, item := range resp.Items {
+			doc, _ := item.AsInterface().(map[string]interface{})
+			id, _ := doc["id"].(string)
+			if id == "" || exported[id] {
+				continue
+			}
+			exported[id] = true
+
+			record := &EntityRecord{ID: id, Tenant: tenantOf(doc)}
+			record.Owner, _ = doc["owner"].(string)
+			if record.Index, err = item.MarshalJSON(); nil != err {
+				return errors.Wrapf(err, "encode search document of entity %s", id)
+			} else if err = s.exportEntity(ctx, sel, aw, record); nil != err {
+				return err
+			}
+		}
+
+		if fetched += int64(len(resp.Items)); fetched >= resp.Total {
+			return nil
+		}
+	}
+}
+
+// exportEntity reads the state of the entity, owner and tenant of the record are overridden by the state.
+func (s *Service) exportEntity(ctx context.Context, sel *selection, aw *archiveWriter, record *EntityRecord) error {
+	state, err := s.repo.GetEntity(ctx, record.ID)
+	if nil != err {
+		if errors.Is(err, xerrors.ErrResourceNotFound) {
+			// deleted while exporting.
+			return nil
+		}
+		return errors.Wrapf(err, "get entity %s", record.ID)
+	}
+
+	record.State = state
+	if owner := tenant.FromState(state); owner != "" {
+		record.Owner = owner
+	}
+	if tenantID := tdtl.New(state).Get(fieldTenant).String(); tenantID != "" {
+		record.Tenant = tenantID
+	} else if record.Tenant == "" {
+		record.Tenant = record.Owner
+	}
+
+	if !sel.entity(record) {
+		return nil
+	}
+	return errors.Wrapf(aw.add(SectionEntities, record), "export entity %s", record.ID)
+}
+
+func tenantOf(doc map[string]interface{}) string {
+	sysField, _ := doc["sysField"].(map[string]interface{})
+	tenantID, _ := sysField["_tenantId"].(string)
+	return tenantID
+}
+
+// Restore verifies the archive and restores the data selected by the filter,
+// nothing is written unless the whole archive is valid.
+func (s *Service) Restore(ctx context.Context, r io.Reader, opts RestoreOptions) (*RestoreResult, error) {
+	manifest, ds, err := ReadArchive(r)
+	if nil != err {
+		return nil, errors.Wrap(err, "restore")
+	}
+
+	ds = opts.Filter.apply(ds)
+	if err = s.verifyOwners(ctx, ds); nil != err {
+		return nil, errors.Wrap(err, "restore")
+	}
+
+	ret := &RestoreResult{
+		Manifest: manifest,
+		Restored: make(map[string]int),
+		Skipped:  make(map[string]int),
+	}
+
+	// in order of sections, records refer records restored before them.
+	for _, schema := range ds.Schemas {
+		if err = s.restore(ret, SectionSchemas, opts.Overwrite, func() (bool, error) {
+			return exists(s.repo.HasSchema(ctx, *schema))
+		}, func() error {
+			return s.repo.PutSchema(ctx, *schema)
+		}); nil != err {
+			return ret, errors.Wrapf(err, "restore schema %s", schema.ID)
+		}
+	}
+
+	// template versions are immutable, existing versions are kept.
+	for _, tpl := range ds.Templates {
+		if err = s.restore(ret, SectionTemplates, false, func() (bool, error) {
+			_, err := s.repo.GetTemplate(ctx, &repository.Template{Owner: tpl.Owner, ID: tpl.ID, Version: tpl.Version})
+			return exists(nil == err, err)
+		}, func() error {
+			return s.repo.PutTemplate(ctx, tpl)
+		}); nil != err {
+			return ret, errors.Wrapf(err, "restore template %s", tpl.ID)
+		}
+	}
+
+	for _, en := range ds.Entities {
+		if err = s.restore(ret, SectionEntities, opts.Overwrite, func() (bool, error) {
+			return s.repo.HasEntity(ctx, en.ID)
+		}, func() error {
+			return s.restoreEntity(ctx, en)
+		}); nil != err {
+			return ret, errors.Wrapf(err, "restore entity %s", en.ID)
+		}
+	}
+	if err = s.repo.FlushEntity(ctx); nil != err {
+		return ret, errors.Wrap(err, "restore entities")
+	}
+
+	instances := make(map[string]map[string]int64)
+	for _, instance := range ds.TemplateInstances {
+		if err = s.restore(ret, SectionTemplateInstances, opts.Overwrite, func() (bool, error) {
+			key := instance.Owner + "/" + instance.TemplateID
+			if _, ok := instances[key]; !ok {
+				existing, err := s.repo.ListTemplateInstance(ctx, instance.Owner, instance.TemplateID)
+				if nil != err {
+					return false, err
+				}
+				instances[key] = existing
+			}
+			_, exists := instances[key][instance.EntityID]
+			return exists, nil
+		}, func() error {
+			return s.repo.PutTemplateInstance(ctx, instance.Owner, instance.TemplateID, instance.EntityID, instance.Version)
+		}); nil != err {
+			return ret, errors.Wrapf(err, "restore template instance %s", instance.EntityID)
+		}
+	}
+
+	for _, trash := range ds.Trash {
+		if err = s.restore(ret, SectionTrash, opts.Overwrite, func() (bool, error) {
+			_, err := s.repo.GetTrash(ctx, &repository.TrashEntity{Owner: trash.Owner, ID: trash.ID})
+			return exists(nil == err, err)
+		}, func() error {
+			return s.repo.PutTrash(ctx, trash)
+		}); nil != err {
+			return ret, errors.Wrapf(err, "restore trash %s", trash.ID)
+		}
+	}
+
+	for _, expr := range ds.Expressions {
+		if err = s.restore(ret, SectionExpressions, opts.Overwrite, func() (bool, error) {
+			return exists(s.repo.HasExpression(ctx, *expr))
+		}, func() error {
+			return s.repo.PutExpression(ctx, *expr)
+		}); nil != err {
+			return ret, errors.Wrapf(err, "restore expression %s", expr.ID)
+		}
+	}
+
+	for _, sub := range ds.Subscriptions {
+		if err = s.restore(ret, SectionSubscriptions, opts.Overwrite, func() (bool, error) {
+			return exists(s.repo.HasSubscription(ctx, sub))
+		}, func() error {
+			return s.repo.PutSubscription(ctx, sub)
+		}); nil != err {
+			return ret, errors.Wrapf(err, "restore subscription %s", sub.ID)
+		}
+	}
+
+	for _, rule := range ds.AlarmRules {
+		if err = s.restore(ret, SectionAlarmRules, opts.Overwrite, func() (bool, error) {
+			_, err := s.repo.GetAlarmRule(ctx, &repository.AlarmRule{Owner: rule.Owner, EntityID: rule.EntityID, ID: rule.ID})
+			return exists(nil == err, err)
+		}, func() error {
+			return s.repo.PutAlarmRule(ctx, rule)
+		}); nil != err {
+			return ret, errors.Wrapf(err, "restore alarm rule %s", rule.ID)
+		}
+	}
+
+	log.L().Info("restore completed", logf.Int64("revision", manifest.Revision),
+		logf.Any("restored", ret.Restored), logf.Any("skipped", ret.Skipped))
+	return ret, nil
+}
+
+func (s *Service) restore(ret *RestoreResult, section string, overwrite bool, has func() (bool, error), put func() error) error {
+	if !overwrite {
+		exists, err := has()
+		if nil != err {
+			return errors.Wrap(err, "check existence")
+		} else if exists {
+			ret.Skipped[section]++
+			return nil
+		}
+	}
+
+	if err := put(); nil != err {
+		return err
+	}
+	ret.Restored[section]++
+	return nil
+}
+
+// exists reports records not found as not existing.
+func exists(has bool, err error) (bool, error) {
+	if errors.Is(err, xerrors.ErrResourceNotFound) || errors.Is(err, xerrors.ErrTemplateNotFound) {
+		return false, nil
+	}
+	return has, err
+}
+
+// verifyOwners rejects entities of which owners of the archive mismatch their states or
+// the stored owners, the filter selects by owners of the archive, which are not trusted.
+func (s *Service) verifyOwners(ctx context.Context, ds *Dataset) error {
+	for _, en := range ds.Entities {
+		if owner := tenant.FromState(en.State); owner != "" && owner != en.Owner {
+			log.L().Warn("restore entity, owner mismatch", logf.Eid(en.ID),
+				logf.Owner(en.Owner), logf.String("state", owner))
+			return errors.Wrapf(xerrors.ErrPermissionDenied, "entity %s owner mismatch", en.ID)
+		}
+
+		owner, err := s.repo.GetEntityOwner(ctx, en.ID)
+		if nil != err {
+			return errors.Wrapf(err, "get owner of entity %s", en.ID)
+		} else if owner != "" && owner != en.Owner {
+			log.L().Warn("restore entity, owner mismatch", logf.Eid(en.ID),
+				logf.Owner(en.Owner), logf.String("stored", owner))
+			return errors.Wrapf(xerrors.ErrPermissionDenied, "entity %s owner mismatch", en.ID)
+		}
+	}
+	return nil
+}
+
+func (s *Service) restoreEntity(ctx context.Context, en *EntityRecord) error {
+	if nil != s.importer {
+		if err := s.importer.ImportEntity(ctx, en.ID, en.State); nil != err {
+			return errors.Wrap(err, "import entity state")
+		}
+	} else if err := s.repo.PutEntity(ctx, en.ID, en.State); nil != err {
+		return errors.Wrap(err, "put entity state")
+	}
+
+	if len(en.Index) > 0 {
+		if _, err := s.searcher.IndexBytes(ctx, en.ID, en.Index); nil != err {
+			log.L().Warn("restore entity search document", logf.Eid(en.ID), logf.Error(err))
+		}
+	}
+	return nil
+}
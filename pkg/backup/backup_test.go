@@ -0,0 +1,232 @@
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	_ "github.com/tkeel-io/core/pkg/resource/store/memory"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type searcher struct {
+	docs    []map[string]interface{}
+	indexed map[string][]byte
+}
+
+func (s *searcher) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
+	out := &pb.SearchResponse{Total: int64(len(s.docs))}
+	start := int((req.PageNum - 1) * req.PageSize)
+	for index := start; index < len(s.docs) && index < start+int(req.PageSize); index++ {
+		val, _ := structpb.NewValue(s.docs[index])
+		out.Items = append(out.Items, val)
+	}
+	return out, nil
+}
+
+func (s *searcher) IndexBytes(ctx context.Context, id string, jsonData []byte) (*pb.IndexResponse, error) {
+	s.indexed[id] = jsonData
+	return &pb.IndexResponse{}, nil
+}
+
+// newRepo returns a repository of memory state and embedded metadata.
+func newRepo(t *testing.T) repository.IRepository {
+	d, err := dao.New(context.Background(), config.Metadata{Name: "memory"},
+		config.Metadata{Name: dao.MetadataEmbedded}, config.EtcdConfig{})
+	assert.Nil(t, err)
+	t.Cleanup(d.Close)
+	return repository.New(d)
+}
+
+func TestBackupRestore(t *testing.T) {
+	ctx := context.Background()
+	repo := newRepo(t)
+	assert.Nil(t, repo.PutEntity(ctx, "device123", []byte(`{"id":"device123","owner":"admin"}`)))
+	assert.Nil(t, repo.PutEntity(ctx, "device234",
+		[]byte(`{"id":"device234","owner":"tomas","properties":{"sysField":{"_tenantId":"tenant1"}}}`)))
+	// entities in trash are not indexed.
+	assert.Nil(t, repo.PutEntity(ctx, "device456", []byte(`{"id":"device456","owner":"admin","deleted_at":1}`)))
+	assert.Nil(t, repo.PutTrash(ctx, &repository.TrashEntity{ID: "device456", Owner: "admin", DeletedAt: 1}))
+	assert.Nil(t, repo.PutAlarmRule(ctx, &repository.AlarmRule{ID: "rule1", Owner: "admin", EntityID: "device123"}))
+	assert.Nil(t, repo.PutTemplate(ctx, &repository.Template{ID: "tpl1", Owner: "admin", Version: 1}))
+	assert.Nil(t, repo.PutTemplateInstance(ctx, "admin", "tpl1", "device123", 1))
+	src := &searcher{docs: []map[string]interface{}{
+		{"id": "device123", "owner": "admin"},
+		{"id": "device234", "owner": "tomas"},
+		{"id": "device345", "owner": "admin"},
+	}}
+
+	var archive bytes.Buffer
+	manifest, err := NewService(repo, src, nil).Backup(ctx, &archive, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, ArchiveVersion, manifest.Version)
+	assert.Len(t, manifest.Sections, 8)
+
+	_, ds, err := ReadArchive(bytes.NewReader(archive.Bytes()))
+	assert.Nil(t, err)
+	assert.Len(t, ds.Entities, 3)
+	assert.Equal(t, "tenant1", ds.Entities[1].Tenant)
+	assert.Equal(t, "device456", ds.Entities[2].ID)
+	assert.Empty(t, ds.Entities[2].Index)
+	assert.Len(t, ds.Trash, 1)
+	assert.Len(t, ds.AlarmRules, 1)
+	assert.Len(t, ds.Templates, 1)
+	assert.Equal(t, []*TemplateInstanceRecord{{Owner: "admin", TemplateID: "tpl1", EntityID: "device123", Version: 1}},
+		ds.TemplateInstances)
+
+	// restore the tenant into an empty deployment.
+	dst := newRepo(t)
+	dstSearcher := &searcher{indexed: make(map[string][]byte)}
+	ret, err := NewService(dst, dstSearcher, nil).Restore(ctx, bytes.NewReader(archive.Bytes()),
+		RestoreOptions{Filter: &Filter{Tenants: []string{"tenant1"}}})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, ret.Restored[SectionEntities])
+	assert.Equal(t, 0, ret.Restored[SectionTrash])
+	state, err := dst.GetEntity(ctx, "device234")
+	assert.Nil(t, err)
+	assert.Equal(t, `{"id":"device234","owner":"tomas","properties":{"sysField":{"_tenantId":"tenant1"}}}`, string(state))
+	assert.Contains(t, dstSearcher.indexed, "device234")
+	_, err = dst.GetEntity(ctx, "device123")
+	assert.ErrorIs(t, err, xerrors.ErrResourceNotFound)
+
+	// existing data is kept unless overwrite.
+	ret, err = NewService(dst, dstSearcher, nil).Restore(ctx, bytes.NewReader(archive.Bytes()), RestoreOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, ret.Restored[SectionEntities])
+	assert.Equal(t, 1, ret.Skipped[SectionEntities])
+	assert.Equal(t, 1, ret.Restored[SectionTrash])
+	assert.Equal(t, 1, ret.Restored[SectionAlarmRules])
+	assert.Equal(t, 1, ret.Restored[SectionTemplates])
+	assert.Equal(t, 1, ret.Restored[SectionTemplateInstances])
+	_, err = dst.GetTrash(ctx, &repository.TrashEntity{ID: "device456", Owner: "admin"})
+	assert.Nil(t, err)
+
+	ret, err = NewService(dst, dstSearcher, nil).Restore(ctx, bytes.NewReader(archive.Bytes()), RestoreOptions{Overwrite: true})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, ret.Restored[SectionEntities])
+	assert.Equal(t, 1, ret.Restored[SectionAlarmRules])
+	// template versions are immutable.
+	assert.Equal(t, 1, ret.Skipped[SectionTemplates])
+}
+
+type importer struct {
+	states map[string][]byte
+}
+
+func (i *importer) ImportEntity(ctx context.Context, id string, state []byte) error {
+	i.states[id] = state
+	return nil
+}
+
+func TestRestore_Owners(t *testing.T) {
+	ctx := context.Background()
+	repo := newRepo(t)
+	assert.Nil(t, repo.PutEntity(ctx, "device123", []byte(`{"id":"device123","owner":"admin"}`)))
+	src := &searcher{docs: []map[string]interface{}{{"id": "device123", "owner": "admin"}}}
+	var archive bytes.Buffer
+	_, err := NewService(repo, src, nil).Backup(ctx, &archive, nil)
+	assert.Nil(t, err)
+
+	// states are restored through the importer.
+	dst := newRepo(t)
+	states := &importer{states: make(map[string][]byte)}
+	ret, err := NewService(dst, &searcher{indexed: make(map[string][]byte)}, states).Restore(ctx,
+		bytes.NewReader(archive.Bytes()), RestoreOptions{Filter: &Filter{Owners: []string{"admin"}}})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, ret.Restored[SectionEntities])
+	assert.Contains(t, states.states, "device123")
+
+	// entities stored with other owners are not overwritten.
+	assert.Nil(t, dst.PutEntity(ctx, "device123", []byte(`{"id":"device123","owner":"tomas"}`)))
+	_, err = NewService(dst, &searcher{indexed: make(map[string][]byte)}, states).Restore(ctx,
+		bytes.NewReader(archive.Bytes()), RestoreOptions{Filter: &Filter{Owners: []string{"admin"}}, Overwrite: true})
+	assert.ErrorIs(t, err, xerrors.ErrPermissionDenied)
+
+	// owners of the archive are checked against states.
+	ds := &Dataset{Entities: []*EntityRecord{{ID: "device234", Owner: "admin",
+		State: []byte(`{"id":"device234","owner":"tomas"}`)}}}
+	assert.ErrorIs(t, NewService(dst, nil, nil).verifyOwners(ctx, ds), xerrors.ErrPermissionDenied)
+}
+
+func TestFilter_apply(t *testing.T) {
+	ds := &Dataset{
+		Entities: []*EntityRecord{
+			{ID: "device123", Owner: "admin", Tenant: "admin"},
+			{ID: "device234", Owner: "admin", Tenant: "tenant1"},
+		},
+		Expressions: []*repository.Expression{
+			{ID: "expr1", Owner: "admin", EntityID: "device123"},
+			{ID: "expr2", Owner: "admin", EntityID: "device234"},
+		},
+		Subscriptions: []*repository.Subscription{{ID: "sub1", Owner: "admin", SourceEntityID: "device234"}},
+		Schemas:       []*repository.Schema{{ID: "schema1", Owner: "admin"}, {ID: "schema2", Owner: "tomas"}},
+		Trash:         []*repository.TrashEntity{{ID: "device123", Owner: "admin"}},
+		AlarmRules:    []*repository.AlarmRule{{ID: "rule1", Owner: "admin", EntityID: "device234"}},
+	}
+
+	var filter *Filter
+	assert.Equal(t, ds, filter.apply(ds))
+
+	ret := (&Filter{Tenants: []string{"tenant1"}}).apply(ds)
+	assert.Len(t, ret.Entities, 1)
+	assert.Equal(t, "expr2", ret.Expressions[0].ID)
+	assert.Len(t, ret.Expressions, 1)
+	assert.Len(t, ret.Subscriptions, 1)
+	assert.Equal(t, "schema1", ret.Schemas[0].ID)
+	assert.Len(t, ret.Trash, 0)
+	assert.Len(t, ret.AlarmRules, 1)
+
+	ret = (&Filter{Owners: []string{"tomas"}}).apply(ds)
+	assert.Len(t, ret.Entities, 0)
+	assert.Len(t, ret.Expressions, 0)
+	assert.Len(t, ret.Schemas, 1)
+}
+
+func TestReadArchive(t *testing.T) {
+	ds := &Dataset{Schemas: []*repository.Schema{{ID: "schema1", Owner: "admin"}}}
+	var archive bytes.Buffer
+	assert.Nil(t, WriteArchive(&archive, &Manifest{}, ds))
+	_, ret, err := ReadArchive(&archive)
+	assert.Nil(t, err)
+	assert.Equal(t, ds.Schemas, ret.Schemas)
+
+	// tampered sections are rejected.
+	_, err = readFiles(t, map[string]string{
+		manifestFile:              `{"version":1,"sections":[{"name":"schemas","count":1,"size":2,"sha256":"00"}]}`,
+		"schemas" + sectionSuffix: `{}`,
+	})
+	assert.ErrorIs(t, err, xerrors.ErrBackupCorrupted)
+
+	// archives of newer versions are rejected.
+	_, err = readFiles(t, map[string]string{manifestFile: `{"version":3}`})
+	assert.ErrorIs(t, err, xerrors.ErrBackupVersion)
+
+	_, _, err = ReadArchive(bytes.NewReader([]byte("not an archive")))
+	assert.ErrorIs(t, err, xerrors.ErrBackupCorrupted)
+}
+
+func readFiles(t *testing.T, files map[string]string) (*Dataset, error) {
+	var archive bytes.Buffer
+	gzipWriter := gzip.NewWriter(&archive)
+	tarWriter := tar.NewWriter(gzipWriter)
+	assert.Nil(t, writeFile(tarWriter, manifestFile, []byte(files[manifestFile]), time.Now()))
+	for name, content := range files {
+		if name != manifestFile {
+			assert.Nil(t, writeFile(tarWriter, name, []byte(content), time.Now()))
+		}
+	}
+	tarWriter.Close()
+	gzipWriter.Close()
+
+	_, ds, err := ReadArchive(&archive)
+	return ds, err
+}
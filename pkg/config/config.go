@@ -29,23 +29,39 @@ import (
 	"io/fs"
 	"os"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/tkeel-io/kit/log"
 
-	"github.com/fsnotify/fsnotify"
 	"github.com/spf13/viper"
 )
 
 var _cmdViper *viper.Viper
 var _config = defaultConfig()
 
+// _lock guards _config, which is replaced on changes of the config file.
+var _lock sync.RWMutex
+
 type Configuration struct {
-	Proxy      Proxy          `yaml:"proxy" mapstructure:"proxy"`
-	Server     Server         `yaml:"server" mapstructure:"server"`
-	Logger     LogConfig      `yaml:"logger" mapstructure:"logger"`
-	Discovery  Discovery      `yaml:"discovery" mapstructure:"discovery"`
-	Components Components     `yaml:"components" mapstructure:"components"`
-	Dispatcher DispatchConfig `yaml:"dispatcher" mapstructure:"dispatcher"`
+	Proxy       Proxy             `yaml:"proxy" mapstructure:"proxy"`
+	Server      Server            `yaml:"server" mapstructure:"server"`
+	Logger      LogConfig         `yaml:"logger" mapstructure:"logger"`
+	Discovery   Discovery         `yaml:"discovery" mapstructure:"discovery"`
+	Components  Components        `yaml:"components" mapstructure:"components"`
+	Dispatcher  DispatchConfig    `yaml:"dispatcher" mapstructure:"dispatcher"`
+	Expression  ExpressionConfig  `yaml:"expression" mapstructure:"expression"`
+	Alarm       AlarmConfig       `yaml:"alarm" mapstructure:"alarm"`
+	Template    TemplateConfig    `yaml:"template" mapstructure:"template"`
+	Auth        AuthConfig        `yaml:"auth" mapstructure:"auth"`
+	Tenant      TenantConfig      `yaml:"tenant" mapstructure:"tenant"`
+	RateLimit   RateLimitConfig   `yaml:"rate_limit" mapstructure:"rate_limit"`
+	Idempotency IdempotencyConfig `yaml:"idempotency" mapstructure:"idempotency"`
+	Transaction TransactionConfig `yaml:"transaction" mapstructure:"transaction"`
+	Trash       TrashConfig       `yaml:"trash" mapstructure:"trash"`
+	Request     RequestConfig     `yaml:"request" mapstructure:"request"`
+	State       StateConfig       `yaml:"state" mapstructure:"state"`
+	Schema      SchemaConfig      `yaml:"schema" mapstructure:"schema"`
 }
 
 type Server struct {
@@ -54,6 +70,10 @@ type Server struct {
 	HTTPAddr string   `yaml:"http_addr" mapstructure:"http_addr"`
 	GRPCAddr string   `yaml:"grpc_addr" mapstructure:"grpc_addr"`
 	Sources  []string `yaml:"sources" mapstructure:"sources"`
+	// ShutdownTimeout is the deadline of draining on shutdown in seconds.
+	ShutdownTimeout int64 `yaml:"shutdown_timeout" mapstructure:"shutdown_timeout"`
+	// ProbeTimeout is the timeout of checking each dependency on readiness probes in milliseconds.
+	ProbeTimeout int64 `yaml:"probe_timeout" mapstructure:"probe_timeout"`
 }
 
 type Proxy struct {
@@ -68,6 +88,8 @@ type Components struct {
 	Store        Metadata   `yaml:"store" mapstructure:"store"`
 	TimeSeries   Metadata   `yaml:"time_series" mapstructure:"time_series"`
 	Rawdata      Metadata   `yaml:"rawdata" mapstructure:"rawdata"`
+	// Metadata is the backend of expressions, subscriptions and schemas, etcd or embedded, defaults to etcd.
+	Metadata Metadata `yaml:"metadata" mapstructure:"metadata"`
 }
 
 type Pair struct {
@@ -85,6 +107,191 @@ type EtcdConfig struct {
 	DialTimeout int64    `yaml:"dial_timeout" mapstructure:"dial_timeout"`
 }
 
+// ExpressionConfig limits the resources an expression evaluation may consume.
+type ExpressionConfig struct {
+	// EvalTimeout is the time budget of a single evaluation in milliseconds, the budget is checked
+	// before each call of extension functions, built-in operators always run to completion.
+	EvalTimeout int64 `yaml:"eval_timeout" mapstructure:"eval_timeout"`
+	// MaxInputs is the step budget of a single evaluation, counted in resolved source inputs.
+	MaxInputs int `yaml:"max_inputs" mapstructure:"max_inputs"`
+	// MaxDepth is the max number of hops a computed change may propagate through expressions.
+	MaxDepth int `yaml:"max_depth" mapstructure:"max_depth"`
+	// Plugins are go plugin files which provide extension functions.
+	Plugins []string `yaml:"plugins" mapstructure:"plugins"`
+}
+
+// AlarmConfig configures where alarm transitions are published and how much history is kept.
+type AlarmConfig struct {
+	// PubsubName is the dapr pubsub component alarm transitions are published to.
+	PubsubName string `yaml:"pubsub_name" mapstructure:"pubsub_name"`
+	// Topic is the topic alarm transitions are published to, publishing is disabled if empty.
+	Topic string `yaml:"topic" mapstructure:"topic"`
+	// HistoryLimit is the max number of transitions kept per alarm rule.
+	HistoryLimit int `yaml:"history_limit" mapstructure:"history_limit"`
+}
+
+// TemplateConfig configures rollouts of template changes to instances.
+type TemplateConfig struct {
+	// RolloutRate is the max number of instances a rollout job migrates per second, unlimited if not positive.
+	RolloutRate int `yaml:"rollout_rate" mapstructure:"rollout_rate"`
+}
+
+// AuthConfig configures authentication and authorization of API requests.
+type AuthConfig struct {
+	// Type is the authorizer type, "jwt" or empty which trusts request headers.
+	Type string `yaml:"type" mapstructure:"type"`
+	// JWKSFile is the local JWKS file holding keys tokens are verified with.
+	JWKSFile string `yaml:"jwks_file" mapstructure:"jwks_file"`
+	// Issuer is the expected token issuer, not checked if empty.
+	Issuer string `yaml:"issuer" mapstructure:"issuer"`
+	// Audience is the expected token audience, not checked if empty.
+	Audience string `yaml:"audience" mapstructure:"audience"`
+	// TenantClaim is the token claim holding the tenant of the caller.
+	TenantClaim string `yaml:"tenant_claim" mapstructure:"tenant_claim"`
+	// RolesClaim is the token claim holding the roles of the caller.
+	RolesClaim string `yaml:"roles_claim" mapstructure:"roles_claim"`
+	// Policies grant actions to roles, callers have admin access to their own tenant if empty.
+	Policies []AuthPolicy `yaml:"policies" mapstructure:"policies"`
+	// CrossTenantExpression allows expressions to read entities of other tenants.
+	CrossTenantExpression bool `yaml:"cross_tenant_expression" mapstructure:"cross_tenant_expression"`
+}
+
+// AuthPolicy grants an action to a role.
+type AuthPolicy struct {
+	Role string `yaml:"role" mapstructure:"role"`
+	// Action is one of read, write and admin, a higher action implies the lower ones.
+	Action string `yaml:"action" mapstructure:"action"`
+	// Tenants the policy applies to, "*" matches any tenant, "$self" or empty matches the caller's tenant.
+	Tenants []string `yaml:"tenants" mapstructure:"tenants"`
+	// EntityTypes the policy applies to, any type if empty.
+	EntityTypes []string `yaml:"entity_types" mapstructure:"entity_types"`
+}
+
+// TenantConfig configures resource quotas of tenants.
+type TenantConfig struct {
+	// Quota is the default quota of tenants.
+	Quota TenantQuota `yaml:"quota" mapstructure:"quota"`
+	// Quotas override the default quota of specific tenants.
+	Quotas []TenantQuota `yaml:"quotas" mapstructure:"quotas"`
+}
+
+// TenantQuota limits the resources of a tenant, a limit is unlimited if not positive.
+type TenantQuota struct {
+	// Tenant the quota overrides, ignored in the default quota.
+	Tenant string `yaml:"tenant" mapstructure:"tenant"`
+	// Entities is the max number of entities.
+	Entities int64 `yaml:"entities" mapstructure:"entities"`
+	// MsgRate is the max number of messages per second.
+	MsgRate int `yaml:"msg_rate" mapstructure:"msg_rate"`
+	// Storage is the max bytes of entity states, size changes of entities are accounted per flush (about a second).
+	Storage int64 `yaml:"storage" mapstructure:"storage"`
+}
+
+// QuotaOf returns the quota of the tenant.
+func (c TenantConfig) QuotaOf(tenantID string) TenantQuota {
+	for _, quota := range c.Quotas {
+		if quota.Tenant == tenantID {
+			return quota
+		}
+	}
+	return c.Quota
+}
+
+// RateLimitConfig configures token buckets which admit entity writes.
+type RateLimitConfig struct {
+	// Tenant limits writes of each tenant.
+	Tenant RateLimit `yaml:"tenant" mapstructure:"tenant"`
+	// Entity limits writes of each entity.
+	Entity RateLimit `yaml:"entity" mapstructure:"entity"`
+	// API limits requests of each API.
+	API RateLimit `yaml:"api" mapstructure:"api"`
+	// APIs override the API limit of specific APIs.
+	APIs []RateLimit `yaml:"apis" mapstructure:"apis"`
+}
+
+// RateLimit is a token bucket limit, unlimited if the rate is not positive.
+type RateLimit struct {
+	// Name of the API the limit overrides, ignored in other limits.
+	Name string `yaml:"name" mapstructure:"name"`
+	// Rate is the number of tokens refilled per second.
+	Rate float64 `yaml:"rate" mapstructure:"rate"`
+	// Burst is the capacity of the bucket, defaults to the rate.
+	Burst int `yaml:"burst" mapstructure:"burst"`
+}
+
+// APILimit returns the limit of the API.
+func (c RateLimitConfig) APILimit(api string) RateLimit {
+	for _, limit := range c.APIs {
+		if limit.Name == api {
+			return limit
+		}
+	}
+	return c.API
+}
+
+// IdempotencyConfig configures the window runtimes deduplicate writes with idempotency keys in.
+type IdempotencyConfig struct {
+	// Window is the number of seconds the result of a write is kept for duplicates.
+	Window int64 `yaml:"window" mapstructure:"window"`
+	// Capacity is the max number of results kept by a runtime, the oldest are evicted first.
+	Capacity int `yaml:"capacity" mapstructure:"capacity"`
+}
+
+// TransactionConfig configures transactions applying patches to multiple entities.
+type TransactionConfig struct {
+	// Timeout is the milliseconds a transaction waits for participants to prepare.
+	Timeout int64 `yaml:"timeout" mapstructure:"timeout"`
+	// LockTimeout is the milliseconds a prepared entity stays locked without commit or abort, then
+	// the recorded decision is applied, it should be longer than Timeout so that participants do not
+	// abort transactions not decided yet.
+	LockTimeout int64 `yaml:"lock_timeout" mapstructure:"lock_timeout"`
+	// Retention is the seconds decisions of transactions are kept for participants resolving their locks
+	// after restarts, never purged if not positive.
+	Retention int64 `yaml:"retention" mapstructure:"retention"`
+}
+
+// TrashConfig configures soft deleted entities.
+type TrashConfig struct {
+	// Retention is the seconds deleted entities are kept before purged, never purged if not positive.
+	Retention int64 `yaml:"retention" mapstructure:"retention"`
+}
+
+// RequestConfig configures async requests.
+type RequestConfig struct {
+	// Retention is the seconds statuses of async requests are kept before purged, never purged if not positive.
+	Retention int64 `yaml:"retention" mapstructure:"retention"`
+	// CallbackHosts are hosts, like "example.com" or "example.com:8080", outcomes of async requests
+	// may be posted to, callbacks are rejected if empty.
+	CallbackHosts []string `yaml:"callback_hosts" mapstructure:"callback_hosts"`
+}
+
+// StateConfig configures how entity states are kept in the state store.
+type StateConfig struct {
+	// Compression is the codec entity states are compressed with, "zstd" or empty for none.
+	Compression string `yaml:"compression" mapstructure:"compression"`
+	// SegmentSize is the bytes above which a state is split into segments of properties,
+	// so that a change rewrites only the touched segments, never split if not positive.
+	SegmentSize int `yaml:"segment_size" mapstructure:"segment_size"`
+	// MaxEntitySize is the max bytes of an entity state, unlimited if not positive.
+	MaxEntitySize int `yaml:"max_entity_size" mapstructure:"max_entity_size"`
+	// FlushInterval is the milliseconds changes of an entity are coalesced before its state is written,
+	// states are written on every change if not positive. offsets of events are committed once the events
+	// are queued, so that coalesced changes not written yet are lost if core crashes.
+	FlushInterval int64 `yaml:"flush_interval" mapstructure:"flush_interval"`
+	// FlushDirty is the number of coalesced changes which triggers a write, unlimited if not positive.
+	FlushDirty int `yaml:"flush_dirty" mapstructure:"flush_dirty"`
+	// CriticalPaths are paths whose changes are written immediately, along with the coalesced changes.
+	CriticalPaths []string `yaml:"critical_paths" mapstructure:"critical_paths"`
+}
+
+// SchemaConfig configures how properties of entities bound to schemas are written into the time series database.
+type SchemaConfig struct {
+	// Measurement is the measurement properties with enabled_time_series are written into.
+	Measurement string `yaml:"measurement" mapstructure:"measurement"`
+	// TelemetryPrefix is trimmed from property paths to make field names.
+	TelemetryPrefix string `yaml:"telemetry_prefix" mapstructure:"telemetry_prefix"`
+}
+
 type LogConfig struct {
 	Dev      bool     `yaml:"dev" mapstructure:"dev"`
 	Level    string   `yaml:"level" mapstructure:"level"`
@@ -103,6 +310,8 @@ func defaultConfig() Configuration {
 }
 
 func Get() Configuration {
+	_lock.RLock()
+	defer _lock.RUnlock()
 	return _config
 }
 
@@ -122,7 +331,7 @@ func Init(cfgFile string) {
 	}
 
 	if err := viper.ReadInConfig(); nil != err {
-		if _, ok := err.(viper.ConfigFileNotFoundError); ok || errors.As(err, &fs.ErrNotExist) { //nolint
+		if _, ok := err.(viper.ConfigFileNotFoundError); ok || errors.Is(err, fs.ErrNotExist) {
 			// Config file not found.
 			defer writeDefault(cfgFile)
 		} else {
@@ -130,16 +339,22 @@ func Init(cfgFile string) {
 		}
 	}
 
-	onConfigChanged(fsnotify.Event{Name: "init", Op: fsnotify.Chmod})
 	// set command line configuration.
-	viper.Unmarshal(&_config)
+	_config, _ = load()
+	_loadedAt = time.Now()
 
+	// reload on changes of the config file.
+	viper.OnConfigChange(onConfigChanged)
 	viper.WatchConfig()
 }
 
-func onConfigChanged(_ fsnotify.Event) {
-	_ = viper.Unmarshal(&_config)
-	_config.Components.SearchModel = strings.Split(viper.GetString("SEARCH_MODEL"), ",")
+// load returns the configuration of viper.
+func load() (Configuration, error) {
+	cfg := defaultConfig()
+	err := viper.Unmarshal(&cfg)
+	// the SEARCH_MODEL environment variable overrides the config file.
+	cfg.Components.SearchModel = strings.Split(viper.GetString("SEARCH_MODEL"), ",")
+	return cfg, err
 }
 
 func writeDefault(cfgFile string) {
@@ -159,6 +374,8 @@ func init() {
 	viper.SetDefault("server.app_id", _defaultAppServer.AppID)
 	viper.SetDefault("server.http_addr", _defaultAppServer.HTTPAddr)
 	viper.SetDefault("server.grpc_addr", _defaultAppServer.GRPCAddr)
+	viper.SetDefault("server.shutdown_timeout", _defaultAppServer.ShutdownTimeout)
+	viper.SetDefault("server.probe_timeout", _defaultAppServer.ProbeTimeout)
 	viper.SetDefault("proxy.http_port", _defaultProxyConfig.HTTPPort)
 	viper.SetDefault("proxy.grpc_port", _defaultProxyConfig.GRPCPort)
 	viper.SetDefault("logger.level", _defaultLogConfig.Level)
@@ -169,6 +386,30 @@ func init() {
 	viper.SetDefault("discovery.dial_timeout", _defaultDiscovery.DialTimeout)
 	viper.SetDefault("components.etcd.endpoints", _defaultEtcdConfig.Endpoints)
 	viper.SetDefault("components.etcd.dial_timeout", _defaultEtcdConfig.DialTimeout)
+	viper.SetDefault("expression.eval_timeout", _defaultExpressionConfig.EvalTimeout)
+	viper.SetDefault("expression.max_inputs", _defaultExpressionConfig.MaxInputs)
+	viper.SetDefault("expression.max_depth", _defaultExpressionConfig.MaxDepth)
+	viper.SetDefault("alarm.pubsub_name", _defaultAlarmConfig.PubsubName)
+	viper.SetDefault("alarm.topic", _defaultAlarmConfig.Topic)
+	viper.SetDefault("alarm.history_limit", _defaultAlarmConfig.HistoryLimit)
+	viper.SetDefault("template.rollout_rate", _defaultTemplateConfig.RolloutRate)
+	viper.SetDefault("idempotency.window", _defaultIdempotencyConfig.Window)
+	viper.SetDefault("idempotency.capacity", _defaultIdempotencyConfig.Capacity)
+	viper.SetDefault("transaction.timeout", _defaultTransactionConfig.Timeout)
+	viper.SetDefault("transaction.lock_timeout", _defaultTransactionConfig.LockTimeout)
+	viper.SetDefault("transaction.retention", _defaultTransactionConfig.Retention)
+	viper.SetDefault("trash.retention", _defaultTrashConfig.Retention)
+	viper.SetDefault("request.retention", _defaultRequestConfig.Retention)
+	viper.SetDefault("state.compression", _defaultStateConfig.Compression)
+	viper.SetDefault("state.segment_size", _defaultStateConfig.SegmentSize)
+	viper.SetDefault("state.max_entity_size", _defaultStateConfig.MaxEntitySize)
+	viper.SetDefault("state.flush_interval", _defaultStateConfig.FlushInterval)
+	viper.SetDefault("state.flush_dirty", _defaultStateConfig.FlushDirty)
+	viper.SetDefault("schema.measurement", _defaultSchemaConfig.Measurement)
+	viper.SetDefault("schema.telemetry_prefix", _defaultSchemaConfig.TelemetryPrefix)
+	viper.SetDefault("auth.type", _defaultAuthConfig.Type)
+	viper.SetDefault("auth.tenant_claim", _defaultAuthConfig.TenantClaim)
+	viper.SetDefault("auth.roles_claim", _defaultAuthConfig.RolesClaim)
 
 	viper.SetEnvPrefix(_corePrefix)
 	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
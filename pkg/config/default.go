@@ -20,6 +20,9 @@ var (
 		AppID:    DefaultAppID,
 		HTTPAddr: ":6789",
 		GRPCAddr: ":31234",
+
+		ShutdownTimeout: 30,
+		ProbeTimeout:    800,
 	}
 	_defaultLogConfig = LogConfig{
 		Dev:      false,
@@ -35,4 +38,47 @@ var (
 		DialTimeout: 3,
 		Endpoints:   []string{"http://localhost:2379"},
 	}
+	_defaultExpressionConfig = ExpressionConfig{
+		EvalTimeout: 200,
+		MaxInputs:   64,
+		MaxDepth:    16,
+	}
+	_defaultAlarmConfig = AlarmConfig{
+		PubsubName:   "core-pubsub",
+		Topic:        "core-alarm",
+		HistoryLimit: 200,
+	}
+	_defaultTemplateConfig = TemplateConfig{
+		RolloutRate: 100,
+	}
+	_defaultIdempotencyConfig = IdempotencyConfig{
+		Window:   600,
+		Capacity: 10000,
+	}
+	_defaultTransactionConfig = TransactionConfig{
+		Timeout:     5000,
+		LockTimeout: 15000,
+		Retention:   86400,
+	}
+	_defaultTrashConfig = TrashConfig{
+		Retention: 604800,
+	}
+	_defaultRequestConfig = RequestConfig{
+		Retention: 86400,
+	}
+	_defaultStateConfig = StateConfig{
+		Compression:   "zstd",
+		SegmentSize:   256 << 10,
+		MaxEntitySize: 16 << 20,
+		FlushInterval: 0,
+		FlushDirty:    100,
+	}
+	_defaultSchemaConfig = SchemaConfig{
+		Measurement:     "keel",
+		TelemetryPrefix: "telemetry.",
+	}
+	_defaultAuthConfig = AuthConfig{
+		TenantClaim: "tenant",
+		RolesClaim:  "roles",
+	}
 )
@@ -0,0 +1,227 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/kit/log"
+	"gopkg.in/yaml.v3"
+)
+
+const maskedValue = "xxxxx"
+
+// Handler is called with the previous and the current configuration after the config file changes.
+type Handler func(prev, cur Configuration)
+
+// Change is a changed setting, values of secrets are masked.
+type Change struct {
+	Path string
+	Prev interface{}
+	Cur  interface{}
+}
+
+var (
+	_handlersLock sync.Mutex
+	_handlers     []Handler
+	_revision     uint64
+	_loadedAt     time.Time
+
+	// url passwords, like "es://admin:password@localhost:9200".
+	_urlPassword = regexp.MustCompile(`(://[^:/@]*):[^@/]*@`)
+
+	// changes of these settings apply after restart, keep in sync with keepRestartSettings.
+	_restartPaths = []string{
+		"server", "proxy", "logger", "discovery", "auth",
+		"components.etcd", "components.store", "components.metadata", "components.search_model",
+		"dispatcher", "expression.plugins",
+	}
+)
+
+// OnChange registers the handler called after the config file changes, handlers are called in order.
+func OnChange(handler Handler) {
+	_handlersLock.Lock()
+	_handlers = append(_handlers, handler)
+	_handlersLock.Unlock()
+}
+
+// Revision returns the number of reloads and the time the active configuration is loaded at.
+func Revision() (uint64, time.Time) {
+	_lock.RLock()
+	defer _lock.RUnlock()
+	return _revision, _loadedAt
+}
+
+// Masked returns the configuration as a tree of settings, values of secrets are masked.
+func Masked(cfg Configuration) map[string]interface{} {
+	tree, _ := mask(toTree(cfg)).(map[string]interface{})
+	return tree
+}
+
+// Diff returns changed settings sorted by path, values of secrets are masked.
+func Diff(prev, cur Configuration) []Change {
+	prevs, curs := make(map[string]interface{}), make(map[string]interface{})
+	flatten("", Masked(prev), prevs)
+	flatten("", Masked(cur), curs)
+
+	var changes []Change
+	for path, val := range curs {
+		if prevVal, ok := prevs[path]; !ok || !reflect.DeepEqual(prevVal, val) {
+			changes = append(changes, Change{Path: path, Prev: prevVal, Cur: val})
+		}
+	}
+	for path, val := range prevs {
+		if _, ok := curs[path]; !ok {
+			changes = append(changes, Change{Path: path, Prev: val})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes
+}
+
+// RestartRequired returns whether the change applies after restart.
+func (c Change) RestartRequired() bool {
+	for _, path := range _restartPaths {
+		if c.Path == path || strings.HasPrefix(c.Path, path+".") || strings.HasPrefix(c.Path, path+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+func onConfigChanged(ev fsnotify.Event) {
+	cur, err := load()
+	if nil != err {
+		log.L().Error("reload configuration", logf.Path(ev.Name), logf.Error(err))
+		return
+	}
+
+	_lock.Lock()
+	prev := _config
+	changes := Diff(prev, cur)
+	// restart-only settings stay as loaded at startup until restart.
+	keepRestartSettings(prev, &cur)
+	if len(Diff(prev, cur)) == 0 {
+		_lock.Unlock()
+		for _, change := range changes {
+			log.L().Warn("configuration changed, restart required", logf.Path(change.Path),
+				logf.Any("prev", change.Prev), logf.Any("cur", change.Cur))
+		}
+		return
+	}
+	_config = cur
+	_revision++
+	_loadedAt = time.Now()
+	revision := _revision
+	_lock.Unlock()
+
+	for _, change := range changes {
+		if change.RestartRequired() {
+			log.L().Warn("configuration changed, restart required", logf.Path(change.Path),
+				logf.Any("prev", change.Prev), logf.Any("cur", change.Cur))
+			continue
+		}
+		log.L().Info("configuration changed", logf.Path(change.Path),
+			logf.Any("prev", change.Prev), logf.Any("cur", change.Cur))
+	}
+	log.L().Info("configuration reloaded", logf.Path(ev.Name),
+		logf.Revision(revision), logf.Count(int64(len(changes))))
+
+	_handlersLock.Lock()
+	handlers := append([]Handler{}, _handlers...)
+	_handlersLock.Unlock()
+	for _, handler := range handlers {
+		handler(prev, cur)
+	}
+}
+
+// keepRestartSettings copies settings applied after restart from prev into cur.
+func keepRestartSettings(prev Configuration, cur *Configuration) {
+	cur.Server = prev.Server
+	cur.Proxy = prev.Proxy
+	cur.Logger = prev.Logger
+	cur.Discovery = prev.Discovery
+	cur.Auth = prev.Auth
+	cur.Components.Etcd = prev.Components.Etcd
+	cur.Components.Store = prev.Components.Store
+	cur.Components.Metadata = prev.Components.Metadata
+	cur.Components.SearchModel = prev.Components.SearchModel
+	cur.Dispatcher = prev.Dispatcher
+	cur.Expression.Plugins = prev.Expression.Plugins
+}
+
+// toTree converts the configuration into maps keyed by yaml names.
+func toTree(cfg Configuration) interface{} {
+	var tree map[string]interface{}
+	bytes, err := yaml.Marshal(cfg)
+	if nil == err {
+		err = yaml.Unmarshal(bytes, &tree)
+	}
+	if nil != err {
+		log.L().Error("convert configuration", logf.Error(err))
+	}
+	return tree
+}
+
+// mask masks passwords in urls, values of keys and pairs named like secrets.
+func mask(node interface{}) interface{} {
+	switch val := node.(type) {
+	case map[string]interface{}:
+		for key, item := range val {
+			val[key] = mask(item)
+			if str, ok := item.(string); isSecret(key) && (!ok || str != "") {
+				val[key] = maskedValue
+			}
+		}
+		// properties of components are key value pairs.
+		if key, ok := val["key"].(string); ok && isSecret(key) && nil != val["value"] {
+			val["value"] = maskedValue
+		}
+	case []interface{}:
+		for index := range val {
+			val[index] = mask(val[index])
+		}
+	case string:
+		return _urlPassword.ReplaceAllString(val, "$1:"+maskedValue+"@")
+	}
+	return node
+}
+
+func isSecret(key string) bool {
+	key = strings.ToLower(key)
+	for _, word := range []string{"password", "secret", "token"} {
+		if strings.Contains(key, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// flatten puts leaves of the tree into settings keyed by dotted paths.
+func flatten(prefix string, node interface{}, settings map[string]interface{}) {
+	switch val := node.(type) {
+	case map[string]interface{}:
+		for key, item := range val {
+			path := key
+			if prefix != "" {
+				path = prefix + "." + key
+			}
+			flatten(path, item, settings)
+		}
+	case []interface{}:
+		for index, item := range val {
+			flatten(fmt.Sprintf("%s[%d]", prefix, index), item, settings)
+		}
+	default:
+		settings[prefix] = val
+	}
+}
@@ -0,0 +1,85 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	prev := Configuration{
+		Server: Server{Name: "core"},
+		Components: Components{
+			SearchEngine: "es://admin:admin@localhost:9200",
+			Store: Metadata{Name: "redis", Properties: []Pair{
+				{Key: "host", Value: "localhost:6379"},
+				{Key: "redisPassword", Value: "secret"},
+			}},
+		},
+		Dispatcher: DispatchConfig{Downstreams: []string{"kafka://localhost:9092/core0/core"}},
+	}
+
+	cur := prev
+	cur.Server = Server{Name: "core1"}
+	cur.Components.SearchEngine = "es://admin:changed@localhost:9201"
+	cur.Dispatcher = DispatchConfig{Downstreams: []string{
+		"kafka://localhost:9092/core0/core", "kafka://localhost:9092/core1/core"}}
+
+	changes := Diff(prev, cur)
+	assert.Equal(t, []Change{
+		{Path: "components.search_engine", Prev: "es://admin:xxxxx@localhost:9200", Cur: "es://admin:xxxxx@localhost:9201"},
+		{Path: "dispatcher.downstreams[1]", Cur: "kafka://localhost:9092/core1/core"},
+		{Path: "server.name", Prev: "core", Cur: "core1"},
+	}, changes)
+	assert.False(t, changes[0].RestartRequired())
+	assert.True(t, changes[1].RestartRequired())
+	assert.True(t, changes[2].RestartRequired())
+
+	masked := Masked(prev)
+	store, _ := masked["components"].(map[string]interface{})["store"].(map[string]interface{})
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"key": "host", "value": "localhost:6379"},
+		map[string]interface{}{"key": "redisPassword", "value": maskedValue},
+	}, store["properties"])
+}
+
+func TestOnChange(t *testing.T) {
+	var prevs, curs []Configuration
+	OnChange(func(prev, cur Configuration) {
+		prevs, curs = append(prevs, prev), append(curs, cur)
+	})
+
+	viper.Set("trash.retention", 60)
+	onConfigChanged(fsnotify.Event{Name: "config.yml", Op: fsnotify.Write})
+	// reloads without changes are ignored.
+	onConfigChanged(fsnotify.Event{Name: "config.yml", Op: fsnotify.Write})
+
+	assert.Len(t, curs, 1)
+	assert.Equal(t, int64(0), prevs[0].Trash.Retention)
+	assert.Equal(t, int64(60), Get().Trash.Retention)
+	revision, _ := Revision()
+	assert.Equal(t, uint64(1), revision)
+}
+
+func TestOnChangeRestartSettings(t *testing.T) {
+	port := Get().Proxy.HTTPPort
+	viper.Set("proxy.http_port", port+1)
+	viper.Set("auth.cross_tenant_expression", true)
+	viper.Set("trash.retention", 120)
+	onConfigChanged(fsnotify.Event{Name: "config.yml", Op: fsnotify.Write})
+
+	// restart-only settings are not published until restart.
+	assert.Equal(t, port, Get().Proxy.HTTPPort)
+	assert.False(t, Get().Auth.CrossTenantExpression)
+	assert.Equal(t, int64(120), Get().Trash.Retention)
+
+	// reloads changing restart-only settings only are not published.
+	revision, _ := Revision()
+	viper.Set("proxy.http_port", port+2)
+	onConfigChanged(fsnotify.Event{Name: "config.yml", Op: fsnotify.Write})
+	assert.Equal(t, port, Get().Proxy.HTTPPort)
+	cur, _ := Revision()
+	assert.Equal(t, revision, cur)
+}
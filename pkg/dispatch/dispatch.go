@@ -3,10 +3,12 @@ package dispatch
 import (
 	"context"
 	"net/http"
+	"sync"
 
 	"github.com/pkg/errors"
 	v1 "github.com/tkeel-io/core/api/core/v1"
 	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
 	logf "github.com/tkeel-io/core/pkg/logfield"
 	"github.com/tkeel-io/core/pkg/placement"
 	"github.com/tkeel-io/core/pkg/resource/pubsub"
@@ -24,7 +26,8 @@ func New(ctx context.Context) *dispatcher { //nolint
 		cancel:      cancel,
 		transmitter: transport.New(transport.TransTypeHTTP),
 		upstreams:   make(map[string]pubsub.Pubsub),
-		downstreams: make(map[string]*xkafka.Pubsub),
+		downstreams: make(map[string]pubsub.Sender),
+		newSink:     newKafkaSink,
 		logstreams:  nil,
 	}
 }
@@ -35,8 +38,17 @@ type dispatcher struct {
 	cancel      context.CancelFunc
 	transmitter transport.Transmitter
 	upstreams   map[string]pubsub.Pubsub
-	downstreams map[string]*xkafka.Pubsub
 	logstreams  *xkafka.Pubsub
+
+	// lock guards downstreams, which are closed on shutdown.
+	lock sync.RWMutex
+	// downstreams change after restart only, since they partition entities.
+	downstreams map[string]pubsub.Sender
+	newSink     func(urlText string) (pubsub.Sender, error)
+}
+
+func newKafkaSink(urlText string) (pubsub.Sender, error) {
+	return xkafka.NewKafkaPubsub(urlText)
 }
 
 func (d *dispatcher) DispatchToLog(ctx context.Context, ev []byte) error {
@@ -92,6 +104,48 @@ func (d *dispatcher) Start(ctx context.Context, cfg config.DispatchConfig) error
 	return nil
 }
 
+// Checks returns health checks of kafka sinks of the dispatcher by name.
+func (d *dispatcher) Checks() map[string]func(context.Context) error {
+	checks := make(map[string]func(context.Context) error)
+	d.lock.RLock()
+	for id, stream := range d.downstreams {
+		if checker, ok := stream.(interface{ Check(context.Context) error }); ok {
+			checks["kafka.sink."+id] = checker.Check
+		}
+	}
+	d.lock.RUnlock()
+	if nil != d.logstreams {
+		checks["kafka.logstream."+d.logstreams.ID()] = d.logstreams.Check
+	}
+	return checks
+}
+
+// Close stops receiving upstreams and closes streams of the dispatcher.
+func (d *dispatcher) Close() error {
+	d.cancel()
+	for id, stream := range d.upstreams {
+		if err := stream.Close(); nil != err {
+			log.L().Error("close upstream", logf.ID(id), logf.Error(err))
+		}
+	}
+
+	d.lock.Lock()
+	for id, stream := range d.downstreams {
+		if err := stream.Close(); nil != err {
+			log.L().Error("close downstream", logf.ID(id), logf.Error(err))
+		}
+	}
+	d.lock.Unlock()
+
+	if nil != d.logstreams {
+		if err := d.logstreams.Close(); nil != err {
+			log.L().Error("close logstream", logf.ID(d.logstreams.ID()), logf.Error(err))
+		}
+	}
+
+	return errors.Wrap(d.transmitter.Close(), "close transmitter")
+}
+
 func (d *dispatcher) dispatch(ctx context.Context, ev v1.Event) error {
 	eid := ev.Entity()
 	partitionID := ev.Attr(v1.MetaPartitionID)
@@ -99,7 +153,20 @@ func (d *dispatcher) dispatch(ctx context.Context, ev v1.Event) error {
 		info := placement.Global().Select(eid)
 		partitionID = info.ID
 	}
-	err := d.downstreams[partitionID].Send(ctx, ev)
+
+	// hold the lock while sending, so that downstreams are not closed in flight.
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	stream, ok := d.downstreams[partitionID]
+	if !ok {
+		// the partition is removed before restart, dispatch to the current placement.
+		if stream, ok = d.downstreams[placement.Global().Select(eid).ID]; !ok {
+			log.L().Error("dispatch event, downstream not found",
+				logf.Eid(eid), logf.ID(partitionID))
+			return errors.Wrap(xerrors.ErrQueueNotFound, "dispatch event")
+		}
+	}
+	err := stream.Send(ctx, ev)
 	return errors.Wrap(err, "dispatch event")
 }
 
@@ -120,13 +187,17 @@ func (d *dispatcher) initUpstream(ctx context.Context, streams []string) error {
 }
 
 func (d *dispatcher) initDownstream(ctx context.Context, streams []string) error {
-	for _, stream := range streams {
-		streamIns, err := xkafka.NewKafkaPubsub(stream)
+	for _, urlText := range streams {
+		streamIns, err := d.newSink(urlText)
 		if nil != err {
 			return errors.Wrap(err, "create sink instance")
 		}
+
+		d.lock.Lock()
 		d.downstreams[streamIns.ID()] = streamIns
+		d.lock.Unlock()
 		placement.Global().Append(placement.Info{ID: streamIns.ID()})
+		log.L().Info("add downstream", logf.ID(streamIns.ID()), logf.URL(urlText))
 	}
 	return nil
 }
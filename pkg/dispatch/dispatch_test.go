@@ -1 +1,57 @@
 package dispatch
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/resource/pubsub"
+)
+
+type sink struct {
+	id     string
+	events []v1.Event
+	closed bool
+}
+
+func (s *sink) ID() string { return s.id }
+
+func (s *sink) Send(_ context.Context, ev v1.Event) error {
+	s.events = append(s.events, ev)
+	return nil
+}
+
+func (s *sink) Close() error {
+	s.closed = true
+	return nil
+}
+
+func TestDispatcher_Downstreams(t *testing.T) {
+	placement.Initialize()
+	sinks := make(map[string]*sink)
+	d := New(context.Background())
+	d.newSink = func(urlText string) (pubsub.Sender, error) {
+		urlIns, _ := url.Parse(urlText)
+		sinks[urlText] = &sink{id: strings.Split(urlIns.Path, "/")[1]}
+		return sinks[urlText], nil
+	}
+
+	core1 := "kafka://localhost:9092/core1/core"
+	assert.Nil(t, d.initDownstream(context.Background(), []string{core1}))
+	assert.Len(t, d.downstreams, 1)
+
+	// events of a partition removed before restart are dispatched to the current placement.
+	ev := &v1.ProtoEvent{Metadata: map[string]string{}}
+	ev.SetEntity("device123")
+	ev.SetAttr(v1.MetaPartitionID, "core0")
+	assert.Nil(t, d.Dispatch(context.Background(), ev))
+	assert.Len(t, sinks[core1].events, 1)
+	assert.Equal(t, "core1", placement.Global().Select("device123").ID)
+
+	assert.Nil(t, d.Close())
+	assert.True(t, sinks[core1].closed)
+}
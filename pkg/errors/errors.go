@@ -1,6 +1,11 @@
 package errors
 
-import "errors"
+import (
+	"errors"
+
+	kerrors "github.com/tkeel-io/kit/errors"
+	"google.golang.org/grpc/codes"
+)
 
 var (
 	ErrInvalidJSONPath          = errors.New("Core.JSON.Path.Invalid")
@@ -11,6 +16,7 @@ var (
 	ErrEntityAleadyExists       = errors.New("Core.Entity.Already.Exists")
 	ErrInvalidEntityParams      = errors.New("Core.Entity.Params.Invalid")
 	ErrRuntimeNotExists         = errors.New("Core.Runtime.NotExists")
+	ErrRuntimeStopped           = errors.New("Core.Runtime.Stopped")
 	ErrMapperNotFound           = errors.New("Core.Mapper.NotFound")
 	ErrQueueNotFound            = errors.New("Core.Queue.NotFound")
 	ErrNodeNotExist             = errors.New("Core.Cluster.Node.NotExist")
@@ -23,6 +29,9 @@ var (
 	ErrInvalidHTTPRequest       = errors.New("Core.Transport.Http.Request.Invalid")
 	ErrInvalidHTTPInited        = errors.New("Core.Transport.Http.Inited")
 	ErrTemplateNotFound         = errors.New("Core.Template.NotFound")
+	ErrTemplateVersionExists    = errors.New("Core.Template.Version.Exists")
+	ErrTemplateCycle            = errors.New("Core.Template.Cycle")
+	ErrTemplateVersionInvalid   = errors.New("Core.Template.Version.Invalid")
 	ErrEntityPropertyIDEmpty    = errors.New("Core.Entity.PropertyID.Empty")
 	ErrInvalidRequest           = errors.New("Core.Request.Invalid")
 	ErrEntityConfigInvalid      = errors.New("invalid entity configurations")
@@ -34,13 +43,46 @@ var (
 	ErrPatchPathLack            = errors.New("patch path lack")
 	ErrPatchPathRoot            = errors.New("patch path lack root")
 	ErrPatchTypeInvalid         = errors.New("patch config type invalid")
+	ErrPatchTestFailed          = errors.New("Core.Patch.TestFailed")
+	ErrPatchFromInvalid         = errors.New("Core.Patch.From.Invalid")
+	ErrEntityLocked             = errors.New("Core.Entity.Locked")
+	ErrEntityNotInTrash         = errors.New("Core.Entity.NotInTrash")
+	ErrEtagMismatch             = errors.New("Core.Store.EtagMismatch")
+	ErrScanUnsupported          = errors.New("Core.Store.Scan.Unsupported")
+	ErrTransactionInvalid       = errors.New("Core.Transaction.Invalid")
+	ErrTransactionTimeout       = errors.New("Core.Transaction.Timeout")
 	ErrServerNotReady           = errors.New("Core.Service.NotReady")
 	ErrConnectionNil            = errors.New("Core.Resource.Connection.Nil")
 	ErrInvalidParam             = errors.New("Core.Params.Invalid")
 	ErrExpressionNotFound       = errors.New("Core.Expression.NotFound")
+	ErrExpressionCycle          = errors.New("Core.Expression.Cycle")
+	ErrExpressionEvalTimeout    = errors.New("Core.Expression.EvalTimeout")
+	ErrExpressionCostExceeded   = errors.New("Core.Expression.CostExceeded")
+	ErrAlarmRuleInvalid         = errors.New("Core.Alarm.Rule.Invalid")
+	ErrAlarmNotActive           = errors.New("Core.Alarm.NotActive")
+	ErrAlarmRuleAlreadyExists   = errors.New("Core.Alarm.Rule.Already.Exists")
+	ErrBackupVersion            = errors.New("Core.Backup.Version.Unsupported")
+	ErrBackupCorrupted          = errors.New("Core.Backup.Corrupted")
+	ErrSchemaNotFound           = errors.New("Core.Schema.NotFound")
+	ErrSchemaAlreadyExists      = errors.New("Core.Schema.Already.Exists")
+	ErrSchemaInvalid            = errors.New("Core.Schema.Invalid")
+	ErrSchemaValidation         = errors.New("Core.Schema.Validation.Failed")
+	ErrSearchMappingConflict    = errors.New("Core.Search.Mapping.Conflict")
+	ErrEntityTooLarge           = errors.New("Core.Entity.TooLarge")
+	ErrEntityStateCorrupted     = errors.New("Core.Entity.State.Corrupted")
+	ErrCallbackNotAllowed       = errors.New("Core.Request.Callback.NotAllowed")
+	ErrIdempotencyKeyReused     = errors.New("Core.Request.IdempotencyKey.Reused")
+
+	// auth, quota, rate limit and readiness errors carry grpc codes, so that they are responded with proper http status.
+	ErrUnauthenticated  = kerrors.New(int(codes.Unauthenticated), "Core.Auth.Unauthenticated", "unauthenticated")
+	ErrPermissionDenied = kerrors.New(int(codes.PermissionDenied), "Core.Auth.PermissionDenied", "permission denied")
+	ErrQuotaExceeded    = kerrors.New(int(codes.ResourceExhausted), "Core.Tenant.QuotaExceeded", "tenant quota exceeded")
+	ErrRateLimited      = kerrors.New(int(codes.ResourceExhausted), "Core.RateLimited", "too many requests")
+	ErrNotReady         = kerrors.New(int(codes.Unavailable), "Core.Service.Unavailable", "service not ready")
 
 	// ErrResourceNotFound errors.
-	ErrResourceNotFound = errors.New("Core.Resource.NotFound")
+	ErrResourceNotFound      = errors.New("Core.Resource.NotFound")
+	ErrResourceAlreadyExists = errors.New("Core.Resource.Already.Exists")
 )
 
 func New(code string) error {
@@ -0,0 +1,126 @@
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/mapper/expression"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/runtime"
+	"github.com/tkeel-io/kit/log"
+)
+
+// CreateAlarmRule creates the alarm rule, an existing rule is never overwritten.
+func (m *apiManager) CreateAlarmRule(ctx context.Context, rule *repository.AlarmRule) error {
+	if _, err := expression.AlarmSources(rule); nil != err {
+		log.L().Error("create alarm rule", logf.ID(rule.ID),
+			logf.Eid(rule.EntityID), logf.Owner(rule.Owner), logf.Error(err))
+		return errors.Wrap(err, "create alarm rule")
+	}
+
+	err := m.entityRepo.CreateAlarmRule(ctx, rule)
+	if errors.Is(err, xerrors.ErrResourceAlreadyExists) {
+		err = xerrors.ErrAlarmRuleAlreadyExists
+	}
+	return errors.Wrap(err, "create alarm rule")
+}
+
+func (m *apiManager) UpdateAlarmRule(ctx context.Context, rule *repository.AlarmRule) error {
+	if _, err := m.entityRepo.GetAlarmRule(ctx, &repository.AlarmRule{
+		ID: rule.ID, Owner: rule.Owner, EntityID: rule.EntityID}); nil != err {
+		return errors.Wrap(err, "update alarm rule")
+	}
+
+	if _, err := expression.AlarmSources(rule); nil != err {
+		log.L().Error("update alarm rule", logf.ID(rule.ID),
+			logf.Eid(rule.EntityID), logf.Owner(rule.Owner), logf.Error(err))
+		return errors.Wrap(err, "update alarm rule")
+	}
+	return errors.Wrap(m.entityRepo.PutAlarmRule(ctx, rule), "update alarm rule")
+}
+
+func (m *apiManager) DeleteAlarmRule(ctx context.Context, rule *repository.AlarmRule) error {
+	return errors.Wrap(m.entityRepo.DelAlarmRule(ctx, rule), "delete alarm rule")
+}
+
+func (m *apiManager) GetAlarmRule(ctx context.Context, rule *repository.AlarmRule) (*repository.AlarmRule, error) {
+	rule, err := m.entityRepo.GetAlarmRule(ctx, rule)
+	return rule, errors.Wrap(err, "get alarm rule")
+}
+
+func (m *apiManager) ListAlarmRule(ctx context.Context, req *repository.ListAlarmRuleReq) ([]*repository.AlarmRule, error) {
+	rules, err := m.entityRepo.ListAlarmRule(ctx, m.entityRepo.GetLastRevision(ctx), req)
+	return rules, errors.Wrap(err, "list alarm rule")
+}
+
+// ListAlarm returns active alarms of the alarm rules.
+func (m *apiManager) ListAlarm(ctx context.Context, req *repository.ListAlarmRuleReq) ([]*repository.Alarm, error) {
+	rules, err := m.ListAlarmRule(ctx, req)
+	if nil != err {
+		return nil, errors.Wrap(err, "list alarm")
+	}
+
+	var alarms []*repository.Alarm
+	for _, rule := range rules {
+		alarm, err := m.entityRepo.GetAlarm(ctx, rule.Owner, rule.EntityID, rule.ID)
+		if nil != err {
+			if !errors.Is(err, xerrors.ErrResourceNotFound) {
+				log.L().Warn("load alarm", logf.ID(rule.ID), logf.Error(err))
+			}
+			continue
+		}
+
+		if alarm.Active() {
+			alarms = append(alarms, alarm)
+		}
+	}
+	return alarms, nil
+}
+
+// AckAlarm acknowledge the active alarm of the alarm rule.
+func (m *apiManager) AckAlarm(ctx context.Context, rule *repository.AlarmRule, operator string) (*repository.Alarm, error) {
+	var err error
+	if rule, err = m.entityRepo.GetAlarmRule(ctx, rule); nil != err {
+		return nil, errors.Wrap(err, "ack alarm")
+	}
+
+	// compare-and-swap, so that transitions of runtimes are never overwritten.
+	now := time.Now().UnixMilli()
+	alarm, err := m.entityRepo.UpdateAlarm(ctx, rule, func(alarm *repository.Alarm) error {
+		if !alarm.Active() {
+			return xerrors.ErrAlarmNotActive
+		}
+		alarm.Acked, alarm.AckedBy, alarm.AckTime = true, operator, now
+		return nil
+	})
+	if nil != err {
+		return nil, errors.Wrap(err, "ack alarm")
+	}
+
+	record := alarm.Record(repository.AlarmStatusAcked, operator, now)
+	if err = m.entityRepo.AppendAlarmHistory(ctx, record, config.Get().Alarm.HistoryLimit); nil != err {
+		log.L().Error("persist alarm history", logf.ID(rule.ID), logf.Error(err))
+	}
+	if err = runtime.PublishAlarm(ctx, record); nil != err {
+		log.L().Error("publish alarm", logf.ID(rule.ID), logf.Error(err))
+	}
+
+	return alarm, nil
+}
+
+func (m *apiManager) ListAlarmHistory(ctx context.Context, rule *repository.AlarmRule) ([]*repository.AlarmRecord, error) {
+	var err error
+	if rule, err = m.entityRepo.GetAlarmRule(ctx, rule); nil != err {
+		return nil, errors.Wrap(err, "list alarm history")
+	}
+
+	records, err := m.entityRepo.GetAlarmHistory(ctx, rule.Owner, rule.EntityID, rule.ID)
+	if errors.Is(err, xerrors.ErrResourceNotFound) {
+		return records, nil
+	}
+	return records, errors.Wrap(err, "list alarm history")
+}
@@ -0,0 +1,29 @@
+package manager
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/util"
+	"github.com/tkeel-io/kit/log"
+)
+
+const bornImport = "apis.ImportEntity"
+
+// ImportEntity replaces the state of the entity through the runtime holding it,
+// so that the runtime applies the imported state instead of overwriting it with the state it holds.
+func (m *apiManager) ImportEntity(ctx context.Context, id string, state []byte) error {
+	reqID := util.IG().ReqID()
+	elapsedTime := util.NewElapsed()
+	log.L().Info("entity.ImportEntity", logf.Eid(id), logf.ReqID(reqID))
+
+	if _, err := m.dispatchSystem(ctx, reqID, bornImport, id, v1.OpImport, state); nil != err {
+		return errors.Wrap(err, "import entity")
+	}
+
+	log.L().Info("processing completed", logf.Eid(id),
+		logf.ReqID(reqID), logf.Elapsed(elapsedTime.Elapsed()))
+	return nil
+}
@@ -16,35 +16,48 @@ type Base struct {
 	LastTime   int64        `json:"last_time" msgpack:"last_time" mapstructure:"last_time"`
 	Mappers    []*v1.Mapper `json:"mappers" msgpack:"mappers" mapstructure:"mappers"`
 	TemplateID string       `json:"template_id" msgpack:"template_id" mapstructure:"template_id"`
-	Scheme     []byte       `json:"-" msgpack:"scheme" mapstructure:"-"`
-	Properties []byte       `json:"properties" msgpack:"properties" mapstructure:"properties"`
+	// TemplateVersion pin the version of template resource, 0 means the latest version.
+	TemplateVersion int64  `json:"template_version" msgpack:"template_version" mapstructure:"template_version"`
+	Scheme          []byte `json:"-" msgpack:"scheme" mapstructure:"-"`
+	Properties      []byte `json:"properties" msgpack:"properties" mapstructure:"properties"`
+	// SchemaID binds the entity to the schema resource, SchemaVersion 0 means the latest version.
+	SchemaID      string `json:"schema_id,omitempty" msgpack:"schema_id" mapstructure:"schema_id"`
+	SchemaVersion int64  `json:"schema_version,omitempty" msgpack:"schema_version" mapstructure:"schema_version"`
 }
 
 type BaseRet struct {
-	ID          string                 `json:"id" msgpack:"id" mapstructure:"id"`
-	Type        string                 `json:"type" msgpack:"type" mapstructure:"type"`
-	Owner       string                 `json:"owner" msgpack:"owner" mapstructure:"owner"`
-	Source      string                 `json:"source" msgpack:"source" mapstructure:"source"`
-	Version     int64                  `json:"version" msgpack:"version" mapstructure:"version"`
-	LastTime    int64                  `json:"last_time" msgpack:"last_time" mapstructure:"last_time"`
-	Mappers     []*v1.Mapper           `json:"mappers" msgpack:"mappers" mapstructure:"mappers"`
-	TemplateID  string                 `json:"template_id" msgpack:"template_id" mapstructure:"template_id"`
-	Description string                 `json:"description" msgpack:"description" mapstructure:"description"`
-	Properties  map[string]interface{} `json:"properties" msgpack:"properties" mapstructure:"properties"`
-	Scheme      map[string]interface{} `json:"scheme" msgpack:"-" mapstructure:"scheme"`
+	ID              string                 `json:"id" msgpack:"id" mapstructure:"id"`
+	Type            string                 `json:"type" msgpack:"type" mapstructure:"type"`
+	Owner           string                 `json:"owner" msgpack:"owner" mapstructure:"owner"`
+	Source          string                 `json:"source" msgpack:"source" mapstructure:"source"`
+	Version         int64                  `json:"version" msgpack:"version" mapstructure:"version"`
+	LastTime        int64                  `json:"last_time" msgpack:"last_time" mapstructure:"last_time"`
+	Mappers         []*v1.Mapper           `json:"mappers" msgpack:"mappers" mapstructure:"mappers"`
+	TemplateID      string                 `json:"template_id" msgpack:"template_id" mapstructure:"template_id"`
+	TemplateVersion int64                  `json:"template_version" msgpack:"template_version" mapstructure:"template_version"`
+	SchemaID        string                 `json:"schema_id" msgpack:"schema_id" mapstructure:"schema_id"`
+	SchemaVersion   int64                  `json:"schema_version" msgpack:"schema_version" mapstructure:"schema_version"`
+	Description     string                 `json:"description" msgpack:"description" mapstructure:"description"`
+	Properties      map[string]interface{} `json:"properties" msgpack:"properties" mapstructure:"properties"`
+	Scheme          map[string]interface{} `json:"scheme" msgpack:"-" mapstructure:"scheme"`
+	// RequestID of async writes, the entity is not written yet.
+	RequestID string `json:"-" msgpack:"-" mapstructure:"-"`
 }
 
 func (b *Base) Basic() Base {
 	cp := Base{
-		ID:         b.ID,
-		Type:       b.Type,
-		Owner:      b.Owner,
-		Source:     b.Source,
-		Version:    b.Version,
-		LastTime:   b.LastTime,
-		TemplateID: b.TemplateID,
-		Scheme:     []byte(`{}`),
-		Properties: []byte(`{}`),
+		ID:              b.ID,
+		Type:            b.Type,
+		Owner:           b.Owner,
+		Source:          b.Source,
+		Version:         b.Version,
+		LastTime:        b.LastTime,
+		TemplateID:      b.TemplateID,
+		TemplateVersion: b.TemplateVersion,
+		SchemaID:        b.SchemaID,
+		SchemaVersion:   b.SchemaVersion,
+		Scheme:          []byte(`{}`),
+		Properties:      []byte(`{}`),
 	}
 
 	cp.Mappers = append(cp.Mappers, b.Mappers...)
@@ -60,6 +73,9 @@ func (b *Base) JSON() map[string]interface{} {
 	info["version"] = b.Version
 	info["last_time"] = b.LastTime
 	info["template_id"] = b.TemplateID
+	info["template_version"] = b.TemplateVersion
+	info["schema_id"] = b.SchemaID
+	info["schema_version"] = b.SchemaVersion
 	info["scheme"] = string(b.Scheme)
 	info["properties"] = string(b.Properties)
 	return info
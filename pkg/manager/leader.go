@@ -0,0 +1,91 @@
+package manager
+
+import (
+	"context"
+	"time"
+
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/kit/log"
+)
+
+const (
+	// managerLease elects the manager which runs template rollouts and purges trash.
+	managerLease = "manager"
+	// managerLeaseTTL is the ttl of the manager lease, the lease is renewed every third of it.
+	managerLeaseTTL = 15 * time.Second
+)
+
+// holdLease acquires and renews the manager lease until the manager stops,
+// the lease holder resumes unfinished template rollouts.
+func (m *apiManager) holdLease() {
+	ticker := time.NewTicker(managerLeaseTTL / 3)
+	defer ticker.Stop()
+	for {
+		if ctx, ok := m.renewLease(); ok {
+			m.resumeRollouts(ctx)
+		}
+
+		select {
+		case <-m.ctx.Done():
+			m.stepDown()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// renewLease acquires or renews the manager lease, returns the context canceled once the lease is lost.
+func (m *apiManager) renewLease() (context.Context, bool) {
+	ctx, cancel := context.WithTimeout(m.ctx, managerLeaseTTL/3)
+	acquired, err := m.entityRepo.AcquireLease(ctx, managerLease, m.leaseHolder, managerLeaseTTL)
+	cancel()
+	if nil != err || !acquired {
+		if nil != err {
+			log.L().Error("renew manager lease", logf.ID(m.leaseHolder), logf.Error(err))
+		}
+		// the lease may still be held, step down before it expires.
+		m.stepDown()
+		return nil, false
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if nil == m.leaderCtx {
+		log.L().Info("acquired manager lease", logf.ID(m.leaseHolder))
+		m.leaderCtx, m.leaderCancel = context.WithCancel(m.ctx)
+	}
+	return m.leaderCtx, true
+}
+
+// stepDown stops jobs of the lease holder.
+func (m *apiManager) stepDown() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if nil != m.leaderCtx {
+		log.L().Info("lost manager lease", logf.ID(m.leaseHolder))
+		m.leaderCancel()
+		m.leaderCtx, m.leaderCancel = nil, nil
+	}
+}
+
+// leader returns the context of the lease holder, returns false if the manager not holds the lease.
+func (m *apiManager) leader() (context.Context, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.leaderCtx, nil != m.leaderCtx
+}
+
+// resumeRollouts starts running rollouts not started yet, including rollouts started by other managers.
+func (m *apiManager) resumeRollouts(ctx context.Context) {
+	rollouts, err := m.entityRepo.ListTemplateRollout(ctx,
+		m.entityRepo.GetLastRevision(ctx), &repository.ListTemplateRolloutReq{Running: true})
+	if nil != err {
+		log.L().Error("resume template rollouts", logf.Error(err))
+		return
+	}
+
+	for _, rollout := range rollouts {
+		m.startRollout(rollout)
+	}
+}
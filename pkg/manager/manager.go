@@ -32,9 +32,13 @@ import (
 	"github.com/tkeel-io/core/pkg/manager/holder"
 	"github.com/tkeel-io/core/pkg/mapper"
 	"github.com/tkeel-io/core/pkg/mapper/expression"
+	"github.com/tkeel-io/core/pkg/mapper/function"
+	"github.com/tkeel-io/core/pkg/metrics"
 	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/tenant"
 	"github.com/tkeel-io/core/pkg/types"
 	"github.com/tkeel-io/core/pkg/util"
+	"github.com/tkeel-io/core/pkg/util/transport"
 	"github.com/tkeel-io/kit/log"
 	"github.com/tkeel-io/tdtl"
 )
@@ -56,6 +60,16 @@ type apiManager struct {
 	holder     holder.Holder
 	dispatcher dispatch.Dispatcher
 	entityRepo repository.IRepository
+	// transmitter posts outcomes of async requests to callbacks.
+	transmitter transport.Transmitter
+
+	// running template rollouts.
+	rollouts map[string]bool
+	// leaseHolder identifies the manager holding the manager lease,
+	// leaderCtx is canceled once the manager loses the lease.
+	leaseHolder  string
+	leaderCtx    context.Context
+	leaderCancel context.CancelFunc
 
 	lock   sync.RWMutex
 	ctx    context.Context
@@ -69,18 +83,25 @@ func New(
 ) (APIManager, error) {
 	ctx, cancel := context.WithCancel(ctx)
 	apiManager := &apiManager{
-		ctx:        ctx,
-		cancel:     cancel,
-		entityRepo: repo,
-		dispatcher: dispatcher,
-		lock:       sync.RWMutex{},
-		holder:     holder.New(ctx, 30*time.Second),
+		ctx:         ctx,
+		cancel:      cancel,
+		entityRepo:  repo,
+		dispatcher:  dispatcher,
+		lock:        sync.RWMutex{},
+		rollouts:    make(map[string]bool),
+		leaseHolder: util.UUID("manager"),
+		holder:      holder.New(ctx, 30*time.Second),
+		transmitter: transport.New(transport.TransTypeHTTP),
 	}
 
 	return apiManager, nil
 }
 
 func (m *apiManager) OnRespond(ctx context.Context, resp *holder.Response) {
+	if resp.Metadata[v1.MetaAsync] != "" {
+		m.onAsyncRespond(ctx, resp)
+		return
+	}
 	m.holder.OnRespond(resp)
 }
 
@@ -98,7 +119,7 @@ func (m *apiManager) callbackAddr() string {
 }
 
 // CreateEntity create a entity.
-func (m *apiManager) CreateEntity(ctx context.Context, en *Base) (*BaseRet, error) {
+func (m *apiManager) CreateEntity(ctx context.Context, en *Base, opts ...Option) (*BaseRet, error) {
 	var (
 		err   error
 		bytes []byte
@@ -110,33 +131,69 @@ func (m *apiManager) CreateEntity(ctx context.Context, en *Base) (*BaseRet, erro
 	log.L().Info("entity.CreateEntity", logf.Eid(en.ID), logf.Type(en.Type),
 		logf.ReqID(reqID), logf.Owner(en.Owner), logf.Source(en.Source), logf.Base(en.JSON()))
 
+	if err = m.checkQuota(ctx, en.Owner, true); nil != err {
+		return nil, errors.Wrap(err, "create entity")
+	}
+
+	// apply template resource.
+	var tpl *repository.Template
+	if tpl, err = m.applyTemplate(ctx, en); nil != err {
+		log.L().Error("create entity, apply template", logf.Eid(en.ID),
+			logf.ReqID(reqID), logf.Template(en.TemplateID), logf.Error(err))
+		return nil, errors.Wrap(err, "create entity")
+	}
+
+	// apply schema resource.
+	if _, err = m.applySchema(ctx, en); nil != err {
+		log.L().Error("create entity, apply schema", logf.Eid(en.ID),
+			logf.ReqID(reqID), logf.ID(en.SchemaID), logf.Error(err))
+		return nil, errors.Wrap(err, "create entity")
+	}
+
 	if bytes, err = en.EncodeJSON(); nil != err {
 		log.L().Error("create entity", logf.Eid(en.ID), logf.Type(en.Type),
 			logf.ReqID(reqID), logf.Owner(en.Owner), logf.Source(en.Source), logf.Base(en.JSON()))
 		return nil, errors.Wrap(err, "create entity")
 	}
 
-	// hold request, wait response.
-	respWaiter := m.holder.Wait(ctx, reqID)
+	// setup metadata.
+	metadata := Metadata{
+		v1.MetaBorn:      bornCreate,
+		v1.MetaType:      sysET,
+		v1.MetaRequestID: reqID,
+		v1.MetaEntityID:  en.ID,
+	}
+	for _, option := range opts {
+		option(metadata)
+	}
 
-	// dispatch event.
-	if err = m.dispatcher.Dispatch(ctx, &v1.ProtoEvent{
+	ev := &v1.ProtoEvent{
 		Id:        util.IG().EvID(),
 		Timestamp: time.Now().UnixNano(),
 		Callback:  m.callbackAddr(),
-		Metadata: map[string]string{
-			v1.MetaBorn:      bornCreate,
-			v1.MetaType:      sysET,
-			v1.MetaRequestID: reqID,
-			v1.MetaEntityID:  en.ID,
-		},
+		Metadata:  metadata,
 		Data: &v1.ProtoEvent_SystemData{
 			SystemData: &v1.SystemData{
 				Operator: string(v1.OpCreate),
 				Data:     bytes,
 			},
 		},
-	}); nil != err {
+	}
+
+	var baseRet BaseRet
+	if isAsync(metadata) {
+		// the instance is indexed once the entity is created.
+		if baseRet, err = m.dispatchAsync(ctx, reqID, bornCreate, en, ev); nil != err {
+			return nil, errors.Wrap(err, "create entity")
+		}
+		return &baseRet, nil
+	}
+
+	// hold request, wait response.
+	respWaiter := m.holder.Wait(ctx, reqID)
+
+	// dispatch event.
+	if err = m.dispatcher.Dispatch(ctx, ev); nil != err {
 		respWaiter.Cancel()
 		log.L().Error("create entity, dispatch event",
 			logf.Error(err), logf.Eid(en.ID), logf.ReqID(reqID))
@@ -156,13 +213,20 @@ func (m *apiManager) CreateEntity(ctx context.Context, en *Base) (*BaseRet, erro
 	log.L().Info("processing completed", logf.Eid(en.ID),
 		logf.ReqID(reqID), logf.Elapsed(elapsedTime.Elapsed()))
 
-	var baseRet BaseRet
 	if err = json.Unmarshal(resp.Data, &baseRet); nil != err {
 		log.L().Error("create entity, decode response", logf.ReqID(reqID),
 			logf.Error(err), logf.Eid(en.ID), logf.Base(en.JSON()))
 		return nil, errors.Wrap(err, "create entity, decode response")
 	}
 
+	if nil != tpl {
+		if err = m.indexInstance(ctx, en.Owner, en.ID, tpl); nil != err {
+			log.L().Error("create entity, index template instance", logf.Eid(en.ID),
+				logf.ReqID(reqID), logf.Template(en.TemplateID), logf.Error(err))
+			return nil, errors.Wrap(err, "create entity")
+		}
+	}
+
 	return &baseRet, errors.Wrap(err, "create entity")
 }
 
@@ -172,8 +236,9 @@ func (m *apiManager) PatchEntity(ctx context.Context, en *Base, pds []*v1.PatchD
 	log.L().Info("entity.PatchEntity", logf.Eid(en.ID), logf.Type(en.Type),
 		logf.ReqID(reqID), logf.Owner(en.Owner), logf.Source(en.Source), logf.Base(en.JSON()))
 
-	// hold request.
-	respWaiter := m.holder.Wait(ctx, reqID)
+	if err = m.checkQuota(ctx, en.Owner, false); nil != err {
+		return out, raw, errors.Wrap(err, "patch entity")
+	}
 
 	// setup metadata.
 	metadata := Metadata{
@@ -187,17 +252,29 @@ func (m *apiManager) PatchEntity(ctx context.Context, en *Base, pds []*v1.PatchD
 		option(metadata)
 	}
 
+	ev := &v1.ProtoEvent{
+		Id:        util.IG().EvID(),
+		Metadata:  metadata,
+		Timestamp: time.Now().UnixNano(),
+		Callback:  m.callbackAddr(),
+		Data: &v1.ProtoEvent_Patches{
+			Patches: &v1.PatchDatas{Patches: pds},
+		},
+	}
+
+	if isAsync(metadata) {
+		var baseRet BaseRet
+		if baseRet, err = m.dispatchAsync(ctx, reqID, bornPatch, en, ev); nil != err {
+			return out, raw, errors.Wrap(err, "patch entity")
+		}
+		return &baseRet, raw, nil
+	}
+
+	// hold request.
+	respWaiter := m.holder.Wait(ctx, reqID)
+
 	// dispatch event.
-	if err = m.dispatcher.Dispatch(ctx,
-		&v1.ProtoEvent{
-			Id:        util.IG().EvID(),
-			Metadata:  metadata,
-			Timestamp: time.Now().UnixNano(),
-			Callback:  m.callbackAddr(),
-			Data: &v1.ProtoEvent_Patches{
-				Patches: &v1.PatchDatas{Patches: pds},
-			},
-		}); nil != err {
+	if err = m.dispatcher.Dispatch(ctx, ev); nil != err {
 		respWaiter.Cancel()
 		log.L().Error("patch entity, dispatch event",
 			logf.Error(err), logf.Eid(en.ID), logf.ReqID(reqID))
@@ -237,11 +314,33 @@ func (m *apiManager) GetEntity(ctx context.Context, en *Base) (*BaseRet, error)
 	log.L().Info("entity.GetEntity", logf.Eid(en.ID), logf.Type(en.Type),
 		logf.ReqID(reqID), logf.Owner(en.Owner), logf.Source(en.Source))
 
+	var state []byte
+	if state, err = m.loadEntity(ctx, reqID, en.ID); nil != err {
+		log.L().Error("get entity", logf.Eid(en.ID),
+			logf.ReqID(reqID), logf.Error(err))
+		return nil, errors.Wrap(err, "get entity")
+	}
+
+	log.L().Info("processing completed", logf.Eid(en.ID),
+		logf.ReqID(reqID), logf.Elapsed(elapsedTime.Elapsed()))
+
+	var baseRet BaseRet
+	if err = json.Unmarshal(state, &baseRet); nil != err {
+		log.L().Error("get entity, decode response", logf.ReqID(reqID),
+			logf.Error(err), logf.Eid(en.ID), logf.Base(en.JSON()))
+		return nil, errors.Wrap(err, "create entity, decode response")
+	}
+
+	return &baseRet, errors.Wrap(err, "get entity")
+}
+
+// loadEntity returns the state of the entity held by the runtime, which includes unflushed changes.
+func (m *apiManager) loadEntity(ctx context.Context, reqID, entityID string) ([]byte, error) {
 	// hold request.
 	respWaiter := m.holder.Wait(ctx, reqID)
 
 	// dispatch event.
-	if err = m.dispatcher.Dispatch(ctx,
+	if err := m.dispatcher.Dispatch(ctx,
 		&v1.ProtoEvent{
 			Id:        util.IG().EvID(),
 			Timestamp: time.Now().UnixNano(),
@@ -250,43 +349,31 @@ func (m *apiManager) GetEntity(ctx context.Context, en *Base) (*BaseRet, error)
 				v1.MetaBorn:      bornGet,
 				v1.MetaType:      enET,
 				v1.MetaRequestID: reqID,
-				v1.MetaEntityID:  en.ID,
+				v1.MetaEntityID:  entityID,
 			},
 			Data: &v1.ProtoEvent_Patches{
 				Patches: &v1.PatchDatas{},
 			},
 		}); nil != err {
 		respWaiter.Cancel()
-		log.L().Error("get entity, dispatch event",
-			logf.Error(err), logf.Eid(en.ID), logf.ReqID(reqID))
-		return nil, errors.Wrap(err, "get entity, dispatch event")
+		log.L().Error("load entity, dispatch event",
+			logf.Error(err), logf.Eid(entityID), logf.ReqID(reqID))
+		return nil, errors.Wrap(err, "dispatch event")
 	}
 
 	log.L().Debug("holding request, wait response",
-		logf.Eid(en.ID), logf.ReqID(reqID))
+		logf.Eid(entityID), logf.ReqID(reqID))
 
 	// wait response.
 	resp := respWaiter.Wait()
 	if resp.Status != types.StatusOK {
-		log.L().Error("get entity", logf.Eid(en.ID),
-			logf.ReqID(reqID), logf.Error(xerrors.New(resp.ErrCode)))
 		return nil, xerrors.New(resp.ErrCode)
 	}
 
-	log.L().Info("processing completed", logf.Eid(en.ID),
-		logf.ReqID(reqID), logf.Elapsed(elapsedTime.Elapsed()))
-
-	var baseRet BaseRet
-	if err = json.Unmarshal(resp.Data, &baseRet); nil != err {
-		log.L().Error("get entity, decode response", logf.ReqID(reqID),
-			logf.Error(err), logf.Eid(en.ID), logf.Base(en.JSON()))
-		return nil, errors.Wrap(err, "create entity, decode response")
-	}
-
-	return &baseRet, errors.Wrap(err, "get entity")
+	return resp.Data, nil
 }
 
-// DeleteEntity delete an entity from manager.
+// DeleteEntity moves an entity into trash, the entity is purged after retention.
 func (m *apiManager) DeleteEntity(ctx context.Context, en *Base) error {
 	var err error
 	reqID := util.IG().ReqID()
@@ -294,6 +381,13 @@ func (m *apiManager) DeleteEntity(ctx context.Context, en *Base) error {
 	log.L().Info("entity.DeleteEntity", logf.Eid(en.ID), logf.Type(en.Type),
 		logf.ReqID(reqID), logf.Owner(en.Owner), logf.Source(en.Source), logf.Base(en.JSON()))
 
+	// trash record of the entity, keeps template, expressions and subscriptions of the entity.
+	trash, err := m.trashRecord(ctx, en)
+	if nil != err {
+		log.L().Error("delete entity", logf.Eid(en.ID), logf.ReqID(reqID), logf.Error(err))
+		return errors.Wrap(err, "delete entity")
+	}
+
 	// hold request.
 	respWaiter := m.holder.Wait(ctx, reqID)
 
@@ -331,6 +425,15 @@ func (m *apiManager) DeleteEntity(ctx context.Context, en *Base) error {
 		return xerrors.New(resp.ErrCode)
 	}
 
+	if trash.TemplateID != "" {
+		if err = m.entityRepo.DelTemplateInstance(ctx, trash.Owner, trash.TemplateID, en.ID); nil != err {
+			log.L().Warn("delete entity, remove template instance", logf.Eid(en.ID),
+				logf.ReqID(reqID), logf.Template(trash.TemplateID), logf.Error(err))
+		}
+	}
+
+	m.suspendEntity(ctx, trash)
+
 	log.L().Info("processing completed", logf.Eid(en.ID),
 		logf.ReqID(reqID), logf.Elapsed(elapsedTime.Elapsed()))
 
@@ -501,6 +604,10 @@ func (m *apiManager) appendExpression(ctx context.Context, exprs []repository.Ex
 		}
 	}
 
+	if err := m.checkCycle(ctx, exprs); nil != err {
+		return errors.Wrap(err, "append expression")
+	}
+
 	// update expressions.
 	for _, expr := range exprs {
 		log.L().Debug("append expression", logf.Path(expr.Path),
@@ -515,6 +622,46 @@ func (m *apiManager) appendExpression(ctx context.Context, exprs []repository.Ex
 	return nil
 }
 
+// checkCycle rejects expressions which make the stored expression graph of their owner cyclic,
+// runtimes reject cycles across owners of cross tenant expressions when mounting expressions.
+func (m *apiManager) checkCycle(ctx context.Context, exprs []repository.Expression) error {
+	graphs := make(map[string]map[string]*repository.Expression)
+	for index := range exprs {
+		expr := &exprs[index]
+		graph, ok := graphs[expr.Owner]
+		if !ok {
+			stored, err := m.entityRepo.ListExpression(ctx,
+				m.entityRepo.GetLastRevision(ctx), &repository.ListExprReq{Owner: expr.Owner})
+			if nil != err {
+				return errors.Wrap(err, "list expressions")
+			}
+
+			graph = make(map[string]*repository.Expression, len(stored))
+			for _, item := range stored {
+				graph[item.ID] = item
+			}
+			graphs[expr.Owner] = graph
+		}
+
+		items := make([]*repository.Expression, 0, len(graph))
+		for _, item := range graph {
+			items = append(items, item)
+		}
+
+		chain, err := expression.DetectCycle(*expr, items)
+		if nil != err {
+			return errors.Wrap(err, "detect expression cycle")
+		} else if len(chain) > 0 {
+			log.L().Error("append expression, cycle detected", logf.ID(expr.ID),
+				logf.Eid(expr.EntityID), logf.Expr(expr.Expression), logf.Any("chain", chain))
+			metrics.CollectorExprRejected.WithLabelValues(metrics.ExprRejectCycle).Inc()
+			return errors.Wrapf(xerrors.ErrExpressionCycle, "expression chain %v", chain)
+		}
+		graph[expr.ID] = expr
+	}
+	return nil
+}
+
 func (m *apiManager) RemoveExpression(ctx context.Context, exprs []repository.Expression) error {
 	// delete expressions.
 	for index := range exprs {
@@ -561,6 +708,87 @@ func (m *apiManager) ListExpression(ctx context.Context, en *Base) ([]*repositor
 	return exprs, nil
 }
 
+// EvaluateExpression evaluate expression with current state of source entities held by runtimes, nothing persisted.
+func (m *apiManager) EvaluateExpression(ctx context.Context, expr repository.Expression) (*ExprEvalResult, error) {
+	if err := checkExpression(&expr); nil != err {
+		log.L().Error("evaluate expression, invalidate expression", logf.Path(expr.Path),
+			logf.Eid(expr.EntityID), logf.Owner(expr.Owner), logf.Expr(expr.Expression))
+		return nil, errors.Wrap(err, "invalid expression")
+	}
+
+	// stateful functions start with empty memory, nothing persisted.
+	exprIns, err := expression.NewExpr(expr.Expression,
+		function.Funcs(&function.Context{
+			Memory: function.NewMemory(),
+			Lookup: func(entityID, propertyKey string) tdtl.Node {
+				bytes, innerErr := m.loadEntity(ctx, util.IG().ReqID(), entityID)
+				if nil != innerErr {
+					return tdtl.UNDEFINED_RESULT
+				} else if !allowReference(expr, bytes) {
+					return tdtl.NULL_RESULT
+				}
+				return tdtl.New(bytes).Get(propertyKey)
+			},
+		}))
+	if nil != err {
+		log.L().Error("evaluate expression", logf.Error(err), logf.Expr(expr.Expression))
+		return nil, errors.Wrap(err, "parse expression")
+	}
+
+	ret := &ExprEvalResult{
+		Expression: expr.Expression,
+		Value:      tdtl.UNDEFINED_RESULT,
+		Inputs:     make(map[string]tdtl.Node),
+	}
+
+	// resolve inputs from source entities.
+	for entityID, paths := range exprIns.Sources() {
+		var bytes []byte
+		if bytes, err = m.loadEntity(ctx, util.IG().ReqID(), entityID); nil != err {
+			log.L().Warn("evaluate expression, load source entity",
+				logf.Eid(entityID), logf.Expr(expr.Expression), logf.Error(err))
+			ret.Err = errors.Wrapf(err, "load source entity %s", entityID)
+			return ret, nil
+		} else if !allowReference(expr, bytes) {
+			log.L().Warn("evaluate expression, source entity of other tenant",
+				logf.Eid(entityID), logf.Owner(expr.Owner), logf.Expr(expr.Expression))
+			return nil, errors.Wrapf(xerrors.ErrPermissionDenied, "source entity %s", entityID)
+		}
+
+		state := tdtl.New(bytes)
+		for _, path := range paths {
+			propertyKey := mapper.NewWatchKey(path).PropertyKey
+			if propertyKey == "*" {
+				// TODO: 现阶段 TQL 仅仅支持 eid.* .
+				propertyKey = "properties"
+			}
+			ret.Inputs[path] = state.Get(propertyKey)
+		}
+	}
+
+	// eval expression within time budget.
+	if timeout := config.Get().Expression.EvalTimeout; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(timeout)*time.Millisecond)
+		defer cancel()
+	}
+
+	ret.Value, ret.Err = exprIns.Eval(ctx, ret.Inputs)
+	log.L().Debug("evaluate expression", logf.Eid(expr.EntityID), logf.Path(expr.Path),
+		logf.Expr(expr.Expression), logf.Input(ret.Inputs), logf.Output(ret.Value.String()))
+
+	return ret, nil
+}
+
+// allowReference reports whether the expression may read the entity, as runtimes do.
+func allowReference(expr repository.Expression, state []byte) bool {
+	if config.Get().Auth.CrossTenantExpression || expr.Owner == "" {
+		return true
+	}
+	owner := tenant.FromState(state)
+	return owner == "" || owner == expr.Owner
+}
+
 ///////////
 
 func (m *apiManager) CreateSubscription(ctx context.Context, subscription *repository.Subscription) error {
@@ -17,10 +17,21 @@ limitations under the License.
 package manager
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/manager/holder"
 	"github.com/tkeel-io/core/pkg/mapper"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	_ "github.com/tkeel-io/core/pkg/resource/store/memory"
+	"github.com/tkeel-io/core/pkg/runtime/mock"
+	"github.com/tkeel-io/core/pkg/types"
 )
 
 func TestEntity_GetEntity(t *testing.T) {
@@ -51,3 +62,130 @@ func Test_checkTQL(t *testing.T) {
 		})
 	}
 }
+
+// stateDispatcher responds get events as runtimes, with states of entities held by runtimes.
+type stateDispatcher struct {
+	eventRecorder
+	m      *apiManager
+	states map[string][]byte
+}
+
+func (d *stateDispatcher) Dispatch(ctx context.Context, ev v1.Event) error {
+	resp := &holder.Response{ID: ev.Attr(v1.MetaRequestID), Status: types.StatusOK, Data: d.states[ev.Entity()]}
+	if nil == resp.Data {
+		resp.Status, resp.ErrCode = types.StatusError, xerrors.ErrEntityNotFound.Error()
+	}
+	go d.m.OnRespond(ctx, resp)
+	return nil
+}
+
+func TestAPIManager_EvaluateExpression(t *testing.T) {
+	repo := mock.NewRepo()
+	err := repo.PutEntity(context.Background(), "device234",
+		[]byte(`{"id":"device234","properties":{"temp":10,"metrics":{"cpu":0.5}}}`))
+	assert.Nil(t, err)
+
+	// inputs are read from runtimes, not the stale state store.
+	dispatcher := &stateDispatcher{states: map[string][]byte{
+		"device234": []byte(`{"id":"device234","properties":{"temp":20,"metrics":{"cpu":0.5}}}`),
+	}}
+	m := &apiManager{
+		ctx:        context.Background(),
+		entityRepo: repo,
+		dispatcher: dispatcher,
+		holder:     holder.New(context.Background(), time.Second),
+	}
+	dispatcher.m = m
+	ret, err := m.EvaluateExpression(context.Background(), repository.Expression{
+		EntityID:   "device123",
+		Path:       "properties.temp2",
+		Expression: "device234.temp + 10",
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, ret.Err)
+	assert.Equal(t, "device234.properties.temp + 10", ret.Expression)
+	assert.Equal(t, "30", ret.Value.String())
+	assert.Equal(t, "20", ret.Inputs["device234.properties.temp"].String())
+
+	// source entity not exists.
+	ret, err = m.EvaluateExpression(context.Background(), repository.Expression{
+		Expression: "device345.temp",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, ret.Err)
+
+	// source entities of other tenants are denied.
+	dispatcher.states["device456"] = []byte(`{"id":"device456","owner":"tenant2","properties":{"temp":30}}`)
+	_, err = m.EvaluateExpression(context.Background(), repository.Expression{
+		Owner:      "tenant1",
+		Expression: "device456.temp",
+	})
+	assert.ErrorIs(t, err, xerrors.ErrPermissionDenied)
+	ret, err = m.EvaluateExpression(context.Background(), repository.Expression{
+		Owner:      "tenant1",
+		Expression: "lookup('device456', 'properties.temp')",
+	})
+	assert.Nil(t, err)
+	assert.NotEqual(t, "30", ret.Value.String())
+
+	// invalid expression.
+	_, err = m.EvaluateExpression(context.Background(), repository.Expression{})
+	assert.NotNil(t, err)
+}
+
+func TestAPIManager_AppendExpressionCycle(t *testing.T) {
+	ctx := context.Background()
+	d, err := dao.New(ctx, config.Metadata{Name: "memory"},
+		config.Metadata{Name: dao.MetadataEmbedded}, config.EtcdConfig{})
+	assert.Nil(t, err)
+	defer d.Close()
+	repo := repository.New(d)
+	m := &apiManager{ctx: ctx, entityRepo: repo}
+
+	// A -> B -> C.
+	assert.Nil(t, m.AppendExpression(ctx, []repository.Expression{
+		*repository.NewExpression("admin", "dev-b", "b", "properties.b", "dev-a.a", ""),
+		*repository.NewExpression("admin", "dev-c", "c", "properties.c", "dev-b.b + 1", ""),
+	}))
+
+	// C -> A makes cycle with stored expressions.
+	err = m.AppendExpression(ctx, []repository.Expression{
+		*repository.NewExpression("admin", "dev-a", "a", "properties.a", "dev-c.c", "")})
+	assert.ErrorIs(t, err, xerrors.ErrExpressionCycle)
+	has, _ := repo.HasExpression(ctx, *repository.NewExpression("admin", "dev-a", "a", "properties.a", "", ""))
+	assert.False(t, has)
+
+	// cycles within the appended expressions.
+	err = m.AppendExpression(ctx, []repository.Expression{
+		*repository.NewExpression("admin", "dev-d", "d", "properties.d", "dev-e.e", ""),
+		*repository.NewExpression("admin", "dev-e", "e", "properties.e", "dev-d.d", ""),
+	})
+	assert.ErrorIs(t, err, xerrors.ErrExpressionCycle)
+
+	// replacing the expression of B breaks the chain.
+	assert.Nil(t, m.AppendExpression(ctx, []repository.Expression{
+		*repository.NewExpression("admin", "dev-b", "b", "properties.b", "dev-x.x", ""),
+		*repository.NewExpression("admin", "dev-a", "a", "properties.a", "dev-c.c", ""),
+	}))
+}
+
+func TestAPIManager_CreateAlarmRule(t *testing.T) {
+	m := &apiManager{entityRepo: mock.NewRepo()}
+	rule := &repository.AlarmRule{
+		ID:        "alarm123",
+		Owner:     "admin",
+		EntityID:  "device123",
+		Severity:  repository.AlarmSeverityMajor,
+		Condition: "device123.properties.temp > 80",
+	}
+	assert.Nil(t, m.CreateAlarmRule(context.Background(), rule))
+
+	// invalid severity.
+	rule.Severity = "UNKNOWN"
+	assert.ErrorIs(t, m.CreateAlarmRule(context.Background(), rule), xerrors.ErrAlarmRuleInvalid)
+
+	// condition references other entity.
+	rule.Severity = repository.AlarmSeverityMajor
+	rule.ClearCondition = "device234.properties.temp < 60"
+	assert.ErrorIs(t, m.CreateAlarmRule(context.Background(), rule), xerrors.ErrAlarmRuleInvalid)
+}
@@ -0,0 +1,42 @@
+package manager
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/tenant"
+	"github.com/tkeel-io/kit/log"
+)
+
+// checkQuota rejects writes of the tenant which exceed its quota,
+// creating entities are also checked against the entity count quota.
+func (m *apiManager) checkQuota(ctx context.Context, tenantID string, create bool) error {
+	if tenantID == "" {
+		return nil
+	}
+
+	quota := config.Get().Tenant.QuotaOf(tenantID)
+	if !tenant.AllowMessage(tenantID) {
+		log.L().Warn("tenant msg rate quota exceeded", logf.Owner(tenantID), logf.Int("quota", quota.MsgRate))
+		return errors.Wrap(xerrors.ErrQuotaExceeded, "msg rate")
+	} else if quota.Entities <= 0 && quota.Storage <= 0 {
+		return nil
+	}
+
+	usage, err := m.entityRepo.GetTenantUsage(ctx, tenantID)
+	if nil != err {
+		return errors.Wrap(err, "check tenant quota")
+	}
+
+	if create && quota.Entities > 0 && usage.Entities >= quota.Entities {
+		log.L().Warn("tenant entities quota exceeded", logf.Owner(tenantID), logf.Int64("quota", quota.Entities))
+		return errors.Wrap(xerrors.ErrQuotaExceeded, "entities")
+	} else if quota.Storage > 0 && usage.Storage >= quota.Storage {
+		log.L().Warn("tenant storage quota exceeded", logf.Owner(tenantID), logf.Int64("quota", quota.Storage))
+		return errors.Wrap(xerrors.ErrQuotaExceeded, "storage")
+	}
+	return nil
+}
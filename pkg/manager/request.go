@@ -0,0 +1,211 @@
+package manager
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/manager/holder"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/types"
+	"github.com/tkeel-io/core/pkg/util/transport"
+	"github.com/tkeel-io/kit/log"
+)
+
+// metaCallback carries the callback url of an async request to trackRequest,
+// it is removed before the event is dispatched.
+const metaCallback = "x-msg-async-callback"
+
+// requestPurgeInterval is the interval of purging statuses of expired async requests.
+const requestPurgeInterval = time.Minute
+
+// NewAsyncOption makes the write return without waiting for the runtime,
+// the outcome is tracked by request id and posted to callback if not empty.
+func NewAsyncOption(callback string) Option {
+	return func(meta Metadata) {
+		meta[v1.MetaAsync] = "true"
+		if callback != "" {
+			meta[metaCallback] = callback
+		}
+	}
+}
+
+func isAsync(meta map[string]string) bool {
+	return meta[v1.MetaAsync] != ""
+}
+
+// checkCallback rejects callbacks of hosts not in the allowed hosts,
+// so that outcomes are never posted to arbitrary addresses.
+func checkCallback(callback string, hosts []string) error {
+	urlIns, err := url.Parse(callback)
+	if nil == err && (urlIns.Scheme == "http" || urlIns.Scheme == "https") {
+		for _, host := range hosts {
+			if strings.EqualFold(host, urlIns.Host) || strings.EqualFold(host, urlIns.Hostname()) {
+				return nil
+			}
+		}
+	}
+	return errors.Wrapf(xerrors.ErrCallbackNotAllowed, "callback %s", callback)
+}
+
+// trackRequest persists the async request before its event is dispatched,
+// so that its status survives restarts.
+func (m *apiManager) trackRequest(ctx context.Context, reqID, operation string, en *Base, meta map[string]string) (*repository.Request, error) {
+	if callback := meta[metaCallback]; callback != "" {
+		if err := checkCallback(callback, config.Get().Request.CallbackHosts); nil != err {
+			log.L().Warn("track request", logf.ReqID(reqID), logf.URL(callback), logf.Error(err))
+			return nil, errors.Wrap(err, "track request")
+		}
+	}
+
+	now := time.Now().UnixNano() / 1e6
+	req := &repository.Request{
+		ID:        reqID,
+		Owner:     en.Owner,
+		EntityID:  en.ID,
+		Operation: operation,
+		Status:    repository.RequestStatusPending,
+		Callback:  meta[metaCallback],
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if operation == bornCreate && en.TemplateVersion > 0 {
+		req.TemplateID, req.TemplateVersion = en.TemplateID, en.TemplateVersion
+	}
+
+	delete(meta, metaCallback)
+	if err := m.entityRepo.PutRequest(ctx, req); nil != err {
+		log.L().Error("track request", logf.ReqID(reqID), logf.Eid(en.ID), logf.Error(err))
+		return nil, errors.Wrap(err, "track request")
+	}
+	return req, nil
+}
+
+// dispatchAsync tracks the request and dispatches its event without waiting for the runtime,
+// returns the entity basic info with the request id.
+func (m *apiManager) dispatchAsync(ctx context.Context, reqID, operation string, en *Base, ev *v1.ProtoEvent) (BaseRet, error) {
+	baseRet := BaseRet{ID: en.ID, Type: en.Type, Owner: en.Owner, Source: en.Source, RequestID: reqID}
+	req, err := m.trackRequest(ctx, reqID, operation, en, ev.Metadata)
+	if nil != err {
+		return baseRet, errors.Wrap(err, "dispatch async")
+	}
+
+	if err = m.dispatcher.Dispatch(ctx, ev); nil != err {
+		log.L().Error("dispatch async request", logf.Error(err), logf.Eid(en.ID), logf.ReqID(reqID))
+		m.completeRequest(ctx, req, types.StatusError, err.Error())
+		return baseRet, errors.Wrap(err, "dispatch async")
+	}
+
+	log.L().Debug("async request dispatched", logf.Eid(en.ID), logf.ReqID(reqID))
+	return baseRet, nil
+}
+
+// completeRequest records the outcome of the async request, and posts it to the callback.
+func (m *apiManager) completeRequest(ctx context.Context, req *repository.Request, status types.Status, errCode string) {
+	if status == types.StatusOK && req.TemplateVersion > 0 {
+		// the entity is created, index it as an instance of the template.
+		tpl, err := m.ResolveTemplate(ctx, &repository.Template{
+			ID: req.TemplateID, Owner: req.Owner, Version: req.TemplateVersion})
+		if nil == err {
+			err = m.indexInstance(ctx, req.Owner, req.EntityID, tpl)
+		}
+		if nil != err {
+			log.L().Error("complete request, index template instance", logf.ReqID(req.ID),
+				logf.Eid(req.EntityID), logf.Template(req.TemplateID), logf.Error(err))
+			status, errCode = types.StatusError, err.Error()
+		}
+	}
+
+	req.Status = repository.RequestStatusSucceeded
+	if status != types.StatusOK {
+		req.Status = repository.RequestStatusFailed
+		req.ErrCode = errCode
+	}
+	req.UpdatedAt = time.Now().UnixNano() / 1e6
+
+	if err := m.entityRepo.PutRequest(ctx, req); nil != err {
+		log.L().Error("complete request", logf.ReqID(req.ID), logf.Eid(req.EntityID), logf.Error(err))
+	}
+
+	if req.Callback == "" || m.transmitter == nil {
+		return
+	} else if err := checkCallback(req.Callback, config.Get().Request.CallbackHosts); nil != err {
+		// callback hosts changed after the request.
+		log.L().Warn("complete request, callback", logf.ReqID(req.ID), logf.URL(req.Callback), logf.Error(err))
+		return
+	}
+
+	payload, err := json.Marshal(req)
+	if nil != err {
+		log.L().Error("complete request, encode callback", logf.ReqID(req.ID), logf.Error(err))
+		return
+	}
+
+	if err = m.transmitter.Do(ctx, &transport.Request{
+		PackageID: req.ID,
+		Method:    http.MethodPost,
+		Address:   req.Callback,
+		Payload:   payload,
+	}); nil != err {
+		log.L().Error("complete request, callback", logf.ReqID(req.ID),
+			logf.URL(req.Callback), logf.Error(err))
+	}
+}
+
+// onAsyncRespond completes the async request the response belongs to.
+func (m *apiManager) onAsyncRespond(ctx context.Context, resp *holder.Response) {
+	req, err := m.entityRepo.GetRequest(ctx, resp.ID)
+	if nil != err {
+		log.L().Error("async request respond", logf.ReqID(resp.ID), logf.Error(err))
+		return
+	} else if req.Status != repository.RequestStatusPending {
+		// redelivered response.
+		return
+	}
+
+	m.completeRequest(ctx, req, resp.Status, resp.ErrCode)
+}
+
+// purgeRequests purges statuses of async requests older than retention periodically on the lease holder.
+func (m *apiManager) purgeRequests() {
+	ticker := time.NewTicker(requestPurgeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-ticker.C:
+			if ctx, ok := m.leader(); ok {
+				m.purgeExpiredRequests(ctx, config.Get().Request.Retention)
+			}
+		}
+	}
+}
+
+func (m *apiManager) purgeExpiredRequests(ctx context.Context, retention int64) {
+	if retention <= 0 {
+		return
+	}
+
+	before := time.Now().Add(-time.Duration(retention) * time.Second).UnixMilli()
+	purged, err := m.entityRepo.PurgeRequests(ctx, before)
+	if nil != err {
+		log.L().Error("purge expired requests", logf.Count(int64(purged)), logf.Error(err))
+		return
+	} else if purged > 0 {
+		log.L().Info("purge expired requests", logf.Count(int64(purged)))
+	}
+}
+
+// GetRequestStatus returns the status of the async request.
+func (m *apiManager) GetRequestStatus(ctx context.Context, reqID string) (*repository.Request, error) {
+	req, err := m.entityRepo.GetRequest(ctx, reqID)
+	return req, errors.Wrap(err, "get request status")
+}
@@ -0,0 +1,98 @@
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/manager/holder"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/runtime/mock"
+	"github.com/tkeel-io/core/pkg/types"
+	"github.com/tkeel-io/core/pkg/util/transport"
+)
+
+type eventRecorder struct {
+	events []v1.Event
+}
+
+func (d *eventRecorder) DispatchToLog(context.Context, []byte) error { return nil }
+
+func (d *eventRecorder) Dispatch(_ context.Context, ev v1.Event) error {
+	d.events = append(d.events, ev)
+	return nil
+}
+
+type requestRecorder struct {
+	requests []*transport.Request
+}
+
+func (t *requestRecorder) Do(_ context.Context, req *transport.Request) error {
+	t.requests = append(t.requests, req)
+	return nil
+}
+
+func (t *requestRecorder) Close() error { return nil }
+
+func TestAPIManager_AsyncPatchEntity(t *testing.T) {
+	ctx := context.Background()
+	dispatcher, transmitter := &eventRecorder{}, &requestRecorder{}
+	m := &apiManager{
+		ctx:         ctx,
+		entityRepo:  mock.NewRepo(),
+		dispatcher:  dispatcher,
+		transmitter: transmitter,
+		holder:      holder.New(ctx, 0),
+	}
+
+	// callbacks of hosts not allowed are rejected.
+	patches := []*v1.PatchData{{Path: "properties.temp", Operator: "replace", Value: []byte("20")}}
+	_, _, err := m.PatchEntity(ctx, &Base{ID: "device123", Owner: "admin"},
+		patches, NewAsyncOption("http://169.254.169.254/latest"))
+	assert.ErrorIs(t, err, xerrors.ErrCallbackNotAllowed)
+	assert.Empty(t, dispatcher.events)
+
+	ret, raw, err := m.PatchEntity(ctx, &Base{ID: "device123", Owner: "admin"}, patches, NewAsyncOption(""))
+	assert.Nil(t, err)
+	assert.Empty(t, raw)
+	assert.NotEmpty(t, ret.RequestID)
+	assert.Len(t, dispatcher.events, 1)
+	assert.Equal(t, "true", dispatcher.events[0].Attributes()[v1.MetaAsync])
+
+	req, err := m.GetRequestStatus(ctx, ret.RequestID)
+	assert.Nil(t, err)
+	assert.Equal(t, repository.RequestStatusPending, req.Status)
+	assert.Equal(t, "device123", req.EntityID)
+
+	meta := dispatcher.events[0].Attributes()
+	m.OnRespond(ctx, &holder.Response{ID: ret.RequestID, Status: types.StatusOK, Metadata: meta})
+	req, err = m.GetRequestStatus(ctx, ret.RequestID)
+	assert.Nil(t, err)
+	assert.Equal(t, repository.RequestStatusSucceeded, req.Status)
+
+	// redelivered response is ignored.
+	m.OnRespond(ctx, &holder.Response{ID: ret.RequestID, Status: types.StatusError, Metadata: meta})
+	req, err = m.GetRequestStatus(ctx, ret.RequestID)
+	assert.Nil(t, err)
+	assert.Equal(t, repository.RequestStatusSucceeded, req.Status)
+	assert.Empty(t, transmitter.requests)
+
+	// requests are purged after retention.
+	purged, err := m.entityRepo.PurgeRequests(ctx, req.CreatedAt+2*3600*1000)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, purged)
+	_, err = m.GetRequestStatus(ctx, ret.RequestID)
+	assert.ErrorIs(t, err, xerrors.ErrResourceNotFound)
+}
+
+func Test_checkCallback(t *testing.T) {
+	hosts := []string{"example.com", "localhost:8080"}
+	assert.Nil(t, checkCallback("https://example.com/callback", hosts))
+	assert.Nil(t, checkCallback("http://localhost:8080/callback", hosts))
+	assert.ErrorIs(t, checkCallback("http://localhost:9090/callback", hosts), xerrors.ErrCallbackNotAllowed)
+	assert.ErrorIs(t, checkCallback("http://example.com@10.0.0.1/callback", hosts), xerrors.ErrCallbackNotAllowed)
+	assert.ErrorIs(t, checkCallback("file:///etc/passwd", hosts), xerrors.ErrCallbackNotAllowed)
+	assert.ErrorIs(t, checkCallback("https://example.com/callback", nil), xerrors.ErrCallbackNotAllowed)
+}
@@ -0,0 +1,194 @@
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/repository"
+	xjson "github.com/tkeel-io/core/pkg/util/json"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+)
+
+const (
+	fieldSchema        = "schema_id"
+	fieldSchemaVersion = "schema_version"
+)
+
+// CreateSchema create the first version of the schema, concurrent creations of the schema fail but one.
+func (m *apiManager) CreateSchema(ctx context.Context, schema *repository.Schema) (*repository.Schema, error) {
+	if _, err := schema.Configs(); nil != err {
+		return nil, errors.Wrap(err, "create schema")
+	}
+
+	switch _, err := m.entityRepo.GetSchema(ctx,
+		repository.Schema{ID: schema.ID, Owner: schema.Owner}); {
+	case nil == err:
+		return nil, errors.Wrap(xerrors.ErrSchemaAlreadyExists, "create schema")
+	case !errors.Is(err, xerrors.ErrSchemaNotFound):
+		return nil, errors.Wrap(err, "create schema")
+	}
+
+	schema.Version = 1
+	schema.CreatedAt = time.Now().UnixMilli()
+	if err := m.entityRepo.CreateSchema(ctx, *schema); nil != err {
+		return nil, errors.Wrap(err, "create schema")
+	}
+	return schema, nil
+}
+
+// UpdateSchema create a new version of the schema,
+// bound entities keep their version until bound again, concurrent updates of the schema fail but one.
+func (m *apiManager) UpdateSchema(ctx context.Context, schema *repository.Schema) (*repository.Schema, error) {
+	if _, err := schema.Configs(); nil != err {
+		return nil, errors.Wrap(err, "update schema")
+	}
+
+	latest, err := m.entityRepo.GetSchema(ctx,
+		repository.Schema{ID: schema.ID, Owner: schema.Owner})
+	if nil != err {
+		return nil, errors.Wrap(err, "update schema")
+	}
+
+	if schema.Name == "" {
+		schema.Name = latest.Name
+	}
+	schema.Version = latest.Version + 1
+	schema.CreatedAt = time.Now().UnixMilli()
+	if err = m.entityRepo.CreateSchema(ctx, *schema); nil != err {
+		return nil, errors.Wrap(err, "update schema")
+	}
+	return schema, nil
+}
+
+// GetSchema returns the schema version, returns the latest version if Version is 0.
+func (m *apiManager) GetSchema(ctx context.Context, schema *repository.Schema) (*repository.Schema, error) {
+	ret, err := m.entityRepo.GetSchema(ctx, *schema)
+	if nil != err {
+		return nil, errors.Wrap(err, "get schema")
+	}
+	return &ret, nil
+}
+
+// ListSchema returns versions of the schema, or latest versions of all schemas if ID is empty.
+func (m *apiManager) ListSchema(ctx context.Context, req *repository.ListSchemaReq) ([]*repository.Schema, error) {
+	schemas, err := m.entityRepo.ListSchema(ctx, m.entityRepo.GetLastRevision(ctx), req)
+	if nil != err || req.ID != "" {
+		return schemas, errors.Wrap(err, "list schema")
+	}
+
+	var latests []*repository.Schema
+	for index, schema := range schemas {
+		// versions of schema are in ascending order.
+		if index+1 == len(schemas) || schemas[index+1].ID != schema.ID {
+			latests = append(latests, schema)
+		}
+	}
+	return latests, nil
+}
+
+// DeleteSchema delete all versions of the schema, bound entities keep their property configs.
+func (m *apiManager) DeleteSchema(ctx context.Context, schema *repository.Schema) error {
+	if _, err := m.entityRepo.GetSchema(ctx,
+		repository.Schema{ID: schema.ID, Owner: schema.Owner}); nil != err {
+		return errors.Wrap(err, "delete schema")
+	}
+	return errors.Wrap(m.entityRepo.DelSchema(ctx, repository.Schema{
+		ID: schema.ID, Owner: schema.Owner}), "delete schema")
+}
+
+// applySchema replace property configs of the entity with configs of the schema version.
+func (m *apiManager) applySchema(ctx context.Context, en *Base) (*repository.Schema, error) {
+	if en.SchemaID == "" {
+		return nil, nil
+	}
+
+	schema, err := m.GetSchema(ctx, &repository.Schema{
+		ID: en.SchemaID, Owner: en.Owner, Version: en.SchemaVersion})
+	if nil != err {
+		return nil, errors.Wrap(err, "apply schema")
+	}
+
+	var scheme map[string]interface{}
+	if len(en.Scheme) > 0 {
+		if err = json.Unmarshal(en.Scheme, &scheme); nil != err {
+			return nil, errors.Wrap(err, "apply schema, decode scheme")
+		}
+	}
+
+	if en.Scheme, err = bindScheme(scheme, schema); nil != err {
+		return nil, errors.Wrap(err, "apply schema")
+	}
+
+	en.SchemaVersion = schema.Version
+	return schema, nil
+}
+
+// UpdateEntitySchema binds the entity to the schema version,
+// the binding fails if properties of the entity violate the schema.
+func (m *apiManager) UpdateEntitySchema(ctx context.Context, en *Base) error {
+	schema, err := m.GetSchema(ctx, &repository.Schema{
+		ID: en.SchemaID, Owner: en.Owner, Version: en.SchemaVersion})
+	if nil != err {
+		return errors.Wrap(err, "update entity schema")
+	}
+
+	current, err := m.GetEntity(ctx, en)
+	if nil != err {
+		return errors.Wrap(err, "update entity schema")
+	}
+
+	schemeBytes, err := bindScheme(current.Scheme, schema)
+	if nil != err {
+		return errors.Wrap(err, "update entity schema")
+	}
+
+	patches := []*v1.PatchData{{
+		Path:     fieldScheme,
+		Value:    schemeBytes,
+		Operator: xjson.OpReplace.String(),
+	}, {
+		Path:     fieldSchema,
+		Value:    tdtl.NewString(schema.ID).Raw(),
+		Operator: xjson.OpReplace.String(),
+	}, {
+		Path:     fieldSchemaVersion,
+		Value:    tdtl.NewInt64(schema.Version).Raw(),
+		Operator: xjson.OpReplace.String(),
+	}}
+
+	if _, _, err = m.PatchEntity(ctx, en, patches); nil != err {
+		return errors.Wrap(err, "update entity schema")
+	}
+
+	en.SchemaVersion = schema.Version
+	return nil
+}
+
+// bindScheme returns the scheme with property configs of the schema replaced.
+func bindScheme(scheme map[string]interface{}, schema *repository.Schema) ([]byte, error) {
+	var configs map[string]interface{}
+	if schema.Schema != "" {
+		if err := json.Unmarshal([]byte(schema.Schema), &configs); nil != err {
+			return nil, errors.Wrap(xerrors.ErrSchemaInvalid, err.Error())
+		}
+	}
+
+	bound := make(map[string]interface{})
+	for key, val := range scheme {
+		bound[key] = val
+	}
+	for key, val := range configs {
+		bound[key] = val
+	}
+
+	bytes, err := json.Marshal(bound)
+	if nil != err {
+		log.L().Error("encode schema configs", logf.ID(schema.ID), logf.Error(err))
+	}
+	return bytes, errors.Wrap(err, "encode schema configs")
+}
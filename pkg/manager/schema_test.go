@@ -0,0 +1,90 @@
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository"
+)
+
+type schemaRepo struct {
+	repository.IRepository
+	schemas map[string][]repository.Schema
+}
+
+func (r *schemaRepo) GetLastRevision(context.Context) int64 {
+	return 0
+}
+
+func (r *schemaRepo) CreateSchema(_ context.Context, schema repository.Schema) error {
+	for _, version := range r.schemas[schema.ID] {
+		if version.Version == schema.Version {
+			return xerrors.ErrSchemaAlreadyExists
+		}
+	}
+	r.schemas[schema.ID] = append(r.schemas[schema.ID], schema)
+	return nil
+}
+
+func (r *schemaRepo) GetSchema(_ context.Context, schema repository.Schema) (repository.Schema, error) {
+	versions := r.schemas[schema.ID]
+	if schema.Version == 0 && len(versions) > 0 {
+		return versions[len(versions)-1], nil
+	}
+	for _, version := range versions {
+		if version.Version == schema.Version {
+			return version, nil
+		}
+	}
+	return schema, xerrors.ErrSchemaNotFound
+}
+
+func (r *schemaRepo) ListSchema(_ context.Context, _ int64, req *repository.ListSchemaReq) ([]*repository.Schema, error) {
+	var schemas []*repository.Schema
+	for index := range r.schemas[req.ID] {
+		schemas = append(schemas, &r.schemas[req.ID][index])
+	}
+	return schemas, nil
+}
+
+func TestAPIManager_Schema(t *testing.T) {
+	ctx := context.Background()
+	m := &apiManager{entityRepo: &schemaRepo{schemas: map[string][]repository.Schema{}}}
+
+	schema, err := m.CreateSchema(ctx, &repository.Schema{ID: "sensor", Owner: "admin",
+		Name: "sensor", Schema: `{"temp":{"type":"float"}}`})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), schema.Version)
+
+	_, err = m.CreateSchema(ctx, &repository.Schema{ID: "sensor", Owner: "admin"})
+	assert.ErrorIs(t, err, xerrors.ErrSchemaAlreadyExists)
+	_, err = m.UpdateSchema(ctx, &repository.Schema{ID: "sensor", Owner: "admin", Schema: `{"temp":{"type":"decimal"}}`})
+	assert.ErrorIs(t, err, xerrors.ErrSchemaInvalid)
+
+	schema, err = m.UpdateSchema(ctx, &repository.Schema{ID: "sensor", Owner: "admin",
+		Schema: `{"temp":{"type":"double"},"online":{"type":"bool"}}`})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), schema.Version)
+	assert.Equal(t, "sensor", schema.Name)
+
+	schemas, err := m.ListSchema(ctx, &repository.ListSchemaReq{Owner: "admin", ID: "sensor"})
+	assert.Nil(t, err)
+	assert.Len(t, schemas, 2)
+
+	// schema configs replace configs of the same properties.
+	en := &Base{ID: "device123", Owner: "admin", SchemaID: "sensor", SchemaVersion: 1,
+		Scheme: []byte(`{"temp":{"type":"int"},"unit":{"type":"string"}}`)}
+	_, err = m.applySchema(ctx, en)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"temp":{"type":"float"},"unit":{"type":"string"}}`, string(en.Scheme))
+
+	en = &Base{ID: "device123", Owner: "admin", SchemaID: "sensor"}
+	_, err = m.applySchema(ctx, en)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), en.SchemaVersion)
+
+	_, err = m.applySchema(ctx, &Base{ID: "device123", Owner: "admin", SchemaID: "actuator"})
+	assert.ErrorIs(t, err, xerrors.ErrSchemaNotFound)
+}
@@ -0,0 +1,309 @@
+package manager
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/mapper/expression"
+	"github.com/tkeel-io/core/pkg/repository"
+	xjson "github.com/tkeel-io/core/pkg/util/json"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+)
+
+const (
+	// maxTemplateDepth limits the length of template inheritance chain.
+	maxTemplateDepth = 16
+
+	fieldScheme          = "scheme"
+	fieldProperties      = "properties"
+	fieldTemplate        = "template_id"
+	fieldTemplateVersion = "template_version"
+)
+
+// MigrateTemplateReq upgrade instances of template from FromVersion to ToVersion.
+type MigrateTemplateReq struct {
+	ID          string
+	Owner       string
+	FromVersion int64
+	ToVersion   int64
+}
+
+// CreateTemplate create a new immutable version of the template.
+func (m *apiManager) CreateTemplate(ctx context.Context, tpl *repository.Template) (*repository.Template, error) {
+	latest, err := m.entityRepo.GetTemplate(ctx,
+		&repository.Template{ID: tpl.ID, Owner: tpl.Owner})
+	switch {
+	case nil == err:
+		tpl.Version = latest.Version + 1
+	case errors.Is(err, xerrors.ErrTemplateNotFound):
+		tpl.Version = 1
+	default:
+		return nil, errors.Wrap(err, "create template")
+	}
+
+	// check inheritance chain.
+	if _, err = m.resolveTemplate(ctx, tpl); nil != err {
+		log.L().Error("create template, resolve template", logf.ID(tpl.ID),
+			logf.Owner(tpl.Owner), logf.Template(tpl.Extends), logf.Error(err))
+		return nil, errors.Wrap(err, "create template")
+	}
+
+	// check expressions.
+	for _, expr := range tpl.InstanceExpressions(tpl.Owner, tpl.ID) {
+		if _, err = expression.NewExpr(expr.Expression, nil); nil != err {
+			log.L().Error("create template, invalid expression", logf.ID(tpl.ID),
+				logf.Owner(tpl.Owner), logf.Expr(expr.Expression), logf.Error(err))
+			return nil, errors.Wrap(err, "create template")
+		}
+	}
+
+	tpl.CreatedAt = time.Now().UnixMilli()
+	if err = m.entityRepo.PutTemplate(ctx, tpl); nil != err {
+		return nil, errors.Wrap(err, "create template")
+	}
+
+	return tpl, nil
+}
+
+// GetTemplate returns the template version, returns the latest version if Version is 0.
+func (m *apiManager) GetTemplate(ctx context.Context, tpl *repository.Template) (*repository.Template, error) {
+	tpl, err := m.entityRepo.GetTemplate(ctx, tpl)
+	return tpl, errors.Wrap(err, "get template")
+}
+
+// ListTemplate returns versions of the template, or latest versions of all templates if ID is empty.
+func (m *apiManager) ListTemplate(ctx context.Context, req *repository.ListTemplateReq) ([]*repository.Template, error) {
+	tpls, err := m.entityRepo.ListTemplate(ctx, m.entityRepo.GetLastRevision(ctx), req)
+	if nil != err || req.ID != "" {
+		return tpls, errors.Wrap(err, "list template")
+	}
+
+	var latests []*repository.Template
+	for index, tpl := range tpls {
+		// versions of template are in ascending order.
+		if index+1 == len(tpls) || tpls[index+1].ID != tpl.ID {
+			latests = append(latests, tpl)
+		}
+	}
+	return latests, nil
+}
+
+func (m *apiManager) DeleteTemplate(ctx context.Context, tpl *repository.Template) error {
+	return errors.Wrap(m.entityRepo.DelTemplate(ctx, tpl), "delete template")
+}
+
+// ResolveTemplate returns the template version merged with its ancestors.
+func (m *apiManager) ResolveTemplate(ctx context.Context, tpl *repository.Template) (*repository.Template, error) {
+	tpl, err := m.entityRepo.GetTemplate(ctx, tpl)
+	if nil != err {
+		return nil, errors.Wrap(err, "resolve template")
+	}
+
+	tpl, err = m.resolveTemplate(ctx, tpl)
+	return tpl, errors.Wrap(err, "resolve template")
+}
+
+func (m *apiManager) resolveTemplate(ctx context.Context, tpl *repository.Template) (*repository.Template, error) {
+	chain := []*repository.Template{tpl}
+	visited := map[string]bool{tpl.ID: true}
+	for current := tpl; current.Extends != ""; {
+		if visited[current.Extends] || len(chain) > maxTemplateDepth {
+			return nil, errors.Wrapf(xerrors.ErrTemplateCycle, "template %s extends %s", current.ID, current.Extends)
+		}
+
+		parent, err := m.entityRepo.GetTemplate(ctx, &repository.Template{
+			ID: current.Extends, Owner: current.Owner, Version: current.ExtendsVersion})
+		if nil != err {
+			return nil, errors.Wrapf(err, "load parent template %s", current.Extends)
+		}
+
+		visited[parent.ID] = true
+		chain = append(chain, parent)
+		current = parent
+	}
+
+	resolved := chain[len(chain)-1]
+	for index := len(chain) - 2; index >= 0; index-- {
+		resolved = chain[index].Inherit(resolved)
+	}
+	return resolved, nil
+}
+
+// applyTemplate merge scheme and properties of the entity on top of the template,
+// returns nil if the template is not a template resource.
+func (m *apiManager) applyTemplate(ctx context.Context, en *Base) (*repository.Template, error) {
+	if en.TemplateID == "" {
+		return nil, nil
+	}
+
+	tpl, err := m.ResolveTemplate(ctx, &repository.Template{
+		ID: en.TemplateID, Owner: en.Owner, Version: en.TemplateVersion})
+	if nil != err {
+		if en.TemplateVersion == 0 && errors.Is(err, xerrors.ErrTemplateNotFound) {
+			// entity as template.
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "apply template")
+	}
+
+	var scheme, properties map[string]interface{}
+	if len(en.Scheme) > 0 {
+		if err = json.Unmarshal(en.Scheme, &scheme); nil != err {
+			return nil, errors.Wrap(err, "apply template, decode scheme")
+		}
+	}
+	if len(en.Properties) > 0 {
+		if err = json.Unmarshal(en.Properties, &properties); nil != err {
+			return nil, errors.Wrap(err, "apply template, decode properties")
+		}
+	}
+
+	scheme, properties = tpl.Instantiate(scheme, properties)
+	if en.Scheme, err = json.Marshal(scheme); nil != err {
+		return nil, errors.Wrap(err, "apply template, encode scheme")
+	} else if en.Properties, err = json.Marshal(properties); nil != err {
+		return nil, errors.Wrap(err, "apply template, encode properties")
+	}
+
+	en.TemplateVersion = tpl.Version
+	return tpl, nil
+}
+
+// indexInstance indexes the created entity as an instance of the template, and appends expressions inherited from the template.
+func (m *apiManager) indexInstance(ctx context.Context, owner, entityID string, tpl *repository.Template) error {
+	if err := m.entityRepo.PutTemplateInstance(ctx, owner, tpl.ID, entityID, tpl.Version); nil != err {
+		return errors.Wrap(err, "index template instance")
+	}
+
+	if len(tpl.Expressions) > 0 {
+		if err := m.AppendExpression(ctx, tpl.InstanceExpressions(owner, entityID)); nil != err {
+			return errors.Wrap(err, "append template expressions")
+		}
+	}
+	return nil
+}
+
+// UpdateEntityTemplate switch the template of the entity to the template version,
+// returns ErrTemplateNotFound if the template is not a template resource.
+func (m *apiManager) UpdateEntityTemplate(ctx context.Context, en *Base) error {
+	to, err := m.ResolveTemplate(ctx, &repository.Template{
+		ID: en.TemplateID, Owner: en.Owner, Version: en.TemplateVersion})
+	if nil != err {
+		return errors.Wrap(err, "update entity template")
+	}
+
+	current, err := m.GetEntity(ctx, en)
+	if nil != err {
+		return errors.Wrap(err, "update entity template")
+	}
+
+	var from *repository.Template
+	if current.TemplateID != "" && current.TemplateVersion > 0 {
+		if from, err = m.ResolveTemplate(ctx, &repository.Template{ID: current.TemplateID,
+			Owner: en.Owner, Version: current.TemplateVersion}); nil != err {
+			log.L().Warn("update entity template, resolve current template", logf.Eid(en.ID),
+				logf.Template(current.TemplateID), logf.Error(err))
+		}
+	}
+
+	en.TemplateVersion = to.Version
+	return errors.Wrap(m.migrateEntity(ctx, en, current, from, to), "update entity template")
+}
+
+// migrateEntity replace scheme of the entity with the template, fill default properties
+// absent from the entity, and replace expressions inherited from template from.
+func (m *apiManager) migrateEntity(ctx context.Context, en *Base, current *BaseRet, from, to *repository.Template) error {
+	scheme := to.Scheme
+	if scheme == nil {
+		scheme = map[string]interface{}{}
+	}
+	schemeBytes, err := json.Marshal(scheme)
+	if nil != err {
+		return errors.Wrap(err, "encode template scheme")
+	}
+
+	patches := []*v1.PatchData{{
+		Path:     fieldScheme,
+		Value:    schemeBytes,
+		Operator: xjson.OpReplace.String(),
+	}}
+
+	keys := make([]string, 0, len(to.Properties))
+	for key := range to.Properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if _, has := current.Properties[key]; has {
+			continue
+		}
+
+		var bytes []byte
+		if bytes, err = json.Marshal(to.Properties[key]); nil != err {
+			return errors.Wrap(err, "encode template property")
+		}
+		patches = append(patches, &v1.PatchData{
+			Path:     strings.Join([]string{fieldProperties, key}, "."),
+			Value:    bytes,
+			Operator: xjson.OpReplace.String(),
+		})
+	}
+
+	patches = append(patches,
+		&v1.PatchData{
+			Path:     fieldTemplate,
+			Value:    tdtl.NewString(to.ID).Raw(),
+			Operator: xjson.OpReplace.String(),
+		},
+		&v1.PatchData{
+			Path:     fieldTemplateVersion,
+			Value:    tdtl.NewInt64(to.Version).Raw(),
+			Operator: xjson.OpReplace.String(),
+		})
+
+	if _, _, err = m.PatchEntity(ctx, en, patches); nil != err {
+		return errors.Wrap(err, "patch entity")
+	}
+
+	// update template instance index.
+	if current.TemplateID != "" && current.TemplateID != to.ID && current.TemplateVersion > 0 {
+		if err = m.entityRepo.DelTemplateInstance(ctx, en.Owner, current.TemplateID, en.ID); nil != err {
+			log.L().Warn("remove template instance", logf.Eid(en.ID),
+				logf.Template(current.TemplateID), logf.Error(err))
+		}
+	}
+	if err = m.entityRepo.PutTemplateInstance(ctx, en.Owner, to.ID, en.ID, to.Version); nil != err {
+		return errors.Wrap(err, "index template instance")
+	}
+
+	// replace inherited expressions.
+	exprs := to.InstanceExpressions(en.Owner, en.ID)
+	if nil != from {
+		paths := make(map[string]bool)
+		for _, expr := range exprs {
+			paths[expr.Path] = true
+		}
+
+		var removed []repository.Expression
+		for _, expr := range from.InstanceExpressions(en.Owner, en.ID) {
+			if !paths[expr.Path] {
+				removed = append(removed, expr)
+			}
+		}
+		if err = m.RemoveExpression(ctx, removed); nil != err {
+			return errors.Wrap(err, "remove template expressions")
+		}
+	}
+
+	if len(exprs) > 0 {
+		return errors.Wrap(m.AppendExpression(ctx, exprs), "append template expressions")
+	}
+	return nil
+}
@@ -0,0 +1,244 @@
+package manager
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/util"
+	"github.com/tkeel-io/kit/log"
+)
+
+// rolloutSaveInterval is the interval of persisting rollout progress.
+const rolloutSaveInterval = time.Second
+
+// Start holds the manager lease, the lease holder resumes unfinished template rollouts,
+// and purges expired entities in trash, async requests and transaction decisions.
+func (m *apiManager) Start() error {
+	go m.holdLease()
+	go m.purgeTrash()
+	go m.purgeRequests()
+	go m.purgeTransactions()
+	return nil
+}
+
+// MigrateTemplate start a rollout which upgrades the instances pinned to template version FromVersion to ToVersion.
+func (m *apiManager) MigrateTemplate(ctx context.Context, req *MigrateTemplateReq) (*repository.TemplateRollout, error) {
+	if req.ToVersion == 0 {
+		req.ToVersion = req.FromVersion + 1
+	}
+	if req.FromVersion <= 0 || req.ToVersion <= req.FromVersion {
+		return nil, errors.Wrap(xerrors.ErrTemplateVersionInvalid, "migrate template")
+	}
+
+	// check template versions.
+	for _, version := range []int64{req.FromVersion, req.ToVersion} {
+		if _, err := m.ResolveTemplate(ctx, &repository.Template{
+			ID: req.ID, Owner: req.Owner, Version: version}); nil != err {
+			return nil, errors.Wrap(err, "migrate template")
+		}
+	}
+
+	instances, err := m.entityRepo.ListTemplateInstance(ctx, req.Owner, req.ID)
+	if nil != err {
+		return nil, errors.Wrap(err, "migrate template")
+	}
+
+	now := time.Now().UnixMilli()
+	rollout := &repository.TemplateRollout{
+		ID:          util.UUID("rollout"),
+		Owner:       req.Owner,
+		TemplateID:  req.ID,
+		FromVersion: req.FromVersion,
+		ToVersion:   req.ToVersion,
+		Status:      repository.RolloutStatusRunning,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	for _, version := range instances {
+		if version == req.FromVersion {
+			rollout.Total++
+		}
+	}
+
+	if err = m.entityRepo.PutTemplateRollout(ctx, rollout); nil != err {
+		return nil, errors.Wrap(err, "migrate template")
+	}
+
+	log.L().Info("start template rollout", logf.ID(rollout.ID), logf.Template(req.ID),
+		logf.Owner(req.Owner), logf.Version(req.ToVersion), logf.Count(int64(rollout.Total)))
+
+	// the lease holder runs the rollout, or resumes it on renewing the lease.
+	m.startRollout(rollout)
+	return rollout, nil
+}
+
+func (m *apiManager) GetTemplateRollout(ctx context.Context, rollout *repository.TemplateRollout) (*repository.TemplateRollout, error) {
+	rollout, err := m.entityRepo.GetTemplateRollout(ctx, rollout)
+	return rollout, errors.Wrap(err, "get template rollout")
+}
+
+func (m *apiManager) ListTemplateRollout(ctx context.Context, req *repository.ListTemplateRolloutReq) ([]*repository.TemplateRollout, error) {
+	rollouts, err := m.entityRepo.ListTemplateRollout(ctx, m.entityRepo.GetLastRevision(ctx), req)
+	return rollouts, errors.Wrap(err, "list template rollout")
+}
+
+// startRollout runs the rollout if the manager holds the manager lease, the rollout is interrupted once the lease is lost.
+func (m *apiManager) startRollout(rollout *repository.TemplateRollout) {
+	ctx, ok := m.leader()
+	if !ok {
+		return
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.rollouts[rollout.ID] {
+		return
+	}
+
+	log.L().Info("run template rollout", logf.ID(rollout.ID),
+		logf.Template(rollout.TemplateID), logf.Owner(rollout.Owner))
+	m.rollouts[rollout.ID] = true
+	go func() {
+		m.runRollout(ctx, rollout)
+		m.lock.Lock()
+		delete(m.rollouts, rollout.ID)
+		m.lock.Unlock()
+	}()
+}
+
+// runRollout migrate instances still pinned to FromVersion, migrating is idempotent,
+// so a rollout interrupted by restart continues from where it left off.
+func (m *apiManager) runRollout(ctx context.Context, rollout *repository.TemplateRollout) {
+	from, err := m.ResolveTemplate(ctx, &repository.Template{
+		ID: rollout.TemplateID, Owner: rollout.Owner, Version: rollout.FromVersion})
+	if nil == err {
+		var to *repository.Template
+		if to, err = m.ResolveTemplate(ctx, &repository.Template{
+			ID: rollout.TemplateID, Owner: rollout.Owner, Version: rollout.ToVersion}); nil == err {
+			err = m.rollout(ctx, rollout, from, to)
+		}
+	}
+
+	switch {
+	case nil != ctx.Err():
+		// the lease is lost, the rollout is resumed by the lease holder which owns the rollout now.
+		log.L().Info("template rollout interrupted", logf.ID(rollout.ID),
+			logf.Template(rollout.TemplateID), logf.Count(int64(rollout.Done)))
+		return
+	case nil == err:
+		rollout.Status = repository.RolloutStatusCompleted
+	default:
+		rollout.Status = repository.RolloutStatusFailed
+		rollout.Error = err.Error()
+	}
+
+	m.saveRollout(ctx, rollout)
+	log.L().Info("template rollout finished", logf.ID(rollout.ID), logf.Status(rollout.Status),
+		logf.Template(rollout.TemplateID), logf.Count(int64(rollout.Done)), logf.Error(err))
+}
+
+func (m *apiManager) rollout(ctx context.Context, rollout *repository.TemplateRollout, from, to *repository.Template) error {
+	instances, err := m.entityRepo.ListTemplateInstance(ctx, rollout.Owner, rollout.TemplateID)
+	if nil != err {
+		return errors.Wrap(err, "list template instances")
+	}
+
+	failed := make(map[string]bool)
+	for _, entityID := range rollout.FailedEntities {
+		failed[entityID] = true
+	}
+
+	var entityIDs []string
+	for entityID, version := range instances {
+		if version == rollout.FromVersion && !failed[entityID] {
+			entityIDs = append(entityIDs, entityID)
+		}
+	}
+	sort.Strings(entityIDs)
+
+	// instances migrated before restart.
+	if done := rollout.Total - len(rollout.FailedEntities) - rollout.Skipped - len(entityIDs); done > rollout.Done {
+		rollout.Done = done
+	}
+
+	var throttle <-chan time.Time
+	if rate := config.Get().Template.RolloutRate; rate > 0 {
+		ticker := time.NewTicker(time.Second / time.Duration(rate))
+		defer ticker.Stop()
+		throttle = ticker.C
+	}
+
+	lastSaved := time.Now()
+	for _, entityID := range entityIDs {
+		if nil != throttle {
+			select {
+			case <-ctx.Done():
+				return errors.Wrap(ctx.Err(), "template rollout")
+			case <-throttle:
+			}
+		} else if nil != ctx.Err() {
+			return errors.Wrap(ctx.Err(), "template rollout")
+		}
+
+		migrated, err := m.rolloutEntity(ctx, rollout, entityID, from, to)
+		switch {
+		case nil != err:
+			log.L().Error("template rollout, migrate entity", logf.ID(rollout.ID),
+				logf.Eid(entityID), logf.Template(rollout.TemplateID), logf.Error(err))
+			rollout.FailedEntities = append(rollout.FailedEntities, entityID)
+		case migrated:
+			rollout.Done++
+		default:
+			rollout.Skipped++
+		}
+
+		if time.Since(lastSaved) > rolloutSaveInterval {
+			lastSaved = time.Now()
+			m.saveRollout(ctx, rollout)
+		}
+	}
+
+	return nil
+}
+
+// rolloutEntity migrates the entity, returns false if the entity is not pinned to FromVersion.
+func (m *apiManager) rolloutEntity(ctx context.Context, rollout *repository.TemplateRollout, entityID string, from, to *repository.Template) (bool, error) {
+	en := &Base{ID: entityID, Owner: rollout.Owner, TemplateID: rollout.TemplateID}
+	current, err := m.GetEntity(ctx, en)
+	if nil != err {
+		return false, errors.Wrap(err, "load entity")
+	}
+
+	if current.TemplateID != rollout.TemplateID || current.TemplateVersion != rollout.FromVersion {
+		// stale index.
+		log.L().Warn("template rollout, instance not pinned to the version", logf.ID(rollout.ID),
+			logf.Eid(entityID), logf.Template(current.TemplateID), logf.Version(current.TemplateVersion))
+		if current.TemplateID != rollout.TemplateID {
+			return false, errors.Wrap(m.entityRepo.DelTemplateInstance(ctx,
+				rollout.Owner, rollout.TemplateID, entityID), "remove template instance")
+		}
+		return false, errors.Wrap(m.entityRepo.PutTemplateInstance(ctx, rollout.Owner,
+			rollout.TemplateID, entityID, current.TemplateVersion), "index template instance")
+	}
+
+	en.TemplateVersion = to.Version
+	return true, errors.Wrap(m.migrateEntity(ctx, en, current, from, to), "migrate entity")
+}
+
+// saveRollout saves the rollout with the context of the manager lease, writes are dropped once the lease is lost.
+func (m *apiManager) saveRollout(ctx context.Context, rollout *repository.TemplateRollout) {
+	if nil != ctx.Err() {
+		return
+	}
+	rollout.UpdatedAt = time.Now().UnixMilli()
+	if err := m.entityRepo.PutTemplateRollout(ctx, rollout); nil != err {
+		log.L().Error("save template rollout", logf.ID(rollout.ID),
+			logf.Template(rollout.TemplateID), logf.Error(err))
+	}
+}
@@ -0,0 +1,228 @@
+package manager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/manager/holder"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/types"
+)
+
+type templateRepo struct {
+	repository.IRepository
+	templates map[string][]*repository.Template
+	instances map[string]int64
+	rollouts  map[string]*repository.TemplateRollout
+	requests  map[string]*repository.Request
+	// holder of the manager lease.
+	leaseHolder string
+}
+
+func newTemplateRepo() *templateRepo {
+	return &templateRepo{
+		templates: map[string][]*repository.Template{},
+		instances: map[string]int64{},
+		rollouts:  map[string]*repository.TemplateRollout{},
+		requests:  map[string]*repository.Request{},
+	}
+}
+
+func (r *templateRepo) GetLastRevision(context.Context) int64 {
+	return 0
+}
+
+func (r *templateRepo) AcquireLease(_ context.Context, _, holder string, _ time.Duration) (bool, error) {
+	if r.leaseHolder == "" {
+		r.leaseHolder = holder
+	}
+	return r.leaseHolder == holder, nil
+}
+
+func (r *templateRepo) ListTemplateInstance(context.Context, string, string) (map[string]int64, error) {
+	return r.instances, nil
+}
+
+func (r *templateRepo) PutTemplateInstance(_ context.Context, _, _, entityID string, version int64) error {
+	r.instances[entityID] = version
+	return nil
+}
+
+func (r *templateRepo) PutRequest(_ context.Context, req *repository.Request) error {
+	cp := *req
+	r.requests[req.ID] = &cp
+	return nil
+}
+
+func (r *templateRepo) GetRequest(_ context.Context, reqID string) (*repository.Request, error) {
+	if req, ok := r.requests[reqID]; ok {
+		cp := *req
+		return &cp, nil
+	}
+	return nil, xerrors.ErrResourceNotFound
+}
+
+func (r *templateRepo) PutTemplateRollout(_ context.Context, rollout *repository.TemplateRollout) error {
+	cp := *rollout
+	r.rollouts[rollout.ID] = &cp
+	return nil
+}
+
+func (r *templateRepo) ListTemplateRollout(_ context.Context, _ int64, req *repository.ListTemplateRolloutReq) ([]*repository.TemplateRollout, error) {
+	var rollouts []*repository.TemplateRollout
+	for _, rollout := range r.rollouts {
+		if req.Running && rollout.Status != repository.RolloutStatusRunning {
+			continue
+		}
+		cp := *rollout
+		rollouts = append(rollouts, &cp)
+	}
+	return rollouts, nil
+}
+
+func (r *templateRepo) PutTemplate(_ context.Context, tpl *repository.Template) error {
+	r.templates[tpl.ID] = append(r.templates[tpl.ID], tpl)
+	return nil
+}
+
+func (r *templateRepo) GetTemplate(_ context.Context, tpl *repository.Template) (*repository.Template, error) {
+	versions := r.templates[tpl.ID]
+	if tpl.Version == 0 && len(versions) > 0 {
+		return versions[len(versions)-1], nil
+	}
+	for _, version := range versions {
+		if version.Version == tpl.Version {
+			return version, nil
+		}
+	}
+	return nil, xerrors.ErrTemplateNotFound
+}
+
+func TestAPIManager_ResolveTemplate(t *testing.T) {
+	ctx := context.Background()
+	m := &apiManager{entityRepo: &templateRepo{templates: map[string][]*repository.Template{}}}
+
+	tpl, err := m.CreateTemplate(ctx, &repository.Template{ID: "base", Owner: "admin",
+		Properties: map[string]interface{}{"status": "offline", "temp": 0.0}})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), tpl.Version)
+
+	_, err = m.CreateTemplate(ctx, &repository.Template{ID: "base", Owner: "admin",
+		Properties: map[string]interface{}{"status": "offline", "temp": 10.0}})
+	assert.Nil(t, err)
+
+	_, err = m.CreateTemplate(ctx, &repository.Template{ID: "sensor", Owner: "admin",
+		Extends: "base", ExtendsVersion: 1,
+		Expressions: []*repository.TemplateExpression{
+			{Path: "properties.temp_f", Expression: "$self.properties.temp * 1.8 + 32"}}})
+	assert.Nil(t, err)
+
+	_, err = m.CreateTemplate(ctx, &repository.Template{ID: "thermometer", Owner: "admin",
+		Extends: "sensor", Properties: map[string]interface{}{"unit": "C"}})
+	assert.Nil(t, err)
+
+	ret, err := m.ResolveTemplate(ctx, &repository.Template{ID: "thermometer", Owner: "admin"})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"status": "offline", "temp": 0.0, "unit": "C"}, ret.Properties)
+	assert.Len(t, ret.Expressions, 1)
+
+	// parent not found.
+	_, err = m.CreateTemplate(ctx, &repository.Template{ID: "meter", Owner: "admin", Extends: "unknown"})
+	assert.ErrorIs(t, err, xerrors.ErrTemplateNotFound)
+
+	// invalid expression.
+	_, err = m.CreateTemplate(ctx, &repository.Template{ID: "meter", Owner: "admin",
+		Expressions: []*repository.TemplateExpression{{Path: "properties.x", Expression: "$self.properties.temp +"}}})
+	assert.NotNil(t, err)
+
+	// inheritance cycle.
+	_, err = m.CreateTemplate(ctx, &repository.Template{ID: "base", Owner: "admin", Extends: "thermometer"})
+	assert.ErrorIs(t, err, xerrors.ErrTemplateCycle)
+}
+
+func TestAPIManager_AsyncCreateEntity(t *testing.T) {
+	ctx := context.Background()
+	repo, dispatcher := newTemplateRepo(), &eventRecorder{}
+	m := &apiManager{entityRepo: repo, ctx: ctx, dispatcher: dispatcher}
+	_, err := m.CreateTemplate(ctx, &repository.Template{ID: "base", Owner: "admin"})
+	assert.Nil(t, err)
+
+	ret, err := m.CreateEntity(ctx, &Base{ID: "device1", Owner: "admin", TemplateID: "base"}, NewAsyncOption(""))
+	assert.Nil(t, err)
+	assert.Len(t, dispatcher.events, 1)
+
+	// the instance is indexed once the entity is created.
+	assert.Empty(t, repo.instances)
+	m.OnRespond(ctx, &holder.Response{ID: ret.RequestID,
+		Status: types.StatusOK, Metadata: dispatcher.events[0].Attributes()})
+	assert.Equal(t, map[string]int64{"device1": 1}, repo.instances)
+	assert.Equal(t, repository.RequestStatusSucceeded, repo.requests[ret.RequestID].Status)
+}
+
+func TestAPIManager_MigrateTemplate(t *testing.T) {
+	ctx := context.Background()
+	repo := newTemplateRepo()
+	m := &apiManager{entityRepo: repo, ctx: ctx, rollouts: map[string]bool{}}
+	_, err := m.MigrateTemplate(ctx, &MigrateTemplateReq{ID: "base", Owner: "admin", FromVersion: 2, ToVersion: 1})
+	assert.ErrorIs(t, err, xerrors.ErrTemplateVersionInvalid)
+
+	_, err = m.MigrateTemplate(ctx, &MigrateTemplateReq{ID: "base", Owner: "admin", FromVersion: 1})
+	assert.ErrorIs(t, err, xerrors.ErrTemplateNotFound)
+
+	for index := 0; index < 2; index++ {
+		_, err = m.CreateTemplate(ctx, &repository.Template{ID: "base", Owner: "admin"})
+		assert.Nil(t, err)
+	}
+
+	// device1 migrated before restart, device2 failed.
+	repo.instances = map[string]int64{"device1": 2, "device2": 1}
+	rollout := &repository.TemplateRollout{ID: "rollout-1", Owner: "admin", TemplateID: "base",
+		FromVersion: 1, ToVersion: 2, Total: 2, FailedEntities: []string{"device2"},
+		Status: repository.RolloutStatusRunning}
+	assert.Nil(t, repo.PutTemplateRollout(ctx, rollout))
+	assert.Equal(t, 1, rollout.Pending())
+
+	m.runRollout(ctx, rollout)
+	assert.Equal(t, repository.RolloutStatusCompleted, repo.rollouts["rollout-1"].Status)
+	assert.Equal(t, 1, repo.rollouts["rollout-1"].Done)
+	assert.Equal(t, 0, repo.rollouts["rollout-1"].Pending())
+
+	// progress is not saved once the lease is lost, the lease holder resumes the rollout.
+	repo.instances = map[string]int64{"device3": 1}
+	rollout = &repository.TemplateRollout{ID: "rollout-2", Owner: "admin", TemplateID: "base",
+		FromVersion: 1, ToVersion: 2, Total: 1, Status: repository.RolloutStatusRunning}
+	assert.Nil(t, repo.PutTemplateRollout(ctx, rollout))
+	lost, cancel := context.WithCancel(ctx)
+	cancel()
+	m.runRollout(lost, rollout)
+	assert.Equal(t, repository.RolloutStatusRunning, repo.rollouts["rollout-2"].Status)
+	running, err := repo.ListTemplateRollout(ctx, 0, &repository.ListTemplateRolloutReq{Running: true})
+	assert.Nil(t, err)
+	assert.Len(t, running, 1)
+}
+
+func TestAPIManager_renewLease(t *testing.T) {
+	ctx := context.Background()
+	repo := newTemplateRepo()
+	m := &apiManager{entityRepo: repo, ctx: ctx, rollouts: map[string]bool{}, leaseHolder: "manager-1"}
+
+	leaderCtx, ok := m.renewLease()
+	assert.True(t, ok)
+	_, ok = m.leader()
+	assert.True(t, ok)
+
+	// rollouts run on the lease holder only, and are interrupted once the lease is lost.
+	repo.leaseHolder = "manager-2"
+	_, ok = m.renewLease()
+	assert.False(t, ok)
+	assert.NotNil(t, leaderCtx.Err())
+	m.startRollout(&repository.TemplateRollout{ID: "rollout-1", Status: repository.RolloutStatusRunning})
+	assert.Empty(t, m.rollouts)
+
+	// stale instances are skipped, not migrated.
+	rollout := &repository.TemplateRollout{Total: 4, Done: 1, Skipped: 1, FailedEntities: []string{"device2"}}
+	assert.Equal(t, 1, rollout.Pending())
+}
@@ -0,0 +1,254 @@
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/manager/holder"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/types"
+	"github.com/tkeel-io/core/pkg/util"
+	"github.com/tkeel-io/kit/log"
+)
+
+const (
+	bornTransact = "apis.TransactEntities"
+
+	defaultTxTimeout = 5 * time.Second
+
+	// txPurgeInterval is the interval of purging decisions of transactions.
+	txPurgeInterval = time.Minute
+)
+
+// transaction status.
+const (
+	TxCommitted = "committed"
+	TxAborted   = "aborted"
+	// TxUnknown is the status of committed transactions not acknowledged by all participants,
+	// participants apply the commit on redelivery or lock expiry.
+	TxUnknown = "unknown"
+)
+
+// TxEntity is an entity patched in a transaction.
+type TxEntity struct {
+	Base    *Base
+	Patches []*v1.PatchData
+}
+
+// TxResult is the outcome of a transaction.
+type TxResult struct {
+	ID     string
+	Status string
+	// FailedEntity is the entity which aborted the transaction, and Reason the error code.
+	FailedEntity string
+	Reason       string
+	// Entities are states of committed entities.
+	Entities []*BaseRet
+}
+
+func txTimeout(cfg config.TransactionConfig) time.Duration {
+	if cfg.Timeout <= 0 {
+		return defaultTxTimeout
+	}
+	return time.Duration(cfg.Timeout) * time.Millisecond
+}
+
+// TransactEntities applies patches to multiple entities atomically with two phases through the dispatcher:
+// runtimes owning the entities check and lock them on prepare, then the decision is recorded,
+// and all of them commit or abort. participants whose locks expire resolve the transaction
+// by the recorded decision, or abort it if it is not decided.
+func (m *apiManager) TransactEntities(ctx context.Context, txs []*TxEntity) (*TxResult, error) {
+	txID := util.IG().TxID()
+	elapsedTime := util.NewElapsed()
+	log.L().Info("entity.TransactEntities", logf.ID(txID), logf.Any("entities", len(txs)))
+
+	entities := make(map[string]bool)
+	for _, tx := range txs {
+		if entities[tx.Base.ID] {
+			return nil, errors.Wrap(xerrors.ErrTransactionInvalid, "duplicate entity "+tx.Base.ID)
+		}
+		entities[tx.Base.ID] = true
+		if err := m.checkQuota(ctx, tx.Base.Owner, false); nil != err {
+			return nil, errors.Wrap(err, "transact entities")
+		}
+	}
+	if len(entities) == 0 {
+		return nil, errors.Wrap(xerrors.ErrTransactionInvalid, "no entities")
+	}
+
+	// phase one, participants hold locks until phase two or lock timeout.
+	prepareCtx, cancel := context.WithTimeout(ctx, txTimeout(config.Get().Transaction))
+	defer cancel()
+
+	result := &TxResult{ID: txID, Status: TxCommitted}
+	for index, resp := range m.txRound(prepareCtx, txID, v1.TxPrepare, txs) {
+		if resp.Status != types.StatusOK {
+			result.Status = TxAborted
+			result.FailedEntity = txs[index].Base.ID
+			result.Reason = resp.ErrCode
+			if resp.Status == types.StatusCanceled {
+				result.Reason = xerrors.ErrTransactionTimeout.Error()
+			}
+			break
+		}
+	}
+
+	// the decision is recorded before phase two, even if the caller has gone.
+	decideCtx, cancelDecide := context.WithTimeout(m.ctx, txTimeout(config.Get().Transaction))
+	defer cancelDecide()
+	phase, err := m.decideTransaction(decideCtx, result, txs)
+	if nil != err {
+		// participants resolve the transaction on lock expiry.
+		log.L().Error("record transaction decision", logf.ID(txID), logf.Error(err))
+		return nil, errors.Wrap(err, "transact entities")
+	}
+
+	// phase two, the decision is delivered even if the caller has gone.
+	for index, resp := range m.txRound(decideCtx, txID, phase, txs) {
+		if resp.Status != types.StatusOK {
+			// participants apply the recorded decision on redelivery or lock expiry.
+			log.L().Error("transaction decision not acknowledged", logf.ID(txID),
+				logf.Eid(txs[index].Base.ID), logf.String("phase", string(phase)), logf.Reason(resp.ErrCode))
+			if phase == v1.TxCommit && result.Status == TxCommitted {
+				result.Status = TxUnknown
+				result.FailedEntity = txs[index].Base.ID
+				result.Reason = resp.ErrCode
+				if resp.Status == types.StatusCanceled {
+					result.Reason = xerrors.ErrTransactionTimeout.Error()
+				}
+			}
+			continue
+		}
+
+		if phase == v1.TxCommit {
+			var baseRet BaseRet
+			if err := json.Unmarshal(resp.Data, &baseRet); nil != err {
+				log.L().Error("transact entities, decode response", logf.ID(txID),
+					logf.Eid(txs[index].Base.ID), logf.Error(err))
+				continue
+			}
+			result.Entities = append(result.Entities, &baseRet)
+		}
+	}
+
+	log.L().Info("transaction completed", logf.ID(txID),
+		logf.Status(result.Status), logf.Elapsed(elapsedTime.Elapsed()))
+	return result, nil
+}
+
+// decideTransaction records the decision of the transaction by the outcome of phase one,
+// participants aborting the transaction on lock expiry may have decided it already.
+func (m *apiManager) decideTransaction(ctx context.Context, result *TxResult, txs []*TxEntity) (v1.TxPhase, error) {
+	decision := &repository.TxDecision{
+		ID:        result.ID,
+		Decision:  repository.TxDecisionCommit,
+		DecidedAt: time.Now().UnixMilli(),
+	}
+	if result.Status == TxAborted {
+		decision.Decision = repository.TxDecisionAbort
+	}
+	for _, tx := range txs {
+		decision.Entities = append(decision.Entities, tx.Base.ID)
+	}
+
+	decided, err := m.entityRepo.DecideTransaction(ctx, decision)
+	if nil != err {
+		return "", errors.Wrap(err, "decide transaction")
+	}
+
+	if decided.Decision == repository.TxDecisionCommit {
+		return v1.TxCommit, nil
+	} else if result.Status == TxCommitted {
+		result.Status = TxAborted
+		result.Reason = xerrors.ErrTransactionTimeout.Error()
+		if len(decided.Entities) > 0 {
+			result.FailedEntity = decided.Entities[0]
+		}
+	}
+
+	log.L().Warn("abort transaction", logf.ID(result.ID),
+		logf.Eid(result.FailedEntity), logf.Reason(result.Reason))
+	return v1.TxAbort, nil
+}
+
+// purgeTransactions purges decisions of transactions older than retention periodically on the lease holder.
+func (m *apiManager) purgeTransactions() {
+	ticker := time.NewTicker(txPurgeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-ticker.C:
+			if ctx, ok := m.leader(); ok {
+				m.purgeExpiredTransactions(ctx, config.Get().Transaction.Retention)
+			}
+		}
+	}
+}
+
+func (m *apiManager) purgeExpiredTransactions(ctx context.Context, retention int64) {
+	if retention <= 0 {
+		return
+	}
+
+	before := time.Now().Add(-time.Duration(retention) * time.Second).UnixMilli()
+	purged, err := m.entityRepo.PurgeTransactions(ctx, before)
+	if nil != err {
+		log.L().Error("purge expired transactions", logf.Count(int64(purged)), logf.Error(err))
+		return
+	} else if purged > 0 {
+		log.L().Info("purge expired transactions", logf.Count(int64(purged)))
+	}
+}
+
+// txRound dispatches the phase to all participants and waits for their responses.
+func (m *apiManager) txRound(ctx context.Context, txID string, phase v1.TxPhase, txs []*TxEntity) []holder.Response {
+	waiters := make([]*holder.Waiter, len(txs))
+	resps := make([]holder.Response, len(txs))
+	for index, tx := range txs {
+		reqID := util.IG().ReqID()
+		patches := tx.Patches
+		if phase == v1.TxAbort {
+			patches = nil
+		}
+
+		ev := &v1.ProtoEvent{
+			Id:        util.IG().EvID(),
+			Timestamp: time.Now().UnixNano(),
+			Callback:  m.callbackAddr(),
+			Metadata: map[string]string{
+				v1.MetaBorn:      bornTransact,
+				v1.MetaType:      enET,
+				v1.MetaEntityID:  tx.Base.ID,
+				v1.MetaRequestID: reqID,
+				v1.MetaTxID:      txID,
+				v1.MetaTxPhase:   string(phase),
+			},
+			Data: &v1.ProtoEvent_Patches{
+				Patches: &v1.PatchDatas{Patches: patches},
+			},
+		}
+
+		waiters[index] = m.holder.Wait(ctx, reqID)
+		if err := m.dispatcher.Dispatch(ctx, ev); nil != err {
+			waiters[index].Cancel()
+			waiters[index] = nil
+			log.L().Error("transact entities, dispatch event", logf.ID(txID),
+				logf.Eid(tx.Base.ID), logf.ReqID(reqID), logf.Error(err))
+			resps[index] = holder.Response{ID: reqID, Status: types.StatusError, ErrCode: err.Error()}
+		}
+	}
+
+	for index, waiter := range waiters {
+		if waiter != nil {
+			resps[index] = waiter.Wait()
+		}
+	}
+	return resps
+}
@@ -0,0 +1,132 @@
+package manager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/manager/holder"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/runtime/mock"
+	"github.com/tkeel-io/core/pkg/types"
+)
+
+// participantDispatcher responds events as runtimes, prepares of the failed entity fail,
+// and commits of the unacked entity are not acknowledged.
+type participantDispatcher struct {
+	eventRecorder
+	m       *apiManager
+	failed  string
+	unacked string
+}
+
+func (d *participantDispatcher) Dispatch(ctx context.Context, ev v1.Event) error {
+	d.eventRecorder.Dispatch(ctx, ev)
+	resp := &holder.Response{ID: ev.Attr(v1.MetaRequestID), Status: types.StatusOK,
+		Metadata: ev.Attributes(), Data: []byte(`{"id":"` + ev.Entity() + `"}`)}
+	if ev.Entity() == d.failed && ev.Attr(v1.MetaTxPhase) == string(v1.TxPrepare) {
+		resp.Status, resp.ErrCode = types.StatusError, xerrors.ErrPatchTestFailed.Error()
+	} else if ev.Entity() == d.unacked && ev.Attr(v1.MetaTxPhase) == string(v1.TxCommit) {
+		resp.Status, resp.ErrCode = types.StatusError, xerrors.ErrEntityNotFound.Error()
+	}
+	go d.m.OnRespond(ctx, resp)
+	return nil
+}
+
+// abortingDispatcher aborts transactions as participants whose locks expire on prepare.
+type abortingDispatcher struct {
+	*participantDispatcher
+	repo *decisionRepo
+}
+
+func (d *abortingDispatcher) Dispatch(ctx context.Context, ev v1.Event) error {
+	if ev.Attr(v1.MetaTxPhase) == string(v1.TxPrepare) {
+		d.repo.DecideTransaction(ctx, &repository.TxDecision{ID: ev.Attr(v1.MetaTxID),
+			Decision: repository.TxDecisionAbort, Entities: []string{ev.Entity()}})
+	}
+	return d.participantDispatcher.Dispatch(ctx, ev)
+}
+
+func (d *participantDispatcher) phases() (phases []string) {
+	for _, ev := range d.events {
+		phases = append(phases, ev.Entity()+":"+ev.Attr(v1.MetaTxPhase))
+	}
+	return phases
+}
+
+// decisionRepo records decisions of transactions once.
+type decisionRepo struct {
+	repository.IRepository
+	decisions map[string]*repository.TxDecision
+}
+
+func (r *decisionRepo) DecideTransaction(_ context.Context, decision *repository.TxDecision) (*repository.TxDecision, error) {
+	if decided, ok := r.decisions[decision.ID]; ok {
+		return decided, nil
+	}
+	r.decisions[decision.ID] = decision
+	return decision, nil
+}
+
+func TestAPIManager_TransactEntities(t *testing.T) {
+	ctx := context.Background()
+	dispatcher := &participantDispatcher{}
+	repo := &decisionRepo{IRepository: mock.NewRepo(), decisions: make(map[string]*repository.TxDecision)}
+	m := &apiManager{
+		ctx:        ctx,
+		entityRepo: repo,
+		dispatcher: dispatcher,
+		holder:     holder.New(ctx, time.Second),
+	}
+	dispatcher.m = m
+
+	patches := []*v1.PatchData{{Path: "properties.group", Operator: "replace", Value: []byte(`"g2"`)}}
+	txs := []*TxEntity{
+		{Base: &Base{ID: "device1", Owner: "admin"}, Patches: patches},
+		{Base: &Base{ID: "device2", Owner: "admin"}, Patches: patches},
+	}
+
+	result, err := m.TransactEntities(ctx, txs)
+	assert.Nil(t, err)
+	assert.Equal(t, TxCommitted, result.Status)
+	assert.Len(t, result.Entities, 2)
+	assert.Equal(t, []string{"device1:prepare", "device2:prepare", "device1:commit", "device2:commit"}, dispatcher.phases())
+	assert.Equal(t, dispatcher.events[0].Attr(v1.MetaTxID), dispatcher.events[3].Attr(v1.MetaTxID))
+	assert.Equal(t, repository.TxDecisionCommit, repo.decisions[result.ID].Decision)
+	assert.Equal(t, []string{"device1", "device2"}, repo.decisions[result.ID].Entities)
+
+	// commits not acknowledged leave the outcome unknown.
+	dispatcher.events, dispatcher.unacked = nil, "device2"
+	result, err = m.TransactEntities(ctx, txs)
+	assert.Nil(t, err)
+	assert.Equal(t, TxUnknown, result.Status)
+	assert.Equal(t, "device2", result.FailedEntity)
+	assert.Len(t, result.Entities, 1)
+	dispatcher.unacked = ""
+
+	// a failed participant aborts all.
+	dispatcher.events, dispatcher.failed = nil, "device2"
+	result, err = m.TransactEntities(ctx, txs)
+	assert.Nil(t, err)
+	assert.Equal(t, TxAborted, result.Status)
+	assert.Equal(t, "device2", result.FailedEntity)
+	assert.Equal(t, xerrors.ErrPatchTestFailed.Error(), result.Reason)
+	assert.Empty(t, result.Entities)
+	assert.Equal(t, []string{"device1:prepare", "device2:prepare", "device1:abort", "device2:abort"}, dispatcher.phases())
+
+	// transactions aborted by participants on lock expiry are aborted.
+	dispatcher.events, dispatcher.failed = nil, ""
+	m.dispatcher = &abortingDispatcher{participantDispatcher: dispatcher, repo: repo}
+	result, err = m.TransactEntities(ctx, txs)
+	assert.Nil(t, err)
+	assert.Equal(t, TxAborted, result.Status)
+	assert.Equal(t, "device1", result.FailedEntity)
+	assert.Equal(t, xerrors.ErrTransactionTimeout.Error(), result.Reason)
+	assert.Equal(t, []string{"device1:prepare", "device2:prepare", "device1:abort", "device2:abort"}, dispatcher.phases())
+
+	_, err = m.TransactEntities(ctx, append(txs, txs[0]))
+	assert.ErrorIs(t, err, xerrors.ErrTransactionInvalid)
+}
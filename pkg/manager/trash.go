@@ -0,0 +1,262 @@
+package manager
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/manager/holder"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/types"
+	"github.com/tkeel-io/core/pkg/util"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+)
+
+const (
+	bornRestore = "apis.RestoreEntity"
+	bornPurge   = "apis.PurgeEntity"
+
+	fieldDeletedAt = "deleted_at"
+
+	// trashPurgeInterval is the interval of purging expired entities in trash.
+	trashPurgeInterval = time.Minute
+)
+
+// trashRecord makes the trash record of the entity from the entity state held by the runtime,
+// which includes changes not flushed to the state store yet.
+func (m *apiManager) trashRecord(ctx context.Context, en *Base) (*repository.TrashEntity, error) {
+	trash := &repository.TrashEntity{ID: en.ID, Owner: en.Owner, Type: en.Type, Source: en.Source}
+	bytes, err := m.loadEntity(ctx, util.IG().ReqID(), en.ID)
+	if nil != err {
+		// runtimes hide entities in trash, tell them from entities not exist by the state store.
+		if bytes, err = m.entityRepo.GetEntity(ctx, en.ID); nil == err {
+			if deletedAt := tdtl.New(bytes).Get(fieldDeletedAt).String(); deletedAt != "" && deletedAt != "0" {
+				return nil, errors.Wrap(xerrors.ErrEntityNotFound, "entity in trash")
+			}
+		}
+		// entity not exists, nothing to keep.
+		return trash, nil
+	}
+
+	state := tdtl.New(bytes)
+	if owner := state.Get("owner").String(); owner != "" {
+		trash.Owner = owner
+	}
+	if typ := state.Get("type").String(); typ != "" {
+		trash.Type = typ
+	}
+	if source := state.Get("source").String(); source != "" {
+		trash.Source = source
+	}
+	if version, _ := strconv.ParseInt(state.Get(fieldTemplateVersion).String(), 10, 64); version > 0 {
+		trash.TemplateID = state.Get(fieldTemplate).String()
+		trash.TemplateVersion = version
+	}
+	return trash, nil
+}
+
+// suspendEntity keeps the trash record of the deleted entity, and suspends expressions and subscriptions of the entity.
+func (m *apiManager) suspendEntity(ctx context.Context, trash *repository.TrashEntity) {
+	rev := m.entityRepo.GetLastRevision(ctx)
+	exprs, err := m.entityRepo.ListExpression(ctx, rev,
+		&repository.ListExprReq{Owner: trash.Owner, EntityID: trash.ID})
+	if nil != err {
+		log.L().Warn("suspend entity, list expression", logf.Eid(trash.ID), logf.Error(err))
+	}
+
+	subs, err := m.entityRepo.ListSubscription(ctx, rev,
+		&repository.ListSubscriptionReq{Owner: trash.Owner, EntityID: trash.ID})
+	if nil != err {
+		log.L().Warn("suspend entity, list subscription", logf.Eid(trash.ID), logf.Error(err))
+	}
+
+	trash.Expressions = exprs
+	for _, sub := range subs {
+		if sub.Owner == trash.Owner && sub.SourceEntityID == trash.ID {
+			trash.Subscriptions = append(trash.Subscriptions, sub)
+		}
+	}
+
+	trash.DeletedAt = time.Now().UnixNano() / int64(time.Millisecond)
+	if err = m.entityRepo.PutTrash(ctx, trash); nil != err {
+		// keep expressions and subscriptions working rather than lose them.
+		log.L().Error("suspend entity, put trash", logf.Eid(trash.ID), logf.Owner(trash.Owner), logf.Error(err))
+		return
+	}
+
+	for _, expr := range trash.Expressions {
+		if err = m.entityRepo.DelExpression(ctx, *expr); nil != err {
+			log.L().Warn("suspend entity, remove expression", logf.Eid(trash.ID),
+				logf.Path(expr.Path), logf.Expr(expr.Expression), logf.Error(err))
+		}
+	}
+	for _, sub := range trash.Subscriptions {
+		if err = m.entityRepo.DelSubscription(ctx, sub); nil != err {
+			log.L().Warn("suspend entity, remove subscription", logf.Eid(trash.ID),
+				logf.ID(sub.ID), logf.Error(err))
+		}
+	}
+}
+
+// RestoreEntity restores an entity from trash, with its expressions and subscriptions.
+func (m *apiManager) RestoreEntity(ctx context.Context, en *Base) (*BaseRet, error) {
+	reqID := util.IG().ReqID()
+	elapsedTime := util.NewElapsed()
+	log.L().Info("entity.RestoreEntity", logf.Eid(en.ID), logf.ReqID(reqID), logf.Owner(en.Owner))
+
+	trash, err := m.entityRepo.GetTrash(ctx, &repository.TrashEntity{ID: en.ID, Owner: en.Owner})
+	if nil != err {
+		log.L().Error("restore entity, get trash", logf.Eid(en.ID), logf.ReqID(reqID), logf.Error(err))
+		return nil, errors.Wrap(xerrors.ErrEntityNotInTrash, "restore entity")
+	}
+
+	resp, err := m.dispatchSystem(ctx, reqID, bornRestore, en.ID, v1.OpRestore, nil)
+	if nil != err {
+		return nil, errors.Wrap(err, "restore entity")
+	}
+
+	var baseRet BaseRet
+	if err = json.Unmarshal(resp.Data, &baseRet); nil != err {
+		log.L().Error("restore entity, decode response", logf.ReqID(reqID),
+			logf.Error(err), logf.Eid(en.ID))
+		return nil, errors.Wrap(err, "restore entity, decode response")
+	}
+
+	// resume expressions and subscriptions.
+	for _, expr := range trash.Expressions {
+		if err = m.entityRepo.PutExpression(ctx, *expr); nil != err {
+			log.L().Error("restore entity, resume expression", logf.Eid(en.ID),
+				logf.Path(expr.Path), logf.Expr(expr.Expression), logf.Error(err))
+		}
+	}
+	for _, sub := range trash.Subscriptions {
+		if err = m.entityRepo.PutSubscription(ctx, sub); nil != err {
+			log.L().Error("restore entity, resume subscription", logf.Eid(en.ID),
+				logf.ID(sub.ID), logf.Error(err))
+		}
+	}
+
+	if trash.TemplateID != "" {
+		if err = m.entityRepo.PutTemplateInstance(ctx, trash.Owner,
+			trash.TemplateID, en.ID, trash.TemplateVersion); nil != err {
+			log.L().Warn("restore entity, index template instance", logf.Eid(en.ID),
+				logf.Template(trash.TemplateID), logf.Error(err))
+		}
+	}
+
+	if err = m.entityRepo.DelTrash(ctx, trash); nil != err {
+		log.L().Warn("restore entity, remove trash", logf.Eid(en.ID), logf.Error(err))
+	}
+
+	log.L().Info("processing completed", logf.Eid(en.ID),
+		logf.ReqID(reqID), logf.Elapsed(elapsedTime.Elapsed()))
+	return &baseRet, nil
+}
+
+// PurgeEntity removes an entity in trash permanently.
+func (m *apiManager) PurgeEntity(ctx context.Context, en *Base) error {
+	reqID := util.IG().ReqID()
+	elapsedTime := util.NewElapsed()
+	log.L().Info("entity.PurgeEntity", logf.Eid(en.ID), logf.ReqID(reqID), logf.Owner(en.Owner))
+
+	trash, err := m.entityRepo.GetTrash(ctx, &repository.TrashEntity{ID: en.ID, Owner: en.Owner})
+	if nil != err {
+		log.L().Error("purge entity, get trash", logf.Eid(en.ID), logf.ReqID(reqID), logf.Error(err))
+		return errors.Wrap(xerrors.ErrEntityNotInTrash, "purge entity")
+	}
+
+	// entities restored or purged already leave the trash record only.
+	if _, err = m.dispatchSystem(ctx, reqID, bornPurge, en.ID, v1.OpPurge, nil); nil != err &&
+		err.Error() != xerrors.ErrEntityNotInTrash.Error() {
+		return errors.Wrap(err, "purge entity")
+	}
+
+	if err = m.entityRepo.DelTrash(ctx, trash); nil != err {
+		log.L().Error("purge entity, remove trash", logf.Eid(en.ID), logf.ReqID(reqID), logf.Error(err))
+		return errors.Wrap(err, "purge entity")
+	}
+
+	log.L().Info("processing completed", logf.Eid(en.ID),
+		logf.ReqID(reqID), logf.Elapsed(elapsedTime.Elapsed()))
+	return nil
+}
+
+// dispatchSystem dispatches a system event of the entity and waits for the response.
+func (m *apiManager) dispatchSystem(ctx context.Context, reqID, born, entityID string, op v1.SystemOp, data []byte) (*holder.Response, error) {
+	respWaiter := m.holder.Wait(ctx, reqID)
+	if err := m.dispatcher.Dispatch(ctx, &v1.ProtoEvent{
+		Id:        util.IG().EvID(),
+		Timestamp: time.Now().UnixNano(),
+		Callback:  m.callbackAddr(),
+		Metadata: map[string]string{
+			v1.MetaBorn:      born,
+			v1.MetaType:      sysET,
+			v1.MetaRequestID: reqID,
+			v1.MetaEntityID:  entityID,
+		},
+		Data: &v1.ProtoEvent_SystemData{
+			SystemData: &v1.SystemData{
+				Operator: string(op),
+				Data:     data,
+			},
+		},
+	}); nil != err {
+		respWaiter.Cancel()
+		log.L().Error("dispatch system event", logf.Eid(entityID),
+			logf.ReqID(reqID), logf.String("op", string(op)), logf.Error(err))
+		return nil, errors.Wrap(err, "dispatch event")
+	}
+
+	resp := respWaiter.Wait()
+	if resp.Status != types.StatusOK {
+		log.L().Error("system event", logf.Eid(entityID), logf.ReqID(reqID),
+			logf.String("op", string(op)), logf.Error(xerrors.New(resp.ErrCode)))
+		return nil, xerrors.New(resp.ErrCode)
+	}
+	return &resp, nil
+}
+
+// purgeTrash purges entities in trash longer than retention periodically on the lease holder.
+func (m *apiManager) purgeTrash() {
+	ticker := time.NewTicker(trashPurgeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-ticker.C:
+			if ctx, ok := m.leader(); ok {
+				m.purgeExpired(ctx, config.Get().Trash.Retention)
+			}
+		}
+	}
+}
+
+func (m *apiManager) purgeExpired(ctx context.Context, retention int64) {
+	if retention <= 0 {
+		return
+	}
+
+	trashes, err := m.entityRepo.ListTrash(ctx,
+		m.entityRepo.GetLastRevision(ctx), &repository.ListTrashReq{})
+	if nil != err {
+		log.L().Error("purge trash, list trash", logf.Error(err))
+		return
+	}
+
+	expiredAt := time.Now().Add(-time.Duration(retention)*time.Second).UnixNano() / int64(time.Millisecond)
+	for _, trash := range trashes {
+		if trash.DeletedAt > expiredAt {
+			continue
+		}
+		if err = m.PurgeEntity(ctx, &Base{ID: trash.ID, Owner: trash.Owner}); nil != err {
+			log.L().Warn("purge trash", logf.Eid(trash.ID), logf.Owner(trash.Owner), logf.Error(err))
+		}
+	}
+}
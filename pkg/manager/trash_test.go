@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/manager/holder"
+	"github.com/tkeel-io/core/pkg/runtime/mock"
+)
+
+func TestAPIManager_trashRecord(t *testing.T) {
+	ctx := context.Background()
+	repo := mock.NewRepo()
+	err := repo.PutEntity(ctx, "device123",
+		[]byte(`{"id":"device123","owner":"admin","type":"DEVICE","source":"dm"}`))
+	assert.Nil(t, err)
+
+	// the record is made from the state held by the runtime, not the stale state store.
+	dispatcher := &stateDispatcher{states: map[string][]byte{
+		"device123": []byte(`{"id":"device123","owner":"admin","type":"DEVICE","source":"dm",` +
+			`"template_id":"tpl1","template_version":2}`),
+	}}
+	m := &apiManager{
+		ctx:        ctx,
+		entityRepo: repo,
+		dispatcher: dispatcher,
+		holder:     holder.New(ctx, time.Second),
+	}
+	dispatcher.m = m
+	trash, err := m.trashRecord(ctx, &Base{ID: "device123", Owner: "admin"})
+	assert.Nil(t, err)
+	assert.Equal(t, "DEVICE", trash.Type)
+	assert.Equal(t, "tpl1", trash.TemplateID)
+	assert.Equal(t, int64(2), trash.TemplateVersion)
+
+	// entity not exists.
+	trash, err = m.trashRecord(ctx, &Base{ID: "device234", Owner: "admin", Type: "DEVICE"})
+	assert.Nil(t, err)
+	assert.Equal(t, "DEVICE", trash.Type)
+	assert.Equal(t, "", trash.TemplateID)
+
+	// entities in trash are hidden by runtimes.
+	err = repo.PutEntity(ctx, "device345", []byte(`{"id":"device345","deleted_at":1650000000000}`))
+	assert.Nil(t, err)
+	_, err = m.trashRecord(ctx, &Base{ID: "device345", Owner: "admin"})
+	assert.ErrorIs(t, err, xerrors.ErrEntityNotFound)
+}
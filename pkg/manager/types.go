@@ -24,6 +24,7 @@ import (
 	"github.com/tkeel-io/core/pkg/manager/holder"
 	"github.com/tkeel-io/core/pkg/mapper"
 	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/tdtl"
 )
 
 const CoreAPISender = "core.api"
@@ -37,16 +38,28 @@ var (
 )
 
 type APIManager interface {
+	// Start resume background jobs.
+	Start() error
 	// OnRespond handle message.
 	OnRespond(context.Context, *holder.Response)
 	// CreateEntity create entity.
-	CreateEntity(context.Context, *Base) (*BaseRet, error)
+	CreateEntity(context.Context, *Base, ...Option) (*BaseRet, error)
 	// UpdateEntity update entity.
 	PatchEntity(context.Context, *Base, []*v1.PatchData, ...Option) (*BaseRet, []byte, error)
+	// TransactEntities patches multiple entities atomically.
+	TransactEntities(context.Context, []*TxEntity) (*TxResult, error)
 	// DeleteEntity delete entity.
 	DeleteEntity(context.Context, *Base) error
+	// RestoreEntity restores entity from trash.
+	RestoreEntity(context.Context, *Base) (*BaseRet, error)
+	// PurgeEntity removes entity in trash permanently.
+	PurgeEntity(context.Context, *Base) error
+	// ImportEntity replaces the state of entity, restored from backups.
+	ImportEntity(ctx context.Context, id string, state []byte) error
 	// GetProperties returns entity properties.
 	GetEntity(context.Context, *Base) (*BaseRet, error)
+	// GetRequestStatus returns status of async write request.
+	GetRequestStatus(context.Context, string) (*repository.Request, error)
 	// AppendMapper append entity mapper.
 	AppendMapper(context.Context, *mapper.Mapper) error
 	AppendMapperZ(context.Context, *mapper.Mapper) error
@@ -56,11 +69,50 @@ type APIManager interface {
 	RemoveExpression(context.Context, []repository.Expression) error
 	GetExpression(context.Context, repository.Expression) (*repository.Expression, error)
 	ListExpression(context.Context, *Base) ([]*repository.Expression, error)
+	EvaluateExpression(context.Context, repository.Expression) (*ExprEvalResult, error)
 
 	// Subscription.
 	CreateSubscription(context.Context, *repository.Subscription) error
 	DeleteSubscription(context.Context, *repository.Subscription) error
 	GetSubscription(context.Context, *repository.Subscription) (*repository.Subscription, error)
+
+	// Alarm.
+	CreateAlarmRule(context.Context, *repository.AlarmRule) error
+	UpdateAlarmRule(context.Context, *repository.AlarmRule) error
+	DeleteAlarmRule(context.Context, *repository.AlarmRule) error
+	GetAlarmRule(context.Context, *repository.AlarmRule) (*repository.AlarmRule, error)
+	ListAlarmRule(context.Context, *repository.ListAlarmRuleReq) ([]*repository.AlarmRule, error)
+	ListAlarm(context.Context, *repository.ListAlarmRuleReq) ([]*repository.Alarm, error)
+	AckAlarm(ctx context.Context, rule *repository.AlarmRule, operator string) (*repository.Alarm, error)
+	ListAlarmHistory(context.Context, *repository.AlarmRule) ([]*repository.AlarmRecord, error)
+
+	// Template.
+	CreateTemplate(context.Context, *repository.Template) (*repository.Template, error)
+	GetTemplate(context.Context, *repository.Template) (*repository.Template, error)
+	ListTemplate(context.Context, *repository.ListTemplateReq) ([]*repository.Template, error)
+	DeleteTemplate(context.Context, *repository.Template) error
+	ResolveTemplate(context.Context, *repository.Template) (*repository.Template, error)
+	UpdateEntityTemplate(context.Context, *Base) error
+	MigrateTemplate(context.Context, *MigrateTemplateReq) (*repository.TemplateRollout, error)
+	GetTemplateRollout(context.Context, *repository.TemplateRollout) (*repository.TemplateRollout, error)
+	ListTemplateRollout(context.Context, *repository.ListTemplateRolloutReq) ([]*repository.TemplateRollout, error)
+
+	// Schema.
+	CreateSchema(context.Context, *repository.Schema) (*repository.Schema, error)
+	UpdateSchema(context.Context, *repository.Schema) (*repository.Schema, error)
+	GetSchema(context.Context, *repository.Schema) (*repository.Schema, error)
+	ListSchema(context.Context, *repository.ListSchemaReq) ([]*repository.Schema, error)
+	DeleteSchema(context.Context, *repository.Schema) error
+	UpdateEntitySchema(context.Context, *Base) error
+}
+
+// ExprEvalResult is the result of an expression dry-run.
+type ExprEvalResult struct {
+	// Expression is the preprocessed expression.
+	Expression string
+	Value      tdtl.Node
+	Inputs     map[string]tdtl.Node
+	Err        error
 }
 
 type Metadata map[string]string
@@ -72,3 +124,11 @@ func NewPathConstructorOption(pc v1.PathConstructor) Option {
 		meta[v1.MetaPathConstructor] = string(pc)
 	}
 }
+
+// NewIdempotencyOption makes duplicates of the write with the same key
+// respond the current state of the entity instead of re-executing.
+func NewIdempotencyOption(key string) Option {
+	return func(meta Metadata) {
+		meta[v1.MetaIdempotencyKey] = key
+	}
+}
@@ -0,0 +1,38 @@
+package expression
+
+import (
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository"
+)
+
+// AlarmSources validates the conditions of the alarm rule and returns the property paths they depend on,
+// conditions may only reference properties of the rule entity.
+func AlarmSources(rule *repository.AlarmRule) ([]string, error) {
+	if rule.EntityID == "" || rule.Condition == "" {
+		return nil, errors.Wrap(xerrors.ErrAlarmRuleInvalid, "empty entity or condition")
+	} else if !repository.ValidSeverity(rule.Severity) {
+		return nil, errors.Wrapf(xerrors.ErrAlarmRuleInvalid, "invalid severity %s", rule.Severity)
+	}
+
+	var paths []string
+	for _, condition := range []string{rule.Condition, rule.ClearCondition} {
+		if condition == "" {
+			continue
+		}
+
+		exprIns, err := NewExpr(condition, nil)
+		if nil != err {
+			return nil, errors.Wrap(xerrors.ErrAlarmRuleInvalid, err.Error())
+		}
+
+		for entityID, sources := range exprIns.Sources() {
+			if entityID != rule.EntityID {
+				return nil, errors.Wrapf(xerrors.ErrAlarmRuleInvalid, "reference entity %s", entityID)
+			}
+			paths = append(paths, sources...)
+		}
+	}
+
+	return paths, nil
+}
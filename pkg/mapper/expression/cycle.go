@@ -0,0 +1,86 @@
+package expression
+
+import (
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/util/path"
+)
+
+// exprNode is an eval expression in the expression graph, writes the target from sources.
+type exprNode struct {
+	id      string
+	target  string
+	sources []string
+}
+
+func newExprNode(expr *repository.Expression) (*exprNode, error) {
+	exprIns, err := NewExpr(expr.Expression, nil)
+	if nil != err {
+		return nil, errors.Wrap(err, "parse expression")
+	}
+
+	node := &exprNode{id: expr.ID, target: path.FmtWatchKey(expr.EntityID, expr.Path)}
+	for _, paths := range exprIns.Sources() {
+		node.sources = append(node.sources, paths...)
+	}
+	return node, nil
+}
+
+func (n *exprNode) reads(target string) bool {
+	for _, source := range n.sources {
+		if path.Overlap(source, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// DetectCycle returns the expression chain which makes expr cyclic with exprs, the result is empty if acyclic.
+// expressions of exprs with the same id as expr are replaced by expr, only eval expressions write their targets.
+func DetectCycle(expr repository.Expression, exprs []*repository.Expression) ([]string, error) {
+	if repository.ExprTypeEval != expr.Type {
+		return nil, nil
+	}
+
+	node, err := newExprNode(&expr)
+	if nil != err || len(node.sources) == 0 {
+		return nil, err
+	}
+
+	var nodes []*exprNode
+	for _, item := range exprs {
+		if item.ID == expr.ID || repository.ExprTypeEval != item.Type {
+			continue
+		}
+		other, err := newExprNode(item)
+		if nil != err {
+			return nil, errors.Wrapf(err, "expression %s", item.ID)
+		}
+		nodes = append(nodes, other)
+	}
+
+	type step struct {
+		target string
+		chain  []string
+	}
+
+	visited := make([]bool, len(nodes))
+	queue := []step{{target: node.target, chain: []string{node.id}}}
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+		if node.reads(curr.target) {
+			return curr.chain, nil
+		}
+
+		for index, other := range nodes {
+			if !visited[index] && other.reads(curr.target) {
+				visited[index] = true
+				chain := append(append([]string{}, curr.chain...), other.id)
+				queue = append(queue, step{target: other.target, chain: chain})
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -2,8 +2,10 @@ package expression
 
 import (
 	"context"
+	"sync"
 
 	"github.com/pkg/errors"
+	"github.com/tkeel-io/core/pkg/mapper/function"
 	"github.com/tkeel-io/core/pkg/repository"
 	"github.com/tkeel-io/tdtl"
 )
@@ -22,16 +24,61 @@ func Validate(expr repository.Expression) error {
 }
 
 type Expr struct {
+	lock    sync.Mutex
+	ctx     context.Context
+	err     error
 	exprIns tdtl.Expression
 }
 
+// NewExpr returns expression evaler, registered functions without context are used if extFuncs is nil.
 func NewExpr(expression string, extFuncs map[string]tdtl.ContextFunc) (IExpression, error) {
-	exprIns, err := tdtl.NewExpr(expression, extFuncs)
-	return &Expr{exprIns: exprIns}, errors.Wrap(err, "new expression evaler")
+	if nil == extFuncs {
+		extFuncs = function.Funcs(nil)
+	}
+
+	var err error
+	expr := &Expr{}
+	expr.exprIns, err = tdtl.NewExpr(expression, expr.guard(extFuncs))
+	return expr, errors.Wrap(err, "new expression evaler")
+}
+
+// guard wraps extension functions, which are skipped once the evaluation context is done.
+func (e *Expr) guard(extFuncs map[string]tdtl.ContextFunc) map[string]tdtl.ContextFunc {
+	guarded := make(map[string]tdtl.ContextFunc, len(extFuncs))
+	for name, fn := range extFuncs {
+		fn := fn
+		guarded[name] = func(args ...tdtl.Node) tdtl.Node {
+			if nil != e.err {
+				return tdtl.UNDEFINED_RESULT
+			} else if nil != e.ctx {
+				if e.err = e.ctx.Err(); nil != e.err {
+					return tdtl.UNDEFINED_RESULT
+				}
+			}
+			return fn(args...)
+		}
+	}
+	return guarded
 }
 
+// Eval evaluates the expression in the calling goroutine, ctx is checked only before each call of
+// extension functions, the budget of ctx bounds time spent in extension functions, like lookups and
+// plugins, built-in operators are not interrupted. returns ctx.Err() if ctx is done before a call.
 func (e *Expr) Eval(ctx context.Context, in map[string]tdtl.Node) (tdtl.Node, error) {
+	if err := ctx.Err(); nil != err {
+		return tdtl.UNDEFINED_RESULT, errors.Wrap(err, "eval expression")
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.ctx, e.err = ctx, nil
 	result := e.exprIns.Eval(in)
+	err := e.err
+	e.ctx, e.err = nil, nil
+
+	if nil != err {
+		return tdtl.UNDEFINED_RESULT, errors.Wrap(err, "eval expression")
+	}
 	return result, errors.Wrap(result.Error(), "eval expression")
 }
 
@@ -2,7 +2,9 @@ package expression
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/tkeel-io/core/pkg/repository"
@@ -53,3 +55,27 @@ func Test_Validate(t *testing.T) {
 		})
 	}
 }
+
+func Test_EvalTimeout(t *testing.T) {
+	calls := 0
+	exprIns, err := NewExpr("slow(1) + slow(2) + slow(3)", map[string]tdtl.ContextFunc{
+		"slow": func(args ...tdtl.Node) tdtl.Node {
+			calls++
+			time.Sleep(20 * time.Millisecond)
+			return args[0]
+		},
+	})
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	res, err := exprIns.Eval(ctx, map[string]tdtl.Node{})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Equal(t, tdtl.UNDEFINED_RESULT, res)
+	// functions after the deadline are skipped.
+	assert.Equal(t, 1, calls)
+
+	res, err = exprIns.Eval(context.Background(), map[string]tdtl.Node{})
+	assert.Nil(t, err)
+	assert.Equal(t, tdtl.IntNode(6), res)
+}
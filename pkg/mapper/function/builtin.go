@@ -0,0 +1,273 @@
+package function
+
+import (
+	"crypto/md5"  //nolint
+	"crypto/sha1" //nolint
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"math"
+	"strings"
+
+	"github.com/tkeel-io/tdtl"
+)
+
+const earthRadius = 6371008.8
+
+func init() {
+	// unit conversion.
+	Register("convert", convertFunc)
+	// time & window.
+	Register("now", nowFunc)
+	Register("delta", deltaFunc)
+	Register("rate", rateFunc)
+	// geo.
+	Register("distance", distanceFunc)
+	// string.
+	Register("format", formatFunc)
+	Register("concat", concatFunc)
+	Register("upper", upperFunc)
+	Register("lower", lowerFunc)
+	// hashing.
+	Register("md5", hashFunc(md5.New))
+	Register("sha1", hashFunc(sha1.New))
+	Register("sha256", hashFunc(sha256.New))
+	// entity lookup.
+	Register("lookup", lookupFunc)
+}
+
+// toFloat convert numeric node to float64.
+func toFloat(node tdtl.Node) (float64, bool) {
+	if nil == node {
+		return 0, false
+	}
+
+	switch val := node.To(tdtl.Number).(type) {
+	case tdtl.IntNode:
+		return float64(val), true
+	case tdtl.FloatNode:
+		return float64(val), true
+	}
+	return 0, false
+}
+
+// toString convert node to string, returns false if node undefined.
+func toString(node tdtl.Node) (string, bool) {
+	if nil == node {
+		return "", false
+	}
+
+	switch node.Type() {
+	case tdtl.Undefined, tdtl.Null:
+		return "", false
+	}
+	return node.String(), true
+}
+
+// number returns IntNode if val is integral, else FloatNode.
+func number(val float64) tdtl.Node {
+	if math.IsNaN(val) || math.IsInf(val, 0) {
+		return tdtl.UNDEFINED_RESULT
+	} else if val == math.Trunc(val) && math.Abs(val) < math.MaxInt64 {
+		return tdtl.IntNode(int64(val))
+	}
+	return tdtl.FloatNode(val)
+}
+
+// convertFunc convert value between units, eg: convert(temp, 'c', 'f').
+func convertFunc(_ *Context, args ...tdtl.Node) tdtl.Node {
+	if len(args) != 3 {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	val, ok1 := toFloat(args[0])
+	from, ok2 := toString(args[1])
+	to, ok3 := toString(args[2])
+	if !ok1 || !ok2 || !ok3 {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	if ret, ok := convertUnit(val, strings.ToLower(from), strings.ToLower(to)); ok {
+		return number(ret)
+	}
+	return tdtl.UNDEFINED_RESULT
+}
+
+func nowFunc(ctx *Context, _ ...tdtl.Node) tdtl.Node {
+	return tdtl.IntNode(ctx.now().UnixMilli())
+}
+
+type sample struct {
+	Value float64 `json:"v"`
+	TS    int64   `json:"ts"`
+}
+
+// previous returns the previous sample of the function call and records current.
+func previous(ctx *Context, name string, val float64) (sample, bool) {
+	var prev sample
+	if nil == ctx.Memory {
+		return prev, false
+	}
+
+	slot := ctx.slot(name)
+	has := ctx.Memory.Load(slot, &prev)
+	ctx.Memory.Store(slot, sample{Value: val, TS: ctx.now().UnixMilli()})
+	return prev, has
+}
+
+// deltaFunc returns the difference from the previous value, eg: delta(counter).
+func deltaFunc(ctx *Context, args ...tdtl.Node) tdtl.Node {
+	if len(args) != 1 {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	val, ok := toFloat(args[0])
+	if !ok {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	prev, has := previous(ctx, "delta", val)
+	if !has {
+		return tdtl.UNDEFINED_RESULT
+	}
+	return number(val - prev.Value)
+}
+
+// rateFunc returns the per-second change from the previous value, eg: rate(counter).
+func rateFunc(ctx *Context, args ...tdtl.Node) tdtl.Node {
+	if len(args) != 1 {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	val, ok := toFloat(args[0])
+	if !ok {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	prev, has := previous(ctx, "rate", val)
+	elapsed := float64(ctx.now().UnixMilli()-prev.TS) / 1000
+	if !has || elapsed <= 0 {
+		return tdtl.UNDEFINED_RESULT
+	}
+	return number((val - prev.Value) / elapsed)
+}
+
+// distanceFunc returns great-circle distance in meters, eg: distance(lat1, lon1, lat2, lon2).
+func distanceFunc(_ *Context, args ...tdtl.Node) tdtl.Node {
+	if len(args) != 4 {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	coords := make([]float64, 4)
+	for index := range args {
+		val, ok := toFloat(args[index])
+		if !ok {
+			return tdtl.UNDEFINED_RESULT
+		}
+		coords[index] = val * math.Pi / 180
+	}
+
+	dLat := coords[2] - coords[0]
+	dLon := coords[3] - coords[1]
+	h := math.Pow(math.Sin(dLat/2), 2) +
+		math.Cos(coords[0])*math.Cos(coords[2])*math.Pow(math.Sin(dLon/2), 2)
+	return tdtl.FloatNode(2 * earthRadius * math.Asin(math.Sqrt(h)))
+}
+
+// formatFunc format args with fmt verbs, eg: format('%s-%v', a, b).
+func formatFunc(_ *Context, args ...tdtl.Node) tdtl.Node {
+	if len(args) < 1 {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	layout, ok := toString(args[0])
+	if !ok {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	values := make([]interface{}, 0, len(args)-1)
+	for _, arg := range args[1:] {
+		switch arg := arg.(type) {
+		case tdtl.IntNode:
+			values = append(values, int64(arg))
+		case tdtl.FloatNode:
+			values = append(values, float64(arg))
+		case tdtl.BoolNode:
+			values = append(values, bool(arg))
+		default:
+			values = append(values, arg.String())
+		}
+	}
+	return tdtl.StringNode(fmt.Sprintf(layout, values...))
+}
+
+func concatFunc(_ *Context, args ...tdtl.Node) tdtl.Node {
+	var builder strings.Builder
+	for _, arg := range args {
+		if str, ok := toString(arg); ok {
+			builder.WriteString(str)
+		}
+	}
+	return tdtl.StringNode(builder.String())
+}
+
+func upperFunc(_ *Context, args ...tdtl.Node) tdtl.Node {
+	if len(args) != 1 {
+		return tdtl.UNDEFINED_RESULT
+	}
+	return tdtl.StringNode(strings.ToUpper(args[0].String()))
+}
+
+func lowerFunc(_ *Context, args ...tdtl.Node) tdtl.Node {
+	if len(args) != 1 {
+		return tdtl.UNDEFINED_RESULT
+	}
+	return tdtl.StringNode(strings.ToLower(args[0].String()))
+}
+
+// hashFunc returns hex digest of the args, eg: sha256(serial).
+func hashFunc(newHash func() hash.Hash) Func {
+	return func(_ *Context, args ...tdtl.Node) tdtl.Node {
+		if len(args) < 1 {
+			return tdtl.UNDEFINED_RESULT
+		}
+
+		h := newHash()
+		for _, arg := range args {
+			str, ok := toString(arg)
+			if !ok {
+				return tdtl.UNDEFINED_RESULT
+			}
+			h.Write([]byte(str))
+		}
+		return tdtl.StringNode(hex.EncodeToString(h.Sum(nil)))
+	}
+}
+
+// lookupFunc returns property of another entity, eg: lookup('iotd-xxx', 'properties.temp').
+// NOTE: the looked up entity is not subscribed, changes of it do not trigger evaluation.
+func lookupFunc(ctx *Context, args ...tdtl.Node) tdtl.Node {
+	if len(args) != 2 || nil == ctx.Lookup {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	entityID, ok1 := toString(args[0])
+	propertyKey, ok2 := toString(args[1])
+	if !ok1 || !ok2 {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	ret := ctx.Lookup(entityID, propertyKey)
+	if nil == ret {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	switch ret := ret.(type) {
+	case *tdtl.JSONNode:
+		return ret.Node()
+	case tdtl.JSONNode:
+		return ret.Node()
+	}
+	return ret
+}
@@ -0,0 +1,140 @@
+package function
+
+import (
+	"fmt"
+	"plugin"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+)
+
+/*
+
+extension functions for tdtl expressions and mappers:
+	1. built-in functions registered in init().
+	2. operator functions registered through Plugin.
+
+*/
+
+// PluginSymbol is the symbol looked up from plugin file, which must implement Plugin.
+const PluginSymbol = "Plugin"
+
+// Func is an extension function, ctx is the evaluation context which is never nil.
+type Func func(ctx *Context, args ...tdtl.Node) tdtl.Node
+
+// Plugin provides a set of extension functions.
+type Plugin interface {
+	Name() string
+	Functions() map[string]Func
+}
+
+// LookupFunc returns property of the entity.
+type LookupFunc func(entityID, propertyKey string) tdtl.Node
+
+// Context is the evaluation context of extension functions.
+type Context struct {
+	// ExprID identifies the expression being evaluated.
+	ExprID string
+	// Memory holds values across evaluations of the expression, stateful functions are disabled if nil.
+	Memory *Memory
+	// Lookup resolves property of another entity, lookup is disabled if nil.
+	Lookup LookupFunc
+	// Now returns current time, default time.Now.
+	Now func() time.Time
+
+	calls int
+}
+
+func (c *Context) now() time.Time {
+	if nil == c.Now {
+		return time.Now()
+	}
+	return c.Now()
+}
+
+// slot returns the unique memory key of the function call within the expression.
+func (c *Context) slot(name string) string {
+	c.calls++
+	return fmt.Sprintf("%s#%d", name, c.calls)
+}
+
+var (
+	lock      sync.RWMutex
+	functions = make(map[string]Func)
+)
+
+// Register register an extension function, an existing function with the same name will be replaced.
+func Register(name string, fn Func) {
+	lock.Lock()
+	defer lock.Unlock()
+	if _, has := functions[name]; has {
+		log.L().Warn("replace registered function", logf.Name(name))
+	}
+	functions[name] = fn
+}
+
+// RegisterPlugin register functions provided by the plugin.
+func RegisterPlugin(p Plugin) {
+	log.L().Info("register function plugin", logf.Name(p.Name()))
+	for name, fn := range p.Functions() {
+		Register(name, fn)
+	}
+}
+
+// LoadPlugins load plugins from go plugin files.
+func LoadPlugins(paths []string) error {
+	for _, path := range paths {
+		pluginIns, err := plugin.Open(path)
+		if nil != err {
+			return errors.Wrapf(err, "open function plugin %s", path)
+		}
+
+		symbol, err := pluginIns.Lookup(PluginSymbol)
+		if nil != err {
+			return errors.Wrapf(err, "lookup function plugin %s", path)
+		}
+
+		switch p := symbol.(type) {
+		case Plugin:
+			RegisterPlugin(p)
+		case *Plugin:
+			RegisterPlugin(*p)
+		default:
+			return errors.Errorf("invalid function plugin %s", path)
+		}
+	}
+	return nil
+}
+
+// Names returns names of registered functions.
+func Names() []string {
+	lock.RLock()
+	defer lock.RUnlock()
+	names := make([]string, 0, len(functions))
+	for name := range functions {
+		names = append(names, name)
+	}
+	return names
+}
+
+// Funcs returns registered functions bound to ctx, for NewExpr and NewTDTL.
+func Funcs(ctx *Context) map[string]tdtl.ContextFunc {
+	if nil == ctx {
+		ctx = &Context{}
+	}
+
+	lock.RLock()
+	defer lock.RUnlock()
+	funcs := make(map[string]tdtl.ContextFunc, len(functions))
+	for name, fn := range functions {
+		fn := fn
+		funcs[name] = func(args ...tdtl.Node) tdtl.Node {
+			return fn(ctx, args...)
+		}
+	}
+	return funcs
+}
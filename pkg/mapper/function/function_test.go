@@ -0,0 +1,101 @@
+package function
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/tdtl"
+)
+
+func eval(t *testing.T, ctx *Context, expr string, in map[string]tdtl.Node) tdtl.Node {
+	exprIns, err := tdtl.NewExpr(expr, Funcs(ctx))
+	assert.Nil(t, err)
+	return exprIns.Eval(in)
+}
+
+func TestBuiltin(t *testing.T) {
+	in := map[string]tdtl.Node{
+		"dev1.properties.temp":   tdtl.NewInt64(100),
+		"dev1.properties.serial": tdtl.NewString("abc"),
+	}
+
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{"convert", `convert(dev1.properties.temp, 'c', 'f')`, "212"},
+		{"convert-km", `convert(1500, 'm', 'km')`, "1.500000"},
+		{"convert-invalid", `convert(1, 'm', 'kg')`, ""},
+		{"distance", `distance(0, 0, 0, 1) > 111000`, "true"},
+		{"format", `format('%s-%d', dev1.properties.serial, dev1.properties.temp)`, "abc-100"},
+		{"concat", `concat(dev1.properties.serial, '-', 1)`, "abc-1"},
+		{"upper", `upper(dev1.properties.serial)`, "ABC"},
+		{"md5", `md5(dev1.properties.serial)`, "900150983cd24fb0d6963f7d28e17f72"},
+		{"sha256", `sha256('abc')`, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, eval(t, nil, tt.expr, in).String())
+		})
+	}
+}
+
+func TestDeltaRate(t *testing.T) {
+	now := time.Unix(1000, 0)
+	memory := NewMemory()
+	newCtx := func() *Context {
+		return &Context{ExprID: "expr1", Memory: memory, Now: func() time.Time { return now }}
+	}
+
+	expr := `delta(dev1.properties.counter) + rate(dev1.properties.counter)`
+	ret := eval(t, newCtx(), expr, map[string]tdtl.Node{"dev1.properties.counter": tdtl.NewInt64(10)})
+	assert.Equal(t, tdtl.Undefined, ret.Type())
+	assert.True(t, memory.Dirty())
+
+	now = now.Add(2 * time.Second)
+	ret = eval(t, newCtx(), expr, map[string]tdtl.Node{"dev1.properties.counter": tdtl.NewInt64(20)})
+	assert.Equal(t, "15", ret.String())
+
+	// restore from snapshot.
+	bytes, err := memory.Encode()
+	assert.Nil(t, err)
+	assert.False(t, memory.Dirty())
+	memory = NewMemory()
+	assert.Nil(t, memory.Decode(bytes))
+	now = now.Add(time.Second)
+	ret = eval(t, newCtx(), `delta(dev1.properties.counter)`, map[string]tdtl.Node{"dev1.properties.counter": tdtl.NewInt64(25)})
+	assert.Equal(t, "5", ret.String())
+}
+
+func TestLookup(t *testing.T) {
+	ctx := &Context{Lookup: func(entityID, propertyKey string) tdtl.Node {
+		if entityID == "dev2" && propertyKey == "properties.temp" {
+			return tdtl.New(`30`)
+		}
+		return tdtl.UNDEFINED_RESULT
+	}}
+
+	assert.Equal(t, "31", eval(t, ctx, `lookup('dev2', 'properties.temp') + 1`, nil).String())
+	assert.Equal(t, tdtl.Undefined, eval(t, nil, `lookup('dev2', 'properties.temp')`, nil).Type())
+}
+
+type testPlugin struct{}
+
+func (p testPlugin) Name() string { return "test" }
+
+func (p testPlugin) Functions() map[string]Func {
+	return map[string]Func{
+		"double": func(_ *Context, args ...tdtl.Node) tdtl.Node {
+			val, _ := toFloat(args[0])
+			return number(val * 2)
+		},
+	}
+}
+
+func TestRegisterPlugin(t *testing.T) {
+	RegisterPlugin(testPlugin{})
+	assert.Contains(t, Names(), "double")
+	assert.Equal(t, "4", eval(t, nil, `double(2)`, nil).String())
+}
@@ -0,0 +1,75 @@
+package function
+
+import (
+	"sync"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+)
+
+// Memory holds the states of stateful functions of an expression.
+type Memory struct {
+	lock   sync.RWMutex
+	values map[string]jsoniter.RawMessage
+	dirty  bool
+}
+
+func NewMemory() *Memory {
+	return &Memory{values: make(map[string]jsoniter.RawMessage)}
+}
+
+// Load decode the state of slot into v, returns false if not exists.
+func (m *Memory) Load(slot string, v interface{}) bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	raw, has := m.values[slot]
+	if !has {
+		return false
+	}
+	return nil == json.Unmarshal(raw, v)
+}
+
+// Store encode v as the state of slot.
+func (m *Memory) Store(slot string, v interface{}) {
+	raw, err := json.Marshal(v)
+	if nil != err {
+		return
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.values[slot] = raw
+	m.dirty = true
+}
+
+// Dirty reports whether the memory changed since last Encode.
+func (m *Memory) Dirty() bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.dirty
+}
+
+// Encode returns the memory snapshot and marks it clean.
+func (m *Memory) Encode() ([]byte, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	bytes, err := json.Marshal(m.values)
+	if nil == err {
+		m.dirty = false
+	}
+	return bytes, errors.Wrap(err, "encode memory")
+}
+
+// Decode restore the memory from snapshot.
+func (m *Memory) Decode(bytes []byte) error {
+	values := make(map[string]jsoniter.RawMessage)
+	if err := json.Unmarshal(bytes, &values); nil != err {
+		return errors.Wrap(err, "decode memory")
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.values = values
+	m.dirty = false
+	return nil
+}
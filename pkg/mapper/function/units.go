@@ -0,0 +1,79 @@
+package function
+
+type unit struct {
+	dimension string
+	// factor to the base unit of dimension.
+	factor float64
+}
+
+var units = map[string]unit{
+	// length, base m.
+	"mm": {"length", 0.001},
+	"cm": {"length", 0.01},
+	"m":  {"length", 1},
+	"km": {"length", 1000},
+	"in": {"length", 0.0254},
+	"ft": {"length", 0.3048},
+	"mi": {"length", 1609.344},
+	// mass, base kg.
+	"mg": {"mass", 0.000001},
+	"g":  {"mass", 0.001},
+	"kg": {"mass", 1},
+	"t":  {"mass", 1000},
+	"lb": {"mass", 0.45359237},
+	"oz": {"mass", 0.028349523125},
+	// speed, base m/s.
+	"m/s":  {"speed", 1},
+	"km/h": {"speed", 1 / 3.6},
+	"mph":  {"speed", 0.44704},
+	"kn":   {"speed", 0.514444},
+	// pressure, base pa.
+	"pa":   {"pressure", 1},
+	"hpa":  {"pressure", 100},
+	"kpa":  {"pressure", 1000},
+	"mpa":  {"pressure", 1000000},
+	"bar":  {"pressure", 100000},
+	"psi":  {"pressure", 6894.757293168},
+	"atm":  {"pressure", 101325},
+	"mmhg": {"pressure", 133.322387415},
+	// energy, base j.
+	"j":   {"energy", 1},
+	"kj":  {"energy", 1000},
+	"wh":  {"energy", 3600},
+	"kwh": {"energy", 3600000},
+	// time, base s.
+	"ms":  {"time", 0.001},
+	"s":   {"time", 1},
+	"min": {"time", 60},
+	"h":   {"time", 3600},
+	"d":   {"time", 86400},
+}
+
+// toCelsius & fromCelsius convert temperature, which is not linear.
+var toCelsius = map[string]func(float64) float64{
+	"c": func(v float64) float64 { return v },
+	"f": func(v float64) float64 { return (v - 32) * 5 / 9 },
+	"k": func(v float64) float64 { return v - 273.15 },
+}
+
+var fromCelsius = map[string]func(float64) float64{
+	"c": func(v float64) float64 { return v },
+	"f": func(v float64) float64 { return v*9/5 + 32 },
+	"k": func(v float64) float64 { return v + 273.15 },
+}
+
+func convertUnit(val float64, from, to string) (float64, bool) {
+	if toC, has := toCelsius[from]; has {
+		if fromC, has := fromCelsius[to]; has {
+			return fromC(toC(val)), true
+		}
+		return 0, false
+	}
+
+	fromUnit, has1 := units[from]
+	toUnit, has2 := units[to]
+	if !has1 || !has2 || fromUnit.dimension != toUnit.dimension {
+		return 0, false
+	}
+	return val * fromUnit.factor / toUnit.factor, true
+}
@@ -0,0 +1,197 @@
+package function
+
+import (
+	"math"
+
+	"github.com/tkeel-io/tdtl"
+)
+
+// maxWindowSamples limits samples kept by a window function call.
+const maxWindowSamples = 1024
+
+func init() {
+	// sliding window.
+	Register("avg_over", windowFunc("avg_over", avgOf))
+	Register("min_over", windowFunc("min_over", minOf))
+	Register("max_over", windowFunc("max_over", maxOf))
+	Register("count_over", countOverFunc)
+	// signal conditioning.
+	Register("debounce", debounceFunc)
+	Register("hysteresis", hysteresisFunc)
+}
+
+// window returns samples of the function call within the last seconds, including current value.
+func window(ctx *Context, name string, val, seconds float64) ([]sample, bool) {
+	if nil == ctx.Memory || seconds <= 0 {
+		return nil, false
+	}
+
+	var samples []sample
+	slot := ctx.slot(name)
+	ctx.Memory.Load(slot, &samples)
+
+	now := ctx.now().UnixMilli()
+	samples = evict(append(samples, sample{Value: val, TS: now}), now-int64(seconds*1000))
+	ctx.Memory.Store(slot, samples)
+	return samples, true
+}
+
+// evict drops samples not after since, and keeps at most maxWindowSamples.
+func evict(samples []sample, since int64) []sample {
+	offset := 0
+	for offset < len(samples) && samples[offset].TS <= since {
+		offset++
+	}
+	if len(samples)-offset > maxWindowSamples {
+		offset = len(samples) - maxWindowSamples
+	}
+	return samples[offset:]
+}
+
+// windowFunc returns a sliding window function, eg: avg_over(temp, 60).
+func windowFunc(name string, aggregate func([]sample) float64) Func {
+	return func(ctx *Context, args ...tdtl.Node) tdtl.Node {
+		if len(args) != 2 {
+			return tdtl.UNDEFINED_RESULT
+		}
+
+		val, ok1 := toFloat(args[0])
+		seconds, ok2 := toFloat(args[1])
+		if !ok1 || !ok2 {
+			return tdtl.UNDEFINED_RESULT
+		}
+
+		samples, ok := window(ctx, name, val, seconds)
+		if !ok || len(samples) == 0 {
+			return tdtl.UNDEFINED_RESULT
+		}
+		return number(aggregate(samples))
+	}
+}
+
+func avgOf(samples []sample) float64 {
+	var sum float64
+	for _, s := range samples {
+		sum += s.Value
+	}
+	return sum / float64(len(samples))
+}
+
+func minOf(samples []sample) float64 {
+	ret := math.Inf(1)
+	for _, s := range samples {
+		ret = math.Min(ret, s.Value)
+	}
+	return ret
+}
+
+func maxOf(samples []sample) float64 {
+	ret := math.Inf(-1)
+	for _, s := range samples {
+		ret = math.Max(ret, s.Value)
+	}
+	return ret
+}
+
+type changeState struct {
+	Last    float64  `json:"last"`
+	Changes []sample `json:"changes"`
+}
+
+// countOverFunc returns the number of value changes within the last seconds, eg: count_over(state, 60).
+func countOverFunc(ctx *Context, args ...tdtl.Node) tdtl.Node {
+	if len(args) != 2 || nil == ctx.Memory {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	val, ok1 := toFloat(args[0])
+	seconds, ok2 := toFloat(args[1])
+	if !ok1 || !ok2 || seconds <= 0 {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	var state changeState
+	slot := ctx.slot("count_over")
+	has := ctx.Memory.Load(slot, &state)
+
+	now := ctx.now().UnixMilli()
+	if has && state.Last != val {
+		state.Changes = append(state.Changes, sample{Value: val, TS: now})
+	}
+
+	state.Last = val
+	state.Changes = evict(state.Changes, now-int64(seconds*1000))
+	ctx.Memory.Store(slot, state)
+	return tdtl.IntNode(len(state.Changes))
+}
+
+type debounceState struct {
+	Pending sample   `json:"pending"`
+	Stable  *float64 `json:"stable,omitempty"`
+}
+
+// debounceFunc returns the last value which stayed unchanged for seconds, eg: debounce(switch, 5).
+// NOTE: the expression is evaluated on changes only, a settled value takes effect at the next evaluation.
+func debounceFunc(ctx *Context, args ...tdtl.Node) tdtl.Node {
+	if len(args) != 2 || nil == ctx.Memory {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	val, ok1 := toFloat(args[0])
+	seconds, ok2 := toFloat(args[1])
+	if !ok1 || !ok2 {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	var state debounceState
+	slot := ctx.slot("debounce")
+	has := ctx.Memory.Load(slot, &state)
+
+	now := ctx.now().UnixMilli()
+	if !has || state.Pending.Value != val {
+		state.Pending = sample{Value: val, TS: now}
+	}
+	if now-state.Pending.TS >= int64(seconds*1000) {
+		stable := state.Pending.Value
+		state.Stable = &stable
+	}
+
+	ctx.Memory.Store(slot, state)
+	if nil == state.Stable {
+		return tdtl.UNDEFINED_RESULT
+	}
+	return number(*state.Stable)
+}
+
+// hysteresisFunc returns true once value reaches high, and false once value falls to low, eg: hysteresis(temp, 60, 80).
+func hysteresisFunc(ctx *Context, args ...tdtl.Node) tdtl.Node {
+	if len(args) != 3 {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	val, ok1 := toFloat(args[0])
+	low, ok2 := toFloat(args[1])
+	high, ok3 := toFloat(args[2])
+	if !ok1 || !ok2 || !ok3 || low > high {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	var active bool
+	var slot string
+	if nil != ctx.Memory {
+		slot = ctx.slot("hysteresis")
+		ctx.Memory.Load(slot, &active)
+	}
+
+	switch {
+	case val >= high:
+		active = true
+	case val <= low:
+		active = false
+	}
+
+	if nil != ctx.Memory {
+		ctx.Memory.Store(slot, active)
+	}
+	return tdtl.BoolNode(active)
+}
@@ -0,0 +1,76 @@
+package function
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/tdtl"
+)
+
+func TestWindow(t *testing.T) {
+	now := time.Unix(1000, 0)
+	memory := NewMemory()
+	newCtx := func() *Context {
+		return &Context{ExprID: "expr1", Memory: memory, Now: func() time.Time { return now }}
+	}
+
+	expr := `concat(avg_over(dev1.properties.temp, 10), '|',
+		min_over(dev1.properties.temp, 10), '|',
+		max_over(dev1.properties.temp, 10), '|',
+		count_over(dev1.properties.temp, 10))`
+	tests := []struct {
+		elapsed time.Duration
+		temp    int64
+		want    string
+	}{
+		{0, 10, "10|10|10|0"},
+		{2 * time.Second, 20, "15|10|20|1"},
+		{2 * time.Second, 20, "16.666667|10|20|1"},
+		{7 * time.Second, 30, "23.333333|20|30|2"},
+		{10 * time.Second, 30, "30|30|30|0"},
+	}
+	for _, tt := range tests {
+		now = now.Add(tt.elapsed)
+		in := map[string]tdtl.Node{"dev1.properties.temp": tdtl.NewInt64(tt.temp)}
+		assert.Equal(t, tt.want, eval(t, newCtx(), expr, in).String())
+	}
+
+	// stateless without memory.
+	assert.Equal(t, tdtl.Undefined, eval(t, nil, `avg_over(1, 10)`, nil).Type())
+}
+
+func TestDebounce(t *testing.T) {
+	now := time.Unix(1000, 0)
+	memory := NewMemory()
+	newCtx := func() *Context {
+		return &Context{ExprID: "expr1", Memory: memory, Now: func() time.Time { return now }}
+	}
+
+	debounce := func(elapsed time.Duration, val int64) string {
+		now = now.Add(elapsed)
+		in := map[string]tdtl.Node{"dev1.properties.switch": tdtl.NewInt64(val)}
+		return eval(t, newCtx(), `debounce(dev1.properties.switch, 5)`, in).String()
+	}
+
+	assert.Equal(t, "", debounce(0, 1))
+	assert.Equal(t, "", debounce(time.Second, 0))
+	assert.Equal(t, "", debounce(time.Second, 1))
+	assert.Equal(t, "1", debounce(5*time.Second, 1))
+	assert.Equal(t, "1", debounce(time.Second, 0))
+	assert.Equal(t, "0", debounce(5*time.Second, 0))
+}
+
+func TestHysteresis(t *testing.T) {
+	memory := NewMemory()
+	hysteresis := func(val int64) string {
+		in := map[string]tdtl.Node{"dev1.properties.temp": tdtl.NewInt64(val)}
+		return eval(t, &Context{Memory: memory}, `hysteresis(dev1.properties.temp, 60, 80)`, in).String()
+	}
+
+	assert.Equal(t, "false", hysteresis(70))
+	assert.Equal(t, "true", hysteresis(85))
+	assert.Equal(t, "true", hysteresis(70))
+	assert.Equal(t, "false", hysteresis(60))
+	assert.Equal(t, "false", hysteresis(79))
+}
@@ -18,6 +18,7 @@ package mapper
 
 import (
 	"github.com/pkg/errors"
+	"github.com/tkeel-io/core/pkg/mapper/function"
 	"github.com/tkeel-io/tdtl"
 )
 
@@ -38,7 +39,7 @@ type mapper struct {
 }
 
 func NewMapper(mp Mapper, version int64) (IMapper, error) {
-	tqlInst, err := tdtl.NewTDTL(mp.TQL, nil)
+	tqlInst, err := tdtl.NewTDTL(mp.TQL, function.Funcs(nil))
 	if nil != err {
 		return nil, errors.Wrap(err, "construct mapper")
 	}
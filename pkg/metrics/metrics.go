@@ -12,6 +12,11 @@ const (
 	MetricsLabelTelemetryID = "telemetry_id"
 	MetricsLabelMsgType     = "msg_type"
 	MetricsLabelSpaceType   = "space_type"
+	MetricsLabelStatus      = "status"
+	MetricsLabelReason      = "reason"
+	MetricsLabelSeverity    = "severity"
+	MetricsLabelAPI         = "api"
+	MetricsLabelLimit       = "limit"
 
 	// msg type.
 	MsgTypeSubscribe  = "subscribe"
@@ -39,6 +44,28 @@ const (
 
 	// metrics device telemetry.
 	EntityTelemetry = "entity_telemetry"
+
+	// metrics expression eval latency.
+	MetricsExprEvalDuration = "core_expression_eval_duration_seconds"
+
+	// metrics expression rejected count.
+	MetricsExprRejected = "core_expression_rejected_total"
+
+	// metrics alarm transition count.
+	MetricsAlarmTransitions = "core_alarm_transitions_total"
+
+	// metrics throttled request count.
+	MetricsRequestThrottled = "core_request_throttled_total"
+
+	// expression eval status.
+	ExprStatusOK      = "ok"
+	ExprStatusError   = "error"
+	ExprStatusTimeout = "timeout"
+
+	// expression rejected reason.
+	ExprRejectCycle = "cycle"
+	ExprRejectDepth = "depth"
+	ExprRejectCost  = "cost"
 )
 
 var CollectorMsgCount = prometheus.NewCounterVec(
@@ -89,6 +116,39 @@ var CollectorTelemetry = prometheus.NewGaugeVec(
 	[]string{MetricsLabelTenant, MetricsLabelSchema, MetricsLabelEntity, MetricsLabelTelemetryID},
 )
 
+var CollectorExprEvalDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    MetricsExprEvalDuration,
+		Help:    "expression eval latency.",
+		Buckets: []float64{.0005, .001, .005, .01, .05, .1, .25, .5, 1},
+	},
+	[]string{MetricsLabelTenant, MetricsLabelStatus},
+)
+
+var CollectorExprRejected = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: MetricsExprRejected,
+		Help: "expression rejected count.",
+	},
+	[]string{MetricsLabelReason},
+)
+
+var CollectorAlarmTransitions = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: MetricsAlarmTransitions,
+		Help: "alarm state transition count.",
+	},
+	[]string{MetricsLabelTenant, MetricsLabelSeverity, MetricsLabelStatus},
+)
+
+var CollectorRequestThrottled = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: MetricsRequestThrottled,
+		Help: "throttled request count.",
+	},
+	[]string{MetricsLabelTenant, MetricsLabelAPI, MetricsLabelLimit},
+)
+
 var Metrics = []prometheus.Collector{
 	CollectorRawDataStorage,
 	CollectorTimeseriesStorage,
@@ -96,4 +156,8 @@ var Metrics = []prometheus.Collector{
 	CollectorMsgStorageSpace,
 	CollectorMsgStorageSeconds,
 	CollectorTelemetry,
+	CollectorExprEvalDuration,
+	CollectorExprRejected,
+	CollectorAlarmTransitions,
+	CollectorRequestThrottled,
 }
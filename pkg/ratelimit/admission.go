@@ -0,0 +1,105 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/emicklei/go-restful"
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/metrics"
+	"github.com/tkeel-io/kit/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	HeaderRetryAfter = "Retry-After"
+
+	LimitTenant = "tenant"
+	LimitEntity = "entity"
+	LimitAPI    = "api"
+)
+
+var _limiter = NewLimiter()
+
+type throttleKey struct{}
+
+// throttle records the time a throttled request should retry after.
+type throttle struct {
+	retryAfter time.Duration
+}
+
+// Admit takes tokens of the API, the tenant and the entity, the request is rejected
+// with ErrRateLimited if any of them is throttled. Empty tenant or entity is not limited.
+func Admit(ctx context.Context, api, tenantID, entityID string) error {
+	cfg := config.Get().RateLimit
+	limits := []struct {
+		name  string
+		key   string
+		limit config.RateLimit
+	}{
+		{name: LimitAPI, key: api, limit: cfg.APILimit(api)},
+		{name: LimitTenant, key: tenantID, limit: cfg.Tenant},
+		{name: LimitEntity, key: entityID, limit: cfg.Entity},
+	}
+
+	for _, l := range limits {
+		if l.key == "" {
+			continue
+		}
+
+		retryAfter, ok := _limiter.Allow(l.name+"/"+l.key, l.limit)
+		if ok {
+			continue
+		}
+
+		metrics.CollectorRequestThrottled.WithLabelValues(tenantID, api, l.name).Inc()
+		log.L().Debug("request throttled", logf.String("api", api), logf.Owner(tenantID),
+			logf.Eid(entityID), logf.String("limit", l.name), logf.Elapsed(retryAfter))
+		setRetryAfter(ctx, retryAfter)
+		return errors.Wrapf(xerrors.ErrRateLimited, "%s %s", l.name, l.key)
+	}
+	return nil
+}
+
+// retryAfterSeconds rounds up the duration to whole seconds, at least one second.
+func retryAfterSeconds(retryAfter time.Duration) string {
+	return strconv.Itoa(int(math.Max(1, math.Ceil(retryAfter.Seconds()))))
+}
+
+func setRetryAfter(ctx context.Context, retryAfter time.Duration) {
+	if t, ok := ctx.Value(throttleKey{}).(*throttle); ok {
+		t.retryAfter = retryAfter
+	}
+
+	// not a grpc request if failed.
+	_ = grpc.SetHeader(ctx, metadata.Pairs(HeaderRetryAfter, retryAfterSeconds(retryAfter)))
+}
+
+// throttleWriter responds throttled requests with 429 and Retry-After header.
+type throttleWriter struct {
+	http.ResponseWriter
+	throttle *throttle
+}
+
+func (w *throttleWriter) WriteHeader(code int) {
+	if w.throttle.retryAfter > 0 {
+		w.Header().Set(HeaderRetryAfter, retryAfterSeconds(w.throttle.retryAfter))
+		code = http.StatusTooManyRequests
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Filter is a http filter, which responds requests throttled by Admit with 429.
+func Filter(req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
+	t := &throttle{}
+	req.Request = req.Request.WithContext(context.WithValue(req.Request.Context(), throttleKey{}, t))
+	resp.ResponseWriter = &throttleWriter{ResponseWriter: resp.ResponseWriter, throttle: t}
+	chain.ProcessFilter(req, resp)
+}
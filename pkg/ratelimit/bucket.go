@@ -0,0 +1,91 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/tkeel-io/core/pkg/config"
+)
+
+// maxIdleBuckets is the number of buckets over which refilled buckets are evicted.
+const maxIdleBuckets = 10000
+
+// bucket is a token bucket, which refills rate tokens per second up to burst tokens.
+type bucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newBucket(limit config.RateLimit, now time.Time) *bucket {
+	burst := float64(limit.Burst)
+	if burst < 1 {
+		burst = math.Max(1, limit.Rate)
+	}
+	return &bucket{rate: limit.Rate, burst: burst, tokens: burst, last: now}
+}
+
+func (b *bucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+elapsed*b.rate)
+		b.last = now
+	}
+}
+
+// take takes a token, returns the time to wait for the next token if the bucket is empty.
+func (b *bucket) take(now time.Time) (time.Duration, bool) {
+	b.refill(now)
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0, true
+	}
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second)), false
+}
+
+// Limiter limits keys with token buckets.
+type Limiter struct {
+	lock    sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time
+}
+
+func NewLimiter() *Limiter {
+	return &Limiter{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// Allow takes a token of the key, returns the time to retry after if the key is throttled.
+// keys are unlimited if the rate of limit is not positive.
+func (l *Limiter) Allow(key string, limit config.RateLimit) (time.Duration, bool) {
+	if limit.Rate <= 0 {
+		return 0, true
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	b, ok := l.buckets[key]
+	if !ok || b.rate != limit.Rate || int(b.burst) != limit.Burst && limit.Burst > 0 {
+		if len(l.buckets) >= maxIdleBuckets {
+			l.evict(now)
+		}
+		b = newBucket(limit, now)
+		l.buckets[key] = b
+	}
+
+	return b.take(now)
+}
+
+// evict removes buckets which are refilled, they are equal to new buckets.
+func (l *Limiter) evict(now time.Time) {
+	for key, b := range l.buckets {
+		if b.refill(now); b.tokens >= b.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/emicklei/go-restful"
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	kerrors "github.com/tkeel-io/kit/errors"
+)
+
+func TestLimiter_Allow(t *testing.T) {
+	now := time.Unix(100, 0)
+	limiter := NewLimiter()
+	limiter.now = func() time.Time { return now }
+
+	limit := config.RateLimit{Rate: 2, Burst: 2}
+	_, ok := limiter.Allow("device1", limit)
+	assert.True(t, ok)
+	_, ok = limiter.Allow("device1", limit)
+	assert.True(t, ok)
+	retryAfter, ok := limiter.Allow("device1", limit)
+	assert.False(t, ok)
+	assert.Equal(t, 500*time.Millisecond, retryAfter)
+
+	// other keys have their own buckets.
+	_, ok = limiter.Allow("device2", limit)
+	assert.True(t, ok)
+
+	now = now.Add(500 * time.Millisecond)
+	_, ok = limiter.Allow("device1", limit)
+	assert.True(t, ok)
+	_, ok = limiter.Allow("device1", limit)
+	assert.False(t, ok)
+
+	// unlimited.
+	_, ok = limiter.Allow("device1", config.RateLimit{})
+	assert.True(t, ok)
+}
+
+func TestFilter(t *testing.T) {
+	ws := new(restful.WebService)
+	ws.Route(ws.GET("/entities/{id}").To(func(req *restful.Request, resp *restful.Response) {
+		ctx := req.Request.Context()
+		setRetryAfter(ctx, 1500*time.Millisecond)
+		tErr := kerrors.FromError(xerrors.ErrRateLimited)
+		resp.WriteHeader(kerrors.GRPCToHTTPStatusCode(tErr.GRPCStatus().Code()))
+	}))
+
+	container := restful.NewContainer()
+	container.Add(ws)
+	container.Filter(Filter)
+
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/entities/device1", nil)
+	container.ServeHTTP(recorder, req.WithContext(context.Background()))
+	assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	assert.Equal(t, "2", recorder.Header().Get(HeaderRetryAfter))
+}
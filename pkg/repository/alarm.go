@@ -0,0 +1,359 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/kit/log"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+)
+
+const (
+	AlarmRulePrefix         = "/core/v1/alarms"
+	AlarmStorePrefix        = "CORE.ALARM"
+	AlarmHistoryStorePrefix = "CORE.ALARM.HISTORY"
+
+	AlarmStatusActive  = "ACTIVE"
+	AlarmStatusCleared = "CLEARED"
+	AlarmStatusAcked   = "ACKED"
+
+	AlarmSeverityCritical = "CRITICAL"
+	AlarmSeverityMajor    = "MAJOR"
+	AlarmSeverityMinor    = "MINOR"
+	AlarmSeverityWarning  = "WARNING"
+)
+
+var alarmSeverities = map[string]bool{
+	AlarmSeverityCritical: true,
+	AlarmSeverityMajor:    true,
+	AlarmSeverityMinor:    true,
+	AlarmSeverityWarning:  true,
+}
+
+// ValidSeverity reports whether severity is a known alarm severity.
+func ValidSeverity(severity string) bool {
+	return alarmSeverities[severity]
+}
+
+type ListAlarmRuleReq struct {
+	Owner    string
+	EntityID string
+}
+
+var _ dao.Resource = (*AlarmRule)(nil)
+
+// AlarmRule raise an alarm on the entity when Condition becomes true,
+// and clear it when ClearCondition becomes true, or Condition becomes false if ClearCondition is empty.
+type AlarmRule struct {
+	// alarm rule identifier.
+	ID string `json:"id"`
+	// alarm rule name.
+	Name string `json:"name"`
+	// alarm rule owner.
+	Owner string `json:"owner"`
+	// entity id.
+	EntityID string `json:"entity_id"`
+	// severity of raised alarms.
+	Severity string `json:"severity"`
+	// activation condition.
+	Condition string `json:"condition"`
+	// clear condition.
+	ClearCondition string `json:"clear_condition"`
+	// description.
+	Description string `json:"description"`
+}
+
+func ListAlarmRulePrefix(owner, entityID string) string {
+	keyString := fmt.Sprintf("%s/%s/%s/",
+		AlarmRulePrefix, owner, entityID)
+	return keyString
+}
+
+func (a *AlarmRule) EncodeKey() ([]byte, error) {
+	if a.Owner == "" || a.EntityID == "" || a.ID == "" {
+		return nil, errors.Wrap(xerrors.ErrInvalidParam, "encode alarm rule key")
+	}
+
+	keyString := fmt.Sprintf("%s/%s/%s/%s",
+		AlarmRulePrefix, a.Owner, a.EntityID, a.ID)
+	return []byte(keyString), nil
+}
+
+func (a *AlarmRule) Encode() ([]byte, error) {
+	bytes, err := json.Marshal(a)
+	return bytes, errors.Wrap(err, "encode AlarmRule")
+}
+
+func (a *AlarmRule) Decode(key, bytes []byte) error {
+	if bytes != nil {
+		err := json.Unmarshal(bytes, a)
+		return errors.Wrap(err, "decode AlarmRule")
+	}
+	// /core/v1/alarms/admin/device123/alarm-1234
+	keys := strings.Split(string(key), "/")
+	if len(keys) != 7 {
+		return errors.Errorf("error:decode AlarmRule from key[%s]", string(key))
+	}
+	a.Owner = keys[4]
+	a.EntityID = keys[5]
+	a.ID = keys[6]
+	return nil
+}
+
+// Alarm is the current state of an alarm rule.
+type Alarm struct {
+	RuleID     string `json:"rule_id"`
+	Name       string `json:"name"`
+	Owner      string `json:"owner"`
+	EntityID   string `json:"entity_id"`
+	Severity   string `json:"severity"`
+	Status     string `json:"status"`
+	Acked      bool   `json:"acked"`
+	AckedBy    string `json:"acked_by,omitempty"`
+	Value      string `json:"value,omitempty"`
+	ActiveTime int64  `json:"active_time,omitempty"`
+	ClearTime  int64  `json:"clear_time,omitempty"`
+	AckTime    int64  `json:"ack_time,omitempty"`
+}
+
+// AlarmRecord is a state transition of an alarm.
+type AlarmRecord struct {
+	RuleID    string `json:"rule_id"`
+	Name      string `json:"name"`
+	Owner     string `json:"owner"`
+	EntityID  string `json:"entity_id"`
+	Severity  string `json:"severity"`
+	Status    string `json:"status"`
+	Operator  string `json:"operator,omitempty"`
+	Value     string `json:"value,omitempty"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+func NewAlarm(rule *AlarmRule) *Alarm {
+	return &Alarm{
+		RuleID:   rule.ID,
+		Name:     rule.Name,
+		Owner:    rule.Owner,
+		EntityID: rule.EntityID,
+		Severity: rule.Severity,
+		Status:   AlarmStatusCleared,
+	}
+}
+
+func (a *Alarm) Active() bool {
+	return a.Status == AlarmStatusActive
+}
+
+// Record returns the transition record of current state.
+func (a *Alarm) Record(status, operator string, timestamp int64) *AlarmRecord {
+	return &AlarmRecord{
+		RuleID:    a.RuleID,
+		Name:      a.Name,
+		Owner:     a.Owner,
+		EntityID:  a.EntityID,
+		Severity:  a.Severity,
+		Status:    status,
+		Operator:  operator,
+		Value:     a.Value,
+		Timestamp: timestamp,
+	}
+}
+
+// alarmResource is the state of an alarm rule, keyed by owner, entity id and rule id like alarm rules.
+type alarmResource struct {
+	owner    string
+	entityID string
+	id       string
+	data     []byte
+}
+
+func (a *alarmResource) EncodeKey() ([]byte, error) {
+	if a.owner == "" || a.entityID == "" || a.id == "" {
+		return nil, errors.Wrap(xerrors.ErrInvalidParam, "encode alarm key")
+	}
+	return []byte(AlarmStorePrefix + "." + a.owner + "." + a.entityID + "." + a.id), nil
+}
+
+func (a *alarmResource) Encode() ([]byte, error) {
+	return a.data, nil
+}
+
+func (a *alarmResource) Decode(key, bytes []byte) error {
+	a.data = bytes
+	return nil
+}
+
+type alarmHistoryResource struct {
+	alarmResource
+}
+
+func (a *alarmHistoryResource) EncodeKey() ([]byte, error) {
+	if a.owner == "" || a.entityID == "" || a.id == "" {
+		return nil, errors.Wrap(xerrors.ErrInvalidParam, "encode alarm history key")
+	}
+	return []byte(AlarmHistoryStorePrefix + "." + a.owner + "." + a.entityID + "." + a.id), nil
+}
+
+func (r *repo) PutAlarmRule(ctx context.Context, rule *AlarmRule) error {
+	err := r.dao.PutResource(ctx, rule)
+	return errors.Wrap(err, "put alarm rule repository")
+}
+
+// CreateAlarmRule puts the alarm rule if it not exists, returns ErrResourceAlreadyExists otherwise.
+func (r *repo) CreateAlarmRule(ctx context.Context, rule *AlarmRule) error {
+	err := r.dao.CreateResource(ctx, rule)
+	return errors.Wrap(err, "create alarm rule repository")
+}
+
+func (r *repo) GetAlarmRule(ctx context.Context, rule *AlarmRule) (*AlarmRule, error) {
+	_, err := r.dao.GetResource(ctx, rule)
+	return rule, errors.Wrap(err, "get alarm rule repository")
+}
+
+func (r *repo) DelAlarmRule(ctx context.Context, rule *AlarmRule) error {
+	err := r.dao.DelResource(ctx, rule)
+	return errors.Wrap(err, "del alarm rule repository")
+}
+
+func (r *repo) ListAlarmRule(ctx context.Context, rev int64, req *ListAlarmRuleReq) ([]*AlarmRule, error) {
+	// construct prefix.
+	prefix := AlarmRulePrefix + "/"
+	if req.Owner != "" {
+		prefix = fmt.Sprintf("%s/%s/", AlarmRulePrefix, req.Owner)
+		if req.EntityID != "" {
+			prefix = ListAlarmRulePrefix(req.Owner, req.EntityID)
+		}
+	}
+
+	ress, err := r.dao.ListResource(ctx, rev, prefix,
+		func(key, raw []byte) (dao.Resource, error) {
+			var res AlarmRule // escape.
+			err := res.Decode(key, raw)
+			return &res, errors.Wrap(err, "decode alarm rule")
+		})
+
+	var rules []*AlarmRule
+	for index := range ress {
+		if rule, ok := ress[index].(*AlarmRule); ok {
+			if req.EntityID == "" || req.EntityID == rule.EntityID {
+				rules = append(rules, rule)
+			}
+		}
+	}
+	return rules, errors.Wrap(err, "list alarm rule repository")
+}
+
+func (r *repo) RangeAlarmRule(ctx context.Context, rev int64, handler RangeAlarmRuleFunc) {
+	r.dao.RangeResource(ctx, rev, AlarmRulePrefix, func(kvs []*mvccpb.KeyValue) {
+		var rules []*AlarmRule
+		for index := range kvs {
+			var rule AlarmRule
+			err := rule.Decode(kvs[index].Key, kvs[index].Value)
+			if nil != err {
+				log.L().Error("decode alarm rule")
+				continue
+			}
+			rules = append(rules, &rule)
+		}
+		handler(rules)
+	})
+}
+
+func (r *repo) WatchAlarmRule(ctx context.Context, rev int64, handler WatchAlarmRuleFunc) {
+	r.dao.WatchResource(ctx, rev, AlarmRulePrefix, func(et dao.EnventType, kv *mvccpb.KeyValue) {
+		rule := &AlarmRule{}
+		err := rule.Decode(kv.Key, kv.Value)
+		if nil != err {
+			log.L().Error("decode alarm rule")
+		}
+		handler(et, rule)
+	})
+}
+
+// UpdateAlarm updates the alarm of the rule with compare-and-swap, update is called with a cleared alarm
+// if the alarm not exists, and called again with the latest alarm on conflicts.
+func (r *repo) UpdateAlarm(ctx context.Context, rule *AlarmRule, update func(*Alarm) error) (*Alarm, error) {
+	var alarm *Alarm
+	res := &alarmResource{owner: rule.Owner, entityID: rule.EntityID, id: rule.ID}
+	err := r.dao.UpdateStoreResource(ctx, res, func(exists bool) error {
+		alarm = NewAlarm(rule)
+		if exists {
+			if err := json.Unmarshal(res.data, alarm); nil != err {
+				return errors.Wrap(err, "decode alarm")
+			}
+		}
+		if err := update(alarm); nil != err {
+			return err
+		}
+
+		bytes, err := json.Marshal(alarm)
+		res.data = bytes
+		return errors.Wrap(err, "encode alarm")
+	})
+	return alarm, errors.Wrap(err, "update alarm repository")
+}
+
+func (r *repo) GetAlarm(ctx context.Context, owner, entityID, ruleID string) (*Alarm, error) {
+	ret, err := r.dao.GetStoreResource(ctx, &alarmResource{owner: owner, entityID: entityID, id: ruleID})
+	if nil != err {
+		return nil, errors.Wrap(err, "get alarm repository")
+	}
+
+	var alarm Alarm
+	res, _ := ret.(*alarmResource)
+	err = json.Unmarshal(res.data, &alarm)
+	return &alarm, errors.Wrap(err, "get alarm repository")
+}
+
+func (r *repo) DelAlarm(ctx context.Context, owner, entityID, ruleID string) error {
+	if err := r.dao.RemoveStoreResource(ctx, &alarmResource{owner: owner, entityID: entityID, id: ruleID}); nil != err {
+		return errors.Wrap(err, "del alarm repository")
+	}
+
+	res := &alarmHistoryResource{alarmResource{owner: owner, entityID: entityID, id: ruleID}}
+	return errors.Wrap(r.dao.RemoveStoreResource(ctx, res), "del alarm history repository")
+}
+
+// AppendAlarmHistory append the record to alarm history with compare-and-swap, keeps the latest limit records.
+func (r *repo) AppendAlarmHistory(ctx context.Context, record *AlarmRecord, limit int) error {
+	res := &alarmHistoryResource{alarmResource{owner: record.Owner, entityID: record.EntityID, id: record.RuleID}}
+	err := r.dao.UpdateStoreResource(ctx, res, func(exists bool) error {
+		var records []*AlarmRecord
+		if exists {
+			if err := json.Unmarshal(res.data, &records); nil != err {
+				return errors.Wrap(err, "decode alarm history")
+			}
+		}
+
+		records = append(records, record)
+		if limit > 0 && len(records) > limit {
+			records = records[len(records)-limit:]
+		}
+
+		bytes, err := json.Marshal(records)
+		res.data = bytes
+		return errors.Wrap(err, "encode alarm history")
+	})
+	return errors.Wrap(err, "append alarm history repository")
+}
+
+func (r *repo) GetAlarmHistory(ctx context.Context, owner, entityID, ruleID string) ([]*AlarmRecord, error) {
+	ret, err := r.dao.GetStoreResource(ctx, &alarmHistoryResource{alarmResource{owner: owner, entityID: entityID, id: ruleID}})
+	if nil != err {
+		return nil, errors.Wrap(err, "get alarm history repository")
+	}
+
+	var records []*AlarmRecord
+	res, _ := ret.(*alarmHistoryResource)
+	err = json.Unmarshal(res.data, &records)
+	return records, errors.Wrap(err, "get alarm history repository")
+}
+
+type (
+	RangeAlarmRuleFunc func([]*AlarmRule)
+	WatchAlarmRuleFunc func(dao.EnventType, *AlarmRule)
+)
@@ -19,10 +19,12 @@ package dao
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
 	logf "github.com/tkeel-io/core/pkg/logfield"
 	"github.com/tkeel-io/core/pkg/resource"
 	"github.com/tkeel-io/core/pkg/resource/store"
@@ -41,6 +43,10 @@ type Dao struct {
 
 func NewMock(ctx context.Context, storeCfg config.Metadata, etcdCfg config.EtcdConfig) (IDao, error) {
 	storeMeta := resource.ParseFrom(storeCfg)
+	stateClient, err := store.NewStore(storeMeta)
+	if nil != err {
+		return nil, errors.Wrap(err, "create state store")
+	}
 
 	// create Dao instance.
 	ctx, cancel := context.WithCancel(ctx)
@@ -50,20 +56,21 @@ func NewMock(ctx context.Context, storeCfg config.Metadata, etcdCfg config.EtcdC
 		etcdCfg:      etcdCfg,
 		storeCfg:     storeCfg,
 		etcdEndpoint: newNoop(),
-		stateClient:  store.NewStore(storeMeta),
+		stateClient:  stateClient,
 	}, nil
 }
 
-func New(ctx context.Context, storeCfg config.Metadata, etcdCfg config.EtcdConfig) (IDao, error) {
+func New(ctx context.Context, storeCfg, metadataCfg config.Metadata, etcdCfg config.EtcdConfig) (IDao, error) {
 	storeMeta := resource.ParseFrom(storeCfg)
-	timeout := etcdCfg.DialTimeout * int64(time.Second)
-	etcdEndpoint, err := clientv3.New(clientv3.Config{
-		Endpoints:   etcdCfg.Endpoints,
-		DialTimeout: time.Duration(timeout),
-	})
+	etcdEndpoint, err := newKeyValue(metadataCfg, etcdCfg)
+	if nil != err {
+		return nil, errors.Wrap(err, "create metadata backend")
+	}
 
+	stateClient, err := store.NewStore(storeMeta)
 	if nil != err {
-		return nil, errors.Wrap(err, "dial etcd")
+		etcdEndpoint.Close()
+		return nil, errors.Wrap(err, "create state store")
 	}
 
 	// create Dao instance.
@@ -74,10 +81,26 @@ func New(ctx context.Context, storeCfg config.Metadata, etcdCfg config.EtcdConfi
 		etcdCfg:      etcdCfg,
 		storeCfg:     storeCfg,
 		etcdEndpoint: etcdEndpoint,
-		stateClient:  store.NewStore(storeMeta),
+		stateClient:  stateClient,
 	}, nil
 }
 
+// newKeyValue creates the metadata backend, etcd unless configured embedded.
+func newKeyValue(metadataCfg config.Metadata, etcdCfg config.EtcdConfig) (KeyValue, error) {
+	meta := resource.ParseFrom(metadataCfg)
+	switch meta.Name {
+	case "", MetadataEtcd:
+		timeout := etcdCfg.DialTimeout * int64(time.Second)
+		return newEtcd(clientv3.Config{
+			Endpoints:   etcdCfg.Endpoints,
+			DialTimeout: time.Duration(timeout),
+		})
+	case MetadataEmbedded:
+		return newEmbeddedKV(meta.Properties)
+	}
+	return nil, errors.Wrapf(xerrors.ErrInvalidParam, "unknown metadata backend %s", meta.Name)
+}
+
 func (d *Dao) GetLastRevision(ctx context.Context) int64 {
 	var err error
 	var res *clientv3.MemberListResponse
@@ -111,7 +134,29 @@ func (d *Dao) GetLastRevision(ctx context.Context) int64 {
 	return rev
 }
 
+// probeKey is read by health checks, it is never written.
+const probeKey = "core/probe"
+
+// CheckMetadata reads the probe key from the metadata backend.
+func (d *Dao) CheckMetadata(ctx context.Context) error {
+	_, err := d.etcdEndpoint.Get(ctx, probeKey)
+	return errors.Wrap(err, "check metadata backend")
+}
+
+// CheckStore reads the probe key from the state store, a missing key is healthy.
+func (d *Dao) CheckStore(ctx context.Context) error {
+	if _, err := d.getState(ctx, probeKey); nil != err && !errors.Is(err, xerrors.ErrResourceNotFound) {
+		return errors.Wrap(err, "check state store")
+	}
+	return nil
+}
+
 func (d *Dao) Close() {
 	d.cancel()
 	d.etcdEndpoint.Close()
+	if closer, ok := d.stateClient.(io.Closer); ok {
+		if err := closer.Close(); nil != err {
+			log.L().Error("close state store", logf.Error(err))
+		}
+	}
 }
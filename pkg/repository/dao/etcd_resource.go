@@ -43,6 +43,26 @@ func (d *Dao) PutResource(ctx context.Context, res Resource) error {
 	return errors.Wrap(err, "put costume resource")
 }
 
+// CreateResource puts the resource if it not exists, returns ErrResourceAlreadyExists otherwise.
+func (d *Dao) CreateResource(ctx context.Context, res Resource) error {
+	var (
+		err     error
+		key     []byte
+		bytes   []byte
+		created bool
+	)
+
+	if bytes, err = res.Encode(); nil != err {
+		return errors.Wrap(err, "create costume resource")
+	} else if key, err = res.EncodeKey(); nil == err {
+		if created, err = d.etcdEndpoint.PutIf(ctx, string(key), string(bytes), 0); nil == err && !created {
+			err = xerrors.ErrResourceAlreadyExists
+		}
+	}
+
+	return errors.Wrap(err, "create costume resource")
+}
+
 func (d *Dao) GetResource(ctx context.Context, res Resource) (Resource, error) {
 	var (
 		err error
@@ -187,6 +207,7 @@ func (d *Dao) WatchResource(ctx context.Context, rev int64, prefix string, handl
 	for {
 		select {
 		case <-ctx.Done():
+			return
 		case wr := <-resp:
 			if len(wr.Events) == 0 {
 				return
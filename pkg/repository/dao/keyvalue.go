@@ -27,6 +27,9 @@ import (
 type KeyValue interface {
 	Close() error
 	Put(ctx context.Context, key, val string, opts ...clientv3.OpOption) (*clientv3.PutResponse, error)
+	// PutIf puts the value if the mod revision of the key is rev, 0 expects the key not exists.
+	// returns false if the revision mismatches.
+	PutIf(ctx context.Context, key, val string, rev int64) (bool, error)
 	Get(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error)
 	Delete(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.DeleteResponse, error)
 	MemberList(ctx context.Context) (*clientv3.MemberListResponse, error)
@@ -34,9 +37,32 @@ type KeyValue interface {
 	Watch(ctx context.Context, key string, opts ...clientv3.OpOption) clientv3.WatchChan
 }
 
-func newEtcd(cfg clientv3.Config) (KeyValue, error) { //nolint
+// metadata backends.
+const (
+	MetadataEtcd     = "etcd"
+	MetadataEmbedded = "embedded"
+)
+
+type etcdKeyValue struct {
+	*clientv3.Client
+}
+
+func newEtcd(cfg clientv3.Config) (KeyValue, error) {
 	etcdEndpoint, err := clientv3.New(cfg)
-	return etcdEndpoint, errors.Wrap(err, "new etcd KeyValue instance")
+	if nil != err {
+		return nil, errors.Wrap(err, "new etcd KeyValue instance")
+	}
+	return &etcdKeyValue{Client: etcdEndpoint}, nil
+}
+
+func (e *etcdKeyValue) PutIf(ctx context.Context, key, val string, rev int64) (bool, error) {
+	resp, err := e.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision(key), "=", rev)).
+		Then(clientv3.OpPut(key, val)).Commit()
+	if nil != err {
+		return false, errors.Wrap(err, "put etcd key conditionally")
+	}
+	return resp.Succeeded, nil
 }
 
 // ---------------------- KeyValue mock.
@@ -54,6 +80,10 @@ func (n *keyValueNoop) Put(ctx context.Context, key, val string, opts ...clientv
 	return &clientv3.PutResponse{}, nil
 }
 
+func (n *keyValueNoop) PutIf(ctx context.Context, key, val string, rev int64) (bool, error) {
+	return true, nil
+}
+
 func (n *keyValueNoop) Get(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error) {
 	return &clientv3.GetResponse{}, xerrors.ErrResourceNotFound
 }
@@ -0,0 +1,658 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dao
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/util"
+	"github.com/tkeel-io/kit/log"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	embeddedLogFile  = "metadata.log"
+	embeddedLockFile = "metadata.lock"
+	embeddedMember   = "embedded"
+
+	// record header: payload length, crc32 of payload.
+	kvHeaderSize = 4 + 4
+	// maxKVRecordSize bounds a record, larger records are corrupted.
+	maxKVRecordSize = 1 << 28
+
+	defaultEmbeddedHistory = 10000
+)
+
+type embeddedKVMetadata struct {
+	// Dir is the data directory, metadata is kept in memory only if empty.
+	Dir string `mapstructure:"dir"`
+	// History is the number of revisions kept for reads at a revision and watches from a revision.
+	History int64 `mapstructure:"history"`
+	// NoSync leaves fsync of the log to the os.
+	NoSync bool `mapstructure:"no_sync"`
+}
+
+// embeddedKV is a single node KeyValue with etcd revision semantics: every write bumps the revision,
+// reads may address a revision within the history, watches replay events from a revision.
+// writes append to a log on disk, compaction drops history and rewrites the log.
+type embeddedKV struct {
+	meta   embeddedKVMetadata
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	lock      sync.Mutex
+	revision  int64
+	compacted int64
+	// keys holds revisions of keys in ascending order, deletions are tombstones of version 0.
+	keys     map[string][]*mvccpb.KeyValue
+	events   []*clientv3.Event
+	watchers map[*kvWatcher]struct{}
+	log      *os.File
+	logSize  int64
+	dirLock  *util.FileLock
+}
+
+func newEmbeddedKV(properties map[string]interface{}) (KeyValue, error) {
+	var meta embeddedKVMetadata
+	if err := mapstructure.WeakDecode(properties, &meta); nil != err {
+		return nil, errors.Wrap(err, "decode metadata.embedded configuration")
+	}
+	if meta.History <= 0 {
+		meta.History = defaultEmbeddedHistory
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	kv := &embeddedKV{
+		meta:     meta,
+		ctx:      ctx,
+		cancel:   cancel,
+		revision: 1,
+		keys:     make(map[string][]*mvccpb.KeyValue),
+		watchers: make(map[*kvWatcher]struct{}),
+	}
+
+	if meta.Dir != "" {
+		if err := kv.load(); nil != err {
+			cancel()
+			return nil, errors.Wrap(err, "load metadata.embedded")
+		}
+	}
+
+	log.L().Info("create metadata.embedded instance", logf.String("dir", meta.Dir),
+		logf.Int64("revision", kv.revision), logf.Int64("compacted", kv.compacted))
+	return kv, nil
+}
+
+func (e *embeddedKV) header() *pb.ResponseHeader {
+	return &pb.ResponseHeader{Revision: e.revision}
+}
+
+func (e *embeddedKV) Close() error {
+	e.cancel()
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if e.log == nil {
+		return nil
+	}
+	err := e.log.Close()
+	if unlockErr := e.dirLock.Unlock(); nil == err {
+		err = unlockErr
+	}
+	e.log = nil
+	return errors.Wrap(err, "close metadata.embedded")
+}
+
+func (e *embeddedKV) Put(ctx context.Context, key, val string, opts ...clientv3.OpOption) (*clientv3.PutResponse, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if err := e.put(key, val); nil != err {
+		return nil, errors.Wrap(err, "put metadata.embedded")
+	}
+	return &clientv3.PutResponse{Header: e.header()}, nil
+}
+
+func (e *embeddedKV) PutIf(ctx context.Context, key, val string, rev int64) (bool, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	var modRev int64
+	if prev := e.at(key, e.revision); prev != nil {
+		modRev = prev.ModRevision
+	}
+	if modRev != rev {
+		return false, nil
+	}
+
+	if err := e.put(key, val); nil != err {
+		return false, errors.Wrap(err, "put metadata.embedded")
+	}
+	return true, nil
+}
+
+func (e *embeddedKV) put(key, val string) error {
+	if err := e.ctx.Err(); nil != err {
+		return err
+	}
+
+	rev := e.revision + 1
+	kv := &mvccpb.KeyValue{Key: []byte(key), Value: []byte(val), CreateRevision: rev, ModRevision: rev, Version: 1}
+	if prev := e.at(key, e.revision); prev != nil {
+		kv.CreateRevision, kv.Version = prev.CreateRevision, prev.Version+1
+	}
+	return e.apply(rev, []*clientv3.Event{{Type: mvccpb.PUT, Kv: kv}})
+}
+
+func (e *embeddedKV) Get(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error) {
+	op := clientv3.OpGet(key, opts...)
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	rev := op.Rev()
+	switch {
+	case rev <= 0:
+		rev = e.revision
+	case rev > e.revision:
+		return nil, rpctypes.ErrFutureRev
+	case rev < e.compacted:
+		return nil, rpctypes.ErrCompacted
+	}
+
+	kvs := e.rangeAt(op.KeyBytes(), op.RangeBytes(), rev)
+	resp := &clientv3.GetResponse{Header: e.header(), Count: int64(len(kvs))}
+	if op.IsCountOnly() {
+		return resp, nil
+	}
+	for _, kv := range kvs {
+		ret := *kv
+		if op.IsKeysOnly() {
+			ret.Value = nil
+		}
+		resp.Kvs = append(resp.Kvs, &ret)
+	}
+	return resp, nil
+}
+
+func (e *embeddedKV) Delete(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.DeleteResponse, error) {
+	op := clientv3.OpDelete(key, opts...)
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if err := e.ctx.Err(); nil != err {
+		return nil, errors.Wrap(err, "delete metadata.embedded")
+	}
+
+	kvs := e.rangeAt(op.KeyBytes(), op.RangeBytes(), e.revision)
+	if len(kvs) == 0 {
+		return &clientv3.DeleteResponse{Header: e.header()}, nil
+	}
+
+	rev := e.revision + 1
+	events := make([]*clientv3.Event, len(kvs))
+	for index, kv := range kvs {
+		events[index] = &clientv3.Event{Type: mvccpb.DELETE, Kv: &mvccpb.KeyValue{Key: kv.Key, ModRevision: rev}}
+	}
+	if err := e.apply(rev, events); nil != err {
+		return nil, errors.Wrap(err, "delete metadata.embedded")
+	}
+	return &clientv3.DeleteResponse{Header: e.header(), Deleted: int64(len(kvs))}, nil
+}
+
+func (e *embeddedKV) MemberList(ctx context.Context) (*clientv3.MemberListResponse, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return &clientv3.MemberListResponse{
+		Header:  e.header(),
+		Members: []*pb.Member{{Name: embeddedMember, ClientURLs: []string{embeddedMember}}},
+	}, nil
+}
+
+func (e *embeddedKV) Status(ctx context.Context, endpoint string) (*clientv3.StatusResponse, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return &clientv3.StatusResponse{Header: e.header(), Version: embeddedMember}, nil
+}
+
+// Watch watches keys from the revision of the options, events since the revision are replayed first.
+// the channel is closed when ctx is done, watching a compacted revision is canceled.
+func (e *embeddedKV) Watch(ctx context.Context, key string, opts ...clientv3.OpOption) clientv3.WatchChan {
+	op := clientv3.OpGet(key, opts...)
+	w := &kvWatcher{
+		key:    op.KeyBytes(),
+		end:    op.RangeBytes(),
+		ch:     make(chan clientv3.WatchResponse),
+		notify: make(chan struct{}, 1),
+	}
+
+	e.lock.Lock()
+	if rev := op.Rev(); rev > 0 && rev <= e.compacted {
+		w.push(clientv3.WatchResponse{Header: *e.header(), CompactRevision: e.compacted, Canceled: true})
+		w.canceled = true
+	} else {
+		if rev > 0 {
+			e.replay(w, rev)
+		}
+		e.watchers[w] = struct{}{}
+	}
+	e.lock.Unlock()
+
+	go func() {
+		w.run(ctx, e.ctx)
+		e.lock.Lock()
+		delete(e.watchers, w)
+		e.lock.Unlock()
+	}()
+	return w.ch
+}
+
+// replay pushes events from the revision to the watcher, one response per revision.
+func (e *embeddedKV) replay(w *kvWatcher, rev int64) {
+	index := sort.Search(len(e.events), func(i int) bool {
+		return e.events[i].Kv.ModRevision >= rev
+	})
+
+	var resp *clientv3.WatchResponse
+	for ; index < len(e.events); index++ {
+		ev := e.events[index]
+		if !inRange(ev.Kv.Key, w.key, w.end) {
+			continue
+		}
+		if resp != nil && resp.Header.Revision != ev.Kv.ModRevision {
+			w.push(*resp)
+			resp = nil
+		}
+		if resp == nil {
+			resp = &clientv3.WatchResponse{Header: pb.ResponseHeader{Revision: ev.Kv.ModRevision}}
+		}
+		resp.Events = append(resp.Events, ev)
+	}
+	if resp != nil {
+		w.push(*resp)
+	}
+}
+
+// at returns the key value of the key at the revision, nil if the key does not exist.
+func (e *embeddedKV) at(key string, rev int64) *mvccpb.KeyValue {
+	revs := e.keys[key]
+	for index := len(revs) - 1; index >= 0; index-- {
+		if revs[index].ModRevision <= rev {
+			if revs[index].Version == 0 {
+				return nil
+			}
+			return revs[index]
+		}
+	}
+	return nil
+}
+
+// rangeAt returns key values in the range at the revision, ordered by key.
+func (e *embeddedKV) rangeAt(key, end []byte, rev int64) []*mvccpb.KeyValue {
+	var kvs []*mvccpb.KeyValue
+	if len(end) == 0 {
+		if kv := e.at(string(key), rev); kv != nil {
+			kvs = append(kvs, kv)
+		}
+		return kvs
+	}
+
+	for k := range e.keys {
+		if inRange([]byte(k), key, end) {
+			if kv := e.at(k, rev); kv != nil {
+				kvs = append(kvs, kv)
+			}
+		}
+	}
+	sort.Slice(kvs, func(i, j int) bool {
+		return bytes.Compare(kvs[i].Key, kvs[j].Key) < 0
+	})
+	return kvs
+}
+
+// inRange reports whether k is in [key, end), a nil end matches key only, "\x00" end matches keys from key.
+func inRange(k, key, end []byte) bool {
+	switch {
+	case len(end) == 0:
+		return bytes.Equal(k, key)
+	case len(end) == 1 && end[0] == 0:
+		return bytes.Compare(k, key) >= 0
+	}
+	return bytes.Compare(k, key) >= 0 && bytes.Compare(k, end) < 0
+}
+
+// apply persists events of the revision, applies them and notifies watchers.
+func (e *embeddedKV) apply(rev int64, events []*clientv3.Event) error {
+	if e.log != nil {
+		if err := e.appendLog(events); nil != err {
+			return err
+		}
+	}
+
+	e.revision = rev
+	for _, ev := range events {
+		e.keys[string(ev.Kv.Key)] = append(e.keys[string(ev.Kv.Key)], ev.Kv)
+		e.events = append(e.events, ev)
+	}
+
+	for w := range e.watchers {
+		var resp *clientv3.WatchResponse
+		for _, ev := range events {
+			if inRange(ev.Kv.Key, w.key, w.end) {
+				if resp == nil {
+					resp = &clientv3.WatchResponse{Header: pb.ResponseHeader{Revision: rev}}
+				}
+				resp.Events = append(resp.Events, ev)
+			}
+		}
+		if resp != nil {
+			w.push(*resp)
+		}
+	}
+
+	if e.revision-e.compacted > 2*e.meta.History {
+		if err := e.compact(e.revision - e.meta.History); nil != err {
+			log.L().Warn("compact metadata.embedded", logf.Int64("revision", e.revision), logf.Error(err))
+		}
+	}
+	return nil
+}
+
+// compact drops history before the revision, reads and watches of earlier revisions fail.
+func (e *embeddedKV) compact(rev int64) error {
+	for key, revs := range e.keys {
+		// keep the latest revision at rev, and revisions after.
+		index := sort.Search(len(revs), func(i int) bool {
+			return revs[i].ModRevision > rev
+		})
+		if index > 0 && revs[index-1].Version > 0 {
+			index--
+		}
+		if index == len(revs) {
+			delete(e.keys, key)
+			continue
+		}
+		e.keys[key] = append([]*mvccpb.KeyValue(nil), revs[index:]...)
+	}
+
+	index := sort.Search(len(e.events), func(i int) bool {
+		return e.events[i].Kv.ModRevision > rev
+	})
+	e.events = append([]*clientv3.Event(nil), e.events[index:]...)
+	e.compacted = rev
+
+	if e.log != nil {
+		return e.rewriteLog()
+	}
+	return nil
+}
+
+// ---------------------- log.
+
+// encodeKVRecord encodes an event, the header record is an event of empty key,
+// carrying the compacted revision and revision as create and mod revision.
+func encodeKVRecord(ev *clientv3.Event) ([]byte, error) {
+	payload, err := (*mvccpb.Event)(ev).Marshal()
+	if nil != err {
+		return nil, errors.Wrap(err, "encode metadata record")
+	}
+	buf := make([]byte, kvHeaderSize+len(payload))
+	binary.BigEndian.PutUint32(buf[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(buf[4:8], crc32.ChecksumIEEE(payload))
+	copy(buf[kvHeaderSize:], payload)
+	return buf, nil
+}
+
+// decodeKVRecord decodes a record, on errors the size is the size the record claims.
+func decodeKVRecord(reader io.Reader) (*clientv3.Event, int64, error) {
+	var header [kvHeaderSize]byte
+	if _, err := io.ReadFull(reader, header[:]); nil != err {
+		return nil, kvHeaderSize, err //nolint
+	}
+
+	size := binary.BigEndian.Uint32(header[0:4])
+	recordSize := int64(kvHeaderSize) + int64(size)
+	if size > maxKVRecordSize {
+		return nil, recordSize, errors.Errorf("metadata record size %d exceeds limit", size)
+	}
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(reader, payload); nil != err {
+		return nil, recordSize, io.ErrUnexpectedEOF
+	} else if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(header[4:8]) {
+		return nil, recordSize, errors.New("metadata record checksum mismatch")
+	}
+
+	var ev mvccpb.Event
+	if err := ev.Unmarshal(payload); nil != err || ev.Kv == nil {
+		return nil, recordSize, errors.New("metadata record malformed")
+	}
+	return (*clientv3.Event)(&ev), recordSize, nil
+}
+
+func (e *embeddedKV) appendLog(events []*clientv3.Event) error {
+	var buf []byte
+	for _, ev := range events {
+		record, err := encodeKVRecord(ev)
+		if nil != err {
+			return err
+		}
+		buf = append(buf, record...)
+	}
+
+	if _, err := e.log.Write(buf); nil != err {
+		// drop the partial record, so that later records are not lost behind it.
+		if terr := e.log.Truncate(e.logSize); nil == terr {
+			e.log.Seek(e.logSize, io.SeekStart)
+		}
+		return errors.Wrap(err, "append metadata log")
+	}
+
+	e.logSize += int64(len(buf))
+	if !e.meta.NoSync {
+		return errors.Wrap(e.log.Sync(), "sync metadata log")
+	}
+	return nil
+}
+
+// load locks the data directory and replays the log, a torn record at the tail is truncated,
+// a corrupted record before the tail fails the load.
+func (e *embeddedKV) load() error {
+	if err := os.MkdirAll(e.meta.Dir, 0o755); nil != err {
+		return errors.Wrap(err, "create metadata dir")
+	}
+
+	// the log has a single writer across processes.
+	dirLock, err := util.LockDir(e.meta.Dir, embeddedLockFile)
+	if nil != err {
+		return errors.Wrap(err, "lock metadata dir")
+	}
+	if err = e.replayLog(); nil != err {
+		dirLock.Unlock()
+		return err
+	}
+	e.dirLock = dirLock
+	return nil
+}
+
+func (e *embeddedKV) replayLog() error {
+	path := filepath.Join(e.meta.Dir, embeddedLogFile)
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o644)
+	if nil != err {
+		return errors.Wrap(err, "open metadata log")
+	}
+	info, err := f.Stat()
+	if nil != err {
+		f.Close()
+		return errors.Wrap(err, "stat metadata log")
+	}
+
+	var offset int64
+	reader := bufio.NewReader(f)
+	for {
+		ev, size, err := decodeKVRecord(reader)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if nil != err && offset+size < info.Size() {
+			// only the last record is torn by a crash, records before it are corrupted.
+			log.L().Error("corrupted metadata log", logf.String("path", path),
+				logf.Int64("offset", offset), logf.Error(err))
+			f.Close()
+			return errors.Wrapf(err, "corrupted metadata record at %d", offset)
+		} else if nil != err {
+			log.L().Warn("truncate torn metadata log", logf.String("path", path),
+				logf.Int64("offset", offset), logf.Error(err))
+			if err = f.Truncate(offset); nil != err {
+				f.Close()
+				return errors.Wrap(err, "truncate metadata log")
+			}
+			break
+		}
+		offset += size
+
+		if len(ev.Kv.Key) == 0 {
+			e.compacted, e.revision = ev.Kv.CreateRevision, ev.Kv.ModRevision
+			continue
+		}
+		e.keys[string(ev.Kv.Key)] = append(e.keys[string(ev.Kv.Key)], ev.Kv)
+		if ev.Kv.ModRevision > e.compacted {
+			e.events = append(e.events, ev)
+		}
+		if ev.Kv.ModRevision > e.revision {
+			e.revision = ev.Kv.ModRevision
+		}
+	}
+
+	if _, err = f.Seek(offset, io.SeekStart); nil != err {
+		f.Close()
+		return errors.Wrap(err, "seek metadata log")
+	}
+	e.log, e.logSize = f, offset
+	return nil
+}
+
+// rewriteLog writes the retained revisions into a new log, and replaces the log with it.
+func (e *embeddedKV) rewriteLog() error {
+	kvs := make([]*mvccpb.KeyValue, 0, len(e.keys))
+	for _, revs := range e.keys {
+		kvs = append(kvs, revs...)
+	}
+	sort.Slice(kvs, func(i, j int) bool {
+		return kvs[i].ModRevision < kvs[j].ModRevision
+	})
+
+	events := []*clientv3.Event{{Kv: &mvccpb.KeyValue{CreateRevision: e.compacted, ModRevision: e.revision}}}
+	for _, kv := range kvs {
+		typ := mvccpb.PUT
+		if kv.Version == 0 {
+			typ = mvccpb.DELETE
+		}
+		events = append(events, &clientv3.Event{Type: typ, Kv: kv})
+	}
+
+	path := filepath.Join(e.meta.Dir, embeddedLogFile)
+	f, err := os.OpenFile(path+".tmp", os.O_CREATE|os.O_TRUNC|os.O_RDWR, 0o644)
+	if nil != err {
+		return errors.Wrap(err, "rewrite metadata log")
+	}
+
+	prev, prevSize := e.log, e.logSize
+	e.log, e.logSize = f, 0
+	if err = e.appendLog(events); nil == err {
+		err = f.Sync()
+	}
+	if nil == err {
+		err = os.Rename(path+".tmp", path)
+	}
+	if nil != err {
+		e.log, e.logSize = prev, prevSize
+		f.Close()
+		os.Remove(path + ".tmp")
+		return errors.Wrap(err, "rewrite metadata log")
+	}
+
+	prev.Close()
+	return nil
+}
+
+// ---------------------- watcher.
+
+// kvWatcher buffers responses, so that writes never block on slow watchers.
+type kvWatcher struct {
+	key, end []byte
+	ch       chan clientv3.WatchResponse
+	notify   chan struct{}
+	canceled bool
+
+	lock    sync.Mutex
+	pending []clientv3.WatchResponse
+}
+
+func (w *kvWatcher) push(resp clientv3.WatchResponse) {
+	w.lock.Lock()
+	w.pending = append(w.pending, resp)
+	w.lock.Unlock()
+
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (w *kvWatcher) run(ctx, kvCtx context.Context) {
+	defer close(w.ch)
+	for {
+		w.lock.Lock()
+		pending := w.pending
+		w.pending = nil
+		w.lock.Unlock()
+
+		for _, resp := range pending {
+			select {
+			case w.ch <- resp:
+			case <-ctx.Done():
+				return
+			case <-kvCtx.Done():
+				return
+			}
+		}
+
+		if w.canceled {
+			return
+		}
+
+		select {
+		case <-w.notify:
+		case <-ctx.Done():
+			return
+		case <-kvCtx.Done():
+			return
+		}
+	}
+}
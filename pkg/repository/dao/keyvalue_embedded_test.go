@@ -0,0 +1,208 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dao
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	_ "github.com/tkeel-io/core/pkg/resource/store/noop"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+func TestEmbeddedKV(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	kv, err := newEmbeddedKV(map[string]interface{}{"dir": dir})
+	assert.Nil(t, err)
+
+	_, err = kv.Put(ctx, "/core/v1/expressions/admin/device123/expr1", "v1")
+	assert.Nil(t, err)
+	_, err = kv.Put(ctx, "/core/v1/expressions/admin/device123/expr2", "v2")
+	assert.Nil(t, err)
+	resp, err := kv.Put(ctx, "/core/v1/expressions/admin/device123/expr1", "v3")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), resp.Header.Revision)
+
+	ret, err := kv.Get(ctx, "/core/v1/expressions/admin", clientv3.WithPrefix())
+	assert.Nil(t, err)
+	assert.Len(t, ret.Kvs, 2)
+	assert.Equal(t, "v3", string(ret.Kvs[0].Value))
+	assert.Equal(t, int64(2), ret.Kvs[0].Version)
+
+	// reads at a revision.
+	ret, err = kv.Get(ctx, "/core/v1/expressions/admin/device123/expr1", clientv3.WithRev(2))
+	assert.Nil(t, err)
+	assert.Equal(t, "v1", string(ret.Kvs[0].Value))
+	_, err = kv.Get(ctx, "/core/v1", clientv3.WithRev(5))
+	assert.ErrorIs(t, err, rpctypes.ErrFutureRev)
+
+	del, err := kv.Delete(ctx, "/core/v1/expressions/admin", clientv3.WithPrefix())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), del.Deleted)
+	ret, err = kv.Get(ctx, "/core/v1/expressions/admin", clientv3.WithPrefix())
+	assert.Nil(t, err)
+	assert.Len(t, ret.Kvs, 0)
+	assert.Equal(t, int64(5), ret.Header.Revision)
+
+	// state survives restart, a torn record at the tail is dropped.
+	assert.Nil(t, kv.Close())
+	f, err := os.OpenFile(filepath.Join(dir, embeddedLogFile), os.O_APPEND|os.O_WRONLY, 0o644)
+	assert.Nil(t, err)
+	record, _ := encodeKVRecord(&clientv3.Event{Kv: &mvccpb.KeyValue{Key: []byte("torn"), ModRevision: 6, Version: 1}})
+	_, err = f.Write(record[:len(record)-1])
+	assert.Nil(t, err)
+	f.Close()
+
+	kv, err = newEmbeddedKV(map[string]interface{}{"dir": dir})
+	assert.Nil(t, err)
+	defer kv.Close()
+	status, err := kv.Status(ctx, embeddedMember)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), status.Header.Revision)
+	ret, err = kv.Get(ctx, "/core/v1/expressions/admin/device123/expr1", clientv3.WithRev(4))
+	assert.Nil(t, err)
+	assert.Equal(t, "v3", string(ret.Kvs[0].Value))
+}
+
+func TestEmbeddedKV_Corrupted(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	kv, err := newEmbeddedKV(map[string]interface{}{"dir": dir})
+	assert.Nil(t, err)
+	_, err = kv.Put(ctx, "/core/v1/expressions/admin/device123/expr1", "v1")
+	assert.Nil(t, err)
+	_, err = kv.Put(ctx, "/core/v1/expressions/admin/device123/expr2", "v2")
+	assert.Nil(t, err)
+
+	// the data directory is locked by the open instance.
+	_, err = newEmbeddedKV(map[string]interface{}{"dir": dir})
+	assert.NotNil(t, err)
+	assert.Nil(t, kv.Close())
+
+	// a corrupted record before the tail is not truncated.
+	path := filepath.Join(dir, embeddedLogFile)
+	data, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	data[kvHeaderSize] ^= 0xff
+	assert.Nil(t, os.WriteFile(path, data, 0o644))
+	_, err = newEmbeddedKV(map[string]interface{}{"dir": dir})
+	assert.NotNil(t, err)
+	info, err := os.Stat(path)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(data)), info.Size())
+}
+
+func TestEmbeddedKV_Watch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	kv, err := newEmbeddedKV(map[string]interface{}{"history": 2})
+	assert.Nil(t, err)
+	defer kv.Close()
+
+	kv.Put(ctx, "/core/v1/subscription/admin/sub1", "v1")
+	kv.Put(ctx, "/core/v1/expressions/admin/expr1", "v1")
+
+	// events from the revision are replayed, then live events follow.
+	watchCh := kv.Watch(ctx, "/core/v1/subscription", clientv3.WithPrefix(), clientv3.WithRev(2))
+	wr := <-watchCh
+	assert.Equal(t, int64(2), wr.Header.Revision)
+	assert.Equal(t, "/core/v1/subscription/admin/sub1", string(wr.Events[0].Kv.Key))
+
+	kv.Delete(ctx, "/core/v1/subscription/admin/sub1")
+	wr = <-watchCh
+	assert.Equal(t, mvccpb.DELETE, wr.Events[0].Type)
+
+	// history is compacted, watches from compacted revisions are canceled.
+	for index := 0; index < 4; index++ {
+		kv.Put(ctx, "/core/v1/expressions/admin/expr1", "v2")
+	}
+	_, err = kv.Get(ctx, "/core/v1", clientv3.WithPrefix(), clientv3.WithRev(2))
+	assert.ErrorIs(t, err, rpctypes.ErrCompacted)
+	wr = <-kv.Watch(ctx, "/core/v1", clientv3.WithPrefix(), clientv3.WithRev(2))
+	assert.True(t, wr.Canceled)
+
+	cancel()
+	select {
+	case _, ok := <-watchCh:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("watch channel not closed")
+	}
+}
+
+func TestDao_EmbeddedMetadata(t *testing.T) {
+	ctx := context.Background()
+	d, err := New(ctx, config.Metadata{Name: "noop"},
+		config.Metadata{Name: MetadataEmbedded}, config.EtcdConfig{})
+	assert.Nil(t, err)
+	defer d.Close()
+
+	rev := d.GetLastRevision(ctx)
+	assert.Equal(t, int64(1), rev)
+
+	done := make(chan EnventType, 1)
+	go d.WatchResource(ctx, rev, "/core/v1/", func(et EnventType, kv *mvccpb.KeyValue) {
+		done <- et
+	})
+
+	assert.Nil(t, d.PutResource(ctx, &keyResource{key: "/core/v1/schema/admin/schema1"}))
+	assert.Equal(t, PUT, <-done)
+
+	ress, err := d.ListResource(ctx, 0, "/core/v1/schema/admin", func(key, bytes []byte) (Resource, error) {
+		return &keyResource{key: string(key)}, nil
+	})
+	assert.Nil(t, err)
+	assert.Len(t, ress, 1)
+
+	// resources are created once.
+	assert.Nil(t, d.CreateResource(ctx, &keyResource{key: "/core/v1/schema/admin/schema2"}))
+	assert.ErrorIs(t, d.CreateResource(ctx, &keyResource{key: "/core/v1/schema/admin/schema2"}),
+		xerrors.ErrResourceAlreadyExists)
+
+	// leases are held by one holder until they expire.
+	acquired, err := d.AcquireLease(ctx, "manager", "node1", time.Minute)
+	assert.Nil(t, err)
+	assert.True(t, acquired)
+	acquired, _ = d.AcquireLease(ctx, "manager", "node1", time.Minute)
+	assert.True(t, acquired)
+	acquired, _ = d.AcquireLease(ctx, "manager", "node2", time.Minute)
+	assert.False(t, acquired)
+	acquired, _ = d.AcquireLease(ctx, "manager", "node1", -time.Minute)
+	assert.True(t, acquired)
+	acquired, _ = d.AcquireLease(ctx, "manager", "node2", time.Minute)
+	assert.True(t, acquired)
+
+	_, err = New(ctx, config.Metadata{Name: "noop"}, config.Metadata{Name: "zookeeper"}, config.EtcdConfig{})
+	assert.NotNil(t, err)
+}
+
+type keyResource struct {
+	key string
+}
+
+func (r *keyResource) EncodeKey() ([]byte, error)     { return []byte(r.key), nil }
+func (r *keyResource) Encode() ([]byte, error)        { return []byte(`{}`), nil }
+func (r *keyResource) Decode(key, bytes []byte) error { return nil }
@@ -0,0 +1,64 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+)
+
+// LeasePrefix is the prefix of leases, a lease is held by one holder until it expires.
+const LeasePrefix = "/core/v1/leases/"
+
+type lease struct {
+	Holder string `json:"holder"`
+	// Expires is unix milliseconds.
+	Expires int64 `json:"expires"`
+}
+
+// AcquireLease acquires or renews the lease for the holder with compare-and-swap,
+// returns false if the lease is held by another holder and not expired.
+func (d *Dao) AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+	key := LeasePrefix + name
+	ret, err := d.etcdEndpoint.Get(ctx, key)
+	if nil != err && !errors.Is(err, xerrors.ErrResourceNotFound) {
+		return false, errors.Wrap(err, "acquire lease")
+	}
+
+	var rev int64
+	now := time.Now()
+	if nil == err && len(ret.Kvs) > 0 {
+		var current lease
+		if err = json.Unmarshal(ret.Kvs[0].Value, &current); nil == err &&
+			current.Holder != holder && current.Expires > now.UnixMilli() {
+			return false, nil
+		}
+		rev = ret.Kvs[0].ModRevision
+	}
+
+	bytes, err := json.Marshal(&lease{Holder: holder, Expires: now.Add(ttl).UnixMilli()})
+	if nil != err {
+		return false, errors.Wrap(err, "acquire lease")
+	}
+
+	acquired, err := d.etcdEndpoint.PutIf(ctx, key, string(bytes), rev)
+	return acquired, errors.Wrap(err, "acquire lease")
+}
@@ -52,15 +52,71 @@ func (d *Dao) GetStoreResource(ctx context.Context, res Resource) (Resource, err
 		return res, errors.Wrap(err, "dao store get entity")
 	}
 
-	if item, err = d.stateClient.Get(ctx, string(key)); nil == err {
-		if len(item.Value) == 0 {
-			return nil, xerrors.ErrResourceNotFound
-		}
+	if item, err = d.getState(ctx, string(key)); nil == err {
 		err = res.Decode([]byte(item.Key), item.Value)
 	}
 	return res, errors.Wrap(err, "dao store get entity")
 }
 
+// getState reads the state of the key, errors.ErrResourceNotFound is returned if the state not exists,
+// stores report missing state as empty values or errors.ErrEntityNotFound (dapr).
+func (d *Dao) getState(ctx context.Context, key string) (*store.StateItem, error) {
+	item, err := d.stateClient.Get(ctx, key)
+	switch {
+	case errors.Is(err, xerrors.ErrEntityNotFound):
+		return nil, xerrors.ErrResourceNotFound
+	case nil == err && len(item.Value) == 0:
+		return nil, xerrors.ErrResourceNotFound
+	}
+	return item, err
+}
+
+// maxUpdateAttempts bounds retries of UpdateStoreResource on conflicts.
+const maxUpdateAttempts = 8
+
+// UpdateStoreResource reads the resource, updates it and writes it back with compare-and-swap,
+// update is called again with the latest state on conflicts, exists is false if the resource not exists.
+// stores without etags write the resource unconditionally.
+func (d *Dao) UpdateStoreResource(ctx context.Context, res Resource, update func(exists bool) error) error {
+	key, err := res.EncodeKey()
+	if nil != err {
+		return errors.Wrap(err, "dao store update")
+	}
+
+	setter, versioned := d.stateClient.(store.EtagSetter)
+	for attempt := 0; attempt < maxUpdateAttempts; attempt++ {
+		etag := store.EtagNotExists
+		item, err := d.getState(ctx, string(key))
+		switch {
+		case nil == err:
+			if err = res.Decode([]byte(item.Key), item.Value); nil != err {
+				return errors.Wrap(err, "dao store update")
+			}
+			etag = item.Etag
+		case errors.Is(err, xerrors.ErrResourceNotFound):
+		default:
+			return errors.Wrap(err, "dao store update")
+		}
+
+		if err = update(etag != store.EtagNotExists); nil != err {
+			return errors.Wrap(err, "dao store update")
+		}
+
+		data, err := res.Encode()
+		if nil != err {
+			return errors.Wrap(err, "dao store update")
+		} else if !versioned {
+			return errors.Wrap(d.stateClient.Set(ctx, string(key), data), "dao store update")
+		}
+
+		if _, err = setter.SetWithEtag(ctx, string(key), data, etag); !errors.Is(err, xerrors.ErrEtagMismatch) {
+			return errors.Wrap(err, "dao store update")
+		}
+	}
+
+	return errors.Wrap(xerrors.ErrEtagMismatch, "dao store update")
+}
+
 func (d *Dao) RemoveStoreResource(ctx context.Context, res Resource) error {
 	key, err := res.EncodeKey()
 	if nil != err {
@@ -74,3 +130,17 @@ func (d *Dao) RemoveStoreResource(ctx context.Context, res Resource) error {
 func (d *Dao) FlushStoreResource(ctx context.Context) error {
 	return d.stateClient.Flush(ctx)
 }
+
+// ScanStoreResource calls the handler with resources of the prefix, returns errors.ErrScanUnsupported
+// if the state store does not enumerate state.
+func (d *Dao) ScanStoreResource(ctx context.Context, prefix string, handler func(key, value []byte) error) error {
+	scanner, ok := d.stateClient.(store.Scanner)
+	if !ok {
+		return errors.Wrap(xerrors.ErrScanUnsupported, "dao store scan")
+	}
+
+	err := scanner.Scan(ctx, prefix, func(key string, value []byte) error {
+		return handler([]byte(key), value)
+	})
+	return errors.Wrap(err, "dao store scan")
+}
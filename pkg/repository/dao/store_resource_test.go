@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dao
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core/pkg/config"
+	_ "github.com/tkeel-io/core/pkg/resource/store/memory"
+)
+
+func TestDao_UpdateStoreResource(t *testing.T) {
+	ctx := context.Background()
+	d, err := NewMock(ctx, config.Metadata{Name: "memory"}, config.EtcdConfig{})
+	assert.Nil(t, err)
+
+	res := &valueResource{key: "CORE.COUNTER"}
+	increase := func(exists bool) error {
+		if !exists {
+			res.value = 0
+		}
+		res.value++
+		return nil
+	}
+	assert.Nil(t, d.UpdateStoreResource(ctx, res, increase))
+	assert.Nil(t, d.UpdateStoreResource(ctx, res, increase))
+
+	// the update is retried with the latest state on conflicts.
+	var attempts int
+	assert.Nil(t, d.UpdateStoreResource(ctx, res, func(exists bool) error {
+		if attempts++; attempts == 1 {
+			assert.Nil(t, d.StoreResource(ctx, &valueResource{key: "CORE.COUNTER", value: 10}))
+		}
+		return increase(exists)
+	}))
+	assert.Equal(t, 2, attempts)
+
+	ret, err := d.GetStoreResource(ctx, &valueResource{key: "CORE.COUNTER"})
+	assert.Nil(t, err)
+	assert.Equal(t, 11, ret.(*valueResource).value)
+}
+
+type valueResource struct {
+	key   string
+	value int
+}
+
+func (r *valueResource) EncodeKey() ([]byte, error) { return []byte(r.key), nil }
+func (r *valueResource) Encode() ([]byte, error)    { return []byte(strconv.Itoa(r.value)), nil }
+func (r *valueResource) Decode(key, bytes []byte) (err error) {
+	r.value, err = strconv.Atoi(string(bytes))
+	return err
+}
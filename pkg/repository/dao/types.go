@@ -18,6 +18,7 @@ package dao
 
 import (
 	"context"
+	"time"
 
 	"go.etcd.io/etcd/api/v3/mvccpb"
 )
@@ -48,6 +49,7 @@ type IDao interface {
 	GetLastRevision(ctx context.Context) int64
 	// resource etcd interfaces.
 	PutResource(ctx context.Context, res Resource) error
+	CreateResource(ctx context.Context, res Resource) error
 	GetResource(ctx context.Context, res Resource) (Resource, error)
 	DelResource(ctx context.Context, res Resource) error
 	DelResources(ctx context.Context, prefix string) error
@@ -59,6 +61,15 @@ type IDao interface {
 	// resource store interfaces.
 	StoreResource(ctx context.Context, res Resource) error
 	GetStoreResource(ctx context.Context, res Resource) (Resource, error)
+	UpdateStoreResource(ctx context.Context, res Resource, update func(exists bool) error) error
 	RemoveStoreResource(ctx context.Context, res Resource) error
 	FlushStoreResource(ctx context.Context) error
+	ScanStoreResource(ctx context.Context, prefix string, handler func(key, value []byte) error) error
+
+	// leases of the metadata backend.
+	AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error)
+
+	// health checks of the metadata backend and the state store.
+	CheckMetadata(ctx context.Context) error
+	CheckStore(ctx context.Context) error
 }
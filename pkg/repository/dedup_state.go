@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+const DedupStateStorePrefix = "CORE.IDEMPOTENCY"
+
+// dedupStateResource holds results of writes with idempotency keys of an entity.
+type dedupStateResource struct {
+	id   string
+	data []byte
+}
+
+func (d *dedupStateResource) EncodeKey() ([]byte, error) {
+	return []byte(DedupStateStorePrefix + "." + d.id), nil
+}
+
+func (d *dedupStateResource) Encode() ([]byte, error) {
+	return d.data, nil
+}
+
+func (d *dedupStateResource) Decode(key, bytes []byte) error {
+	d.data = bytes
+	return nil
+}
+
+func (r *repo) PutDedupState(ctx context.Context, entityID string, data []byte) error {
+	err := r.dao.StoreResource(ctx, &dedupStateResource{id: entityID, data: data})
+	return errors.Wrap(err, "put dedup state repository")
+}
+
+func (r *repo) GetDedupState(ctx context.Context, entityID string) ([]byte, error) {
+	ret, err := r.dao.GetStoreResource(ctx, &dedupStateResource{id: entityID})
+
+	res, _ := ret.(*dedupStateResource)
+	if nil == res {
+		return nil, errors.Wrap(err, "get dedup state repository")
+	}
+	return res.data, errors.Wrap(err, "get dedup state repository")
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/pkg/errors"
 	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/tenant"
 	"github.com/tkeel-io/tdtl"
 )
 
@@ -93,13 +94,18 @@ func (e *Entity) JSON() string {
 	return string(bytes)
 }
 
+// entityResource is the entity state, keyed by tenant and entity id.
 type entityResource struct {
-	id   string
-	data []byte
+	tenant string
+	id     string
+	data   []byte
 }
 
 func (e *entityResource) EncodeKey() ([]byte, error) {
-	return []byte(EntityStorePrefix + "." + e.id), nil
+	if e.tenant == "" {
+		return []byte(EntityStorePrefix + "." + e.id), nil
+	}
+	return []byte(EntityStorePrefix + "." + e.tenant + "." + e.id), nil
 }
 
 func (e *entityResource) Encode() ([]byte, error) {
@@ -112,28 +118,68 @@ func (e *entityResource) Decode(key, bytes []byte) error {
 }
 
 func (r *repo) PutEntity(ctx context.Context, eid string, data []byte) error {
-	err := r.dao.StoreResource(ctx, &entityResource{id: eid, data: data})
+	tenantID := tenant.FromState(data)
+	if err := r.storeEntityState(ctx, &entityResource{tenant: tenantID, id: eid, data: data}); nil != err {
+		return errors.Wrap(err, "put entity repository")
+	} else if tenantID == "" {
+		return nil
+	}
+
+	err := r.locateEntity(ctx, eid, tenantID, int64(len(data)))
 	return errors.Wrap(err, "put entity repository")
 }
 
+// FlushEntity writes batched tenant usage and flushes the state store.
 func (r *repo) FlushEntity(ctx context.Context) error {
+	if err := r.flushTenantUsage(ctx); nil != err {
+		return errors.Wrap(err, "flush entity repository")
+	}
 	return r.dao.FlushStoreResource(ctx)
 }
 
 func (r *repo) GetEntity(ctx context.Context, eid string) ([]byte, error) {
-	ret, err := r.dao.GetStoreResource(ctx, &entityResource{id: eid})
+	loc, err := r.getEntityLocator(ctx, eid)
+	if nil != err {
+		return nil, errors.Wrap(err, "get entity repository")
+	}
+
+	res := &entityResource{id: eid}
+	if loc != nil {
+		res.tenant = loc.Tenant
+	}
+
+	if _, err = r.dao.GetStoreResource(ctx, res); nil != err {
+		return nil, errors.Wrap(err, "get entity repository")
+	}
 
-	res, _ := ret.(*entityResource)
-	return res.data, errors.Wrap(err, "get entity repository")
+	data, err := r.loadEntityState(ctx, res)
+	return data, errors.Wrap(err, "get entity repository")
 }
 
 func (r *repo) DelEntity(ctx context.Context, eid string) error {
-	err := r.dao.RemoveStoreResource(ctx, &entityResource{id: eid})
+	loc, err := r.getEntityLocator(ctx, eid)
+	if nil != err {
+		return errors.Wrap(err, "del entity repository")
+	}
+
+	if loc != nil {
+		if err = r.removeEntityState(ctx, &entityResource{tenant: loc.Tenant, id: eid}); nil != err {
+			return errors.Wrap(err, "del entity repository")
+		} else if err = r.unlocateEntity(ctx, loc); nil != err {
+			return errors.Wrap(err, "del entity repository")
+		}
+	}
+
+	if err = r.dao.RemoveStoreResource(ctx, &dedupStateResource{id: eid}); nil != err {
+		return errors.Wrap(err, "del entity repository")
+	}
+
+	err = r.removeEntityState(ctx, &entityResource{id: eid})
 	return errors.Wrap(err, "del entity repository")
 }
 
 func (r *repo) HasEntity(ctx context.Context, eid string) (bool, error) {
-	_, err := r.dao.GetStoreResource(ctx, &entityResource{id: eid})
+	_, err := r.GetEntity(ctx, eid)
 	if nil != err {
 		if errors.Is(err, xerrors.ErrResourceNotFound) {
 			return false, nil
@@ -0,0 +1,335 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"hash/fnv"
+
+	"github.com/DataDog/zstd"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/kit/log"
+)
+
+/*
+entity states are stored as plain json, or as encoded records:
+
+	| magic(0x00) | flags | payload |
+
+the payload is compressed if flagged zstd. a segmented state is a head record, whose payload
+is the state without properties and keys of segments, and segment records holding properties.
+properties are assigned to segments by the hash of their ids, a segment is keyed by the hash
+of its content, so that a change rewrites only the touched segments and the head.
+*/
+
+const (
+	StateCompressionZstd = "zstd"
+
+	stateMagic         byte = 0x00
+	stateFlagZstd      byte = 1 << 0
+	stateFlagSegmented byte = 1 << 1
+)
+
+// stateRecord is a raw record of entity states.
+type stateRecord struct {
+	key  string
+	data []byte
+}
+
+func (s *stateRecord) EncodeKey() ([]byte, error) {
+	return []byte(s.key), nil
+}
+
+func (s *stateRecord) Encode() ([]byte, error) {
+	return s.data, nil
+}
+
+func (s *stateRecord) Decode(key, bytes []byte) error {
+	s.data = bytes
+	return nil
+}
+
+// stateHead is the payload of the head record of segmented states.
+type stateHead struct {
+	State    jsoniter.RawMessage `json:"state"`
+	Segments []string            `json:"segments"`
+}
+
+// stateLoadRetries bounds reloads of heads whose segments are removed by concurrent stores.
+const stateLoadRetries = 3
+
+// storeEntityState stores the state, segments the stored head refers to are not rewritten if unchanged.
+// the stored head is read since the state may be stored by other processes, unless both the state and
+// the stored head known by this process are not segmented, segments stored by others since are left as garbage.
+func (r *repo) storeEntityState(ctx context.Context, res *entityResource) error {
+	key, _ := res.EncodeKey()
+	parts, base, segmented := splitState(res.data, r.stateCfg.SegmentSize)
+
+	var err error
+	var prev []string
+	if _, plain := r.plainStates.Load(string(key)); segmented || !plain {
+		if prev, err = r.stateSegments(ctx, string(key)); nil != err {
+			return errors.Wrap(err, "store entity state")
+		}
+	}
+
+	var flags byte
+	var segments []string
+	head := res.data
+	if segmented {
+		stored := make(map[string]struct{}, len(prev))
+		for _, segment := range prev {
+			stored[segment] = struct{}{}
+		}
+
+		segments = make([]string, len(parts))
+		for index, part := range parts {
+			hash := fnv.New64a()
+			hash.Write(part)
+			segments[index] = fmt.Sprintf("%s#%d.%016x", key, index, hash.Sum64())
+			if _, has := stored[segments[index]]; has {
+				continue
+			}
+
+			data := r.encodeState(part, 0)
+			if err = r.dao.StoreResource(ctx, &stateRecord{key: segments[index], data: data}); nil != err {
+				return errors.Wrap(err, "store entity state segment")
+			}
+		}
+
+		flags = stateFlagSegmented
+		if head, err = json.Marshal(stateHead{State: base, Segments: segments}); nil != err {
+			return errors.Wrap(err, "encode entity state head")
+		}
+	}
+
+	// the head switches to the new segments atomically, stale segments are removed after,
+	// readers of the previous head reload the head on missing segments.
+	if err = r.dao.StoreResource(ctx, &entityResource{
+		tenant: res.tenant, id: res.id, data: r.encodeState(head, flags)}); nil != err {
+		return errors.Wrap(err, "store entity state")
+	}
+
+	if segmented {
+		r.plainStates.Delete(string(key))
+	} else {
+		r.plainStates.Store(string(key), struct{}{})
+	}
+	r.removeSegments(ctx, prev, segments)
+	return nil
+}
+
+// loadEntityState loads the state, res holds the stored head record,
+// the head is reloaded if its segments are removed by a concurrent store.
+func (r *repo) loadEntityState(ctx context.Context, res *entityResource) ([]byte, error) {
+	for attempt := 0; ; attempt++ {
+		data, missing, err := r.assembleState(ctx, res)
+		if nil == err || !missing || attempt == stateLoadRetries {
+			return data, errors.Wrap(err, "load entity state")
+		}
+
+		head := res.data
+		if _, reloadErr := r.dao.GetStoreResource(ctx, res); nil != reloadErr {
+			return nil, errors.Wrap(reloadErr, "load entity state, reload head")
+		} else if bytes.Equal(head, res.data) {
+			// segments of the current head are missing.
+			return nil, errors.Wrap(err, "load entity state")
+		}
+		log.L().Debug("reload entity state head, segments removed", logf.Eid(res.id))
+	}
+}
+
+// assembleState returns the state of the head record, missing reports whether a segment is not found.
+func (r *repo) assembleState(ctx context.Context, res *entityResource) ([]byte, bool, error) {
+	key, _ := res.EncodeKey()
+	flags, payload, err := decodeState(res.data)
+	if nil != err {
+		return nil, false, err
+	} else if flags&stateFlagSegmented == 0 {
+		r.plainStates.Store(string(key), struct{}{})
+		return payload, false, nil
+	}
+
+	r.plainStates.Delete(string(key))
+
+	var head stateHead
+	if err = json.Unmarshal(payload, &head); nil != err {
+		return nil, false, errors.Wrap(xerrors.ErrEntityStateCorrupted, err.Error())
+	}
+
+	props := make(map[string]jsoniter.RawMessage)
+	for _, segment := range head.Segments {
+		record := &stateRecord{key: segment}
+		if _, err = r.dao.GetStoreResource(ctx, record); nil != err {
+			log.L().Error("load entity state segment", logf.Eid(res.id),
+				logf.Key(segment), logf.Error(err))
+			return nil, errors.Is(err, xerrors.ErrResourceNotFound),
+				errors.Wrapf(xerrors.ErrEntityStateCorrupted, "load segment %s, %s", segment, err.Error())
+		}
+
+		var part map[string]jsoniter.RawMessage
+		if _, payload, err = decodeState(record.data); nil != err {
+			return nil, false, err
+		} else if err = json.Unmarshal(payload, &part); nil != err {
+			return nil, false, errors.Wrapf(xerrors.ErrEntityStateCorrupted, "decode segment %s, %s", segment, err.Error())
+		}
+		for id, val := range part {
+			props[id] = val
+		}
+	}
+
+	state := make(map[string]jsoniter.RawMessage)
+	if err = json.Unmarshal(head.State, &state); nil != err {
+		return nil, false, errors.Wrap(xerrors.ErrEntityStateCorrupted, err.Error())
+	} else if state["properties"], err = json.Marshal(props); nil != err {
+		return nil, false, errors.Wrap(err, "encode entity properties")
+	}
+
+	data, err := json.Marshal(state)
+	return data, false, err
+}
+
+// removeEntityState removes the state and its segments.
+func (r *repo) removeEntityState(ctx context.Context, res *entityResource) error {
+	key, _ := res.EncodeKey()
+	segments, err := r.stateSegments(ctx, string(key))
+	if nil != err {
+		return errors.Wrap(err, "remove entity state")
+	} else if err = r.dao.RemoveStoreResource(ctx, res); nil != err {
+		return errors.Wrap(err, "remove entity state")
+	}
+
+	r.plainStates.Delete(string(key))
+
+	r.removeSegments(ctx, segments, nil)
+	return nil
+}
+
+// stateSegments returns keys of segments the stored head refers to.
+func (r *repo) stateSegments(ctx context.Context, key string) ([]string, error) {
+	record := &stateRecord{key: key}
+	if _, err := r.dao.GetStoreResource(ctx, record); nil != err {
+		if errors.Is(err, xerrors.ErrResourceNotFound) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "get entity state head")
+	}
+
+	var head stateHead
+	if flags, payload, err := decodeState(record.data); nil != err {
+		return nil, errors.Wrap(err, "get entity state head")
+	} else if flags&stateFlagSegmented == 0 {
+		return nil, nil
+	} else if err = json.Unmarshal(payload, &head); nil != err {
+		return nil, errors.Wrap(xerrors.ErrEntityStateCorrupted, err.Error())
+	}
+	return head.Segments, nil
+}
+
+// removeSegments removes segments not kept, failures leave garbage only and are logged.
+func (r *repo) removeSegments(ctx context.Context, segments, kept []string) {
+	keep := make(map[string]struct{}, len(kept))
+	for _, segment := range kept {
+		keep[segment] = struct{}{}
+	}
+
+	for _, segment := range segments {
+		if _, has := keep[segment]; has {
+			continue
+		}
+		if err := r.dao.RemoveStoreResource(ctx, &stateRecord{key: segment}); nil != err {
+			log.L().Warn("remove entity state segment", logf.Key(segment), logf.Error(err))
+		}
+	}
+}
+
+// encodeState compresses the data if configured and smaller, unflagged data is stored plain.
+func (r *repo) encodeState(data []byte, flags byte) []byte {
+	if r.stateCfg.Compression == StateCompressionZstd {
+		if compressed, err := zstd.Compress(nil, data); nil != err {
+			log.L().Warn("compress entity state", logf.Error(err))
+		} else if len(compressed)+2 < len(data) {
+			data, flags = compressed, flags|stateFlagZstd
+		}
+	}
+
+	if flags == 0 {
+		return data
+	}
+	return append([]byte{stateMagic, flags}, data...)
+}
+
+// decodeState returns flags and the decompressed payload of the record, plain json is returned as is.
+func decodeState(record []byte) (byte, []byte, error) {
+	if len(record) < 2 || record[0] != stateMagic {
+		return 0, record, nil
+	}
+
+	flags, payload := record[1], record[2:]
+	if flags&stateFlagZstd != 0 {
+		var err error
+		if payload, err = zstd.Decompress(nil, payload); nil != err {
+			return flags, nil, errors.Wrap(xerrors.ErrEntityStateCorrupted, err.Error())
+		}
+	}
+	return flags, payload, nil
+}
+
+// splitState splits properties of states larger than the segment size into segments,
+// returns the state without properties, states without properties are not split.
+func splitState(data []byte, segmentSize int) ([][]byte, []byte, bool) {
+	if segmentSize <= 0 || len(data) <= segmentSize {
+		return nil, nil, false
+	}
+
+	var state, props map[string]jsoniter.RawMessage
+	if err := json.Unmarshal(data, &state); nil != err {
+		return nil, nil, false
+	} else if err = json.Unmarshal(state["properties"], &props); nil != err || len(props) == 0 {
+		return nil, nil, false
+	}
+
+	// segment counts are powers of two, so that properties move between segments only when the count doubles.
+	count := 1
+	for count*segmentSize < len(data) {
+		count <<= 1
+	}
+
+	buckets := make([]map[string]jsoniter.RawMessage, count)
+	for index := range buckets {
+		buckets[index] = make(map[string]jsoniter.RawMessage)
+	}
+	for id, val := range props {
+		hash := fnv.New32a()
+		hash.Write([]byte(id))
+		buckets[hash.Sum32()&uint32(count-1)][id] = val
+	}
+
+	parts := make([][]byte, count)
+	for index, bucket := range buckets {
+		parts[index], _ = json.Marshal(bucket)
+	}
+
+	delete(state, "properties")
+	base, err := json.Marshal(state)
+	return parts, base, nil == err
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	_ "github.com/tkeel-io/core/pkg/resource/store/memory"
+)
+
+// storeRecorder records keys of stored resources and counts reads.
+type storeRecorder struct {
+	dao.IDao
+	keys  []string
+	reads int
+}
+
+func (s *storeRecorder) GetStoreResource(ctx context.Context, res dao.Resource) (dao.Resource, error) {
+	s.reads++
+	return s.IDao.GetStoreResource(ctx, res)
+}
+
+func (s *storeRecorder) StoreResource(ctx context.Context, res dao.Resource) error {
+	key, _ := res.EncodeKey()
+	s.keys = append(s.keys, string(key))
+	return s.IDao.StoreResource(ctx, res)
+}
+
+func largeState(temp int) []byte {
+	props := make([]string, 0, 64)
+	for index := 0; index < 64; index++ {
+		props = append(props, fmt.Sprintf(`"prop%d":"%s"`, index, strings.Repeat("x", 64)))
+	}
+	return []byte(fmt.Sprintf(`{"id":"device1","type":"device","properties":{"temp":%d,%s}}`,
+		temp, strings.Join(props, ",")))
+}
+
+func TestRepo_EntityState(t *testing.T) {
+	ctx := context.Background()
+	memDao, err := dao.NewMock(ctx, config.Metadata{Name: "memory"}, config.EtcdConfig{})
+	assert.Nil(t, err)
+	recorder := &storeRecorder{IDao: memDao}
+	r := &repo{dao: recorder, stateCfg: config.StateConfig{
+		Compression: StateCompressionZstd, SegmentSize: 1024}}
+
+	// small states are stored as compressed plain records.
+	small := []byte(`{"id":"device2","properties":{"temp":20}}`)
+	assert.Nil(t, r.PutEntity(ctx, "device2", small))
+	bytes, err := r.GetEntity(ctx, "device2")
+	assert.Nil(t, err)
+	assert.Equal(t, small, bytes)
+
+	recorder.keys = nil
+	state := largeState(20)
+	assert.Nil(t, r.PutEntity(ctx, "device1", state))
+	assert.Len(t, recorder.keys, 9)
+	bytes, err = r.GetEntity(ctx, "device1")
+	assert.Nil(t, err)
+	assert.JSONEq(t, string(state), string(bytes))
+
+	record := &stateRecord{key: EntityStorePrefix + ".device1"}
+	_, err = memDao.GetStoreResource(ctx, record)
+	assert.Nil(t, err)
+	assert.Equal(t, stateMagic, record.data[0])
+	assert.Less(t, len(record.data), len(state))
+
+	// a change rewrites the touched segment and the head.
+	recorder.keys = nil
+	state = largeState(30)
+	assert.Nil(t, r.PutEntity(ctx, "device1", state))
+	assert.Len(t, recorder.keys, 2)
+	bytes, err = r.GetEntity(ctx, "device1")
+	assert.Nil(t, err)
+	assert.JSONEq(t, string(state), string(bytes))
+
+	// a state shrinking below the segment size removes its segments.
+	segments, err := r.stateSegments(ctx, EntityStorePrefix+".device1")
+	assert.Nil(t, err)
+	assert.Len(t, segments, 8)
+	assert.Nil(t, r.PutEntity(ctx, "device1", small))
+	_, err = memDao.GetStoreResource(ctx, &stateRecord{key: segments[0]})
+	assert.ErrorIs(t, err, xerrors.ErrResourceNotFound)
+	bytes, err = r.GetEntity(ctx, "device1")
+	assert.Nil(t, err)
+	assert.Equal(t, small, bytes)
+
+	assert.Nil(t, r.PutEntity(ctx, "device1", state))
+	segments, err = r.stateSegments(ctx, EntityStorePrefix+".device1")
+	assert.Nil(t, err)
+	assert.Nil(t, r.DelEntity(ctx, "device1"))
+	_, err = memDao.GetStoreResource(ctx, &stateRecord{key: segments[1]})
+	assert.ErrorIs(t, err, xerrors.ErrResourceNotFound)
+
+	// stores of other processes read the stored head, so that its segments are removed.
+	other := &repo{dao: memDao, stateCfg: r.stateCfg}
+	assert.Nil(t, r.PutEntity(ctx, "device4", largeState(20)))
+	segments, err = r.stateSegments(ctx, EntityStorePrefix+".device4")
+	assert.Nil(t, err)
+	assert.Nil(t, other.PutEntity(ctx, "device4", small))
+	_, err = memDao.GetStoreResource(ctx, &stateRecord{key: segments[0]})
+	assert.ErrorIs(t, err, xerrors.ErrResourceNotFound)
+
+	// readers of a replaced head reload the head.
+	assert.Nil(t, r.PutEntity(ctx, "device4", largeState(20)))
+	head := &entityResource{id: "device4"}
+	_, err = memDao.GetStoreResource(ctx, head)
+	assert.Nil(t, err)
+	assert.Nil(t, other.PutEntity(ctx, "device4", largeState(40)))
+	bytes, err = r.loadEntityState(ctx, head)
+	assert.Nil(t, err)
+	assert.JSONEq(t, string(largeState(40)), string(bytes))
+
+	// missing segments of the current head are corruptions.
+	segments, err = r.stateSegments(ctx, EntityStorePrefix+".device4")
+	assert.Nil(t, err)
+	assert.Nil(t, memDao.RemoveStoreResource(ctx, &stateRecord{key: segments[0]}))
+	_, err = r.GetEntity(ctx, "device4")
+	assert.ErrorIs(t, err, xerrors.ErrEntityStateCorrupted)
+
+	// stored heads known not segmented are not read again for plain states.
+	recorder.reads = 0
+	assert.Nil(t, r.PutEntity(ctx, "device5", small))
+	assert.Equal(t, 1, recorder.reads)
+	assert.Nil(t, r.PutEntity(ctx, "device5", small))
+	assert.Equal(t, 1, recorder.reads)
+	assert.Nil(t, r.PutEntity(ctx, "device5", state))
+	assert.Equal(t, 2, recorder.reads)
+
+	// plain states stored before are read as is.
+	assert.Nil(t, memDao.StoreResource(ctx, &entityResource{id: "device3", data: small}))
+	bytes, err = r.GetEntity(ctx, "device3")
+	assert.Nil(t, err)
+	assert.Equal(t, small, bytes)
+}
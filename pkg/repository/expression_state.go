@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+const ExprStateStorePrefix = "CORE.EXPRESSION.STATE"
+
+// exprStateResource holds states of stateful functions of an expression.
+type exprStateResource struct {
+	id   string
+	data []byte
+}
+
+func (e *exprStateResource) EncodeKey() ([]byte, error) {
+	return []byte(ExprStateStorePrefix + "." + e.id), nil
+}
+
+func (e *exprStateResource) Encode() ([]byte, error) {
+	return e.data, nil
+}
+
+func (e *exprStateResource) Decode(key, bytes []byte) error {
+	e.data = bytes
+	return nil
+}
+
+func (r *repo) PutExprState(ctx context.Context, exprID string, data []byte) error {
+	err := r.dao.StoreResource(ctx, &exprStateResource{id: exprID, data: data})
+	return errors.Wrap(err, "put expression state repository")
+}
+
+func (r *repo) GetExprState(ctx context.Context, exprID string) ([]byte, error) {
+	ret, err := r.dao.GetStoreResource(ctx, &exprStateResource{id: exprID})
+
+	res, _ := ret.(*exprStateResource)
+	if nil == res {
+		return nil, errors.Wrap(err, "get expression state repository")
+	}
+	return res.data, errors.Wrap(err, "get expression state repository")
+}
+
+func (r *repo) DelExprState(ctx context.Context, exprID string) error {
+	err := r.dao.RemoveStoreResource(ctx, &exprStateResource{id: exprID})
+	return errors.Wrap(err, "del expression state repository")
+}
@@ -2,7 +2,12 @@ package repository
 
 import (
 	"context"
+	"sync"
+	"time"
 
+	"github.com/pkg/errors"
+
+	"github.com/tkeel-io/core/pkg/config"
 	"github.com/tkeel-io/core/pkg/repository/dao"
 )
 
@@ -10,12 +15,26 @@ var _ IRepository = (*repo)(nil)
 
 type repo struct {
 	dao dao.IDao
+	// locators caches entity locators.
+	locators sync.Map
+	// plainStates caches keys of entity states stored without segments.
+	plainStates sync.Map
+	// usages batches size changes of entities.
+	usages usageBatch
+	// stateCfg configures the layout of entity states.
+	stateCfg config.StateConfig
 }
 
 func New(dao dao.IDao) IRepository {
-	return &repo{dao: dao}
+	return &repo{dao: dao, stateCfg: config.Get().State}
 }
 
 func (r *repo) GetLastRevision(ctx context.Context) int64 {
 	return r.dao.GetLastRevision(ctx)
 }
+
+// AcquireLease acquires or renews the lease for the holder, returns false if the lease is held by others.
+func (r *repo) AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+	acquired, err := r.dao.AcquireLease(ctx, name, holder, ttl)
+	return acquired, errors.Wrap(err, "acquire lease repository")
+}
@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+)
+
+const (
+	RequestStorePrefix       = "CORE.REQUEST"
+	RequestExpiryStorePrefix = "CORE.REQUEST.EXPIRY"
+
+	RequestStatusPending   = "PENDING"
+	RequestStatusSucceeded = "SUCCEEDED"
+	RequestStatusFailed    = "FAILED"
+
+	// requests are indexed by the hour they are created in, the index of an hour is spread over shards.
+	requestExpiryShards = 8
+	requestExpiryHour   = int64(3600 * 1000)
+	// requestPurgeLookback is the hours purged by the first purge.
+	requestPurgeLookback = 24
+)
+
+// Request tracks an asynchronous write request.
+type Request struct {
+	// request identifier, the MetaRequestID of the write event.
+	ID string `json:"id"`
+	// request owner.
+	Owner string `json:"owner"`
+	// entity the request writes.
+	EntityID string `json:"entity_id"`
+	// write operation.
+	Operation string `json:"operation"`
+	// request status.
+	Status string `json:"status"`
+	// error code of failed request.
+	ErrCode string `json:"err_code,omitempty"`
+	// callback url the outcome of the request is posted to.
+	Callback string `json:"callback,omitempty"`
+	// template the created entity is an instance of, the instance is indexed once the entity is created.
+	TemplateID      string `json:"template_id,omitempty"`
+	TemplateVersion int64  `json:"template_version,omitempty"`
+	// created time, unix milliseconds.
+	CreatedAt int64 `json:"created_at"`
+	// updated time, unix milliseconds.
+	UpdatedAt int64 `json:"updated_at"`
+}
+
+func (r *Request) EncodeKey() ([]byte, error) {
+	return []byte(RequestStorePrefix + "." + r.ID), nil
+}
+
+func (r *Request) Encode() ([]byte, error) {
+	bytes, err := json.Marshal(r)
+	return bytes, errors.Wrap(err, "encode Request")
+}
+
+func (r *Request) Decode(key, bytes []byte) error {
+	return errors.Wrap(json.Unmarshal(bytes, r), "decode Request")
+}
+
+// requestIndexResource is a shard of the index of requests created in an hour, or the purge cursor.
+type requestIndexResource struct {
+	key  string
+	data []byte
+}
+
+func (r *requestIndexResource) EncodeKey() ([]byte, error) {
+	return []byte(r.key), nil
+}
+
+func (r *requestIndexResource) Encode() ([]byte, error) {
+	return r.data, nil
+}
+
+func (r *requestIndexResource) Decode(key, bytes []byte) error {
+	r.data = bytes
+	return nil
+}
+
+func requestExpiryKey(hour int64, shard uint32) string {
+	return fmt.Sprintf("%s.%d.%d", RequestExpiryStorePrefix, hour, shard)
+}
+
+func requestExpiryShard(reqID string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(reqID))
+	return h.Sum32() % requestExpiryShards
+}
+
+// PutRequest stores the request, pending requests are indexed by created time, so that they are purged after retention.
+func (r *repo) PutRequest(ctx context.Context, req *Request) error {
+	if req.Status == RequestStatusPending {
+		res := &requestIndexResource{key: requestExpiryKey(
+			req.CreatedAt/requestExpiryHour, requestExpiryShard(req.ID))}
+		if err := r.dao.UpdateStoreResource(ctx, res, func(exists bool) error {
+			var reqIDs []string
+			if exists {
+				if err := json.Unmarshal(res.data, &reqIDs); nil != err {
+					return errors.Wrap(err, "decode request index")
+				}
+			}
+
+			bytes, err := json.Marshal(append(reqIDs, req.ID))
+			res.data = bytes
+			return errors.Wrap(err, "encode request index")
+		}); nil != err {
+			return errors.Wrap(err, "put request repository")
+		}
+	}
+
+	err := r.dao.StoreResource(ctx, req)
+	return errors.Wrap(err, "put request repository")
+}
+
+// PurgeRequests removes requests created before the time in unix milliseconds, returns the number of removed requests.
+// purged hours are recorded by a cursor, so that each hour is purged once.
+func (r *repo) PurgeRequests(ctx context.Context, before int64) (int, error) {
+	end := before / requestExpiryHour
+	last := end - requestPurgeLookback - 1
+	cursor := &requestIndexResource{key: RequestExpiryStorePrefix + ".CURSOR"}
+	if _, err := r.dao.GetStoreResource(ctx, cursor); nil == err {
+		if err = json.Unmarshal(cursor.data, &last); nil != err {
+			return 0, errors.Wrap(err, "purge requests repository, decode cursor")
+		}
+	} else if !errors.Is(err, xerrors.ErrResourceNotFound) {
+		return 0, errors.Wrap(err, "purge requests repository")
+	}
+
+	var purged int
+	for hour := last + 1; hour < end; hour++ {
+		for shard := uint32(0); shard < requestExpiryShards; shard++ {
+			count, err := r.purgeRequestShard(ctx, &requestIndexResource{key: requestExpiryKey(hour, shard)})
+			purged += count
+			if nil != err {
+				return purged, errors.Wrap(err, "purge requests repository")
+			}
+		}
+
+		cursor.data, _ = json.Marshal(hour)
+		if err := r.dao.StoreResource(ctx, cursor); nil != err {
+			return purged, errors.Wrap(err, "purge requests repository, store cursor")
+		}
+	}
+	return purged, nil
+}
+
+func (r *repo) purgeRequestShard(ctx context.Context, res *requestIndexResource) (int, error) {
+	if _, err := r.dao.GetStoreResource(ctx, res); nil != err {
+		if errors.Is(err, xerrors.ErrResourceNotFound) {
+			return 0, nil
+		}
+		return 0, errors.Wrap(err, "get request index")
+	}
+
+	var reqIDs []string
+	if err := json.Unmarshal(res.data, &reqIDs); nil != err {
+		return 0, errors.Wrap(err, "decode request index")
+	}
+
+	for _, reqID := range reqIDs {
+		if err := r.dao.RemoveStoreResource(ctx, &Request{ID: reqID}); nil != err &&
+			!errors.Is(err, xerrors.ErrResourceNotFound) {
+			return 0, errors.Wrap(err, "remove request")
+		}
+	}
+	return len(reqIDs), errors.Wrap(r.dao.RemoveStoreResource(ctx, res), "remove request index")
+}
+
+func (r *repo) GetRequest(ctx context.Context, reqID string) (*Request, error) {
+	req := &Request{ID: reqID}
+	_, err := r.dao.GetStoreResource(ctx, req)
+	return req, errors.Wrap(err, "get request repository")
+}
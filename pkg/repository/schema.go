@@ -3,9 +3,13 @@ package repository
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
 	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/core/pkg/scheme"
 	"github.com/tkeel-io/kit/log"
 	"go.etcd.io/etcd/api/v3/mvccpb"
 )
@@ -15,12 +19,13 @@ const (
 )
 
 type ListSchemaReq struct {
-	Owner    string
-	EntityID string
+	Owner string
+	ID    string
 }
 
 var _ dao.Resource = (*Schema)(nil)
 
+// Schema is an immutable version of the property configs entities bind to.
 type Schema struct {
 	// Schema identifier.
 	ID string
@@ -28,10 +33,14 @@ type Schema struct {
 	Name string
 	// Schema owner.
 	Owner string
-	// Schema.
+	// Schema, property configs in json.
 	Schema string
 	// description.
 	Description string
+	// Schema version, starts from 1.
+	Version int64
+	// created time, unix milliseconds.
+	CreatedAt int64
 }
 
 func NewSchema(owner, ID, name, schema, desc string) *Schema {
@@ -44,9 +53,23 @@ func NewSchema(owner, ID, name, schema, desc string) *Schema {
 	}
 }
 
-func ListSchemaPrefix(Owner, EntityID string) string {
-	keyString := fmt.Sprintf("%s/%s",
-		SchemaPrefix, Owner)
+// Configs returns the parsed property configs of the schema.
+func (s *Schema) Configs() (map[string]*scheme.Config, error) {
+	if s.Schema == "" {
+		return map[string]*scheme.Config{}, nil
+	}
+	cfgs, err := scheme.ParseStrict([]byte(s.Schema))
+	return cfgs, errors.Wrap(err, "parse Schema")
+}
+
+func ListSchemaPrefix(owner, id string) string {
+	keyString := SchemaPrefix + "/"
+	if owner != "" {
+		keyString = fmt.Sprintf("%s/%s/", SchemaPrefix, owner)
+		if id != "" {
+			keyString = fmt.Sprintf("%s/%s/%s/", SchemaPrefix, owner, id)
+		}
+	}
 	return keyString
 }
 
@@ -57,9 +80,13 @@ func (s *Schema) EncodeKey() ([]byte, error) {
 	if s.ID == "" {
 		return nil, errors.Errorf("Schema ID is empty")
 	}
+	if s.Version <= 0 {
+		return nil, errors.Errorf("Schema Version is empty")
+	}
 
-	keyString := fmt.Sprintf("%s/%s/%s",
-		SchemaPrefix, s.Owner, s.ID)
+	// zero padding keeps versions ordered in etcd.
+	keyString := fmt.Sprintf("%s/%s/%s/%010d",
+		SchemaPrefix, s.Owner, s.ID, s.Version)
 	return []byte(keyString), nil
 }
 
@@ -69,49 +96,107 @@ func (s *Schema) Encode() ([]byte, error) {
 }
 
 func (s *Schema) Decode(key, bytes []byte) error {
-	err := json.Unmarshal(bytes, s)
-	return errors.Wrap(err, "decode Schema")
+	if bytes != nil {
+		err := json.Unmarshal(bytes, s)
+		return errors.Wrap(err, "decode Schema")
+	}
+	// /core/v1/schema/admin/schema-123/0000000001
+	keys := strings.Split(string(key), "/")
+	if len(keys) != 7 {
+		return errors.Errorf("error:decode Schema from key[%s]", string(key))
+	}
+	version, err := strconv.ParseInt(keys[6], 10, 64)
+	if nil != err {
+		return errors.Wrapf(err, "decode Schema from key[%s]", string(key))
+	}
+	s.Owner = keys[4]
+	s.ID = keys[5]
+	s.Version = version
+	return nil
+}
+
+// PutSchema put the schema version, appends a new version if Version is 0.
+func (r *repo) PutSchema(ctx context.Context, schema Schema) error {
+	if schema.Version <= 0 {
+		latest, err := r.GetSchema(ctx, Schema{ID: schema.ID, Owner: schema.Owner})
+		switch {
+		case nil == err:
+			schema.Version = latest.Version + 1
+		case errors.Is(err, xerrors.ErrSchemaNotFound):
+			schema.Version = 1
+		default:
+			return errors.Wrap(err, "put schema repository")
+		}
+	}
+
+	err := r.dao.PutResource(ctx, &schema)
+	return errors.Wrap(err, "put schema repository")
 }
 
-func (r *repo) PutSchema(ctx context.Context, expr Schema) error {
-	err := r.dao.PutResource(ctx, &expr)
-	return errors.Wrap(err, "put expression repository")
+// CreateSchema puts the schema version if it not exists, returns ErrSchemaAlreadyExists otherwise,
+// so that concurrent writers never overwrite a version.
+func (r *repo) CreateSchema(ctx context.Context, schema Schema) error {
+	err := r.dao.CreateResource(ctx, &schema)
+	if errors.Is(err, xerrors.ErrResourceAlreadyExists) {
+		err = xerrors.ErrSchemaAlreadyExists
+	}
+	return errors.Wrap(err, "create schema repository")
 }
 
-func (r *repo) GetSchema(ctx context.Context, expr Schema) (Schema, error) {
-	_, err := r.dao.GetResource(ctx, &expr)
-	return expr, errors.Wrap(err, "get expression repository")
+// GetSchema returns the schema version, returns the latest version if Version is 0.
+func (r *repo) GetSchema(ctx context.Context, schema Schema) (Schema, error) {
+	if schema.Version > 0 {
+		_, err := r.dao.GetResource(ctx, &schema)
+		if errors.Is(err, xerrors.ErrResourceNotFound) {
+			err = xerrors.ErrSchemaNotFound
+		}
+		return schema, errors.Wrap(err, "get schema repository")
+	}
+
+	schemas, err := r.ListSchema(ctx, r.GetLastRevision(ctx),
+		&ListSchemaReq{Owner: schema.Owner, ID: schema.ID})
+	if (nil == err && len(schemas) == 0) || errors.Is(err, xerrors.ErrResourceNotFound) {
+		return schema, errors.Wrap(xerrors.ErrSchemaNotFound, "get schema repository")
+	} else if nil != err {
+		return schema, errors.Wrap(err, "get schema repository")
+	}
+	return *schemas[len(schemas)-1], nil
 }
 
-func (r *repo) DelSchema(ctx context.Context, expr Schema) error {
-	err := r.dao.DelResource(ctx, &expr)
-	return errors.Wrap(err, "del expression repository")
+// DelSchema delete the schema version, deletes all versions if Version is 0.
+func (r *repo) DelSchema(ctx context.Context, schema Schema) error {
+	if schema.Version > 0 {
+		err := r.dao.DelResource(ctx, &schema)
+		return errors.Wrap(err, "del schema repository")
+	}
+
+	err := r.dao.DelResources(ctx, ListSchemaPrefix(schema.Owner, schema.ID))
+	return errors.Wrap(err, "del schema repository")
 }
 
-func (r *repo) HasSchema(ctx context.Context, expr Schema) (bool, error) {
-	has, err := r.dao.HasResource(ctx, &expr)
-	return has, errors.Wrap(err, "exists expression repository")
+func (r *repo) HasSchema(ctx context.Context, schema Schema) (bool, error) {
+	has, err := r.dao.HasResource(ctx, &schema)
+	return has, errors.Wrap(err, "exists schema repository")
 }
 
+// ListSchema returns schema versions in ascending order.
 func (r *repo) ListSchema(ctx context.Context, rev int64, req *ListSchemaReq) ([]*Schema, error) {
 	// construct prefix.
-	prefix := ListSchemaPrefix(req.EntityID, req.Owner)
+	prefix := ListSchemaPrefix(req.Owner, req.ID)
 	ress, err := r.dao.ListResource(ctx, rev, prefix,
 		func(key, raw []byte) (dao.Resource, error) {
 			var res Schema // escape.
 			err := res.Decode(key, raw)
-			return &res, errors.Wrap(err, "decode expression")
+			return &res, errors.Wrap(err, "decode schema")
 		})
 
-	var exprs []*Schema
+	var schemas []*Schema
 	for index := range ress {
-		if expr, ok := ress[index].(*Schema); ok {
-			exprs = append(exprs, expr)
-			continue
+		if schema, ok := ress[index].(*Schema); ok {
+			schemas = append(schemas, schema)
 		}
-		// panic.
 	}
-	return exprs, errors.Wrap(err, "list expression repository")
+	return schemas, errors.Wrap(err, "list schema repository")
 }
 
 func (r *repo) RangeSchema(ctx context.Context, rev int64, handler RangeSchemaFunc) {
@@ -20,6 +20,8 @@ import (
 	"context"
 	"reflect"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestNewSchema(t *testing.T) {
@@ -63,3 +65,25 @@ func Test_repo_PutSchema(t *testing.T) {
 		})
 	}
 }
+
+func TestSchema_Decode(t *testing.T) {
+	schema := &Schema{ID: "schema-sensor", Owner: "admin", Version: 3}
+	key, err := schema.EncodeKey()
+	assert.Nil(t, err)
+	assert.Equal(t, "/core/v1/schema/admin/schema-sensor/0000000003", string(key))
+
+	var ret Schema
+	assert.Nil(t, ret.Decode(key, nil))
+	assert.Equal(t, *schema, ret)
+
+	_, err = (&Schema{ID: "schema-sensor", Owner: "admin"}).EncodeKey()
+	assert.NotNil(t, err)
+	assert.Equal(t, "/core/v1/schema/admin/schema-sensor/", ListSchemaPrefix("admin", "schema-sensor"))
+	assert.Equal(t, "/core/v1/schema/", ListSchemaPrefix("", ""))
+
+	cfgs, err := (&Schema{Schema: `{"temp":{"type":"float"}}`}).Configs()
+	assert.Nil(t, err)
+	assert.Equal(t, "temp", cfgs["temp"].ID)
+	_, err = (&Schema{Schema: `{"temp":{"type":"decimal"}}`}).Configs()
+	assert.NotNil(t, err)
+}
@@ -106,7 +106,7 @@ func (r *repo) HasSubscription(ctx context.Context, expr *Subscription) (bool, e
 
 func (r *repo) ListSubscription(ctx context.Context, rev int64, req *ListSubscriptionReq) ([]*Subscription, error) {
 	// construct prefix.
-	prefix := ListSubscriptionPrefix(req.EntityID, req.Owner)
+	prefix := ListSubscriptionPrefix(req.Owner, req.EntityID)
 	ress, err := r.dao.ListResource(ctx, rev, prefix,
 		func(key, raw []byte) (dao.Resource, error) {
 			var res Subscription // escape.
@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+)
+
+const (
+	TemplatePrefix = "/core/v1/templates"
+	// TemplateSelf refers to the instance entity in template expressions.
+	TemplateSelf = "$self"
+)
+
+type ListTemplateReq struct {
+	Owner string
+	ID    string
+}
+
+var _ dao.Resource = (*Template)(nil)
+
+// TemplateExpression is an expression inherited by every instance of the template.
+type TemplateExpression struct {
+	// target path.
+	Path string `json:"path"`
+	// expression name.
+	Name string `json:"name"`
+	// expression, use TemplateSelf to refer the instance entity.
+	Expression string `json:"expression"`
+	// description.
+	Description string `json:"description"`
+}
+
+// Template is an immutable version of a thing model.
+type Template struct {
+	// template identifier.
+	ID string `json:"id"`
+	// template owner.
+	Owner string `json:"owner"`
+	// template name.
+	Name string `json:"name"`
+	// template version, starts from 1.
+	Version int64 `json:"version"`
+	// parent template id.
+	Extends string `json:"extends,omitempty"`
+	// parent template version, 0 means the latest version.
+	ExtendsVersion int64 `json:"extends_version,omitempty"`
+	// scheme of instances.
+	Scheme map[string]interface{} `json:"scheme,omitempty"`
+	// default property values of instances.
+	Properties map[string]interface{} `json:"properties,omitempty"`
+	// expressions of instances.
+	Expressions []*TemplateExpression `json:"expressions,omitempty"`
+	// description.
+	Description string `json:"description"`
+	// created time, unix milliseconds.
+	CreatedAt int64 `json:"created_at"`
+}
+
+func ListTemplatePrefix(owner, id string) string {
+	keyString := fmt.Sprintf("%s/%s/%s/",
+		TemplatePrefix, owner, id)
+	return keyString
+}
+
+func (t *Template) EncodeKey() ([]byte, error) {
+	if t.Owner == "" || t.ID == "" || t.Version <= 0 {
+		return nil, errors.Wrap(xerrors.ErrInvalidParam, "encode template key")
+	}
+
+	// zero padding keeps versions ordered in etcd.
+	keyString := fmt.Sprintf("%s/%s/%s/%010d",
+		TemplatePrefix, t.Owner, t.ID, t.Version)
+	return []byte(keyString), nil
+}
+
+func (t *Template) Encode() ([]byte, error) {
+	bytes, err := json.Marshal(t)
+	return bytes, errors.Wrap(err, "encode Template")
+}
+
+func (t *Template) Decode(key, bytes []byte) error {
+	if bytes != nil {
+		err := json.Unmarshal(bytes, t)
+		return errors.Wrap(err, "decode Template")
+	}
+	// /core/v1/templates/admin/tpl-123/0000000001
+	keys := strings.Split(string(key), "/")
+	if len(keys) != 7 {
+		return errors.Errorf("error:decode Template from key[%s]", string(key))
+	}
+	version, err := strconv.ParseInt(keys[6], 10, 64)
+	if nil != err {
+		return errors.Wrapf(err, "decode Template from key[%s]", string(key))
+	}
+	t.Owner = keys[4]
+	t.ID = keys[5]
+	t.Version = version
+	return nil
+}
+
+// Inherit returns the template merged on top of parent,
+// scheme and default properties are deep merged, expressions are overridden by path.
+func (t *Template) Inherit(parent *Template) *Template {
+	ret := *t
+	ret.Scheme = mergeMap(mergeMap(nil, parent.Scheme), t.Scheme)
+	ret.Properties = mergeMap(mergeMap(nil, parent.Properties), t.Properties)
+
+	ret.Expressions = nil
+	overrides := make(map[string]bool)
+	for _, expr := range t.Expressions {
+		overrides[expr.Path] = true
+	}
+	for _, expr := range parent.Expressions {
+		if !overrides[expr.Path] {
+			ret.Expressions = append(ret.Expressions, expr)
+		}
+	}
+	ret.Expressions = append(ret.Expressions, t.Expressions...)
+	return &ret
+}
+
+// InstanceExpressions returns expressions of the instance entity.
+func (t *Template) InstanceExpressions(owner, entityID string) []Expression {
+	exprs := make([]Expression, 0, len(t.Expressions))
+	for _, expr := range t.Expressions {
+		exprs = append(exprs, *NewExpression(owner, entityID, expr.Name, expr.Path,
+			strings.ReplaceAll(expr.Expression, TemplateSelf, entityID), expr.Description))
+	}
+	return exprs
+}
+
+// Instantiate returns scheme and properties of the instance merged on top of the template.
+func (t *Template) Instantiate(scheme, properties map[string]interface{}) (map[string]interface{}, map[string]interface{}) {
+	return mergeMap(mergeMap(nil, t.Scheme), scheme),
+		mergeMap(mergeMap(nil, t.Properties), properties)
+}
+
+func mergeMap(dst, src map[string]interface{}) map[string]interface{} {
+	if dst == nil {
+		dst = make(map[string]interface{})
+	}
+
+	for key, val := range src {
+		srcMap, ok := val.(map[string]interface{})
+		if !ok {
+			dst[key] = val
+			continue
+		}
+
+		dstMap, _ := dst[key].(map[string]interface{})
+		dst[key] = mergeMap(mergeMap(nil, dstMap), srcMap)
+	}
+	return dst
+}
+
+func (r *repo) PutTemplate(ctx context.Context, tpl *Template) error {
+	// template versions are immutable.
+	err := r.dao.CreateResource(ctx, tpl)
+	if errors.Is(err, xerrors.ErrResourceAlreadyExists) {
+		err = xerrors.ErrTemplateVersionExists
+	}
+	return errors.Wrap(err, "put template repository")
+}
+
+// GetTemplate returns the template version, returns the latest version if Version is 0.
+func (r *repo) GetTemplate(ctx context.Context, tpl *Template) (*Template, error) {
+	if tpl.Version > 0 {
+		_, err := r.dao.GetResource(ctx, tpl)
+		if errors.Is(err, xerrors.ErrResourceNotFound) {
+			err = xerrors.ErrTemplateNotFound
+		}
+		return tpl, errors.Wrap(err, "get template repository")
+	}
+
+	tpls, err := r.ListTemplate(ctx, r.GetLastRevision(ctx),
+		&ListTemplateReq{Owner: tpl.Owner, ID: tpl.ID})
+	if nil != err {
+		return nil, errors.Wrap(err, "get template repository")
+	} else if len(tpls) == 0 {
+		return nil, errors.Wrap(xerrors.ErrTemplateNotFound, "get template repository")
+	}
+	return tpls[len(tpls)-1], nil
+}
+
+// DelTemplate delete all versions of the template.
+func (r *repo) DelTemplate(ctx context.Context, tpl *Template) error {
+	err := r.dao.DelResources(ctx, ListTemplatePrefix(tpl.Owner, tpl.ID))
+	return errors.Wrap(err, "del template repository")
+}
+
+// ListTemplate returns template versions in ascending order.
+func (r *repo) ListTemplate(ctx context.Context, rev int64, req *ListTemplateReq) ([]*Template, error) {
+	// construct prefix.
+	prefix := TemplatePrefix + "/"
+	if req.Owner != "" {
+		prefix = fmt.Sprintf("%s/%s/", TemplatePrefix, req.Owner)
+		if req.ID != "" {
+			prefix = ListTemplatePrefix(req.Owner, req.ID)
+		}
+	}
+
+	ress, err := r.dao.ListResource(ctx, rev, prefix,
+		func(key, raw []byte) (dao.Resource, error) {
+			var res Template // escape.
+			err := res.Decode(key, raw)
+			return &res, errors.Wrap(err, "decode template")
+		})
+
+	var tpls []*Template
+	for index := range ress {
+		if tpl, ok := ress[index].(*Template); ok {
+			tpls = append(tpls, tpl)
+		}
+	}
+	return tpls, errors.Wrap(err, "list template repository")
+}
@@ -0,0 +1,315 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+)
+
+const (
+	TemplateRolloutPrefix       = "/core/v1/rollouts"
+	RunningRolloutPrefix        = "/core/v1/rollouts-running"
+	TemplateInstanceStorePrefix = "CORE.TEMPLATE.INSTANCE"
+
+	RolloutStatusRunning   = "RUNNING"
+	RolloutStatusCompleted = "COMPLETED"
+	RolloutStatusFailed    = "FAILED"
+
+	// templateInstanceShards spread instances of a template over state items,
+	// keeps items small for templates with lots of instances.
+	templateInstanceShards = 64
+)
+
+type ListTemplateRolloutReq struct {
+	Owner      string
+	TemplateID string
+	// Running lists running rollouts only, from the index of running rollouts.
+	Running bool
+}
+
+var _ dao.Resource = (*TemplateRollout)(nil)
+
+// TemplateRollout is a background job which migrates instances of a template
+// from FromVersion to ToVersion.
+type TemplateRollout struct {
+	// rollout identifier.
+	ID string `json:"id"`
+	// rollout owner.
+	Owner string `json:"owner"`
+	// template id.
+	TemplateID string `json:"template_id"`
+	// template version of instances before rollout.
+	FromVersion int64 `json:"from_version"`
+	// template version of instances after rollout.
+	ToVersion int64 `json:"to_version"`
+	// rollout status.
+	Status string `json:"status"`
+	// number of instances to migrate.
+	Total int `json:"total"`
+	// number of migrated instances.
+	Done int `json:"done"`
+	// number of instances not pinned to FromVersion any more, the index of them is corrected.
+	Skipped int `json:"skipped,omitempty"`
+	// instances failed to migrate, not retried when the rollout resumes.
+	FailedEntities []string `json:"failed_entities,omitempty"`
+	// error of failed rollout.
+	Error string `json:"error,omitempty"`
+	// created time, unix milliseconds.
+	CreatedAt int64 `json:"created_at"`
+	// updated time, unix milliseconds.
+	UpdatedAt int64 `json:"updated_at"`
+}
+
+// Pending returns the number of instances waiting to migrate.
+func (t *TemplateRollout) Pending() int {
+	if pending := t.Total - t.Done - t.Skipped - len(t.FailedEntities); pending > 0 {
+		return pending
+	}
+	return 0
+}
+
+func (t *TemplateRollout) EncodeKey() ([]byte, error) {
+	if t.Owner == "" || t.ID == "" {
+		return nil, errors.Wrap(xerrors.ErrInvalidParam, "encode template rollout key")
+	}
+
+	keyString := fmt.Sprintf("%s/%s/%s",
+		TemplateRolloutPrefix, t.Owner, t.ID)
+	return []byte(keyString), nil
+}
+
+func (t *TemplateRollout) Encode() ([]byte, error) {
+	bytes, err := json.Marshal(t)
+	return bytes, errors.Wrap(err, "encode TemplateRollout")
+}
+
+func (t *TemplateRollout) Decode(key, bytes []byte) error {
+	if bytes != nil {
+		err := json.Unmarshal(bytes, t)
+		return errors.Wrap(err, "decode TemplateRollout")
+	}
+	// /core/v1/rollouts/admin/rollout-1234
+	keys := strings.Split(string(key), "/")
+	if len(keys) != 6 {
+		return errors.Errorf("error:decode TemplateRollout from key[%s]", string(key))
+	}
+	t.Owner = keys[4]
+	t.ID = keys[5]
+	return nil
+}
+
+// runningRolloutResource indexes the running rollout, keyed by owner and rollout id.
+type runningRolloutResource struct {
+	owner string
+	id    string
+}
+
+func (t *runningRolloutResource) EncodeKey() ([]byte, error) {
+	if t.owner == "" || t.id == "" {
+		return nil, errors.Wrap(xerrors.ErrInvalidParam, "encode running rollout key")
+	}
+	return []byte(fmt.Sprintf("%s/%s/%s", RunningRolloutPrefix, t.owner, t.id)), nil
+}
+
+func (t *runningRolloutResource) Encode() ([]byte, error) {
+	return []byte{}, nil
+}
+
+func (t *runningRolloutResource) Decode(key, bytes []byte) error {
+	// /core/v1/rollouts-running/admin/rollout-1234
+	keys := strings.Split(string(key), "/")
+	if len(keys) != 6 {
+		return errors.Errorf("error:decode running rollout from key[%s]", string(key))
+	}
+	t.owner = keys[4]
+	t.id = keys[5]
+	return nil
+}
+
+// PutTemplateRollout puts the rollout, the index of running rollouts is put before the rollout,
+// and removed after the rollout finished, stale entries are removed on listing running rollouts.
+func (r *repo) PutTemplateRollout(ctx context.Context, rollout *TemplateRollout) error {
+	index := &runningRolloutResource{owner: rollout.Owner, id: rollout.ID}
+	if rollout.Status == RolloutStatusRunning {
+		if err := r.dao.PutResource(ctx, index); nil != err {
+			return errors.Wrap(err, "put template rollout repository")
+		}
+	}
+
+	if err := r.dao.PutResource(ctx, rollout); nil != err {
+		return errors.Wrap(err, "put template rollout repository")
+	}
+
+	if rollout.Status != RolloutStatusRunning {
+		return errors.Wrap(r.dao.DelResource(ctx, index), "put template rollout repository")
+	}
+	return nil
+}
+
+func (r *repo) GetTemplateRollout(ctx context.Context, rollout *TemplateRollout) (*TemplateRollout, error) {
+	_, err := r.dao.GetResource(ctx, rollout)
+	return rollout, errors.Wrap(err, "get template rollout repository")
+}
+
+func (r *repo) ListTemplateRollout(ctx context.Context, rev int64, req *ListTemplateRolloutReq) ([]*TemplateRollout, error) {
+	if req.Running {
+		return r.listRunningRollout(ctx, rev, req)
+	}
+
+	// construct prefix.
+	prefix := TemplateRolloutPrefix + "/"
+	if req.Owner != "" {
+		prefix = fmt.Sprintf("%s/%s/", TemplateRolloutPrefix, req.Owner)
+	}
+
+	ress, err := r.dao.ListResource(ctx, rev, prefix,
+		func(key, raw []byte) (dao.Resource, error) {
+			var res TemplateRollout // escape.
+			err := res.Decode(key, raw)
+			return &res, errors.Wrap(err, "decode template rollout")
+		})
+
+	var rollouts []*TemplateRollout
+	for index := range ress {
+		if rollout, ok := ress[index].(*TemplateRollout); ok {
+			if req.TemplateID == "" || req.TemplateID == rollout.TemplateID {
+				rollouts = append(rollouts, rollout)
+			}
+		}
+	}
+	return rollouts, errors.Wrap(err, "list template rollout repository")
+}
+
+func (r *repo) listRunningRollout(ctx context.Context, rev int64, req *ListTemplateRolloutReq) ([]*TemplateRollout, error) {
+	prefix := RunningRolloutPrefix + "/"
+	if req.Owner != "" {
+		prefix = fmt.Sprintf("%s/%s/", RunningRolloutPrefix, req.Owner)
+	}
+
+	ress, err := r.dao.ListResource(ctx, rev, prefix,
+		func(key, raw []byte) (dao.Resource, error) {
+			var res runningRolloutResource // escape.
+			err := res.Decode(key, raw)
+			return &res, errors.Wrap(err, "decode running rollout")
+		})
+	if nil != err {
+		return nil, errors.Wrap(err, "list running rollout repository")
+	}
+
+	var rollouts []*TemplateRollout
+	for index := range ress {
+		res, _ := ress[index].(*runningRolloutResource)
+		rollout, err := r.GetTemplateRollout(ctx, &TemplateRollout{Owner: res.owner, ID: res.id})
+		switch {
+		case nil == err && rollout.Status == RolloutStatusRunning:
+			if req.TemplateID == "" || req.TemplateID == rollout.TemplateID {
+				rollouts = append(rollouts, rollout)
+			}
+		case nil == err || errors.Is(err, xerrors.ErrResourceNotFound):
+			// stale entry of a finished or removed rollout.
+			if err = r.dao.DelResource(ctx, res); nil != err {
+				return rollouts, errors.Wrap(err, "list running rollout repository")
+			}
+		default:
+			return rollouts, errors.Wrap(err, "list running rollout repository")
+		}
+	}
+	return rollouts, nil
+}
+
+// templateInstanceResource is a shard of the template instance index,
+// maps instance entity id to template version.
+type templateInstanceResource struct {
+	owner      string
+	templateID string
+	shard      uint32
+	data       []byte
+}
+
+func (t *templateInstanceResource) EncodeKey() ([]byte, error) {
+	return []byte(fmt.Sprintf("%s.%s.%s.%d",
+		TemplateInstanceStorePrefix, t.owner, t.templateID, t.shard)), nil
+}
+
+func (t *templateInstanceResource) Encode() ([]byte, error) {
+	return t.data, nil
+}
+
+func (t *templateInstanceResource) Decode(key, bytes []byte) error {
+	t.data = bytes
+	return nil
+}
+
+func templateInstanceShard(entityID string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(entityID))
+	return h.Sum32() % templateInstanceShards
+}
+
+func (r *repo) getTemplateInstanceShard(ctx context.Context, owner, templateID string, shard uint32) (map[string]int64, error) {
+	instances := make(map[string]int64)
+	ret, err := r.dao.GetStoreResource(ctx, &templateInstanceResource{
+		owner: owner, templateID: templateID, shard: shard})
+	if nil != err {
+		if errors.Is(err, xerrors.ErrResourceNotFound) {
+			return instances, nil
+		}
+		return nil, errors.Wrap(err, "get template instance shard")
+	}
+
+	res, _ := ret.(*templateInstanceResource)
+	err = json.Unmarshal(res.data, &instances)
+	return instances, errors.Wrap(err, "decode template instance shard")
+}
+
+// updateTemplateInstance updates the shard of the entity with compare-and-swap,
+// update is called again with the latest shard on conflicts.
+func (r *repo) updateTemplateInstance(ctx context.Context, owner, templateID, entityID string, update func(map[string]int64)) error {
+	res := &templateInstanceResource{owner: owner,
+		templateID: templateID, shard: templateInstanceShard(entityID)}
+	err := r.dao.UpdateStoreResource(ctx, res, func(exists bool) error {
+		instances := make(map[string]int64)
+		if exists {
+			if err := json.Unmarshal(res.data, &instances); nil != err {
+				return errors.Wrap(err, "decode template instance shard")
+			}
+		}
+
+		update(instances)
+		bytes, err := json.Marshal(instances)
+		res.data = bytes
+		return errors.Wrap(err, "encode template instance shard")
+	})
+	return errors.Wrap(err, "update template instance")
+}
+
+// PutTemplateInstance index the entity as an instance of the template version.
+func (r *repo) PutTemplateInstance(ctx context.Context, owner, templateID, entityID string, version int64) error {
+	return errors.Wrap(r.updateTemplateInstance(ctx, owner, templateID, entityID,
+		func(instances map[string]int64) { instances[entityID] = version }), "put template instance repository")
+}
+
+func (r *repo) DelTemplateInstance(ctx context.Context, owner, templateID, entityID string) error {
+	return errors.Wrap(r.updateTemplateInstance(ctx, owner, templateID, entityID,
+		func(instances map[string]int64) { delete(instances, entityID) }), "del template instance repository")
+}
+
+// ListTemplateInstance returns instances of the template, maps entity id to template version.
+func (r *repo) ListTemplateInstance(ctx context.Context, owner, templateID string) (map[string]int64, error) {
+	instances := make(map[string]int64)
+	for shard := uint32(0); shard < templateInstanceShards; shard++ {
+		items, err := r.getTemplateInstanceShard(ctx, owner, templateID, shard)
+		if nil != err {
+			return nil, errors.Wrap(err, "list template instance repository")
+		}
+		for entityID, version := range items {
+			instances[entityID] = version
+		}
+	}
+	return instances, nil
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+)
+
+func TestTemplate_Inherit(t *testing.T) {
+	parent := &Template{
+		ID:         "tpl-base",
+		Version:    2,
+		Scheme:     map[string]interface{}{"temp": map[string]interface{}{"type": "float", "unit": "C"}},
+		Properties: map[string]interface{}{"temp": 20.0, "status": "offline"},
+		Expressions: []*TemplateExpression{
+			{Path: "properties.temp_f", Expression: "$self.properties.temp * 1.8 + 32"},
+			{Path: "properties.online", Expression: "$self.properties.status == 'online'"},
+		},
+	}
+
+	child := &Template{
+		ID:         "tpl-sensor",
+		Version:    1,
+		Extends:    "tpl-base",
+		Scheme:     map[string]interface{}{"temp": map[string]interface{}{"unit": "F"}},
+		Properties: map[string]interface{}{"temp": 68.0},
+		Expressions: []*TemplateExpression{
+			{Path: "properties.temp_f", Expression: "$self.properties.temp"},
+		},
+	}
+
+	ret := child.Inherit(parent)
+	assert.Equal(t, "tpl-sensor", ret.ID)
+	assert.Equal(t, int64(1), ret.Version)
+	assert.Equal(t, map[string]interface{}{"type": "float", "unit": "F"}, ret.Scheme["temp"])
+	assert.Equal(t, map[string]interface{}{"temp": 68.0, "status": "offline"}, ret.Properties)
+	assert.Len(t, ret.Expressions, 2)
+	assert.Equal(t, "properties.online", ret.Expressions[0].Path)
+	assert.Equal(t, "$self.properties.temp", ret.Expressions[1].Expression)
+
+	// parent not modified.
+	assert.Equal(t, "C", parent.Scheme["temp"].(map[string]interface{})["unit"])
+
+	exprs := ret.InstanceExpressions("admin", "device123")
+	assert.Equal(t, "device123.properties.status == 'online'", exprs[0].Expression)
+	assert.Equal(t, "device123", exprs[0].EntityID)
+
+	scheme, properties := ret.Instantiate(nil, map[string]interface{}{"status": "online"})
+	assert.Equal(t, ret.Scheme, scheme)
+	assert.Equal(t, map[string]interface{}{"temp": 68.0, "status": "online"}, properties)
+}
+
+func TestTemplate_Decode(t *testing.T) {
+	tpl := &Template{ID: "tpl-sensor", Owner: "admin", Version: 12}
+	key, err := tpl.EncodeKey()
+	assert.Nil(t, err)
+	assert.Equal(t, "/core/v1/templates/admin/tpl-sensor/0000000012", string(key))
+
+	var ret Template
+	assert.Nil(t, ret.Decode(key, nil))
+	assert.Equal(t, *tpl, ret)
+
+	_, err = (&Template{ID: "tpl-sensor", Owner: "admin"}).EncodeKey()
+	assert.NotNil(t, err)
+}
+
+func TestRepo_PutTemplate(t *testing.T) {
+	ctx := context.Background()
+	d, err := dao.New(ctx, config.Metadata{Name: "memory"},
+		config.Metadata{Name: dao.MetadataEmbedded}, config.EtcdConfig{})
+	assert.Nil(t, err)
+	defer d.Close()
+	r := &repo{dao: d}
+
+	tpl := &Template{ID: "tpl1", Owner: "admin", Version: 1, Description: "v1"}
+	assert.Nil(t, r.PutTemplate(ctx, tpl))
+
+	// template versions are immutable.
+	err = r.PutTemplate(ctx, &Template{ID: "tpl1", Owner: "admin", Version: 1, Description: "changed"})
+	assert.ErrorIs(t, err, xerrors.ErrTemplateVersionExists)
+	ret, err := r.GetTemplate(ctx, &Template{ID: "tpl1", Owner: "admin", Version: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, "v1", ret.Description)
+}
+
+func TestRepo_ListRunningRollout(t *testing.T) {
+	ctx := context.Background()
+	d, err := dao.New(ctx, config.Metadata{Name: "memory"},
+		config.Metadata{Name: dao.MetadataEmbedded}, config.EtcdConfig{})
+	assert.Nil(t, err)
+	defer d.Close()
+	r := &repo{dao: d}
+
+	running := &TemplateRollout{ID: "rollout-1", Owner: "admin", TemplateID: "tpl1", Status: RolloutStatusRunning}
+	assert.Nil(t, r.PutTemplateRollout(ctx, running))
+	assert.Nil(t, r.PutTemplateRollout(ctx, &TemplateRollout{ID: "rollout-2", Owner: "admin",
+		TemplateID: "tpl1", Status: RolloutStatusRunning}))
+	assert.Nil(t, r.PutTemplateRollout(ctx, &TemplateRollout{ID: "rollout-2", Owner: "admin",
+		TemplateID: "tpl1", Status: RolloutStatusCompleted}))
+	// stale index of a removed rollout.
+	assert.Nil(t, d.PutResource(ctx, &runningRolloutResource{owner: "admin", id: "rollout-3"}))
+
+	rollouts, err := r.ListTemplateRollout(ctx, 0, &ListTemplateRolloutReq{Running: true})
+	assert.Nil(t, err)
+	assert.Len(t, rollouts, 1)
+	assert.Equal(t, "rollout-1", rollouts[0].ID)
+	has, _ := d.HasResource(ctx, &runningRolloutResource{owner: "admin", id: "rollout-3"})
+	assert.False(t, has)
+
+	// finished rollouts are still listed.
+	rollouts, err = r.ListTemplateRollout(ctx, 0, &ListTemplateRolloutReq{Owner: "admin"})
+	assert.Nil(t, err)
+	assert.Len(t, rollouts, 2)
+}
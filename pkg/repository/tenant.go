@@ -0,0 +1,310 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/tenant"
+)
+
+const (
+	TenantUsageStorePrefix   = "CORE.TENANT.USAGE"
+	EntityLocatorStorePrefix = "CORE.TENANT.ENTITY"
+)
+
+// TenantUsage is the resource usage of a tenant.
+type TenantUsage struct {
+	Tenant string `json:"tenant"`
+	// number of entities.
+	Entities int64 `json:"entities"`
+	// state storage in bytes.
+	Storage int64 `json:"storage"`
+}
+
+type tenantUsageResource struct {
+	tenant string
+	data   []byte
+}
+
+func (t *tenantUsageResource) EncodeKey() ([]byte, error) {
+	return []byte(TenantUsageStorePrefix + "." + t.tenant), nil
+}
+
+func (t *tenantUsageResource) Encode() ([]byte, error) {
+	return t.data, nil
+}
+
+func (t *tenantUsageResource) Decode(key, bytes []byte) error {
+	t.data = bytes
+	return nil
+}
+
+// entityLocator locates the state of an entity by tenant, and records the state size.
+type entityLocator struct {
+	id     string
+	Tenant string `json:"tenant"`
+	Size   int64  `json:"size"`
+}
+
+func (e *entityLocator) EncodeKey() ([]byte, error) {
+	return []byte(EntityLocatorStorePrefix + "." + e.id), nil
+}
+
+func (e *entityLocator) Encode() ([]byte, error) {
+	bytes, err := json.Marshal(e)
+	return bytes, errors.Wrap(err, "encode entity locator")
+}
+
+func (e *entityLocator) Decode(key, bytes []byte) error {
+	return errors.Wrap(json.Unmarshal(bytes, e), "decode entity locator")
+}
+
+// getEntityLocator returns the locator of the entity, nil if the entity is not located.
+func (r *repo) getEntityLocator(ctx context.Context, eid string) (*entityLocator, error) {
+	if loc, ok := r.locators.Load(eid); ok {
+		return loc.(*entityLocator), nil
+	}
+
+	loc := &entityLocator{id: eid}
+	if _, err := r.dao.GetStoreResource(ctx, loc); nil != err {
+		if errors.Is(err, xerrors.ErrResourceNotFound) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "get entity locator")
+	}
+
+	r.locators.Store(eid, loc)
+	return loc, nil
+}
+
+// GetEntityOwner returns the owner of the entity from the stored locator, or the stored state of entities
+// not located, returns empty if the entity not exists. the locator is read from the store, not the cache.
+func (r *repo) GetEntityOwner(ctx context.Context, eid string) (string, error) {
+	loc := &entityLocator{id: eid}
+	_, err := r.dao.GetStoreResource(ctx, loc)
+	switch {
+	case nil == err:
+		// cached locators of the tenant may have batched sizes.
+		if cached, ok := r.locators.Load(eid); !ok || cached.(*entityLocator).Tenant != loc.Tenant {
+			r.locators.Store(eid, loc)
+		}
+		return loc.Tenant, nil
+	case !errors.Is(err, xerrors.ErrResourceNotFound):
+		return "", errors.Wrap(err, "get entity owner repository")
+	}
+
+	// state stored before tenant isolation.
+	res := &entityResource{id: eid}
+	if _, err = r.dao.GetStoreResource(ctx, res); nil != err {
+		if errors.Is(err, xerrors.ErrResourceNotFound) {
+			return "", nil
+		}
+		return "", errors.Wrap(err, "get entity owner repository")
+	}
+
+	data, err := r.loadEntityState(ctx, res)
+	if nil != err {
+		return "", errors.Wrap(err, "get entity owner repository")
+	}
+	return tenant.FromState(data), nil
+}
+
+// ScanEntity calls the handler with entities located and their owners, entities stored before
+// tenant isolation are not located, errors.ErrScanUnsupported is returned if the state store does not scan.
+func (r *repo) ScanEntity(ctx context.Context, handler func(eid, owner string) error) error {
+	prefix := EntityLocatorStorePrefix + "."
+	err := r.dao.ScanStoreResource(ctx, prefix, func(key, value []byte) error {
+		loc := &entityLocator{id: strings.TrimPrefix(string(key), prefix)}
+		if err := loc.Decode(key, value); nil != err {
+			return errors.Wrapf(err, "entity %s", loc.id)
+		}
+		return handler(loc.id, loc.Tenant)
+	})
+	return errors.Wrap(err, "scan entity repository")
+}
+
+// usageFlushInterval bounds how long batched storage changes of tenants are kept in memory.
+const usageFlushInterval = time.Second
+
+// usageBatch batches size changes of located entities, the usage of tenants and locators
+// are written per flush instead of per entity write.
+type usageBatch struct {
+	lock sync.Mutex
+	// storage changes of tenants.
+	storage map[string]int64
+	// locators whose sizes changed.
+	locators  map[string]*entityLocator
+	flushedAt time.Time
+}
+
+// add batches the size change of the entity, returns true if the batch should be flushed.
+func (u *usageBatch) add(loc *entityLocator, storage int64) bool {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	u.init()
+	u.storage[loc.Tenant] += storage
+	u.locators[loc.id] = loc
+	return time.Since(u.flushedAt) >= usageFlushInterval
+}
+
+// restore batches changes not written again, locators batched later are kept.
+func (u *usageBatch) restore(storage map[string]int64, locators map[string]*entityLocator) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	u.init()
+	for tenantID, changed := range storage {
+		u.storage[tenantID] += changed
+	}
+	for eid, loc := range locators {
+		if _, ok := u.locators[eid]; !ok {
+			u.locators[eid] = loc
+		}
+	}
+}
+
+func (u *usageBatch) init() {
+	if nil == u.storage {
+		u.storage = make(map[string]int64)
+		u.locators = make(map[string]*entityLocator)
+		u.flushedAt = time.Now()
+	}
+}
+
+// forget drops the batched locator of the entity, which is written or removed.
+func (u *usageBatch) forget(eid string) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	delete(u.locators, eid)
+}
+
+// take returns and resets batched changes.
+func (u *usageBatch) take() (map[string]int64, map[string]*entityLocator) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	storage, locators := u.storage, u.locators
+	u.storage, u.locators = nil, nil
+	u.flushedAt = time.Now()
+	return storage, locators
+}
+
+// locateEntity records the tenant and state size of the entity, and accounts tenant usage.
+// usage is written immediately when the entity is created or moved, size changes are batched.
+func (r *repo) locateEntity(ctx context.Context, eid, tenantID string, size int64) error {
+	loc, err := r.getEntityLocator(ctx, eid)
+	if nil != err {
+		return errors.Wrap(err, "locate entity")
+	} else if loc != nil && loc.Tenant == tenantID && loc.Size == size {
+		return nil
+	}
+
+	switch {
+	case loc == nil:
+		// remove state stored before tenant isolation.
+		if err = r.removeEntityState(ctx, &entityResource{id: eid}); nil != err {
+			return errors.Wrap(err, "locate entity")
+		}
+		err = r.updateTenantUsage(ctx, tenantID, 1, size)
+	case loc.Tenant != tenantID:
+		if err = r.removeEntityState(ctx, &entityResource{tenant: loc.Tenant, id: eid}); nil != err {
+			return errors.Wrap(err, "locate entity")
+		} else if err = r.updateTenantUsage(ctx, loc.Tenant, -1, -loc.Size); nil != err {
+			return errors.Wrap(err, "locate entity")
+		}
+		err = r.updateTenantUsage(ctx, tenantID, 1, size)
+	default:
+		prev := loc.Size
+		loc = &entityLocator{id: eid, Tenant: tenantID, Size: size}
+		r.locators.Store(eid, loc)
+		if r.usages.add(loc, size-prev) {
+			return errors.Wrap(r.flushTenantUsage(ctx), "locate entity")
+		}
+		return nil
+	}
+	if nil != err {
+		return errors.Wrap(err, "locate entity")
+	}
+
+	loc = &entityLocator{id: eid, Tenant: tenantID, Size: size}
+	r.usages.forget(eid)
+	if err = r.dao.StoreResource(ctx, loc); nil != err {
+		return errors.Wrap(err, "locate entity")
+	}
+	r.locators.Store(eid, loc)
+	return nil
+}
+
+// unlocateEntity removes the locator of the entity, and accounts tenant usage.
+func (r *repo) unlocateEntity(ctx context.Context, loc *entityLocator) error {
+	r.locators.Delete(loc.id)
+	r.usages.forget(loc.id)
+	if err := r.dao.RemoveStoreResource(ctx, loc); nil != err {
+		return errors.Wrap(err, "unlocate entity")
+	}
+	return errors.Wrap(r.updateTenantUsage(ctx, loc.Tenant, -1, -loc.Size), "unlocate entity")
+}
+
+// flushTenantUsage writes batched locators and storage changes of tenants,
+// changes not written are batched again.
+func (r *repo) flushTenantUsage(ctx context.Context) error {
+	storage, locators := r.usages.take()
+	for eid, loc := range locators {
+		// skip locators of entities removed or moved since.
+		if cached, ok := r.locators.Load(eid); ok && cached == loc {
+			if err := r.dao.StoreResource(ctx, loc); nil != err {
+				r.usages.restore(storage, locators)
+				return errors.Wrap(err, "flush tenant usage")
+			}
+		}
+		delete(locators, eid)
+	}
+
+	for tenantID, changed := range storage {
+		if changed != 0 {
+			if err := r.updateTenantUsage(ctx, tenantID, 0, changed); nil != err {
+				r.usages.restore(storage, nil)
+				return errors.Wrap(err, "flush tenant usage")
+			}
+		}
+		delete(storage, tenantID)
+	}
+	return nil
+}
+
+// updateTenantUsage accounts tenant usage with compare-and-swap, concurrent updates of all nodes are kept.
+func (r *repo) updateTenantUsage(ctx context.Context, tenantID string, entities, storage int64) error {
+	res := &tenantUsageResource{tenant: tenantID}
+	err := r.dao.UpdateStoreResource(ctx, res, func(exists bool) error {
+		usage := &TenantUsage{Tenant: tenantID}
+		if exists {
+			if err := json.Unmarshal(res.data, usage); nil != err {
+				return errors.Wrap(err, "decode tenant usage")
+			}
+		}
+
+		usage.Entities += entities
+		usage.Storage += storage
+		bytes, err := json.Marshal(usage)
+		res.data = bytes
+		return errors.Wrap(err, "encode tenant usage")
+	})
+	return errors.Wrap(err, "update tenant usage")
+}
+
+func (r *repo) GetTenantUsage(ctx context.Context, tenantID string) (*TenantUsage, error) {
+	usage := &TenantUsage{Tenant: tenantID}
+	ret, err := r.dao.GetStoreResource(ctx, &tenantUsageResource{tenant: tenantID})
+	if nil != err {
+		if errors.Is(err, xerrors.ErrResourceNotFound) {
+			return usage, nil
+		}
+		return nil, errors.Wrap(err, "get tenant usage repository")
+	}
+
+	res, _ := ret.(*tenantUsageResource)
+	err = json.Unmarshal(res.data, usage)
+	return usage, errors.Wrap(err, "get tenant usage repository")
+}
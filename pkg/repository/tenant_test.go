@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/core/pkg/resource"
+	"github.com/tkeel-io/core/pkg/resource/store"
+	_ "github.com/tkeel-io/core/pkg/resource/store/memory"
+)
+
+func TestRepo_TenantEntity(t *testing.T) {
+	ctx := context.Background()
+	memDao, err := dao.NewMock(ctx, config.Metadata{Name: "memory"}, config.EtcdConfig{})
+	assert.Nil(t, err)
+	r := &repo{dao: memDao}
+
+	// state stored before tenant isolation.
+	legacy := []byte(`{"id":"device1"}`)
+	assert.Nil(t, memDao.StoreResource(ctx, &entityResource{id: "device1", data: legacy}))
+
+	state := []byte(`{"id":"device1","owner":"tenant1"}`)
+	assert.Nil(t, r.PutEntity(ctx, "device1", state))
+	_, err = memDao.GetStoreResource(ctx, &entityResource{id: "device1"})
+	assert.ErrorIs(t, err, xerrors.ErrResourceNotFound)
+	_, err = memDao.GetStoreResource(ctx, &entityResource{tenant: "tenant1", id: "device1"})
+	assert.Nil(t, err)
+
+	bytes, err := r.GetEntity(ctx, "device1")
+	assert.Nil(t, err)
+	assert.Equal(t, state, bytes)
+
+	usage, err := r.GetTenantUsage(ctx, "tenant1")
+	assert.Nil(t, err)
+	assert.Equal(t, &TenantUsage{Tenant: "tenant1", Entities: 1, Storage: int64(len(state))}, usage)
+
+	// entity moves to another tenant.
+	moved := []byte(`{"id":"device1","owner":"tenant2"}`)
+	assert.Nil(t, r.PutEntity(ctx, "device1", moved))
+	usage, err = r.GetTenantUsage(ctx, "tenant1")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), usage.Entities)
+	assert.Equal(t, int64(0), usage.Storage)
+	usage, err = r.GetTenantUsage(ctx, "tenant2")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), usage.Entities)
+
+	// size changes are accounted per flush.
+	grown := []byte(`{"id":"device1","owner":"tenant2","properties":{"temp":20}}`)
+	assert.Nil(t, r.PutEntity(ctx, "device1", grown))
+	usage, err = r.GetTenantUsage(ctx, "tenant2")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(moved)), usage.Storage)
+	assert.Nil(t, r.FlushEntity(ctx))
+	usage, err = r.GetTenantUsage(ctx, "tenant2")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(grown)), usage.Storage)
+
+	owners := make(map[string]string)
+	assert.Nil(t, r.ScanEntity(ctx, func(eid, owner string) error {
+		owners[eid] = owner
+		return nil
+	}))
+	assert.Equal(t, map[string]string{"device1": "tenant2"}, owners)
+
+	assert.Nil(t, r.DelEntity(ctx, "device1"))
+	_, err = r.GetEntity(ctx, "device1")
+	assert.NotNil(t, err)
+	usage, err = r.GetTenantUsage(ctx, "tenant2")
+	assert.Nil(t, err)
+	assert.Equal(t, &TenantUsage{Tenant: "tenant2"}, usage)
+}
+
+func TestRepo_updateTenantUsage(t *testing.T) {
+	ctx := context.Background()
+	memDao, err := dao.NewMock(ctx, config.Metadata{Name: "memory"}, config.EtcdConfig{})
+	assert.Nil(t, err)
+
+	// repositories of two nodes account usage of the tenant concurrently.
+	var wg sync.WaitGroup
+	for _, r := range []*repo{{dao: memDao}, {dao: memDao}} {
+		wg.Add(1)
+		go func(r *repo) {
+			defer wg.Done()
+			for index := 0; index < 20; index++ {
+				assert.Nil(t, r.updateTenantUsage(ctx, "tenant1", 1, 10))
+			}
+		}(r)
+	}
+	wg.Wait()
+
+	usage, err := (&repo{dao: memDao}).GetTenantUsage(ctx, "tenant1")
+	assert.Nil(t, err)
+	assert.Equal(t, &TenantUsage{Tenant: "tenant1", Entities: 40, Storage: 400}, usage)
+}
+
+// notFoundStore reports missing state with errors.ErrEntityNotFound, as the dapr store does.
+type notFoundStore struct {
+	store.Store
+}
+
+func (s *notFoundStore) Get(ctx context.Context, key string) (*store.StateItem, error) {
+	item, err := s.Store.Get(ctx, key)
+	if errors.Is(err, xerrors.ErrResourceNotFound) {
+		return nil, xerrors.ErrEntityNotFound
+	}
+	return item, err
+}
+
+func init() {
+	store.Register("notfound", func(properties map[string]interface{}) (store.Store, error) {
+		s, err := store.NewStore(resource.Metadata{Name: "memory"})
+		return &notFoundStore{Store: s}, err
+	})
+}
+
+func TestRepo_EntityNotFoundStore(t *testing.T) {
+	ctx := context.Background()
+	notFoundDao, err := dao.NewMock(ctx, config.Metadata{Name: "notfound"}, config.EtcdConfig{})
+	assert.Nil(t, err)
+	r := &repo{dao: notFoundDao}
+
+	owner, err := r.GetEntityOwner(ctx, "device1")
+	assert.Nil(t, err)
+	assert.Equal(t, "", owner)
+	_, err = r.GetEntity(ctx, "device1")
+	assert.ErrorIs(t, err, xerrors.ErrResourceNotFound)
+
+	state := []byte(`{"id":"device1","owner":"tenant1"}`)
+	assert.Nil(t, r.PutEntity(ctx, "device1", state))
+	bytes, err := r.GetEntity(ctx, "device1")
+	assert.Nil(t, err)
+	assert.Equal(t, state, bytes)
+
+	usage, err := r.GetTenantUsage(ctx, "tenant1")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), usage.Entities)
+	usage, err = r.GetTenantUsage(ctx, "tenant2")
+	assert.Nil(t, err)
+	assert.Equal(t, &TenantUsage{Tenant: "tenant2"}, usage)
+	assert.Nil(t, notFoundDao.CheckStore(ctx))
+}
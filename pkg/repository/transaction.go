@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+)
+
+const (
+	TransactionPrefix = "/core/v1/transactions"
+	TxLockStorePrefix = "CORE.TXLOCK"
+)
+
+// decisions of transactions.
+const (
+	TxDecisionCommit = "commit"
+	TxDecisionAbort  = "abort"
+)
+
+// TxDecision is the durable decision of a transaction, it is made once, by the coordinator
+// after phase one, or by a participant aborting the transaction on lock expiry.
+type TxDecision struct {
+	ID       string   `json:"id"`
+	Decision string   `json:"decision"`
+	Entities []string `json:"entities,omitempty"`
+	// DecidedAt is unix milliseconds.
+	DecidedAt int64 `json:"decided_at"`
+}
+
+func (t *TxDecision) EncodeKey() ([]byte, error) {
+	if t.ID == "" {
+		return nil, errors.Wrap(xerrors.ErrInvalidParam, "encode transaction key")
+	}
+	return []byte(TransactionPrefix + "/" + t.ID), nil
+}
+
+func (t *TxDecision) Encode() ([]byte, error) {
+	bytes, err := json.Marshal(t)
+	return bytes, errors.Wrap(err, "encode transaction")
+}
+
+func (t *TxDecision) Decode(key, bytes []byte) error {
+	if bytes != nil {
+		err := json.Unmarshal(bytes, t)
+		return errors.Wrap(err, "decode transaction")
+	}
+	t.ID = strings.TrimPrefix(string(key), TransactionPrefix+"/")
+	return nil
+}
+
+// DecideTransaction records the decision of the transaction unless it is decided, returns the recorded decision.
+func (r *repo) DecideTransaction(ctx context.Context, decision *TxDecision) (*TxDecision, error) {
+	err := r.dao.CreateResource(ctx, decision)
+	if nil == err {
+		return decision, nil
+	} else if !errors.Is(err, xerrors.ErrResourceAlreadyExists) {
+		return nil, errors.Wrap(err, "decide transaction repository")
+	}
+
+	decided := &TxDecision{ID: decision.ID}
+	_, err = r.dao.GetResource(ctx, decided)
+	return decided, errors.Wrap(err, "decide transaction repository")
+}
+
+// PurgeTransactions removes decisions of transactions decided before the time in unix milliseconds.
+func (r *repo) PurgeTransactions(ctx context.Context, before int64) (int, error) {
+	ress, err := r.dao.ListResource(ctx, 0, TransactionPrefix+"/",
+		func(key, raw []byte) (dao.Resource, error) {
+			var res TxDecision // escape.
+			err := res.Decode(key, raw)
+			return &res, errors.Wrap(err, "decode transaction")
+		})
+	if errors.Is(err, xerrors.ErrResourceNotFound) {
+		return 0, nil
+	} else if nil != err {
+		return 0, errors.Wrap(err, "purge transactions repository")
+	}
+
+	purged := 0
+	for index := range ress {
+		if decision, ok := ress[index].(*TxDecision); ok && decision.DecidedAt < before {
+			if err = r.dao.DelResource(ctx, decision); nil != err {
+				return purged, errors.Wrap(err, "purge transactions repository")
+			}
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+// TxChange is a change of an entity prepared by a transaction.
+type TxChange struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value []byte `json:"value,omitempty"`
+}
+
+// TxLock is the lock of an entity prepared by a transaction, it is kept with the state of the entity,
+// so that the prepared state and events deferred by the lock survive restarts of the runtime.
+type TxLock struct {
+	EntityID string `json:"entity_id"`
+	TxID     string `json:"tx_id"`
+	// Expires is unix milliseconds.
+	Expires int64 `json:"expires"`
+	// Prepared is the state of the entity with patches of the transaction applied.
+	Prepared []byte      `json:"prepared"`
+	Changes  []*TxChange `json:"changes,omitempty"`
+	// Deferred are encoded events of the entity arriving while the entity is locked,
+	// they are appended one by one by AppendTxDeferred, not written with the lock.
+	Deferred [][]byte `json:"-"`
+}
+
+type txLockResource struct {
+	lock *TxLock
+}
+
+func (t *txLockResource) EncodeKey() ([]byte, error) {
+	return []byte(TxLockStorePrefix + "." + t.lock.EntityID), nil
+}
+
+func (t *txLockResource) Encode() ([]byte, error) {
+	bytes, err := json.Marshal(t.lock)
+	return bytes, errors.Wrap(err, "encode transaction lock")
+}
+
+func (t *txLockResource) Decode(key, bytes []byte) error {
+	err := json.Unmarshal(bytes, t.lock)
+	return errors.Wrap(err, "decode transaction lock")
+}
+
+// txDeferredResource is an event deferred by the lock, keyed by the transaction and the index of the event,
+// events deferred by stale locks of the entity are never read.
+type txDeferredResource struct {
+	entityID string
+	txID     string
+	index    int
+	data     []byte
+}
+
+func (t *txDeferredResource) EncodeKey() ([]byte, error) {
+	return []byte(fmt.Sprintf("%s.%s.%s.%d", TxLockStorePrefix, t.entityID, t.txID, t.index)), nil
+}
+
+func (t *txDeferredResource) Encode() ([]byte, error) {
+	return t.data, nil
+}
+
+func (t *txDeferredResource) Decode(key, bytes []byte) error {
+	t.data = bytes
+	return nil
+}
+
+// PutTxLock puts the lock without deferred events.
+func (r *repo) PutTxLock(ctx context.Context, lock *TxLock) error {
+	err := r.dao.StoreResource(ctx, &txLockResource{lock: lock})
+	return errors.Wrap(err, "put transaction lock repository")
+}
+
+// AppendTxDeferred puts the index-th event deferred by the lock, events are appended in order without gaps.
+func (r *repo) AppendTxDeferred(ctx context.Context, entityID, txID string, index int, event []byte) error {
+	err := r.dao.StoreResource(ctx, &txDeferredResource{entityID: entityID, txID: txID, index: index, data: event})
+	return errors.Wrap(err, "append transaction deferred event repository")
+}
+
+// GetTxLock returns the lock with deferred events of the entity.
+func (r *repo) GetTxLock(ctx context.Context, entityID string) (*TxLock, error) {
+	res := &txLockResource{lock: &TxLock{EntityID: entityID}}
+	if _, err := r.dao.GetStoreResource(ctx, res); nil != err {
+		return nil, errors.Wrap(err, "get transaction lock repository")
+	}
+
+	lock := res.lock
+	for index := 0; ; index++ {
+		item := &txDeferredResource{entityID: entityID, txID: lock.TxID, index: index}
+		if _, err := r.dao.GetStoreResource(ctx, item); errors.Is(err, xerrors.ErrResourceNotFound) {
+			return lock, nil
+		} else if nil != err {
+			return nil, errors.Wrap(err, "get transaction lock repository")
+		}
+		lock.Deferred = append(lock.Deferred, item.data)
+	}
+}
+
+// DelTxLock removes the lock with the deferred events of the entity, the lock is removed last.
+func (r *repo) DelTxLock(ctx context.Context, entityID, txID string, deferred int) error {
+	for index := 0; index < deferred; index++ {
+		if err := r.dao.RemoveStoreResource(ctx, &txDeferredResource{
+			entityID: entityID, txID: txID, index: index}); nil != err {
+			return errors.Wrap(err, "del transaction lock repository")
+		}
+	}
+
+	err := r.dao.RemoveStoreResource(ctx, &txLockResource{lock: &TxLock{EntityID: entityID}})
+	return errors.Wrap(err, "del transaction lock repository")
+}
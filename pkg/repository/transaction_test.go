@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	_ "github.com/tkeel-io/core/pkg/resource/store/memory"
+)
+
+func TestRepo_DecideTransaction(t *testing.T) {
+	ctx := context.Background()
+	daoIns, err := dao.New(ctx, config.Metadata{Name: "memory"},
+		config.Metadata{Name: dao.MetadataEmbedded}, config.EtcdConfig{})
+	assert.Nil(t, err)
+	defer daoIns.Close()
+	r := New(daoIns)
+
+	// the transaction is decided once.
+	decided, err := r.DecideTransaction(ctx, &TxDecision{ID: "tx1", Decision: TxDecisionCommit,
+		Entities: []string{"device1", "device2"}, DecidedAt: 100})
+	assert.Nil(t, err)
+	assert.Equal(t, TxDecisionCommit, decided.Decision)
+	decided, err = r.DecideTransaction(ctx, &TxDecision{ID: "tx1", Decision: TxDecisionAbort, DecidedAt: 200})
+	assert.Nil(t, err)
+	assert.Equal(t, TxDecisionCommit, decided.Decision)
+	assert.Equal(t, []string{"device1", "device2"}, decided.Entities)
+
+	_, err = r.DecideTransaction(ctx, &TxDecision{ID: "tx2", Decision: TxDecisionAbort, DecidedAt: 300})
+	assert.Nil(t, err)
+	purged, err := r.PurgeTransactions(ctx, 200)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, purged)
+	decided, err = r.DecideTransaction(ctx, &TxDecision{ID: "tx2", Decision: TxDecisionCommit, DecidedAt: 400})
+	assert.Nil(t, err)
+	assert.Equal(t, TxDecisionAbort, decided.Decision)
+
+	// locks are kept with prepared states, deferred events are appended.
+	assert.Nil(t, r.PutTxLock(ctx, &TxLock{EntityID: "device1", TxID: "tx1", Expires: 100,
+		Prepared: []byte(`{"id":"device1"}`), Changes: []*TxChange{{Op: "replace", Path: "properties.temp", Value: []byte("30")}}}))
+	assert.Nil(t, r.AppendTxDeferred(ctx, "device1", "tx1", 0, []byte("event1")))
+	assert.Nil(t, r.AppendTxDeferred(ctx, "device1", "tx1", 1, []byte("event2")))
+	lock, err := r.GetTxLock(ctx, "device1")
+	assert.Nil(t, err)
+	assert.Equal(t, "tx1", lock.TxID)
+	assert.Equal(t, []byte("30"), lock.Changes[0].Value)
+	assert.Equal(t, [][]byte{[]byte("event1"), []byte("event2")}, lock.Deferred)
+	assert.Nil(t, r.DelTxLock(ctx, "device1", "tx1", 2))
+	_, err = r.GetTxLock(ctx, "device1")
+	assert.ErrorIs(t, err, xerrors.ErrResourceNotFound)
+
+	// events deferred by stale locks are not read.
+	assert.Nil(t, r.AppendTxDeferred(ctx, "device1", "tx1", 0, []byte("event1")))
+	assert.Nil(t, r.PutTxLock(ctx, &TxLock{EntityID: "device1", TxID: "tx2", Prepared: []byte(`{"id":"device1"}`)}))
+	lock, err = r.GetTxLock(ctx, "device1")
+	assert.Nil(t, err)
+	assert.Empty(t, lock.Deferred)
+}
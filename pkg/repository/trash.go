@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+)
+
+const (
+	TrashPrefix = "/core/v1/trash"
+)
+
+type ListTrashReq struct {
+	Owner string
+}
+
+var _ dao.Resource = (*TrashEntity)(nil)
+
+// TrashEntity records a soft deleted entity until it is restored or purged,
+// keeps expressions and subscriptions of the entity suspended meanwhile.
+type TrashEntity struct {
+	// entity identifier.
+	ID string `json:"id"`
+	// entity owner.
+	Owner string `json:"owner"`
+	// entity type.
+	Type string `json:"type"`
+	// entity source.
+	Source string `json:"source"`
+	// template of the entity, for restoring template instance index.
+	TemplateID      string `json:"template_id,omitempty"`
+	TemplateVersion int64  `json:"template_version,omitempty"`
+	// deleted time, unix milliseconds.
+	DeletedAt int64 `json:"deleted_at"`
+	// suspended expressions of the entity.
+	Expressions []*Expression `json:"expressions,omitempty"`
+	// suspended subscriptions of the entity.
+	Subscriptions []*Subscription `json:"subscriptions,omitempty"`
+}
+
+func (t *TrashEntity) EncodeKey() ([]byte, error) {
+	if t.Owner == "" || t.ID == "" {
+		return nil, errors.Wrap(xerrors.ErrInvalidParam, "encode trash entity key")
+	}
+
+	keyString := fmt.Sprintf("%s/%s/%s",
+		TrashPrefix, t.Owner, t.ID)
+	return []byte(keyString), nil
+}
+
+func (t *TrashEntity) Encode() ([]byte, error) {
+	bytes, err := json.Marshal(t)
+	return bytes, errors.Wrap(err, "encode TrashEntity")
+}
+
+func (t *TrashEntity) Decode(key, bytes []byte) error {
+	if bytes != nil {
+		err := json.Unmarshal(bytes, t)
+		return errors.Wrap(err, "decode TrashEntity")
+	}
+	// /core/v1/trash/admin/device123
+	keys := strings.Split(string(key), "/")
+	if len(keys) != 6 {
+		return errors.Errorf("error:decode TrashEntity from key[%s]", string(key))
+	}
+	t.Owner = keys[4]
+	t.ID = keys[5]
+	return nil
+}
+
+func (r *repo) PutTrash(ctx context.Context, trash *TrashEntity) error {
+	err := r.dao.PutResource(ctx, trash)
+	return errors.Wrap(err, "put trash repository")
+}
+
+func (r *repo) GetTrash(ctx context.Context, trash *TrashEntity) (*TrashEntity, error) {
+	_, err := r.dao.GetResource(ctx, trash)
+	return trash, errors.Wrap(err, "get trash repository")
+}
+
+func (r *repo) DelTrash(ctx context.Context, trash *TrashEntity) error {
+	err := r.dao.DelResource(ctx, trash)
+	return errors.Wrap(err, "delete trash repository")
+}
+
+func (r *repo) ListTrash(ctx context.Context, rev int64, req *ListTrashReq) ([]*TrashEntity, error) {
+	// construct prefix.
+	prefix := TrashPrefix + "/"
+	if req.Owner != "" {
+		prefix = fmt.Sprintf("%s/%s/", TrashPrefix, req.Owner)
+	}
+
+	ress, err := r.dao.ListResource(ctx, rev, prefix,
+		func(key, raw []byte) (dao.Resource, error) {
+			var res TrashEntity // escape.
+			err := res.Decode(key, raw)
+			return &res, errors.Wrap(err, "decode trash entity")
+		})
+
+	var trashes []*TrashEntity
+	for index := range ress {
+		if trash, ok := ress[index].(*TrashEntity); ok {
+			trashes = append(trashes, trash)
+		}
+	}
+	return trashes, errors.Wrap(err, "list trash repository")
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrashEntity_Decode(t *testing.T) {
+	trash := &TrashEntity{ID: "device123", Owner: "admin"}
+	key, err := trash.EncodeKey()
+	assert.Nil(t, err)
+	assert.Equal(t, "/core/v1/trash/admin/device123", string(key))
+
+	var ret TrashEntity
+	assert.Nil(t, ret.Decode(key, nil))
+	assert.Equal(t, *trash, ret)
+
+	trash.DeletedAt = 1650000000000
+	trash.Expressions = []*Expression{{ID: "expr1", Owner: "admin", EntityID: "device123", Path: "temp"}}
+	bytes, err := trash.Encode()
+	assert.Nil(t, err)
+	ret = TrashEntity{}
+	assert.Nil(t, ret.Decode(key, bytes))
+	assert.Equal(t, *trash, ret)
+
+	_, err = (&TrashEntity{ID: "device123"}).EncodeKey()
+	assert.NotNil(t, err)
+}
@@ -2,15 +2,29 @@ package repository
 
 import (
 	"context"
+	"time"
 )
 
 type IRepository interface {
 	GetLastRevision(ctx context.Context) int64
+	AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error)
 	PutEntity(ctx context.Context, eid string, data []byte) error
 	FlushEntity(ctx context.Context) error
 	GetEntity(ctx context.Context, eid string) ([]byte, error)
 	DelEntity(ctx context.Context, eid string) error
 	HasEntity(ctx context.Context, eid string) (bool, error)
+	GetEntityOwner(ctx context.Context, eid string) (string, error)
+	ScanEntity(ctx context.Context, handler func(eid, owner string) error) error
+	GetTenantUsage(ctx context.Context, tenantID string) (*TenantUsage, error)
+	PutRequest(ctx context.Context, req *Request) error
+	GetRequest(ctx context.Context, reqID string) (*Request, error)
+	PurgeRequests(ctx context.Context, before int64) (int, error)
+	DecideTransaction(ctx context.Context, decision *TxDecision) (*TxDecision, error)
+	PurgeTransactions(ctx context.Context, before int64) (int, error)
+	PutTxLock(ctx context.Context, lock *TxLock) error
+	AppendTxDeferred(ctx context.Context, entityID, txID string, index int, event []byte) error
+	GetTxLock(ctx context.Context, entityID string) (*TxLock, error)
+	DelTxLock(ctx context.Context, entityID, txID string, deferred int) error
 	PutExpression(ctx context.Context, expr Expression) error
 	GetExpression(ctx context.Context, expr Expression) (Expression, error)
 	DelExpression(ctx context.Context, expr Expression) error
@@ -19,10 +33,49 @@ type IRepository interface {
 	ListExpression(ctx context.Context, rev int64, req *ListExprReq) ([]*Expression, error)
 	RangeExpression(ctx context.Context, rev int64, handler RangeExpressionFunc)
 	WatchExpression(ctx context.Context, rev int64, handler WatchExpressionFunc)
+	PutExprState(ctx context.Context, exprID string, data []byte) error
+	GetExprState(ctx context.Context, exprID string) ([]byte, error)
+	DelExprState(ctx context.Context, exprID string) error
+	PutDedupState(ctx context.Context, entityID string, data []byte) error
+	GetDedupState(ctx context.Context, entityID string) ([]byte, error)
 	PutSubscription(ctx context.Context, expr *Subscription) error
 	GetSubscription(ctx context.Context, expr *Subscription) (*Subscription, error)
 	DelSubscription(ctx context.Context, expr *Subscription) error
 	HasSubscription(ctx context.Context, expr *Subscription) (bool, error)
+	ListSubscription(ctx context.Context, rev int64, req *ListSubscriptionReq) ([]*Subscription, error)
 	RangeSubscription(ctx context.Context, rev int64, handler RangeSubscriptionFunc)
 	WatchSubscription(ctx context.Context, rev int64, handler WatchSubscriptionFunc)
+	PutSchema(ctx context.Context, schema Schema) error
+	CreateSchema(ctx context.Context, schema Schema) error
+	GetSchema(ctx context.Context, schema Schema) (Schema, error)
+	DelSchema(ctx context.Context, schema Schema) error
+	HasSchema(ctx context.Context, schema Schema) (bool, error)
+	ListSchema(ctx context.Context, rev int64, req *ListSchemaReq) ([]*Schema, error)
+	RangeSchema(ctx context.Context, rev int64, handler RangeSchemaFunc)
+	PutAlarmRule(ctx context.Context, rule *AlarmRule) error
+	CreateAlarmRule(ctx context.Context, rule *AlarmRule) error
+	GetAlarmRule(ctx context.Context, rule *AlarmRule) (*AlarmRule, error)
+	DelAlarmRule(ctx context.Context, rule *AlarmRule) error
+	ListAlarmRule(ctx context.Context, rev int64, req *ListAlarmRuleReq) ([]*AlarmRule, error)
+	RangeAlarmRule(ctx context.Context, rev int64, handler RangeAlarmRuleFunc)
+	WatchAlarmRule(ctx context.Context, rev int64, handler WatchAlarmRuleFunc)
+	UpdateAlarm(ctx context.Context, rule *AlarmRule, update func(*Alarm) error) (*Alarm, error)
+	GetAlarm(ctx context.Context, owner, entityID, ruleID string) (*Alarm, error)
+	DelAlarm(ctx context.Context, owner, entityID, ruleID string) error
+	AppendAlarmHistory(ctx context.Context, record *AlarmRecord, limit int) error
+	GetAlarmHistory(ctx context.Context, owner, entityID, ruleID string) ([]*AlarmRecord, error)
+	PutTemplate(ctx context.Context, tpl *Template) error
+	GetTemplate(ctx context.Context, tpl *Template) (*Template, error)
+	DelTemplate(ctx context.Context, tpl *Template) error
+	ListTemplate(ctx context.Context, rev int64, req *ListTemplateReq) ([]*Template, error)
+	PutTemplateInstance(ctx context.Context, owner, templateID, entityID string, version int64) error
+	DelTemplateInstance(ctx context.Context, owner, templateID, entityID string) error
+	ListTemplateInstance(ctx context.Context, owner, templateID string) (map[string]int64, error)
+	PutTemplateRollout(ctx context.Context, rollout *TemplateRollout) error
+	GetTemplateRollout(ctx context.Context, rollout *TemplateRollout) (*TemplateRollout, error)
+	ListTemplateRollout(ctx context.Context, rev int64, req *ListTemplateRolloutReq) ([]*TemplateRollout, error)
+	PutTrash(ctx context.Context, trash *TrashEntity) error
+	GetTrash(ctx context.Context, trash *TrashEntity) (*TrashEntity, error)
+	DelTrash(ctx context.Context, trash *TrashEntity) error
+	ListTrash(ctx context.Context, rev int64, req *ListTrashReq) ([]*TrashEntity, error)
 }
@@ -36,6 +36,11 @@ func (r *RawDataEntry) Write(ctx context.Context, req *rawdata.Request) (err err
 	return r.ts.Send(ctx, req)
 }
 
+// Flush writes pending batches of the transport.
+func (r *RawDataEntry) Flush(ctx context.Context) error {
+	return r.ts.Flush(ctx)
+}
+
 func NewRawDataEntry() rawdata.Service {
 	return &RawDataEntry{}
 }
@@ -2,7 +2,6 @@ package clickhouse
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -11,9 +10,11 @@ import (
 	"github.com/tkeel-io/core/pkg/resource/transport"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
 	pb "github.com/tkeel-io/core/api/core/v1"
 	"github.com/tkeel-io/core/pkg/resource"
 	"github.com/tkeel-io/core/pkg/resource/rawdata"
+	"github.com/tkeel-io/core/pkg/tenant"
 	"github.com/tkeel-io/kit/log"
 )
 
@@ -123,6 +124,19 @@ func (c *Clickhouse) Init(metadata resource.Metadata) error {
 	return nil
 }
 
+// Ping checks the connection to one of the clickhouse servers.
+func (c *Clickhouse) Ping(ctx context.Context) error {
+	if nil == c.balance {
+		return errors.New("clickhouse not connected")
+	}
+
+	server, err := c.balance.Select(nil)
+	if nil != err {
+		return errors.Wrap(err, "select clickhouse server")
+	}
+	return errors.Wrap(server.DB.PingContext(ctx), "ping clickhouse")
+}
+
 func (c *Clickhouse) Write(ctx context.Context, req *rawdata.Request) (err error) {
 	// log.Info("chronus Insert ", logf.Any("messages", messages)).
 	msg, err := c.BuildBulkData(req)
@@ -190,6 +204,11 @@ func (c *Clickhouse) Query(ctx context.Context, req *pb.GetRawdataRequest) (resp
 	querySQL += fmt.Sprintf(" AND `path`='%s'", req.Path)
 	countSQL += fmt.Sprintf(" AND `path`='%s'", req.Path)
 
+	if tenantID := tenant.FromContext(ctx); tenantID != "" {
+		querySQL += fmt.Sprintf(" AND has(tag, '%s')", tenant.Tag(tenantID))
+		countSQL += fmt.Sprintf(" AND has(tag, '%s')", tenant.Tag(tenantID))
+	}
+
 	filters := make([]string, 0)
 	if req.Filters != nil {
 		for k, v := range req.Filters {
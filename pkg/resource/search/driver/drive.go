@@ -15,6 +15,16 @@ type SearchEngine interface {
 	Delete(ctx context.Context, id string) error
 }
 
+// Mapper is implemented by search engines with explicit field mappings.
+type Mapper interface {
+	PutMapping(ctx context.Context, mapping string) error
+}
+
+// Pinger is implemented by search engines which check connections to their clusters.
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
 type SelectDriveOption func() Type
 
 func Parse(drive string) SelectDriveOption {
@@ -20,11 +20,13 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"net/url"
 	"reflect"
 	"strings"
+	"sync"
 
 	"github.com/goinggo/mapstructure"
 	pb "github.com/tkeel-io/core/api/core/v1"
@@ -56,6 +58,8 @@ type ESConfig struct {
 
 type ESClient struct {
 	Client *elastic.Client
+	// aliases caches created tenant aliases.
+	aliases sync.Map
 }
 
 func NewElasticsearchEngine(cfgJSON map[string]interface{}) (SearchEngine, error) {
@@ -95,6 +99,35 @@ func NewElasticsearchEngine(cfgJSON map[string]interface{}) (SearchEngine, error
 	return &ESClient{Client: client}, nil
 }
 
+// TenantAlias returns the name of the filtered alias of the tenant,
+// tenants with characters invalid in index names are hex encoded.
+func TenantAlias(tenantID string) string {
+	for _, c := range tenantID {
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			return EntityIndex + "-tenant-x" + hex.EncodeToString([]byte(tenantID))
+		}
+	}
+	return EntityIndex + "-tenant-" + tenantID
+}
+
+// tenantIndex returns the index searches of the tenant are performed on,
+// which is the entity index filtered by owner.
+func (es *ESClient) tenantIndex(ctx context.Context, tenantID string) (string, error) {
+	alias := TenantAlias(tenantID)
+	if _, ok := es.aliases.Load(alias); ok {
+		return alias, nil
+	}
+
+	filter := elastic.NewTermQuery("owner.keyword", tenantID)
+	if _, err := es.Client.Alias().Action(elastic.NewAliasAddAction(alias).
+		Index(EntityIndex).Filter(filter)).Do(ctx); nil != err {
+		return "", errors.Wrap(err, "create tenant alias")
+	}
+
+	es.aliases.Store(alias, struct{}{})
+	return alias, nil
+}
+
 func (es *ESClient) BuildIndex(ctx context.Context, index, body string) error {
 	if _, err := es.Client.Index().Index(EntityIndex).
 		Id(index).BodyString(body).Refresh("true").Do(ctx); err != nil {
@@ -103,6 +136,35 @@ func (es *ESClient) BuildIndex(ctx context.Context, index, body string) error {
 	return nil
 }
 
+// PutMapping adds field mappings to the entity index, mappings of existing fields can not be changed,
+// ErrSearchMappingConflict is returned if a field is mapped with another type.
+func (es *ESClient) PutMapping(ctx context.Context, mapping string) error {
+	if _, err := es.Client.PutMapping().Index(EntityIndex).BodyString(mapping).Do(ctx); nil != err {
+		if elastic.IsStatusCode(err, http.StatusBadRequest) {
+			return errors.Wrap(xerrors.ErrSearchMappingConflict, elastic.ErrorReason(err))
+		}
+		return errors.Wrap(err, "put mapping")
+	}
+	return nil
+}
+
+// Ping checks the health of the elasticsearch cluster, a red cluster is unhealthy.
+func (es *ESClient) Ping(ctx context.Context) error {
+	health, err := es.Client.ClusterHealth().Do(ctx)
+	if nil != err {
+		return errors.Wrap(err, "elasticsearch cluster health")
+	} else if health.Status == "red" {
+		return errors.Errorf("elasticsearch cluster %s status red", health.ClusterName)
+	}
+	return nil
+}
+
+// Close stops background processes of the client, the client is replaced on config changes.
+func (es *ESClient) Close() error {
+	es.Client.Stop()
+	return nil
+}
+
 func (es *ESClient) Delete(ctx context.Context, id string) error {
 	_, err := es.Client.Delete().Index(EntityIndex).Id(id).Refresh("true").Do(ctx)
 	if nil != err {
@@ -126,8 +188,16 @@ func (es *ESClient) DeleteByQuery(ctx context.Context, query map[string]interfac
 
 func (es *ESClient) Search(ctx context.Context, req SearchRequest) (SearchResponse, error) {
 	resp := SearchResponse{}
+	index := EntityIndex
+	if req.Owner != "" {
+		var err error
+		if index, err = es.tenantIndex(ctx, req.Owner); nil != err {
+			return resp, errors.Wrap(err, "query search failed")
+		}
+	}
+
 	boolQuery := elastic.NewBoolQuery()
-	searchQuery := es.Client.Search().Index(EntityIndex)
+	searchQuery := es.Client.Search().Index(index)
 
 	if req.Condition != nil {
 		condition2boolQuery(req.Condition, boolQuery)
@@ -3,8 +3,10 @@ package search
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/url"
 	"strings"
+	"sync"
 
 	logf "github.com/tkeel-io/core/pkg/logfield"
 
@@ -20,13 +22,24 @@ import (
 var GlobalService *Service
 
 func Init(urlText string) error {
+	registered, opt, err := newEngines(urlText)
+	if nil != err {
+		return errors.Wrap(err, "initialize SearchEngine")
+	}
+
+	GlobalService = NewService(registered).Use(opt)
+	return nil
+}
+
+// newEngines creates the search engine of the url, the noop engine is used for unknown drivers.
+func newEngines(urlText string) (map[driver.Type]driver.SearchEngine, driver.SelectDriveOption, error) {
 	log.L().Info(fmt.Sprintf("load search...%s", urlText))
 	// pasre configuration.
 	meta, err := parseConfig(urlText)
 	if nil != err {
 		log.L().Error("parse default search engine configuration",
 			logf.Error(err), logf.String("url", urlText))
-		return errors.Wrap(err, "initialize SearchEngine")
+		return nil, nil, errors.Wrap(err, "parse search engine configuration")
 	}
 	// register default(user set) search engine.
 	driverType := driver.Parse(meta.Name)()
@@ -36,7 +49,7 @@ func Init(urlText string) error {
 		if nil != err {
 			log.L().Error("new search engine instance",
 				logf.Error(err), logf.String("url", urlText))
-			return errors.Wrap(err, "new search engine instances")
+			return nil, nil, errors.Wrap(err, "new search engine instances")
 		}
 		defaultRegistered = map[driver.Type]driver.SearchEngine{
 			// Add other drivers to SearchService here.
@@ -44,8 +57,7 @@ func Init(urlText string) error {
 		}
 	}
 
-	GlobalService = NewService(defaultRegistered).Use(driver.Parse(meta.Name))
-	return nil
+	return defaultRegistered, driver.Parse(meta.Name), nil
 }
 
 func defaultRegisteredSE() map[driver.Type]driver.SearchEngine {
@@ -58,17 +70,61 @@ func defaultRegisteredSE() map[driver.Type]driver.SearchEngine {
 var _ pb.SearchHTTPServer = &Service{}
 
 type Service struct {
+	lock      *sync.RWMutex
 	drivers   map[driver.Type]driver.SearchEngine
 	selectOpt driver.SelectDriveOption
 }
 
 func NewService(registered map[driver.Type]driver.SearchEngine) *Service {
 	return &Service{
+		lock:      new(sync.RWMutex),
 		drivers:   registered,
 		selectOpt: driver.NoopDriver,
 	}
 }
 
+// Reload replaces engines with the search engine of the url and returns the previous engines,
+// which should be closed by the caller once requests in flight are done.
+// engines are kept if the engine of the url can not be created.
+func (s *Service) Reload(urlText string) (io.Closer, error) {
+	registered, opt, err := newEngines(urlText)
+	if nil != err {
+		return nil, errors.Wrap(err, "reload search engine")
+	}
+
+	s.lock.Lock()
+	prev := engines(s.drivers)
+	s.drivers = registered
+	s.selectOpt = opt
+	s.lock.Unlock()
+	return prev, nil
+}
+
+// engines closes search engines which hold connections.
+type engines map[driver.Type]driver.SearchEngine
+
+func (es engines) Close() error {
+	for typ, engine := range es {
+		if closer, ok := engine.(io.Closer); ok {
+			if err := closer.Close(); nil != err {
+				return errors.Wrap(err, "close search engine "+string(typ))
+			}
+		}
+	}
+	return nil
+}
+
+// engine returns the selected engine.
+func (s *Service) engine() (driver.SearchEngine, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	engine, ok := s.drivers[s.selectOpt()]
+	if !ok {
+		return nil, errors.New("no specified engine:" + string(s.selectOpt()))
+	}
+	return engine, nil
+}
+
 func (s *Service) Search(ctx context.Context, request *pb.SearchRequest) (*pb.SearchResponse, error) {
 	out := &pb.SearchResponse{}
 	req := driver.SearchRequest{
@@ -86,9 +142,9 @@ func (s *Service) Search(ctx context.Context, request *pb.SearchRequest) (*pb.Se
 	// TODO: Multiple Driver Services One Response support.
 	// assumption len(s.selectOpt) == 1.
 
-	engine, ok := s.drivers[s.selectOpt()]
-	if !ok {
-		return out, errors.New("no specified engine:" + string(s.selectOpt()))
+	engine, err := s.engine()
+	if nil != err {
+		return out, err
 	}
 	resp, err := engine.Search(ctx, req)
 	if err != nil {
@@ -110,9 +166,9 @@ func (s *Service) Search(ctx context.Context, request *pb.SearchRequest) (*pb.Se
 
 func (s *Service) DeleteByID(ctx context.Context, request *pb.DeleteByIDRequest) (*pb.DeleteByIDResponse, error) {
 	out := &pb.DeleteByIDResponse{}
-	engine, ok := s.drivers[s.selectOpt()]
-	if !ok {
-		return out, errors.New("no specified engine:" + string(s.selectOpt()))
+	engine, err := s.engine()
+	if nil != err {
+		return out, err
 	}
 	if err := engine.Delete(ctx, request.Id); err != nil {
 		return out, errors.Wrap(err, "build index error")
@@ -121,6 +177,32 @@ func (s *Service) DeleteByID(ctx context.Context, request *pb.DeleteByIDRequest)
 	return out, nil
 }
 
+// PutMapping adds field mappings to the selected engine, engines without mappings ignore it.
+func (s *Service) PutMapping(ctx context.Context, mapping []byte) error {
+	engine, err := s.engine()
+	if nil != err {
+		return err
+	}
+
+	if mapper, ok := engine.(driver.Mapper); ok {
+		return errors.Wrap(mapper.PutMapping(ctx, string(mapping)), "put mapping")
+	}
+	return nil
+}
+
+// Ping checks connections of the selected engine, engines without connections are always healthy.
+func (s *Service) Ping(ctx context.Context) error {
+	engine, err := s.engine()
+	if nil != err {
+		return err
+	}
+
+	if pinger, ok := engine.(driver.Pinger); ok {
+		return errors.Wrap(pinger.Ping(ctx), "ping search engine")
+	}
+	return nil
+}
+
 func (s *Service) Index(ctx context.Context, in *pb.IndexObject) (*pb.IndexResponse, error) {
 	var (
 		id  string
@@ -141,9 +223,9 @@ func (s *Service) Index(ctx context.Context, in *pb.IndexObject) (*pb.IndexRespo
 	if err != nil {
 		return out, errors.Wrap(err, "json marshal error")
 	}
-	engine, ok := s.drivers[s.selectOpt()]
-	if !ok {
-		return out, errors.New("no specified engine:" + string(s.selectOpt()))
+	engine, err := s.engine()
+	if nil != err {
+		return out, err
 	}
 	if err = engine.BuildIndex(ctx, id, string(objBytes)); err != nil {
 		return out, errors.Wrap(err, "build index error")
@@ -154,9 +236,9 @@ func (s *Service) Index(ctx context.Context, in *pb.IndexObject) (*pb.IndexRespo
 
 func (s *Service) IndexBytes(ctx context.Context, id string, jsonData []byte) (out *pb.IndexResponse, err error) {
 	out = &pb.IndexResponse{}
-	engine, ok := s.drivers[s.selectOpt()]
-	if !ok {
-		return out, errors.New("no specified engine:" + string(s.selectOpt()))
+	engine, err := s.engine()
+	if nil != err {
+		return out, err
 	}
 	if err = engine.BuildIndex(ctx, id, string(jsonData)); err != nil {
 		return out, errors.Wrap(err, "build index error")
@@ -167,7 +249,9 @@ func (s *Service) IndexBytes(ctx context.Context, id string, jsonData []byte) (o
 
 // Use SelectDriveOption and set the option to this service.
 func (s *Service) Use(opt driver.SelectDriveOption) *Service {
+	s.lock.Lock()
 	s.selectOpt = opt
+	s.lock.Unlock()
 	return s
 }
 
@@ -179,6 +263,8 @@ func (s Service) With(opt driver.SelectDriveOption) *Service {
 }
 
 func (s *Service) Register(name driver.Type, implement driver.SearchEngine) *Service {
+	s.lock.Lock()
+	defer s.lock.Unlock()
 	if s.drivers == nil {
 		s.drivers = make(map[driver.Type]driver.SearchEngine)
 	}
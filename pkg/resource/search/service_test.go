@@ -57,3 +57,29 @@ func (f fakeEngine) Search(ctx context.Context, request driver.SearchRequest) (d
 func (f fakeEngine) Delete(ctx context.Context, id string) error {
 	return nil
 }
+
+type closingEngine struct {
+	fakeEngine
+	closed bool
+}
+
+func (c *closingEngine) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestService_Reload(t *testing.T) {
+	fake := driver.Type("fake")
+	engine := &closingEngine{}
+	service := NewService(map[driver.Type]driver.SearchEngine{fake: engine})
+
+	prev, err := service.Reload("noop://localhost")
+	assert.Nil(t, err)
+	_, ok := service.drivers[fake]
+	assert.False(t, ok)
+
+	// previous engines are closed by the caller.
+	assert.False(t, engine.closed)
+	assert.Nil(t, prev.Close())
+	assert.True(t, engine.closed)
+}
@@ -17,6 +17,8 @@ import (
 	logf "github.com/tkeel-io/core/pkg/logfield"
 	"github.com/tkeel-io/core/pkg/util"
 	"github.com/tkeel-io/kit/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type daprMetadata struct {
@@ -79,6 +81,33 @@ func (d *daprStore) Set(ctx context.Context, key string, data []byte) error {
 	return errors.Wrap(conn.SaveState(ctx, d.storeName, key, data), "dapr store set")
 }
 
+// SetWithEtag saves the data with first-write concurrency if the etag of the state matches,
+// the state is written through instead of the bulk transport. dapr does not return the new etag.
+func (d *daprStore) SetWithEtag(ctx context.Context, key string, data []byte, etag string) (string, error) {
+	var conn dapr.Client
+	if conn = dapr.Get().Select(); nil == conn {
+		log.L().Error("nil connection", logf.Key(key),
+			logf.String("store_name", d.storeName), logf.ID(d.id))
+		return "", errors.Wrap(xerrors.ErrConnectionNil, "dapr send")
+	}
+
+	item := &client.SetStateItem{Key: key, Value: data}
+	if etag != "" {
+		item.Options = &client.StateOptions{Concurrency: client.StateConcurrencyFirstWrite}
+		// first-write without etag expects the state not exists.
+		if etag != store.EtagNotExists {
+			item.Etag = &client.ETag{Value: etag}
+		}
+	}
+
+	err := conn.SaveBulkState(ctx, d.storeName, item)
+	// dapr reports etag mismatches as aborted.
+	if status.Code(errors.Cause(err)) == codes.Aborted {
+		return "", errors.Wrapf(xerrors.ErrEtagMismatch, "dapr store set %s", key)
+	}
+	return "", errors.Wrap(err, "dapr store set")
+}
+
 func (d *daprStore) BatchWrite(ctx context.Context, args *[]interface{}) error {
 	var conn dapr.Client
 	stateMap := make(map[string]*client.SetStateItem)
@@ -0,0 +1,558 @@
+package embedded
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/resource/store"
+	"github.com/tkeel-io/core/pkg/util"
+	"github.com/tkeel-io/kit/log"
+)
+
+// sync policies of the write ahead log.
+const (
+	// SyncAlways fsync the log on every write.
+	SyncAlways = "always"
+	// SyncInterval fsync the log every sync interval, and on flush.
+	SyncInterval = "interval"
+	// SyncNone leaves fsync to the os, except on flush.
+	SyncNone = "none"
+)
+
+const (
+	walFile      = "state.wal"
+	snapshotFile = "state.snapshot"
+	lockFile     = "state.lock"
+
+	opSet byte = 1
+	opDel byte = 2
+
+	// record header: crc32, op, key length, value length.
+	headerSize = 4 + 1 + 4 + 4
+	// maxRecordSize bounds key and value of a record, larger records are corrupted.
+	maxRecordSize = 1 << 30
+
+	defaultSyncInterval   = 1000
+	defaultCompactSize    = 64 << 20
+	defaultCompactRatio   = 2
+	defaultCompactionScan = 10 * time.Second
+)
+
+type embeddedMetadata struct {
+	// Dir is the data directory of the store.
+	Dir string `mapstructure:"dir"`
+	// Sync is the sync policy of the log, always, interval or none.
+	Sync string `mapstructure:"sync"`
+	// SyncInterval is the milliseconds between fsync of the log with interval policy.
+	SyncInterval int64 `mapstructure:"sync_interval"`
+	// CompactSize is the log size in bytes which triggers compaction.
+	CompactSize int64 `mapstructure:"compact_size"`
+	// CompactRatio triggers compaction only if the log is CompactRatio times larger than live data.
+	CompactRatio int64 `mapstructure:"compact_ratio"`
+}
+
+type item struct {
+	value   []byte
+	version int64
+}
+
+// embeddedStore keeps state in memory, backed by a snapshot and a write ahead log on disk.
+// writes append to the log, compaction rewrites live state into the snapshot and truncates the log.
+type embeddedStore struct {
+	id    string
+	dir   string
+	meta  embeddedMetadata
+	items map[string]*item
+
+	dirLock  *util.FileLock
+	wal      *os.File
+	walSize  int64
+	liveSize int64
+	dirty    bool
+	refs     int
+
+	lock   sync.RWMutex
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+var errClosed = errors.New("store.embedded closed")
+
+var (
+	// stores share an instance per data directory, the log has a single writer.
+	opened     = make(map[string]*embeddedStore)
+	openedLock sync.Mutex
+)
+
+func open(meta embeddedMetadata) (*embeddedStore, error) {
+	if meta.Dir == "" {
+		return nil, errors.Wrap(xerrors.ErrInvalidParam, "store.embedded dir required")
+	}
+	switch meta.Sync {
+	case "":
+		meta.Sync = SyncInterval
+	case SyncAlways, SyncInterval, SyncNone:
+	default:
+		return nil, errors.Wrapf(xerrors.ErrInvalidParam, "store.embedded sync policy %s", meta.Sync)
+	}
+	if meta.SyncInterval <= 0 {
+		meta.SyncInterval = defaultSyncInterval
+	}
+	if meta.CompactSize <= 0 {
+		meta.CompactSize = defaultCompactSize
+	}
+	if meta.CompactRatio <= 0 {
+		meta.CompactRatio = defaultCompactRatio
+	}
+
+	dir, err := filepath.Abs(meta.Dir)
+	if nil != err {
+		return nil, errors.Wrap(err, "store.embedded dir")
+	}
+
+	openedLock.Lock()
+	defer openedLock.Unlock()
+	if s, ok := opened[dir]; ok {
+		s.refs++
+		return s, nil
+	}
+
+	if err = os.MkdirAll(dir, 0o755); nil != err {
+		return nil, errors.Wrap(err, "store.embedded create dir")
+	}
+
+	// the log has a single writer across processes too.
+	dirLock, err := util.LockDir(dir, lockFile)
+	if nil != err {
+		return nil, errors.Wrap(err, "store.embedded lock dir")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &embeddedStore{
+		id:      util.UUID("sembedded"),
+		dir:     dir,
+		meta:    meta,
+		items:   make(map[string]*item),
+		dirLock: dirLock,
+		refs:    1,
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+
+	if err = s.load(); nil != err {
+		cancel()
+		if nil != s.wal {
+			s.wal.Close()
+		}
+		dirLock.Unlock()
+		return nil, errors.Wrap(err, "store.embedded load")
+	}
+
+	opened[dir] = s
+	go s.run()
+	log.L().Info("open store.embedded", logf.ID(s.id), logf.String("dir", dir),
+		logf.String("sync", meta.Sync), logf.Any("items", len(s.items)))
+	return s, nil
+}
+
+// load replays the snapshot and the log, a torn record at the tail of the log is truncated,
+// a corrupted record before the tail fails the load.
+func (s *embeddedStore) load() error {
+	if _, err := s.replay(filepath.Join(s.dir, snapshotFile), false); nil != err {
+		return errors.Wrap(err, "replay snapshot")
+	}
+
+	walPath := filepath.Join(s.dir, walFile)
+	size, err := s.replay(walPath, true)
+	if nil != err {
+		return errors.Wrap(err, "replay log")
+	}
+
+	if s.wal, err = os.OpenFile(walPath, os.O_CREATE|os.O_RDWR, 0o644); nil != err {
+		return errors.Wrap(err, "open log")
+	}
+	if err = s.wal.Truncate(size); nil != err {
+		return errors.Wrap(err, "truncate log")
+	}
+	if _, err = s.wal.Seek(size, io.SeekStart); nil != err {
+		return errors.Wrap(err, "seek log")
+	}
+	s.walSize = size
+	return nil
+}
+
+// replay applies records of the file, returns the size of valid records.
+func (s *embeddedStore) replay(path string, tolerateTail bool) (int64, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	} else if nil != err {
+		return 0, errors.Wrap(err, "open file")
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if nil != err {
+		return 0, errors.Wrap(err, "stat file")
+	}
+
+	var size int64
+	reader := bufio.NewReader(f)
+	for {
+		op, key, value, n, err := readRecord(reader)
+		if errors.Is(err, io.EOF) {
+			return size, nil
+		} else if nil != err {
+			// only the last record is torn by a crash, records before it are corrupted.
+			if tolerateTail && size+n >= info.Size() {
+				log.L().Warn("store.embedded truncate torn log tail", logf.ID(s.id),
+					logf.String("file", path), logf.Any("offset", size), logf.Error(err))
+				return size, nil
+			}
+			log.L().Error("store.embedded corrupted record", logf.ID(s.id),
+				logf.String("file", path), logf.Any("offset", size), logf.Error(err))
+			return size, errors.Wrapf(err, "corrupted record at %d", size)
+		}
+		s.apply(op, key, value)
+		size += n
+	}
+}
+
+func (s *embeddedStore) apply(op byte, key string, value []byte) {
+	old, has := s.items[key]
+	if has {
+		s.liveSize -= int64(headerSize + len(key) + len(old.value))
+	}
+
+	switch op {
+	case opSet:
+		version := int64(1)
+		if has {
+			version = old.version + 1
+		}
+		s.items[key] = &item{value: value, version: version}
+		s.liveSize += int64(headerSize + len(key) + len(value))
+	case opDel:
+		delete(s.items, key)
+	}
+}
+
+func encodeRecord(op byte, key string, value []byte) []byte {
+	buf := make([]byte, headerSize+len(key)+len(value))
+	buf[4] = op
+	binary.BigEndian.PutUint32(buf[5:9], uint32(len(key)))
+	binary.BigEndian.PutUint32(buf[9:13], uint32(len(value)))
+	copy(buf[headerSize:], key)
+	copy(buf[headerSize+len(key):], value)
+	binary.BigEndian.PutUint32(buf[0:4], crc32.ChecksumIEEE(buf[4:]))
+	return buf
+}
+
+// readRecord reads a record, on errors n is the length the record claims.
+func readRecord(reader io.Reader) (op byte, key string, value []byte, n int64, err error) {
+	header := make([]byte, headerSize)
+	if _, err = io.ReadFull(reader, header); nil != err {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			err = errors.Wrap(err, "torn header")
+		}
+		return 0, "", nil, headerSize, err
+	}
+
+	keyLen := binary.BigEndian.Uint32(header[5:9])
+	valueLen := binary.BigEndian.Uint32(header[9:13])
+	n = int64(headerSize) + int64(keyLen) + int64(valueLen)
+	if int64(keyLen)+int64(valueLen) > maxRecordSize {
+		return 0, "", nil, n, errors.Errorf("record size %d exceeds limit", int64(keyLen)+int64(valueLen))
+	}
+
+	body := make([]byte, int(keyLen)+int(valueLen))
+	if _, err = io.ReadFull(reader, body); nil != err {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return 0, "", nil, n, errors.Wrap(err, "torn body")
+	}
+
+	checksum := crc32.NewIEEE()
+	checksum.Write(header[4:])
+	checksum.Write(body)
+	if checksum.Sum32() != binary.BigEndian.Uint32(header[0:4]) {
+		return 0, "", nil, n, errors.New("checksum mismatch")
+	}
+
+	op = header[4]
+	if op != opSet && op != opDel {
+		return 0, "", nil, n, errors.Errorf("unknown op %d", op)
+	}
+	return op, string(body[:keyLen]), body[keyLen:], int64(len(header) + len(body)), nil
+}
+
+// Get returns state.
+func (s *embeddedStore) Get(ctx context.Context, key string) (*store.StateItem, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if v, ok := s.items[key]; ok {
+		return &store.StateItem{
+			Key:      key,
+			Etag:     strconv.FormatInt(v.version, 10),
+			Value:    v.value,
+			Metadata: map[string]string{},
+		}, nil
+	}
+	return nil, xerrors.ErrResourceNotFound
+}
+
+// Set saves the raw data into store using default state options.
+func (s *embeddedStore) Set(ctx context.Context, key string, data []byte) error {
+	value := make([]byte, len(data))
+	copy(value, data)
+	return errors.Wrap(s.write(opSet, key, value), "store.embedded set")
+}
+
+func (s *embeddedStore) Del(ctx context.Context, key string) error {
+	return errors.Wrap(s.write(opDel, key, nil), "store.embedded del")
+}
+
+// SetWithEtag saves the raw data if the etag of the state matches.
+func (s *embeddedStore) SetWithEtag(ctx context.Context, key string, data []byte, etag string) (string, error) {
+	value := make([]byte, len(data))
+	copy(value, data)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	current := store.EtagNotExists
+	if v, ok := s.items[key]; ok {
+		current = strconv.FormatInt(v.version, 10)
+	}
+	if etag != "" && etag != current {
+		return current, errors.Wrapf(xerrors.ErrEtagMismatch, "store.embedded set %s", key)
+	}
+
+	if err := s.writeLocked(opSet, key, value); nil != err {
+		return current, errors.Wrap(err, "store.embedded set")
+	}
+	return strconv.FormatInt(s.items[key].version, 10), nil
+}
+
+func (s *embeddedStore) write(op byte, key string, value []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.writeLocked(op, key, value)
+}
+
+func (s *embeddedStore) writeLocked(op byte, key string, value []byte) error {
+	if nil == s.wal {
+		return errClosed
+	}
+
+	record := encodeRecord(op, key, value)
+	if _, err := s.wal.Write(record); nil != err {
+		log.L().Error("store.embedded append log", logf.ID(s.id), logf.Key(key), logf.Error(err))
+		return errors.Wrap(err, "append log")
+	}
+	s.walSize += int64(len(record))
+	s.apply(op, key, value)
+
+	if s.meta.Sync == SyncAlways {
+		return errors.Wrap(s.wal.Sync(), "sync log")
+	}
+	s.dirty = true
+	return nil
+}
+
+// Flush fsync the log regardless of the sync policy.
+func (s *embeddedStore) Flush(ctx context.Context) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return errors.Wrap(s.sync(), "store.embedded flush")
+}
+
+func (s *embeddedStore) sync() error {
+	if !s.dirty || nil == s.wal {
+		return nil
+	}
+	if err := s.wal.Sync(); nil != err {
+		return errors.Wrap(err, "sync log")
+	}
+	s.dirty = false
+	return nil
+}
+
+// Scan calls the handler with items of the prefix in key order, items are copied so that
+// the handler does not block writes.
+func (s *embeddedStore) Scan(ctx context.Context, prefix string, handler func(key string, value []byte) error) error {
+	s.lock.RLock()
+	keys := make([]string, 0)
+	values := make(map[string][]byte)
+	for key, v := range s.items {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+			values[key] = v.value
+		}
+	}
+	s.lock.RUnlock()
+
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := handler(key, values[key]); nil != err {
+			return err
+		}
+	}
+	return nil
+}
+
+// Snapshot writes a consistent copy of live state in the snapshot format,
+// which restores the store as the snapshot file of an empty data directory.
+func (s *embeddedStore) Snapshot(ctx context.Context, w io.Writer) error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return errors.Wrap(s.writeSnapshot(w), "store.embedded snapshot")
+}
+
+func (s *embeddedStore) writeSnapshot(w io.Writer) error {
+	writer := bufio.NewWriter(w)
+	for key, v := range s.items {
+		if _, err := writer.Write(encodeRecord(opSet, key, v.value)); nil != err {
+			return errors.Wrap(err, "write record")
+		}
+	}
+	return errors.Wrap(writer.Flush(), "write records")
+}
+
+// Compact rewrites live state into the snapshot and truncates the log.
+func (s *embeddedStore) Compact(ctx context.Context) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return errors.Wrap(s.compact(), "store.embedded compact")
+}
+
+func (s *embeddedStore) compact() error {
+	if nil == s.wal {
+		return nil
+	}
+
+	tmpPath := filepath.Join(s.dir, snapshotFile+".tmp")
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if nil != err {
+		return errors.Wrap(err, "create snapshot")
+	}
+	if err = s.writeSnapshot(f); nil == err {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); nil == err {
+		err = closeErr
+	}
+	if nil != err {
+		os.Remove(tmpPath)
+		return errors.Wrap(err, "write snapshot")
+	}
+
+	// replaying the log over the new snapshot yields the same state, so a crash in between is safe.
+	if err = os.Rename(tmpPath, filepath.Join(s.dir, snapshotFile)); nil != err {
+		return errors.Wrap(err, "rename snapshot")
+	}
+	if err = s.wal.Truncate(0); nil != err {
+		return errors.Wrap(err, "truncate log")
+	}
+	if _, err = s.wal.Seek(0, io.SeekStart); nil != err {
+		return errors.Wrap(err, "seek log")
+	}
+
+	log.L().Info("store.embedded compacted", logf.ID(s.id),
+		logf.Any("log_size", s.walSize), logf.Any("live_size", s.liveSize))
+	s.walSize, s.dirty = 0, false
+	return errors.Wrap(s.wal.Sync(), "sync log")
+}
+
+func (s *embeddedStore) needCompact() bool {
+	return s.walSize >= s.meta.CompactSize &&
+		s.walSize >= s.liveSize*s.meta.CompactRatio
+}
+
+// run syncs the log with interval policy, and compacts the log when it grows.
+func (s *embeddedStore) run() {
+	syncTicker := time.NewTicker(time.Duration(s.meta.SyncInterval) * time.Millisecond)
+	compactTicker := time.NewTicker(defaultCompactionScan)
+	defer syncTicker.Stop()
+	defer compactTicker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-syncTicker.C:
+			if s.meta.Sync != SyncInterval {
+				continue
+			}
+			s.lock.Lock()
+			if err := s.sync(); nil != err {
+				log.L().Error("store.embedded sync log", logf.ID(s.id), logf.Error(err))
+			}
+			s.lock.Unlock()
+		case <-compactTicker.C:
+			s.lock.Lock()
+			if s.needCompact() {
+				if err := s.compact(); nil != err {
+					log.L().Error("store.embedded compact", logf.ID(s.id), logf.Error(err))
+				}
+			}
+			s.lock.Unlock()
+		}
+	}
+}
+
+// Close syncs and closes the log when the last user of the data directory closes.
+func (s *embeddedStore) Close() error {
+	openedLock.Lock()
+	defer openedLock.Unlock()
+	if s.refs--; s.refs > 0 {
+		return nil
+	}
+
+	delete(opened, s.dir)
+	s.cancel()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	defer s.dirLock.Unlock()
+	if nil == s.wal {
+		return nil
+	}
+
+	s.dirty = true
+	err := s.sync()
+	if closeErr := s.wal.Close(); nil == err {
+		err = closeErr
+	}
+	s.wal = nil
+	log.L().Info("close store.embedded", logf.ID(s.id), logf.String("dir", s.dir))
+	return errors.Wrap(err, "store.embedded close")
+}
+
+func init() {
+	log.SuccessStatusEvent(os.Stdout, "Register Resource<state.embedded> successful")
+	store.Register("embedded", func(properties map[string]interface{}) (store.Store, error) {
+		var meta embeddedMetadata
+		if err := mapstructure.WeakDecode(properties, &meta); nil != err {
+			return nil, errors.Wrap(err, "decode store.embedded configuration")
+		}
+		s, err := open(meta)
+		if nil != err {
+			return nil, errors.Wrap(err, "open store.embedded")
+		}
+		return s, nil
+	})
+}
@@ -0,0 +1,124 @@
+package embedded
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/resource"
+	"github.com/tkeel-io/core/pkg/resource/store"
+	"github.com/tkeel-io/core/pkg/util"
+)
+
+func Test_EmbeddedStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	s, err := store.NewStore(resource.Metadata{Name: "embedded",
+		Properties: map[string]interface{}{"dir": dir, "sync": "always"}})
+	assert.Nil(t, err)
+
+	_, err = s.Get(ctx, "entity123")
+	assert.ErrorIs(t, err, xerrors.ErrResourceNotFound)
+	assert.Nil(t, s.Set(ctx, "entity123", []byte(`{"temp":20}`)))
+	assert.Nil(t, s.Set(ctx, "entity123", []byte(`{"temp":30}`)))
+	assert.Nil(t, s.Set(ctx, "entity456", []byte(`{}`)))
+	assert.Nil(t, s.Del(ctx, "entity456"))
+	ret, err := s.Get(ctx, "entity123")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(`{"temp":30}`), ret.Value)
+	assert.Equal(t, "2", ret.Etag)
+
+	// state survives restart, a torn record at the tail is dropped.
+	es, _ := s.(*embeddedStore)
+	assert.Nil(t, es.Close())
+	f, err := os.OpenFile(filepath.Join(dir, walFile), os.O_APPEND|os.O_WRONLY, 0o644)
+	assert.Nil(t, err)
+	_, err = f.Write(encodeRecord(opSet, "entity789", []byte(`{}`))[:10])
+	assert.Nil(t, err)
+	f.Close()
+
+	es, err = open(embeddedMetadata{Dir: dir})
+	assert.Nil(t, err)
+	ret, err = es.Get(ctx, "entity123")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(`{"temp":30}`), ret.Value)
+	_, err = es.Get(ctx, "entity456")
+	assert.NotNil(t, err)
+	_, err = es.Get(ctx, "entity789")
+	assert.NotNil(t, err)
+
+	// compaction keeps live state only.
+	assert.Nil(t, es.Compact(ctx))
+	assert.Equal(t, int64(0), es.walSize)
+	assert.Nil(t, es.Set(ctx, "entity456", []byte(`{"temp":40}`)))
+
+	var snapshot bytes.Buffer
+	assert.Nil(t, es.Snapshot(ctx, &snapshot))
+	assert.Nil(t, es.Close())
+
+	es, err = open(embeddedMetadata{Dir: dir})
+	assert.Nil(t, err)
+	assert.Len(t, es.items, 2)
+	var keys []string
+	assert.Nil(t, es.Scan(ctx, "entity", func(key string, value []byte) error {
+		keys = append(keys, key)
+		return nil
+	}))
+	assert.Equal(t, []string{"entity123", "entity456"}, keys)
+	assert.Nil(t, es.Close())
+
+	// snapshots restore as the snapshot file of an empty directory.
+	restored := t.TempDir()
+	assert.Nil(t, os.WriteFile(filepath.Join(restored, snapshotFile), snapshot.Bytes(), 0o644))
+	es, err = open(embeddedMetadata{Dir: restored})
+	assert.Nil(t, err)
+	ret, err = es.Get(ctx, "entity456")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(`{"temp":40}`), ret.Value)
+	assert.Nil(t, es.Close())
+}
+
+func Test_EmbeddedStoreCorrupted(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	es, err := open(embeddedMetadata{Dir: dir, Sync: SyncAlways})
+	assert.Nil(t, err)
+	assert.Nil(t, es.Set(ctx, "entity123", []byte(`{"temp":20}`)))
+	assert.Nil(t, es.Set(ctx, "entity456", []byte(`{"temp":30}`)))
+
+	// the data directory is locked by the open store.
+	_, err = util.LockDir(dir, lockFile)
+	assert.NotNil(t, err)
+	assert.Nil(t, es.Close())
+
+	// a corrupted record before the tail is not truncated.
+	path := filepath.Join(dir, walFile)
+	data, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	data[headerSize] ^= 0xff
+	assert.Nil(t, os.WriteFile(path, data, 0o644))
+	_, err = open(embeddedMetadata{Dir: dir})
+	assert.NotNil(t, err)
+	size, err := os.Stat(path)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(data)), size.Size())
+
+	// the lock is released on failed opens.
+	lock, err := util.LockDir(dir, lockFile)
+	assert.Nil(t, err)
+	assert.Nil(t, lock.Unlock())
+}
+
+func Test_NewStoreError(t *testing.T) {
+	_, err := store.NewStore(resource.Metadata{Name: "embedded"})
+	assert.NotNil(t, err)
+	_, err = store.NewStore(resource.Metadata{Name: "embedded",
+		Properties: map[string]interface{}{"dir": t.TempDir(), "sync": "sometimes"}})
+	assert.NotNil(t, err)
+	_, err = store.NewStore(resource.Metadata{Name: "unknown"})
+	assert.NotNil(t, err)
+}
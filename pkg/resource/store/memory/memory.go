@@ -3,9 +3,13 @@ package memory
 import (
 	"context"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/google/uuid"
+	"github.com/pkg/errors"
 
 	xerrors "github.com/tkeel-io/core/pkg/errors"
 	logf "github.com/tkeel-io/core/pkg/logfield"
@@ -35,13 +39,39 @@ func (n *memStore) Get(ctx context.Context, key string) (*store.StateItem, error
 func (n *memStore) Set(ctx context.Context, key string, data []byte) error {
 	lock.Lock()
 	defer lock.Unlock()
+	n.set(key, data)
+	return nil
+}
+
+// SetWithEtag saves the raw data if the etag of the state matches.
+func (n *memStore) SetWithEtag(ctx context.Context, key string, data []byte, etag string) (string, error) {
+	lock.Lock()
+	defer lock.Unlock()
+	current := store.EtagNotExists
+	if v, ok := n.store[key]; ok {
+		current = v.Etag
+	}
+	if etag != "" && etag != current {
+		return current, errors.Wrapf(xerrors.ErrEtagMismatch, "memory store set %s", key)
+	}
+	return n.set(key, data), nil
+}
+
+// set saves the raw data, etags are versions of the state.
+func (n *memStore) set(key string, data []byte) string {
+	var version int64
+	if v, ok := n.store[key]; ok {
+		version, _ = strconv.ParseInt(v.Etag, 10, 64)
+	}
+
+	etag := strconv.FormatInt(version+1, 10)
 	n.store[key] = &store.StateItem{
 		Key:      key,
-		Etag:     UUID.String(),
+		Etag:     etag,
 		Value:    data,
 		Metadata: map[string]string{},
 	}
-	return nil
+	return etag
 }
 
 func (n *memStore) Del(ctx context.Context, key string) error {
@@ -50,6 +80,27 @@ func (n *memStore) Del(ctx context.Context, key string) error {
 	delete(n.store, key)
 	return nil
 }
+
+// Scan calls the handler with items of the prefix in key order.
+func (n *memStore) Scan(ctx context.Context, prefix string, handler func(key string, value []byte) error) error {
+	lock.RLock()
+	items := make([]*store.StateItem, 0)
+	for key, item := range n.store {
+		if strings.HasPrefix(key, prefix) {
+			items = append(items, item)
+		}
+	}
+	lock.RUnlock()
+
+	sort.Slice(items, func(i, j int) bool { return items[i].Key < items[j].Key })
+	for _, item := range items {
+		if err := handler(item.Key, item.Value); nil != err {
+			return err
+		}
+	}
+	return nil
+}
+
 func (n *memStore) Flush(ctx context.Context) error {
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core/pkg/resource/store"
 )
 
 func Test_MemStore(t *testing.T) {
@@ -19,6 +20,13 @@ func Test_MemStore(t *testing.T) {
 	ret, err = ns.Get(context.Background(), "entity123")
 	assert.Equal(t, ret.Value, val)
 	assert.Nil(t, err)
+	var keys []string
+	err = ns.(store.Scanner).Scan(context.Background(), "entity", func(key string, value []byte) error {
+		keys = append(keys, key)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"entity123"}, keys)
 	err = ns.Del(context.Background(), "entity123")
 	assert.Nil(t, err)
 	ret, err = ns.Get(context.Background(), "entity123")
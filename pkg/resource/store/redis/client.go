@@ -0,0 +1,496 @@
+package redis
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/kit/log"
+)
+
+// deployment modes.
+const (
+	ModeStandalone = "standalone"
+	ModeSentinel   = "sentinel"
+	ModeCluster    = "cluster"
+)
+
+const (
+	clusterSlots = 16384
+	maxRedirects = 3
+)
+
+// command is a command on a key, keys route commands to cluster nodes.
+type command struct {
+	key  string
+	args []interface{}
+}
+
+// client runs commands in pipelines, replies are in order of commands and may be redisError.
+type client interface {
+	pipeline(ctx context.Context, cmds []command) ([]interface{}, error)
+	// masters returns pools of masters holding keys, keys are scanned on each of them.
+	masters(ctx context.Context) ([]*pool, error)
+	close()
+}
+
+type options struct {
+	password    string
+	db          int
+	poolSize    int
+	dialTimeout time.Duration
+	readTimeout time.Duration
+}
+
+// pool keeps idle connections to a node.
+type pool struct {
+	addr string
+	opts *options
+	idle chan *conn
+}
+
+func newPool(addr string, opts *options) *pool {
+	return &pool{addr: addr, opts: opts, idle: make(chan *conn, opts.poolSize)}
+}
+
+func (p *pool) dial(ctx context.Context) (*conn, error) {
+	dialer := net.Dialer{Timeout: p.opts.dialTimeout}
+	netConn, err := dialer.DialContext(ctx, "tcp", p.addr)
+	if nil != err {
+		return nil, errors.Wrapf(err, "dial %s", p.addr)
+	}
+
+	c := newConn(netConn, p.opts.readTimeout)
+	var cmds [][]interface{}
+	if p.opts.password != "" {
+		cmds = append(cmds, []interface{}{"AUTH", p.opts.password})
+	}
+	if p.opts.db > 0 {
+		cmds = append(cmds, []interface{}{"SELECT", p.opts.db})
+	}
+	if len(cmds) > 0 {
+		replies, err := c.pipeline(ctx, cmds)
+		if nil == err {
+			for _, reply := range replies {
+				if err = replyError(reply); nil != err {
+					break
+				}
+			}
+		}
+		if nil != err {
+			c.close()
+			return nil, errors.Wrapf(err, "initialize connection to %s", p.addr)
+		}
+	}
+	return c, nil
+}
+
+func (p *pool) do(ctx context.Context, cmds [][]interface{}) ([]interface{}, error) {
+	var c *conn
+	select {
+	case c = <-p.idle:
+	default:
+		var err error
+		if c, err = p.dial(ctx); nil != err {
+			return nil, err
+		}
+	}
+
+	replies, err := c.pipeline(ctx, cmds)
+	if nil != err {
+		// the connection state is unknown.
+		c.close()
+		return nil, errors.Wrapf(err, "pipeline on %s", p.addr)
+	}
+
+	select {
+	case p.idle <- c:
+	default:
+		c.close()
+	}
+	return replies, nil
+}
+
+func (p *pool) close() {
+	for {
+		select {
+		case c := <-p.idle:
+			c.close()
+		default:
+			return
+		}
+	}
+}
+
+func commandArgs(cmds []command) [][]interface{} {
+	args := make([][]interface{}, len(cmds))
+	for index := range cmds {
+		args[index] = cmds[index].args
+	}
+	return args
+}
+
+// standalone talks to a single redis server.
+type standalone struct {
+	pool *pool
+}
+
+func (s *standalone) pipeline(ctx context.Context, cmds []command) ([]interface{}, error) {
+	return s.pool.do(ctx, commandArgs(cmds))
+}
+
+func (s *standalone) masters(ctx context.Context) ([]*pool, error) {
+	return []*pool{s.pool}, nil
+}
+
+func (s *standalone) close() {
+	s.pool.close()
+}
+
+// sentinel talks to the master resolved through sentinels, and resolves again on failover.
+type sentinel struct {
+	sentinels  []string
+	masterName string
+	opts       *options
+
+	lock   sync.Mutex
+	master *pool
+}
+
+func (s *sentinel) resolve(ctx context.Context) (*pool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if nil != s.master {
+		return s.master, nil
+	}
+
+	// sentinels do not share the password and db of the master.
+	sentinelOpts := &options{poolSize: 1, dialTimeout: s.opts.dialTimeout, readTimeout: s.opts.readTimeout}
+	var err error
+	for _, addr := range s.sentinels {
+		var replies []interface{}
+		p := newPool(addr, sentinelOpts)
+		replies, err = p.do(ctx, [][]interface{}{{"SENTINEL", "get-master-addr-by-name", s.masterName}})
+		p.close()
+		if nil != err {
+			log.L().Warn("resolve redis master", logf.String("sentinel", addr), logf.Error(err))
+			continue
+		}
+
+		hostPort, ok := replies[0].([]interface{})
+		if !ok || len(hostPort) != 2 {
+			err = errors.Errorf("sentinel %s knows no master %s", addr, s.masterName)
+			continue
+		}
+		master := net.JoinHostPort(replyString(hostPort[0]), replyString(hostPort[1]))
+		log.L().Info("resolve redis master", logf.String("sentinel", addr), logf.String("master", master))
+		s.master = newPool(master, s.opts)
+		return s.master, nil
+	}
+	return nil, errors.Wrap(err, "resolve master")
+}
+
+func (s *sentinel) reset(master *pool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.master == master {
+		s.master.close()
+		s.master = nil
+	}
+}
+
+func (s *sentinel) pipeline(ctx context.Context, cmds []command) ([]interface{}, error) {
+	var err error
+	for attempt := 0; attempt < 2; attempt++ {
+		var master *pool
+		if master, err = s.resolve(ctx); nil != err {
+			return nil, err
+		}
+
+		var replies []interface{}
+		if replies, err = master.do(ctx, commandArgs(cmds)); nil == err && !readonly(replies) {
+			return replies, nil
+		}
+
+		// the master failed over, or became a replica.
+		if nil == err {
+			err = errors.New("master is readonly")
+		}
+		s.reset(master)
+	}
+	return nil, err
+}
+
+func (s *sentinel) masters(ctx context.Context) ([]*pool, error) {
+	master, err := s.resolve(ctx)
+	if nil != err {
+		return nil, err
+	}
+	return []*pool{master}, nil
+}
+
+func (s *sentinel) close() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if nil != s.master {
+		s.master.close()
+	}
+}
+
+func readonly(replies []interface{}) bool {
+	for _, reply := range replies {
+		if err := replyError(reply); nil != err && strings.HasPrefix(err.Error(), "READONLY") {
+			return true
+		}
+	}
+	return false
+}
+
+// cluster routes commands to nodes owning slots of their keys, and follows redirects.
+type cluster struct {
+	seeds []string
+	opts  *options
+
+	lock  sync.RWMutex
+	slots [clusterSlots]string
+	pools map[string]*pool
+}
+
+func (c *cluster) pool(addr string) *pool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	p, ok := c.pools[addr]
+	if !ok {
+		p = newPool(addr, c.opts)
+		c.pools[addr] = p
+	}
+	return p
+}
+
+// refresh loads the slot table from any reachable node.
+func (c *cluster) refresh(ctx context.Context) error {
+	c.lock.RLock()
+	addrs := append([]string{}, c.seeds...)
+	for addr := range c.pools {
+		addrs = append(addrs, addr)
+	}
+	c.lock.RUnlock()
+
+	var err error
+	for _, addr := range addrs {
+		var replies []interface{}
+		if replies, err = c.pool(addr).do(ctx, [][]interface{}{{"CLUSTER", "SLOTS"}}); nil != err {
+			continue
+		} else if err = replyError(replies[0]); nil != err {
+			continue
+		}
+
+		ranges, _ := replies[0].([]interface{})
+		c.lock.Lock()
+		for _, item := range ranges {
+			slotRange, _ := item.([]interface{})
+			if len(slotRange) < 3 {
+				continue
+			}
+			start, _ := slotRange[0].(int64)
+			end, _ := slotRange[1].(int64)
+			node, _ := slotRange[2].([]interface{})
+			if len(node) < 2 || start < 0 || end >= clusterSlots {
+				continue
+			}
+			master := net.JoinHostPort(replyString(node[0]), replyString(node[1]))
+			for slot := start; slot <= end; slot++ {
+				c.slots[slot] = master
+			}
+		}
+		c.lock.Unlock()
+		return nil
+	}
+	return errors.Wrap(err, "refresh cluster slots")
+}
+
+func (c *cluster) nodeOf(key string) string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if addr := c.slots[keySlot(key)]; addr != "" {
+		return addr
+	}
+	return c.seeds[0]
+}
+
+func (c *cluster) pipeline(ctx context.Context, cmds []command) ([]interface{}, error) {
+	// group commands by node, one pipeline per node.
+	groups := make(map[string][]int)
+	for index := range cmds {
+		addr := c.nodeOf(cmds[index].key)
+		groups[addr] = append(groups[addr], index)
+	}
+
+	replies := make([]interface{}, len(cmds))
+	for addr, indexes := range groups {
+		group := make([][]interface{}, len(indexes))
+		for i, index := range indexes {
+			group[i] = cmds[index].args
+		}
+		groupReplies, err := c.pool(addr).do(ctx, group)
+		if nil != err {
+			return nil, err
+		}
+		for i, index := range indexes {
+			replies[index] = groupReplies[i]
+		}
+	}
+
+	// follow redirects of slots in migration.
+	for index := range replies {
+		var err error
+		if replies[index], err = c.redirect(ctx, cmds[index], replies[index]); nil != err {
+			return nil, err
+		}
+	}
+	return replies, nil
+}
+
+func (c *cluster) redirect(ctx context.Context, cmd command, reply interface{}) (interface{}, error) {
+	for attempt := 0; attempt < maxRedirects; attempt++ {
+		err := replyError(reply)
+		if nil == err {
+			return reply, nil
+		}
+
+		fields := strings.Fields(err.Error())
+		if len(fields) != 3 || (fields[0] != "MOVED" && fields[0] != "ASK") {
+			return reply, nil
+		}
+
+		cmds := [][]interface{}{cmd.args}
+		if fields[0] == "ASK" {
+			cmds = [][]interface{}{{"ASKING"}, cmd.args}
+		} else if err = c.refresh(ctx); nil != err {
+			log.L().Warn("redis cluster refresh slots", logf.Error(err))
+		}
+
+		replies, err := c.pool(fields[2]).do(ctx, cmds)
+		if nil != err {
+			return nil, err
+		}
+		reply = replies[len(replies)-1]
+	}
+	return reply, nil
+}
+
+// masters returns masters owning slots of the refreshed slot table.
+func (c *cluster) masters(ctx context.Context) ([]*pool, error) {
+	if err := c.refresh(ctx); nil != err {
+		return nil, err
+	}
+
+	c.lock.RLock()
+	addrs := make(map[string]bool)
+	for _, addr := range c.slots {
+		if addr != "" {
+			addrs[addr] = true
+		}
+	}
+	c.lock.RUnlock()
+
+	pools := make([]*pool, 0, len(addrs))
+	for addr := range addrs {
+		pools = append(pools, c.pool(addr))
+	}
+	return pools, nil
+}
+
+func (c *cluster) close() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, p := range c.pools {
+		p.close()
+	}
+}
+
+// keySlot returns the cluster slot of the key, only the hash tag is hashed if the key has one.
+func keySlot(key string) int {
+	if start := strings.IndexByte(key, '{'); start >= 0 {
+		if end := strings.IndexByte(key[start+1:], '}'); end > 0 {
+			key = key[start+1 : start+1+end]
+		}
+	}
+	return int(crc16(key)) % clusterSlots
+}
+
+// crc16 is CRC-16/XMODEM used by redis cluster.
+func crc16(key string) uint16 {
+	var crc uint16
+	for index := 0; index < len(key); index++ {
+		crc ^= uint16(key[index]) << 8
+		for bit := 0; bit < 8; bit++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+func newClient(ctx context.Context, meta *redisMetadata) (client, error) {
+	opts := &options{
+		password:    meta.Password,
+		db:          meta.DB,
+		poolSize:    meta.PoolSize,
+		dialTimeout: time.Duration(meta.DialTimeout) * time.Millisecond,
+		readTimeout: time.Duration(meta.ReadTimeout) * time.Millisecond,
+	}
+
+	addrs := strings.Split(meta.Addrs, ",")
+	for index := range addrs {
+		addrs[index] = strings.TrimSpace(addrs[index])
+	}
+
+	switch meta.Mode {
+	case ModeStandalone:
+		return &standalone{pool: newPool(addrs[0], opts)}, nil
+	case ModeSentinel:
+		if meta.MasterName == "" {
+			return nil, errors.New("master_name required in sentinel mode")
+		}
+		return &sentinel{sentinels: addrs, masterName: meta.MasterName, opts: opts}, nil
+	case ModeCluster:
+		// cluster has database 0 only.
+		opts.db = 0
+		c := &cluster{seeds: addrs, opts: opts, pools: make(map[string]*pool)}
+		if err := c.refresh(ctx); nil != err {
+			c.close()
+			return nil, errors.Wrap(err, "connect cluster")
+		}
+		return c, nil
+	}
+	return nil, errors.Errorf("unknown mode %s", meta.Mode)
+}
+
+// ping checks the connectivity of the client.
+func ping(ctx context.Context, cli client) error {
+	replies, err := cli.pipeline(ctx, []command{{args: []interface{}{"PING"}}})
+	if nil != err {
+		return err
+	}
+	if err = replyError(replies[0]); nil != err {
+		return errors.Wrap(err, "ping")
+	}
+	return nil
+}
+
+func parseVersion(reply interface{}) (int64, error) {
+	if v, ok := reply.(int64); ok {
+		return v, nil
+	}
+	v, err := strconv.ParseInt(replyString(reply), 10, 64)
+	return v, errors.Wrap(err, "parse version")
+}
@@ -0,0 +1,514 @@
+package redis
+
+import (
+	"container/list"
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/resource/store"
+	"github.com/tkeel-io/core/pkg/resource/transport"
+	"github.com/tkeel-io/core/pkg/util"
+	"github.com/tkeel-io/kit/log"
+)
+
+const (
+	fieldData    = "data"
+	fieldVersion = "version"
+
+	// setScript sets the data if the expected version matches, an empty expected version sets unconditionally,
+	// "0" expects the key not exists. returns the new version.
+	setScript = `local version = redis.call("HGET", KEYS[1], "version") or "0"
+if ARGV[1] ~= "" and ARGV[1] ~= version then
+  return redis.error_reply("ETAG_MISMATCH " .. version)
+end
+redis.call("HSET", KEYS[1], "data", ARGV[2])
+return redis.call("HINCRBY", KEYS[1], "version", 1)`
+
+	etagMismatch = "ETAG_MISMATCH"
+
+	// scanCount hints keys returned by a page of SCAN.
+	scanCount = 500
+
+	defaultPoolSize     = 16
+	defaultEtagCapacity = 1 << 20
+	defaultDialTimeout  = 3000
+	defaultReadTimeout  = 3000
+)
+
+type redisMetadata struct {
+	// Mode is standalone, sentinel or cluster.
+	Mode string `mapstructure:"mode"`
+	// Addrs are comma separated addresses of the server, sentinels or cluster seeds.
+	Addrs string `mapstructure:"addrs"`
+	// MasterName is the master monitored by sentinels.
+	MasterName string `mapstructure:"master_name"`
+	Password   string `mapstructure:"password"`
+	DB         int    `mapstructure:"db"`
+	PoolSize   int    `mapstructure:"pool_size"`
+	// DialTimeout and ReadTimeout are milliseconds.
+	DialTimeout int64 `mapstructure:"dial_timeout"`
+	ReadTimeout int64 `mapstructure:"read_timeout"`
+	// KeyPrefix prefixes keys, separates deployments sharing a server.
+	KeyPrefix string `mapstructure:"key_prefix"`
+	// EtagCapacity bounds etags remembered, least recently used keys beyond it are written unconditionally.
+	EtagCapacity int `mapstructure:"etag_capacity"`
+}
+
+type bulkItem struct {
+	key  string
+	data []byte
+}
+
+type etagEntry struct {
+	key  string
+	etag string
+}
+
+// redisStore keeps state items as hashes of data and version, the version is the etag of the item.
+// it remembers etags of the items it reads and writes, and writes them with compare-and-swap,
+// so that a node holding a stale entity can not overwrite the entity written by its new owner.
+// on conflicts the etag is refreshed to the stored version and errors.ErrEtagMismatch is returned,
+// the caller reloads the state before writing again.
+type redisStore struct {
+	id     string
+	prefix string
+	cli    client
+
+	lock     sync.Mutex
+	capacity int
+	etags    map[string]*list.Element
+	order    *list.List
+}
+
+// redisBulkStore batches writes, conflicts of batched writes are returned by the next write of the key
+// until the key is read again, other failures are returned by Flush.
+type redisBulkStore struct {
+	*redisStore
+	bulkTransport transport.Transport
+
+	failedLock sync.Mutex
+	failed     map[string]error
+}
+
+// Get returns state, conflicts of the key are resolved by reading it.
+func (r *redisBulkStore) Get(ctx context.Context, key string) (*store.StateItem, error) {
+	item, err := r.redisStore.Get(ctx, key)
+	if nil == err || errors.Is(err, xerrors.ErrResourceNotFound) {
+		r.clearFailed(key)
+	}
+	return item, err
+}
+
+func (r *redisBulkStore) Set(ctx context.Context, key string, data []byte) error {
+	r.failedLock.Lock()
+	err := r.failed[key]
+	delete(r.failed, key)
+	if errors.Is(err, xerrors.ErrEtagMismatch) {
+		// the state is stale, writes fail until the caller reloads it.
+		r.failed[key] = err
+		r.failedLock.Unlock()
+		return err
+	}
+	r.failedLock.Unlock()
+	return r.bulkTransport.Send(ctx, &bulkItem{key: key, data: data})
+}
+
+func (r *redisBulkStore) Del(ctx context.Context, key string) error {
+	r.clearFailed(key)
+	return r.redisStore.Del(ctx, key)
+}
+
+// Flush writes batched items, returns failures of items written since the last flush.
+func (r *redisBulkStore) Flush(ctx context.Context) error {
+	// failures of batches are kept per item.
+	r.bulkTransport.Flush(ctx) //nolint
+
+	r.failedLock.Lock()
+	defer r.failedLock.Unlock()
+	var failed int
+	var err error
+	for key, keyErr := range r.failed {
+		if !errors.Is(keyErr, xerrors.ErrEtagMismatch) {
+			delete(r.failed, key)
+			failed++
+			err = keyErr
+		}
+	}
+	if failed > 0 {
+		return errors.Wrapf(err, "redis store flush, %d items failed", failed)
+	}
+	return nil
+}
+
+func (r *redisBulkStore) clearFailed(key string) {
+	r.failedLock.Lock()
+	defer r.failedLock.Unlock()
+	delete(r.failed, key)
+}
+
+// batchWrite writes batched items, and keeps failures of the items.
+func (r *redisBulkStore) batchWrite(messages []interface{}) error {
+	items, errs := r.redisStore.batchWrite(context.Background(), messages)
+	r.failedLock.Lock()
+	defer r.failedLock.Unlock()
+	var failed int
+	var err error
+	for index, item := range items {
+		if nil != errs[index] {
+			failed++
+			err = errs[index]
+			r.failed[item.key] = errs[index]
+		}
+	}
+
+	if failed > 0 {
+		log.L().Error("redis store batch write", logf.ID(r.id),
+			logf.Any("failed", failed), logf.Any("items", len(items)), logf.Error(err))
+		return errors.Wrapf(err, "redis store batch write, %d of %d items failed", failed, len(items))
+	}
+	return nil
+}
+
+func (r *redisBulkStore) Close() error {
+	if err := r.bulkTransport.Flush(context.Background()); nil != err {
+		log.L().Error("redis store flush on close", logf.ID(r.id), logf.Error(err))
+	}
+	return r.redisStore.Close()
+}
+
+func (r *redisStore) key(key string) string {
+	return r.prefix + key
+}
+
+func (r *redisStore) etag(key string) string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	elem, ok := r.etags[key]
+	if !ok {
+		return ""
+	}
+	r.order.MoveToBack(elem)
+	entry, _ := elem.Value.(*etagEntry)
+	return entry.etag
+}
+
+func (r *redisStore) setEtag(key, etag string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	elem, ok := r.etags[key]
+	if etag == "" {
+		if ok {
+			r.order.Remove(elem)
+			delete(r.etags, key)
+		}
+		return
+	} else if ok {
+		entry, _ := elem.Value.(*etagEntry)
+		entry.etag = etag
+		r.order.MoveToBack(elem)
+		return
+	}
+
+	r.etags[key] = r.order.PushBack(&etagEntry{key: key, etag: etag})
+	for r.order.Len() > r.capacity {
+		entry, _ := r.order.Remove(r.order.Front()).(*etagEntry)
+		delete(r.etags, entry.key)
+	}
+}
+
+// Get returns state.
+func (r *redisStore) Get(ctx context.Context, key string) (*store.StateItem, error) {
+	replies, err := r.cli.pipeline(ctx, []command{{key: r.key(key),
+		args: []interface{}{"HMGET", r.key(key), fieldData, fieldVersion}}})
+	if nil != err {
+		log.L().Error("redis store get", logf.Key(key), logf.ID(r.id), logf.Error(err))
+		return nil, errors.Wrap(err, "redis store get")
+	} else if err = replyError(replies[0]); nil != err {
+		return nil, errors.Wrap(err, "redis store get")
+	}
+
+	fields, _ := replies[0].([]interface{})
+	if len(fields) != 2 || fields[0] == nil {
+		return nil, xerrors.ErrResourceNotFound
+	}
+
+	value, _ := fields[0].([]byte)
+	etag := replyString(fields[1])
+	r.setEtag(key, etag)
+	return &store.StateItem{
+		Key:      key,
+		Etag:     etag,
+		Value:    value,
+		Metadata: map[string]string{},
+	}, nil
+}
+
+// Set saves the raw data with the etag it knows for the key.
+func (r *redisStore) Set(ctx context.Context, key string, data []byte) error {
+	_, err := r.SetWithEtag(ctx, key, data, r.etag(key))
+	return err
+}
+
+// SetWithEtag saves the data if the stored etag matches, returns the new etag.
+func (r *redisStore) SetWithEtag(ctx context.Context, key string, data []byte, etag string) (string, error) {
+	errs := r.setItems(ctx, []*bulkItem{{key: key, data: data}}, []string{etag})
+	return r.etag(key), errs[0]
+}
+
+// setItems pipelines compare-and-swap of items, returns errors of items.
+func (r *redisStore) setItems(ctx context.Context, items []*bulkItem, etags []string) []error {
+	cmds := make([]command, len(items))
+	for index, item := range items {
+		cmds[index] = command{key: r.key(item.key),
+			args: []interface{}{"EVAL", setScript, 1, r.key(item.key), etags[index], item.data}}
+	}
+
+	errs := make([]error, len(items))
+	replies, err := r.cli.pipeline(ctx, cmds)
+	if nil != err {
+		log.L().Error("redis store set", logf.ID(r.id), logf.Any("items", len(items)), logf.Error(err))
+		for index := range errs {
+			errs[index] = errors.Wrap(err, "redis store set")
+		}
+		return errs
+	}
+
+	for index, reply := range replies {
+		key := items[index].key
+		if err = replyError(reply); nil != err {
+			if strings.HasPrefix(err.Error(), etagMismatch) {
+				log.L().Warn("redis store set, etag mismatch", logf.Key(key), logf.ID(r.id),
+					logf.String("etag", etags[index]), logf.Reason(err.Error()))
+				// the reply carries the stored version, writes after reloading the state succeed.
+				r.setEtag(key, strings.TrimSpace(strings.TrimPrefix(err.Error(), etagMismatch)))
+				errs[index] = errors.Wrapf(xerrors.ErrEtagMismatch, "redis store set %s", key)
+				continue
+			}
+			errs[index] = errors.Wrap(err, "redis store set")
+			continue
+		}
+
+		version, err := parseVersion(reply)
+		if nil != err {
+			errs[index] = errors.Wrap(err, "redis store set")
+			continue
+		}
+		r.setEtag(key, strconv.FormatInt(version, 10))
+	}
+	return errs
+}
+
+func (r *redisStore) Del(ctx context.Context, key string) error {
+	replies, err := r.cli.pipeline(ctx, []command{{key: r.key(key), args: []interface{}{"DEL", r.key(key)}}})
+	if nil == err {
+		err = replyError(replies[0])
+	}
+	if nil != err {
+		log.L().Error("redis store del", logf.Key(key), logf.ID(r.id), logf.Error(err))
+		return errors.Wrap(err, "redis store del")
+	}
+	r.setEtag(key, "")
+	return nil
+}
+
+func (r *redisStore) Flush(ctx context.Context) error {
+	return nil
+}
+
+func (r *redisStore) BatchWrite(ctx context.Context, args *[]interface{}) error {
+	items, errs := r.batchWrite(ctx, *args)
+	var failed int
+	var err error
+	for _, itemErr := range errs {
+		if nil != itemErr {
+			failed++
+			err = itemErr
+		}
+	}
+	if failed > 0 {
+		return errors.Wrapf(err, "redis store batch write, %d of %d items failed", failed, len(items))
+	}
+	return nil
+}
+
+// batchWrite writes the latest item of each key, returns written items and their errors.
+func (r *redisStore) batchWrite(ctx context.Context, messages []interface{}) ([]*bulkItem, []error) {
+	latest := make(map[string]int)
+	var items []*bulkItem
+	for _, val := range messages {
+		item, ok := val.(*bulkItem)
+		if !ok {
+			log.L().Error("redis store batch write, invalid item", logf.ID(r.id), logf.Any("item", val))
+			continue
+		}
+		if index, has := latest[item.key]; has {
+			items[index] = item
+			continue
+		}
+		latest[item.key] = len(items)
+		items = append(items, item)
+	}
+
+	etags := make([]string, len(items))
+	for index, item := range items {
+		etags[index] = r.etag(item.key)
+	}
+	return items, r.setItems(ctx, items, etags)
+}
+
+// Scan scans keys of the prefix on every master, values of a page of keys are read in a pipeline.
+// keys are not ordered, keys deleted while scanning are skipped.
+func (r *redisStore) Scan(ctx context.Context, prefix string, handler func(key string, value []byte) error) error {
+	masters, err := r.cli.masters(ctx)
+	if nil != err {
+		return errors.Wrap(err, "redis store scan")
+	}
+
+	pattern := escapePattern(r.key(prefix)) + "*"
+	for _, master := range masters {
+		for cursor := "0"; ; {
+			replies, err := master.do(ctx, [][]interface{}{{"SCAN", cursor, "MATCH", pattern, "COUNT", scanCount}})
+			if nil != err {
+				return errors.Wrap(err, "redis store scan")
+			} else if err = replyError(replies[0]); nil != err {
+				return errors.Wrap(err, "redis store scan")
+			}
+
+			page, _ := replies[0].([]interface{})
+			if len(page) != 2 {
+				return errors.New("redis store scan, malformed reply")
+			}
+			keys, _ := page[1].([]interface{})
+			if err = r.scanValues(ctx, master, keys, handler); nil != err {
+				return err
+			}
+			if cursor = replyString(page[0]); cursor == "0" {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func (r *redisStore) scanValues(ctx context.Context, master *pool, keys []interface{}, handler func(key string, value []byte) error) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	cmds := make([][]interface{}, len(keys))
+	for index := range keys {
+		cmds[index] = []interface{}{"HGET", replyString(keys[index]), fieldData}
+	}
+	replies, err := master.do(ctx, cmds)
+	if nil != err {
+		return errors.Wrap(err, "redis store scan")
+	}
+
+	for index, reply := range replies {
+		value, ok := reply.([]byte)
+		if !ok {
+			// deleted while scanning.
+			continue
+		}
+		if err = handler(strings.TrimPrefix(replyString(keys[index]), r.prefix), value); nil != err {
+			return err
+		}
+	}
+	return nil
+}
+
+// escapePattern escapes glob characters of the key.
+func escapePattern(key string) string {
+	var builder strings.Builder
+	for _, c := range key {
+		switch c {
+		case '*', '?', '[', ']', '\\':
+			builder.WriteByte('\\')
+		}
+		builder.WriteRune(c)
+	}
+	return builder.String()
+}
+
+func (r *redisStore) BuildBulkData(m interface{}) (interface{}, error) {
+	return m, nil
+}
+
+func (r *redisStore) Close() error {
+	r.cli.close()
+	return nil
+}
+
+func newStore(properties map[string]interface{}) (*redisStore, error) {
+	var meta redisMetadata
+	if err := mapstructure.WeakDecode(properties, &meta); nil != err {
+		return nil, errors.Wrap(err, "decode store.redis configuration")
+	}
+	if meta.Addrs == "" {
+		return nil, errors.Wrap(xerrors.ErrInvalidParam, "store.redis addrs required")
+	}
+	if meta.Mode == "" {
+		meta.Mode = ModeStandalone
+	}
+	if meta.PoolSize <= 0 {
+		meta.PoolSize = defaultPoolSize
+	}
+	if meta.DialTimeout <= 0 {
+		meta.DialTimeout = defaultDialTimeout
+	}
+	if meta.ReadTimeout <= 0 {
+		meta.ReadTimeout = defaultReadTimeout
+	}
+	if meta.EtagCapacity <= 0 {
+		meta.EtagCapacity = defaultEtagCapacity
+	}
+
+	ctx := context.Background()
+	cli, err := newClient(ctx, &meta)
+	if nil != err {
+		return nil, errors.Wrap(err, "create store.redis client")
+	}
+	if err = ping(ctx, cli); nil != err {
+		cli.close()
+		return nil, errors.Wrap(err, "connect store.redis")
+	}
+
+	id := util.UUID("sredis")
+	log.L().Info("create store.redis instance", logf.ID(id),
+		logf.String("mode", meta.Mode), logf.String("addrs", meta.Addrs))
+	return &redisStore{
+		id:       id,
+		prefix:   meta.KeyPrefix,
+		cli:      cli,
+		capacity: meta.EtagCapacity,
+		etags:    make(map[string]*list.Element),
+		order:    list.New(),
+	}, nil
+}
+
+func init() {
+	log.SuccessStatusEvent(os.Stdout, "Register Resource<state.redis> successful")
+	store.Register("redis", func(properties map[string]interface{}) (store.Store, error) {
+		s, err := newStore(properties)
+		if nil != err {
+			return nil, err
+		}
+
+		bulkStore := &redisBulkStore{redisStore: s, failed: make(map[string]error)}
+		bulkTransport, err := transport.NewSinkTransport(context.Background(),
+			"redis-state", bulkStore.batchWrite, s.BuildBulkData)
+		if nil != err {
+			s.Close()
+			return nil, errors.Wrap(err, "create store.redis transport")
+		}
+		bulkStore.bulkTransport = bulkTransport
+		return bulkStore, nil
+	})
+}
@@ -0,0 +1,203 @@
+package redis
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/resource/transport"
+)
+
+// fakeServer serves the commands the store uses from memory.
+type fakeServer struct {
+	listener net.Listener
+	lock     sync.Mutex
+	hashes   map[string]map[string]string
+	commands int
+}
+
+func newFakeServer(t *testing.T) *fakeServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	s := &fakeServer{listener: listener, hashes: make(map[string]map[string]string)}
+	go func() {
+		for {
+			netConn, err := listener.Accept()
+			if nil != err {
+				return
+			}
+			go s.serve(netConn)
+		}
+	}()
+	t.Cleanup(func() { listener.Close() })
+	return s
+}
+
+func (s *fakeServer) serve(netConn net.Conn) {
+	defer netConn.Close()
+	c := newConn(netConn, 0)
+	writer := bufio.NewWriter(netConn)
+	for {
+		reply, err := c.readReply()
+		if nil != err {
+			return
+		}
+		args, _ := reply.([]interface{})
+		writer.WriteString(s.handle(args))
+		writer.Flush()
+	}
+}
+
+func bulk(v string) string {
+	return "$" + strconv.Itoa(len(v)) + "\r\n" + v + "\r\n"
+}
+
+func (s *fakeServer) handle(args []interface{}) string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.commands++
+	switch replyString(args[0]) {
+	case "PING":
+		return "+PONG\r\n"
+	case "HMGET":
+		hash, ok := s.hashes[replyString(args[1])]
+		if !ok {
+			return "*2\r\n$-1\r\n$-1\r\n"
+		}
+		return "*2\r\n" + bulk(hash["data"]) + bulk(hash["version"])
+	case "HGET":
+		hash, ok := s.hashes[replyString(args[1])]
+		if !ok {
+			return "$-1\r\n"
+		}
+		return bulk(hash[replyString(args[2])])
+	case "SCAN":
+		// one page of all keys, the pattern is a prefix.
+		prefix := strings.TrimSuffix(replyString(args[3]), "*")
+		var keys []string
+		for key := range s.hashes {
+			if strings.HasPrefix(key, prefix) {
+				keys = append(keys, bulk(key))
+			}
+		}
+		return "*2\r\n" + bulk("0") + "*" + strconv.Itoa(len(keys)) + "\r\n" + strings.Join(keys, "")
+	case "DEL":
+		delete(s.hashes, replyString(args[1]))
+		return ":1\r\n"
+	case "EVAL":
+		key, etag, data := replyString(args[3]), replyString(args[4]), replyString(args[5])
+		hash, ok := s.hashes[key]
+		version := "0"
+		if ok {
+			version = hash["version"]
+		}
+		if etag != "" && etag != version {
+			return "-ETAG_MISMATCH " + version + "\r\n"
+		}
+		next, _ := strconv.Atoi(version)
+		s.hashes[key] = map[string]string{"data": data, "version": strconv.Itoa(next + 1)}
+		return ":" + strconv.Itoa(next+1) + "\r\n"
+	}
+	return "-ERR unknown command\r\n"
+}
+
+func Test_RedisStore(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeServer(t)
+	s, err := newStore(map[string]interface{}{"addrs": server.listener.Addr().String(), "key_prefix": "core."})
+	assert.Nil(t, err)
+	defer s.Close()
+
+	_, err = s.Get(ctx, "entity123")
+	assert.ErrorIs(t, err, xerrors.ErrResourceNotFound)
+	assert.Nil(t, s.Set(ctx, "entity123", []byte(`{"temp":20}`)))
+	item, err := s.Get(ctx, "entity123")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(`{"temp":20}`), item.Value)
+	assert.Equal(t, "1", item.Etag)
+	assert.Contains(t, server.hashes, "core.entity123")
+
+	// another node takes over the entity, writes of the stale node fail.
+	other, err := newStore(map[string]interface{}{"addrs": server.listener.Addr().String(), "key_prefix": "core."})
+	assert.Nil(t, err)
+	defer other.Close()
+	_, err = other.Get(ctx, "entity123")
+	assert.Nil(t, err)
+	assert.Nil(t, other.Set(ctx, "entity123", []byte(`{"temp":30}`)))
+	assert.ErrorIs(t, s.Set(ctx, "entity123", []byte(`{"temp":25}`)), xerrors.ErrEtagMismatch)
+	assert.Equal(t, "2", s.etag("entity123"))
+	etag, err := s.SetWithEtag(ctx, "entity123", []byte(`{"temp":25}`), "2")
+	assert.Nil(t, err)
+	assert.Equal(t, "3", etag)
+
+	// bulk writes are pipelined, the latest item of a key wins.
+	server.commands = 0
+	assert.Nil(t, s.BatchWrite(ctx, &[]interface{}{
+		&bulkItem{key: "entity123", data: []byte(`{"temp":40}`)},
+		&bulkItem{key: "entity456", data: []byte(`{}`)},
+		&bulkItem{key: "entity123", data: []byte(`{"temp":50}`)},
+	}))
+	assert.Equal(t, 2, server.commands)
+	item, err = s.Get(ctx, "entity123")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(`{"temp":50}`), item.Value)
+
+	// keys of the prefix are scanned without the key prefix.
+	scanned := make(map[string]string)
+	assert.Nil(t, s.Scan(ctx, "entity", func(key string, value []byte) error {
+		scanned[key] = string(value)
+		return nil
+	}))
+	assert.Equal(t, map[string]string{"entity123": `{"temp":50}`, "entity456": `{}`}, scanned)
+	assert.Equal(t, `core\*\?`, escapePattern("core*?"))
+
+	assert.Nil(t, s.Del(ctx, "entity123"))
+	_, err = s.Get(ctx, "entity123")
+	assert.NotNil(t, err)
+}
+
+func Test_RedisBulkStore(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeServer(t)
+	s, err := newStore(map[string]interface{}{"addrs": server.listener.Addr().String(), "etag_capacity": 2})
+	assert.Nil(t, err)
+	bulkStore := &redisBulkStore{redisStore: s, failed: make(map[string]error)}
+	bulkStore.bulkTransport, err = transport.NewSinkTransport(ctx, "redis-state", bulkStore.batchWrite, s.BuildBulkData)
+	assert.Nil(t, err)
+	defer bulkStore.Close()
+
+	assert.Nil(t, bulkStore.Set(ctx, "entity123", []byte(`{"temp":20}`)))
+	assert.Nil(t, bulkStore.Flush(ctx))
+	server.hashes["entity123"]["version"] = "5"
+
+	// conflicts of batched writes are returned by writes of the key until it is read.
+	assert.Nil(t, bulkStore.Set(ctx, "entity123", []byte(`{"temp":30}`)))
+	assert.Nil(t, bulkStore.Flush(ctx))
+	assert.ErrorIs(t, bulkStore.Set(ctx, "entity123", []byte(`{"temp":40}`)), xerrors.ErrEtagMismatch)
+	assert.ErrorIs(t, bulkStore.Set(ctx, "entity123", []byte(`{"temp":40}`)), xerrors.ErrEtagMismatch)
+	_, err = bulkStore.Get(ctx, "entity123")
+	assert.Nil(t, err)
+	assert.Nil(t, bulkStore.Set(ctx, "entity123", []byte(`{"temp":40}`)))
+	assert.Nil(t, bulkStore.Flush(ctx))
+	assert.Equal(t, "6", server.hashes["entity123"]["version"])
+
+	// etags are bounded, least recently used first out.
+	assert.Nil(t, bulkStore.Set(ctx, "entity456", []byte(`{}`)))
+	assert.Nil(t, bulkStore.Set(ctx, "entity789", []byte(`{}`)))
+	assert.Nil(t, bulkStore.Flush(ctx))
+	assert.Len(t, s.etags, 2)
+	assert.Equal(t, "", s.etag("entity123"))
+}
+
+func Test_keySlot(t *testing.T) {
+	assert.Equal(t, uint16(0x31C3), crc16("123456789"))
+	assert.Equal(t, 12182, keySlot("foo"))
+	assert.Equal(t, keySlot("user1000"), keySlot("{user1000}.following"))
+	assert.Equal(t, keySlot("{}.following"), keySlot("{}.following"))
+}
@@ -0,0 +1,167 @@
+package redis
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// redisError is an error reply of a command, the connection is still usable.
+type redisError string
+
+func (e redisError) Error() string {
+	return string(e)
+}
+
+// conn is a connection speaking RESP, replies are string, []byte, int64, []interface{}, nil or redisError.
+type conn struct {
+	netConn     net.Conn
+	reader      *bufio.Reader
+	writer      *bufio.Writer
+	readTimeout time.Duration
+}
+
+func newConn(netConn net.Conn, readTimeout time.Duration) *conn {
+	return &conn{
+		netConn:     netConn,
+		reader:      bufio.NewReader(netConn),
+		writer:      bufio.NewWriter(netConn),
+		readTimeout: readTimeout,
+	}
+}
+
+// pipeline writes commands in one round trip and reads their replies in order.
+func (c *conn) pipeline(ctx context.Context, cmds [][]interface{}) ([]interface{}, error) {
+	deadline := time.Now().Add(c.readTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := c.netConn.SetDeadline(deadline); nil != err {
+		return nil, errors.Wrap(err, "set deadline")
+	}
+
+	for _, args := range cmds {
+		if err := c.writeCommand(args); nil != err {
+			return nil, errors.Wrap(err, "write command")
+		}
+	}
+	if err := c.writer.Flush(); nil != err {
+		return nil, errors.Wrap(err, "flush commands")
+	}
+
+	replies := make([]interface{}, len(cmds))
+	for index := range cmds {
+		reply, err := c.readReply()
+		if nil != err {
+			return nil, errors.Wrap(err, "read reply")
+		}
+		replies[index] = reply
+	}
+	return replies, nil
+}
+
+func (c *conn) writeCommand(args []interface{}) error {
+	c.writer.WriteString("*" + strconv.Itoa(len(args)) + "\r\n")
+	for _, arg := range args {
+		var bytes []byte
+		switch v := arg.(type) {
+		case string:
+			bytes = []byte(v)
+		case []byte:
+			bytes = v
+		case int:
+			bytes = []byte(strconv.Itoa(v))
+		case int64:
+			bytes = []byte(strconv.FormatInt(v, 10))
+		default:
+			bytes = []byte(fmt.Sprint(v))
+		}
+		c.writer.WriteString("$" + strconv.Itoa(len(bytes)) + "\r\n")
+		c.writer.Write(bytes)
+		if _, err := c.writer.WriteString("\r\n"); nil != err {
+			return errors.Wrap(err, "write argument")
+		}
+	}
+	return nil
+}
+
+func (c *conn) readLine() ([]byte, error) {
+	line, err := c.reader.ReadSlice('\n')
+	if nil != err {
+		return nil, errors.Wrap(err, "read line")
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, errors.Errorf("malformed reply line %q", line)
+	}
+	return line[:len(line)-2], nil
+}
+
+func (c *conn) readReply() (interface{}, error) {
+	line, err := c.readLine()
+	if nil != err {
+		return nil, err
+	}
+
+	switch line[0] {
+	case '+':
+		return string(line[1:]), nil
+	case '-':
+		return redisError(line[1:]), nil
+	case ':':
+		n, err := strconv.ParseInt(string(line[1:]), 10, 64)
+		return n, errors.Wrap(err, "parse integer reply")
+	case '$':
+		n, err := strconv.Atoi(string(line[1:]))
+		if nil != err || n < 0 {
+			return nil, errors.Wrap(err, "parse bulk reply")
+		}
+		bytes := make([]byte, n+2)
+		if _, err = io.ReadFull(c.reader, bytes); nil != err {
+			return nil, errors.Wrap(err, "read bulk reply")
+		}
+		return bytes[:n], nil
+	case '*':
+		n, err := strconv.Atoi(string(line[1:]))
+		if nil != err || n < 0 {
+			return nil, errors.Wrap(err, "parse array reply")
+		}
+		replies := make([]interface{}, n)
+		for index := range replies {
+			if replies[index], err = c.readReply(); nil != err {
+				return nil, err
+			}
+		}
+		return replies, nil
+	}
+	return nil, errors.Errorf("unknown reply type %q", line[0])
+}
+
+func (c *conn) close() error {
+	return errors.Wrap(c.netConn.Close(), "close connection")
+}
+
+// replyError returns the error of the reply, if any.
+func replyError(reply interface{}) error {
+	if err, ok := reply.(redisError); ok {
+		return err
+	}
+	return nil
+}
+
+func replyString(reply interface{}) string {
+	switch v := reply.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	}
+	return ""
+}
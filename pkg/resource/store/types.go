@@ -2,7 +2,9 @@ package store
 
 import (
 	"context"
+	"io"
 
+	"github.com/pkg/errors"
 	logf "github.com/tkeel-io/core/pkg/logfield"
 
 	"github.com/tkeel-io/core/pkg/resource"
@@ -17,6 +19,9 @@ type StateItem struct {
 	Metadata map[string]string
 }
 
+// EtagNotExists is the etag of state not exists.
+const EtagNotExists = "0"
+
 type Store interface {
 	// GetState retrieves state from specific store using default consistency option.
 	Get(ctx context.Context, key string) (item *StateItem, err error)
@@ -27,6 +32,25 @@ type Store interface {
 	Flush(ctx context.Context) error
 }
 
+// EtagSetter is implemented by stores which save state with optimistic concurrency.
+type EtagSetter interface {
+	// SetWithEtag saves the data if the etag of the state matches and returns the new etag,
+	// an empty etag saves unconditionally, EtagNotExists expects the state not exists.
+	// errors.ErrEtagMismatch is returned if the etag mismatches.
+	SetWithEtag(ctx context.Context, key string, data []byte, etag string) (string, error)
+}
+
+// Snapshotter is implemented by stores which take consistent point-in-time copies of state.
+type Snapshotter interface {
+	Snapshot(ctx context.Context, w io.Writer) error
+}
+
+// Scanner is implemented by stores which enumerate state items, items written while scanning may be missed.
+type Scanner interface {
+	// Scan calls the handler with keys and values of items whose keys start with the prefix, stops on errors of the handler.
+	Scan(ctx context.Context, prefix string, handler func(key string, value []byte) error) error
+}
+
 var registeredStores = make(map[string]Generator)
 
 type Generator func(map[string]interface{}) (Store, error) //
@@ -35,16 +59,20 @@ func Register(name string, handler Generator) {
 	registeredStores[name] = handler
 }
 
-func NewStore(metadata resource.Metadata) Store {
-	var err error
-	var storeClient Store
-	if generator, has := registeredStores[metadata.Name]; has {
-		if storeClient, err = generator(metadata.Properties); nil == err {
-			return storeClient
-		}
+// NewStore creates the store of the configured driver, a driver failing to start is an error,
+// state never falls back to memory silently.
+func NewStore(metadata resource.Metadata) (Store, error) {
+	generator, has := registeredStores[metadata.Name]
+	if !has {
+		log.L().Error("new Store instance, driver not registered", logf.String("name", metadata.Name))
+		return nil, errors.Errorf("store driver %q not registered", metadata.Name)
+	}
+
+	storeClient, err := generator(metadata.Properties)
+	if nil != err {
 		log.L().Error("new Store instance", logf.Error(err),
 			logf.String("name", metadata.Name), logf.Any("properties", metadata.Properties))
+		return nil, errors.Wrapf(err, "new store %s", metadata.Name)
 	}
-	storeClient, _ = registeredStores["memory"](metadata.Properties)
-	return storeClient
+	return storeClient, nil
 }
@@ -36,6 +36,11 @@ func (r *TimeSeriesEntry) Write(ctx context.Context, req *tseries.TSeriesRequest
 	return &tseries.TSeriesResponse{}, r.ts.Send(ctx, req)
 }
 
+// Flush writes pending batches of the transport.
+func (r *TimeSeriesEntry) Flush(ctx context.Context) error {
+	return r.ts.Flush(ctx)
+}
+
 func NewTimeSeriesEntry() tseries.TimeSerier {
 	return &TimeSeriesEntry{}
 }
@@ -17,6 +17,7 @@ import (
 	logf "github.com/tkeel-io/core/pkg/logfield"
 	"github.com/tkeel-io/core/pkg/resource"
 	"github.com/tkeel-io/core/pkg/resource/tseries"
+	"github.com/tkeel-io/core/pkg/tenant"
 
 	"github.com/pkg/errors"
 	"github.com/tkeel-io/kit/log"
@@ -133,9 +134,12 @@ func (c *Clickhouse) BuildBulkData(req interface{}) (interface{}, error) {
 			var builder strings.Builder
 			builder.WriteString("id=")
 			builder.WriteString(entityID)
-			tagID := builder.String()
+			tags := []string{builder.String()}
+			if tenantID, has := item.Tags[tenant.TagKey]; has {
+				tags = append(tags, tenant.Tag(tenantID))
+			}
 			for k, v := range item.Fields {
-				*args = append(*args, []interface{}{timeMilli, k, []string{tagID}, v, timestamp})
+				*args = append(*args, []interface{}{timeMilli, k, tags, v, timestamp})
 			}
 		}
 	}
@@ -157,6 +161,9 @@ func (c *Clickhouse) Query(ctx context.Context, req *pb.GetTSDataRequest) (*pb.G
 	tag := fmt.Sprintf(`'id=%s'`, req.GetId())
 	querySQL := fmt.Sprintf(ClickHouseQuery, c.cfg.Database, c.cfg.Table, tag)
 	querySQL += fmt.Sprintf(" `timestamp` > FROM_UNIXTIME(%d) AND `timestamp` < FROM_UNIXTIME(%d)", req.StartTime, req.EndTime)
+	if tenantID := tenant.FromContext(ctx); tenantID != "" {
+		querySQL += fmt.Sprintf(" AND has(tags, '%s')", tenant.Tag(tenantID))
+	}
 	identifiers := strings.Split(req.Identifiers, ",")
 	respData := make(map[time.Time]map[string]float32)
 	for _, identifier := range identifiers {
@@ -213,6 +220,14 @@ func (c *Clickhouse) Query(ctx context.Context, req *pb.GetTSDataRequest) (*pb.G
 	return resp, nil
 }
 
+// Ping checks the connection to clickhouse.
+func (c *Clickhouse) Ping(ctx context.Context) error {
+	if nil == c.conn {
+		return errors.New("clickhouse not connected")
+	}
+	return errors.Wrap(c.conn.PingContext(ctx), "ping clickhouse")
+}
+
 func (c *Clickhouse) GetMetrics() (count, storage float64) {
 	metricsSQL := fmt.Sprintf(`SELECT 
     	sum(rows) AS count,
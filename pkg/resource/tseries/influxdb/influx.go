@@ -12,11 +12,13 @@ import (
 
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
 	"github.com/influxdata/influxdb-client-go/v2/api"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/pkg/errors"
 	pb "github.com/tkeel-io/core/api/core/v1"
 	"github.com/tkeel-io/core/pkg/resource"
 	"github.com/tkeel-io/core/pkg/resource/tseries"
+	"github.com/tkeel-io/core/pkg/tenant"
 	"github.com/tkeel-io/kit/log"
 )
 
@@ -163,11 +165,18 @@ func (i *Influx) Query(ctx context.Context, req *pb.GetTSDataRequest) (*pb.GetTS
 	from(bucket: "%s")
     |> range(start: %d, stop: %d)
     |> filter(fn: (r) => r["_measurement"] == "%s")
-    |> filter(fn: (r) => r["id"] == "%s")
+    |> filter(fn: (r) => r["id"] == "%s")%s
     |> limit(n: %d, offset: %d)
 	`
 
-	querySS := fmt.Sprintf(queryString, bucket, startTime, endTime, measurement, entityID, pageSize, offset)
+	// restrict to the tenant.
+	tenantFilter := ""
+	if tenantID := tenant.FromContext(ctx); tenantID != "" {
+		tenantFilter = fmt.Sprintf(`
+    |> filter(fn: (r) => r["%s"] == "%s")`, tenant.TagKey, tenantID)
+	}
+
+	querySS := fmt.Sprintf(queryString, bucket, startTime, endTime, measurement, entityID, tenantFilter, pageSize, offset)
 	identifiers := strings.Split(req.Identifiers, ",")
 	identifiersItems := make([]string, 0)
 	for _, identifier := range identifiers {
@@ -224,6 +233,17 @@ func (i *Influx) Query(ctx context.Context, req *pb.GetTSDataRequest) (*pb.GetTS
 	return resp, nil
 }
 
+// Ping checks the health of influxdb.
+func (i *Influx) Ping(ctx context.Context) error {
+	health, err := i.client.Health(ctx)
+	if nil != err {
+		return errors.Wrap(err, "influxdb health")
+	} else if health.Status != domain.HealthCheckStatusPass {
+		return errors.Errorf("influxdb status %s", health.Status)
+	}
+	return nil
+}
+
 func (i *Influx) GetMetrics() (count, storage float64) {
 	return
 }
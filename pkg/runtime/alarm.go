@@ -0,0 +1,256 @@
+package runtime
+
+import (
+	"context"
+	"time"
+
+	daprSDK "github.com/dapr/go-sdk/client"
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/mapper"
+	"github.com/tkeel-io/core/pkg/mapper/expression"
+	"github.com/tkeel-io/core/pkg/metrics"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/util/dapr"
+	"github.com/tkeel-io/core/pkg/util/path"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+)
+
+// AlarmRuleInfo is an alarm rule prepared for evaluation.
+type AlarmRuleInfo struct {
+	repository.AlarmRule
+
+	// paths are property paths conditions depend on.
+	paths     []string
+	condition expression.IExpression
+	clear     expression.IExpression
+}
+
+func newAlarmRuleInfo(rule *repository.AlarmRule) (*AlarmRuleInfo, error) {
+	paths, err := expression.AlarmSources(rule)
+	if nil != err {
+		return nil, errors.Wrap(err, "parse alarm rule")
+	}
+
+	info := &AlarmRuleInfo{AlarmRule: *rule, paths: paths}
+	if info.condition, err = expression.NewExpr(rule.Condition, nil); nil != err {
+		return nil, errors.Wrap(err, "parse alarm condition")
+	}
+	if rule.ClearCondition != "" {
+		if info.clear, err = expression.NewExpr(rule.ClearCondition, nil); nil != err {
+			return nil, errors.Wrap(err, "parse alarm clear condition")
+		}
+	}
+	return info, nil
+}
+
+func (r *Runtime) AppendAlarmRule(rule *repository.AlarmRule) error {
+	info, err := newAlarmRuleInfo(rule)
+	if nil != err {
+		return errors.Wrap(err, "append alarm rule")
+	}
+
+	r.mlock.Lock()
+	defer r.mlock.Unlock()
+	if _, has := r.alarmRules[rule.EntityID]; !has {
+		r.alarmRules[rule.EntityID] = make(map[string]*AlarmRuleInfo)
+	}
+	r.alarmRules[rule.EntityID][rule.ID] = info
+	return nil
+}
+
+// RemoveAlarmRule remove the alarm rule, and release its state and history.
+func (r *Runtime) RemoveAlarmRule(rule *repository.AlarmRule) {
+	r.mlock.Lock()
+	if rules, has := r.alarmRules[rule.EntityID]; has {
+		delete(rules, rule.ID)
+		if len(rules) == 0 {
+			delete(r.alarmRules, rule.EntityID)
+		}
+	}
+	delete(r.alarmStates, alarmKey(rule))
+	r.mlock.Unlock()
+
+	if nil != r.repository {
+		if err := r.repository.DelAlarm(context.TODO(), rule.Owner, rule.EntityID, rule.ID); nil != err {
+			log.L().Warn("remove alarm state", logf.ID(rule.ID), logf.Error(err))
+		}
+	}
+}
+
+// alarmKey identifies the alarm of the rule, rule ids are unique within the owner and the entity.
+func alarmKey(rule *repository.AlarmRule) string {
+	return rule.Owner + "/" + rule.EntityID + "/" + rule.ID
+}
+
+func (r *Runtime) entityAlarmRules(entityID string) []*AlarmRuleInfo {
+	r.mlock.RLock()
+	defer r.mlock.RUnlock()
+	rules := make([]*AlarmRuleInfo, 0, len(r.alarmRules[entityID]))
+	for _, rule := range r.alarmRules[entityID] {
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// handleAlarm evaluate alarm rules of the entity on changes.
+func (r *Runtime) handleAlarm(ctx context.Context, feed *Feed) *Feed {
+	if nil != feed.Err || len(feed.Changes) == 0 {
+		return feed
+	}
+
+	rules := r.entityAlarmRules(feed.EntityID)
+	if len(rules) == 0 {
+		return feed
+	}
+
+	state := tdtl.New(feed.State)
+	for _, rule := range rules {
+		if !alarmAffected(rule, feed) {
+			continue
+		}
+
+		in := make(map[string]tdtl.Node)
+		for _, item := range rule.paths {
+			in[item] = state.Get(mapper.NewWatchKey(item).PropertyKey)
+		}
+
+		active, err := r.alarmActive(ctx, rule)
+		if nil != err {
+			log.L().Error("load alarm state", logf.ID(rule.ID),
+				logf.Eid(feed.EntityID), logf.Error(err))
+			continue
+		}
+
+		switch {
+		case !active && r.evalCondition(ctx, rule, rule.condition, in):
+			r.transitAlarm(ctx, rule, repository.AlarmStatusActive, in)
+		case active && nil == rule.clear && !r.evalCondition(ctx, rule, rule.condition, in):
+			r.transitAlarm(ctx, rule, repository.AlarmStatusCleared, in)
+		case active && nil != rule.clear && r.evalCondition(ctx, rule, rule.clear, in):
+			r.transitAlarm(ctx, rule, repository.AlarmStatusCleared, in)
+		}
+	}
+
+	return feed
+}
+
+func alarmAffected(rule *AlarmRuleInfo, feed *Feed) bool {
+	for _, change := range feed.Changes {
+		for _, item := range rule.paths {
+			if path.Overlap(feed.EntityID+"."+change.Path, item) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *Runtime) evalCondition(ctx context.Context, rule *AlarmRuleInfo, condition expression.IExpression, in map[string]tdtl.Node) bool {
+	out, err := r.evalWithBudget(ctx, condition, rule.Owner, in)
+	if nil != err {
+		log.L().Warn("eval alarm condition", logf.ID(rule.ID), logf.Input(in), logf.Error(err))
+		return false
+	}
+
+	ret, ok := out.(tdtl.BoolNode)
+	return ok && bool(ret)
+}
+
+// alarmActive returns whether the alarm of rule is active, the state is restored from state store once.
+func (r *Runtime) alarmActive(ctx context.Context, rule *AlarmRuleInfo) (bool, error) {
+	r.mlock.RLock()
+	active, has := r.alarmStates[alarmKey(&rule.AlarmRule)]
+	r.mlock.RUnlock()
+	if has || nil == r.repository {
+		return active, nil
+	}
+
+	alarm, err := r.repository.GetAlarm(ctx, rule.Owner, rule.EntityID, rule.ID)
+	if nil != err {
+		if !errors.Is(err, xerrors.ErrResourceNotFound) {
+			return false, errors.Wrap(err, "load alarm")
+		}
+		alarm = repository.NewAlarm(&rule.AlarmRule)
+	}
+
+	r.mlock.Lock()
+	r.alarmStates[alarmKey(&rule.AlarmRule)] = alarm.Active()
+	r.mlock.Unlock()
+	return alarm.Active(), nil
+}
+
+// transitAlarm persist the transition of alarm, record history and publish it.
+// the alarm is updated with compare-and-swap, so that concurrent acknowledgements are kept.
+func (r *Runtime) transitAlarm(ctx context.Context, rule *AlarmRuleInfo, status string, in map[string]tdtl.Node) {
+	r.mlock.Lock()
+	r.alarmStates[alarmKey(&rule.AlarmRule)] = status == repository.AlarmStatusActive
+	r.mlock.Unlock()
+
+	now := time.Now().UnixMilli()
+	transit := func(alarm *repository.Alarm) error {
+		alarm.Name, alarm.Severity = rule.Name, rule.Severity
+		alarm.Status, alarm.Value = status, alarmValue(in)
+		if status == repository.AlarmStatusActive {
+			alarm.ActiveTime, alarm.ClearTime = now, 0
+			alarm.Acked, alarm.AckedBy, alarm.AckTime = false, "", 0
+		} else {
+			alarm.ClearTime = now
+		}
+		return nil
+	}
+
+	alarm := repository.NewAlarm(&rule.AlarmRule)
+	transit(alarm)
+	if nil != r.repository {
+		updated, err := r.repository.UpdateAlarm(ctx, &rule.AlarmRule, transit)
+		if nil != err {
+			log.L().Error("persist alarm", logf.ID(rule.ID), logf.Owner(rule.Owner), logf.Error(err))
+		} else {
+			alarm = updated
+		}
+	}
+
+	log.L().Info("alarm transition", logf.ID(rule.ID), logf.Eid(rule.EntityID),
+		logf.Owner(rule.Owner), logf.Status(status), logf.Value(alarm.Value))
+	metrics.CollectorAlarmTransitions.WithLabelValues(rule.Owner, rule.Severity, status).Inc()
+
+	record := alarm.Record(status, "", now)
+	if nil != r.repository {
+		if err := r.repository.AppendAlarmHistory(ctx, record, config.Get().Alarm.HistoryLimit); nil != err {
+			log.L().Error("persist alarm history", logf.ID(rule.ID), logf.Error(err))
+		}
+	}
+
+	if err := PublishAlarm(ctx, record); nil != err {
+		log.L().Error("publish alarm", logf.ID(rule.ID), logf.Error(err))
+	}
+}
+
+func alarmValue(in map[string]tdtl.Node) string {
+	ret := tdtl.New(`{}`)
+	for key, val := range in {
+		ret.Set(mapper.NewWatchKey(key).PropertyKey, val)
+	}
+	return ret.String()
+}
+
+// PublishAlarm publish the alarm transition to the configured topic, publishing is disabled if topic empty.
+func PublishAlarm(ctx context.Context, record *repository.AlarmRecord) error {
+	cfg := config.Get().Alarm
+	if cfg.PubsubName == "" || cfg.Topic == "" {
+		return nil
+	}
+
+	bytes, err := json.Marshal(record)
+	if nil != err {
+		return errors.Wrap(err, "encode alarm record")
+	}
+
+	ctOpts := daprSDK.PublishEventWithContentType("application/json")
+	err = dapr.Get().Select().PublishEvent(ctx, cfg.PubsubName, cfg.Topic, bytes, ctOpts)
+	return errors.Wrap(err, "publish alarm via dapr")
+}
@@ -0,0 +1,133 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/runtime/mock"
+	tkeelJson "github.com/tkeel-io/core/pkg/util/json"
+	"github.com/tkeel-io/tdtl"
+)
+
+func TestRuntime_handleAlarm(t *testing.T) {
+	repo := mock.NewRepo()
+	rt := newSandboxRuntime(Sandbox{})
+	rt.repository = repo
+
+	rule := &repository.AlarmRule{
+		ID:             "alarm-1",
+		Owner:          "admin",
+		EntityID:       "dev-a",
+		Severity:       repository.AlarmSeverityMajor,
+		Condition:      "dev-a.properties.temp > 80",
+		ClearCondition: "dev-a.properties.temp < 60",
+	}
+	assert.Nil(t, rt.AppendAlarmRule(rule))
+
+	// reference other entity.
+	assert.ErrorIs(t, rt.AppendAlarmRule(&repository.AlarmRule{
+		ID: "alarm-2", EntityID: "dev-a", Severity: repository.AlarmSeverityMajor,
+		Condition: "dev-b.properties.temp > 80",
+	}), xerrors.ErrAlarmRuleInvalid)
+
+	feed := func(temp int) *Feed {
+		return &Feed{
+			EntityID: "dev-a",
+			State:    []byte(`{"properties":{"temp":` + tdtl.NewInt64(int64(temp)).String() + `}}`),
+			Changes: []Patch{{
+				Op:    tkeelJson.OpReplace,
+				Path:  "properties.temp",
+				Value: tdtl.NewInt64(int64(temp)),
+			}},
+		}
+	}
+
+	steps := []struct {
+		temp   int
+		status string
+	}{
+		{70, ""},
+		{90, repository.AlarmStatusActive},
+		{70, repository.AlarmStatusActive},
+		{50, repository.AlarmStatusCleared},
+		{85, repository.AlarmStatusActive},
+	}
+	for _, step := range steps {
+		rt.handleAlarm(context.Background(), feed(step.temp))
+		alarm, err := repo.GetAlarm(context.Background(), rule.Owner, rule.EntityID, rule.ID)
+		if step.status == "" {
+			assert.ErrorIs(t, err, xerrors.ErrResourceNotFound)
+			continue
+		}
+		assert.Nil(t, err)
+		assert.Equal(t, step.status, alarm.Status)
+	}
+
+	records, err := repo.GetAlarmHistory(context.Background(), rule.Owner, rule.EntityID, rule.ID)
+	assert.Nil(t, err)
+	assert.Len(t, records, 3)
+	assert.Equal(t, `{"properties":{"temp":85}}`, records[2].Value)
+
+	// state restored after restart.
+	rt = newSandboxRuntime(Sandbox{})
+	rt.repository = repo
+	assert.Nil(t, rt.AppendAlarmRule(rule))
+	active, err := rt.alarmActive(context.Background(), rt.entityAlarmRules("dev-a")[0])
+	assert.Nil(t, err)
+	assert.True(t, active)
+
+	rt.RemoveAlarmRule(rule)
+	assert.Len(t, rt.entityAlarmRules("dev-a"), 0)
+	_, err = repo.GetAlarm(context.Background(), rule.Owner, rule.EntityID, rule.ID)
+	assert.ErrorIs(t, err, xerrors.ErrResourceNotFound)
+}
+
+func TestRuntime_transitAlarm(t *testing.T) {
+	repo := mock.NewRepo()
+	rt := newSandboxRuntime(Sandbox{})
+	rt.repository = repo
+
+	rule := &repository.AlarmRule{
+		ID:        "alarm-1",
+		Owner:     "admin",
+		EntityID:  "dev-a",
+		Severity:  repository.AlarmSeverityMajor,
+		Condition: "dev-a.properties.temp > 80",
+	}
+	assert.Nil(t, rt.AppendAlarmRule(rule))
+	info := rt.entityAlarmRules("dev-a")[0]
+	rt.transitAlarm(context.Background(), info, repository.AlarmStatusActive, map[string]tdtl.Node{})
+
+	// acknowledgement is kept by the transition of runtime.
+	_, err := repo.UpdateAlarm(context.Background(), rule, func(alarm *repository.Alarm) error {
+		alarm.Acked, alarm.AckedBy = true, "operator"
+		return nil
+	})
+	assert.Nil(t, err)
+	rt.transitAlarm(context.Background(), info, repository.AlarmStatusCleared, map[string]tdtl.Node{})
+
+	alarm, err := repo.GetAlarm(context.Background(), "admin", "dev-a", rule.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, repository.AlarmStatusCleared, alarm.Status)
+	assert.True(t, alarm.Acked)
+
+	// alarms of rules with the same id are isolated by owner.
+	_, err = repo.GetAlarm(context.Background(), "tenant1", "dev-a", rule.ID)
+	assert.ErrorIs(t, err, xerrors.ErrResourceNotFound)
+
+	// and by entity.
+	other := *rule
+	other.EntityID, other.Condition = "dev-b", "dev-b.properties.temp > 80"
+	assert.Nil(t, rt.AppendAlarmRule(&other))
+	rt.transitAlarm(context.Background(), rt.entityAlarmRules("dev-b")[0], repository.AlarmStatusActive, map[string]tdtl.Node{})
+	alarm, err = repo.GetAlarm(context.Background(), "admin", "dev-a", rule.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, repository.AlarmStatusCleared, alarm.Status)
+	alarm, err = repo.GetAlarm(context.Background(), "admin", "dev-b", rule.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, repository.AlarmStatusActive, alarm.Status)
+	assert.Equal(t, "dev-b", alarm.EntityID)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
 	logf "github.com/tkeel-io/core/pkg/logfield"
 	"github.com/tkeel-io/core/pkg/repository"
 	"github.com/tkeel-io/kit/log"
@@ -50,6 +51,11 @@ func (ec *eCache) Load(ctx context.Context, id string) (Entity, error) {
 		return nil, errors.Wrap(err, "create entity instance")
 	}
 
+	// entities in trash are invisible.
+	if deletedAt(en) > 0 {
+		return nil, xerrors.ErrEntityNotFound
+	}
+
 	// cache entity.
 	ec.entities[id] = en
 	return en, errors.Wrap(err, "load cache entity")
@@ -0,0 +1,212 @@
+package runtime
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/config"
+	"google.golang.org/protobuf/proto"
+)
+
+// dedupResult records a successful write with idempotency key, states of entities are not kept,
+// duplicates respond the current state of the entity.
+type dedupResult struct {
+	entityID string
+	key      string
+	// digest of the payload of the write, duplicates carry the same payload.
+	digest string
+	at     time.Time
+}
+
+// dedupEntry is a persisted dedupResult.
+type dedupEntry struct {
+	Key    string `json:"key"`
+	Digest string `json:"digest"`
+	// At is unix milliseconds.
+	At int64 `json:"at"`
+}
+
+// dedupWindow keeps successful writes with idempotency keys, so that duplicates of a write
+// are responded instead of re-executing, failed writes are retried.
+// results are persisted per entity, and loaded on the first write with idempotency key of the entity.
+type dedupWindow struct {
+	window   time.Duration
+	capacity int
+	results  map[string]*list.Element
+	order    *list.List
+	// results of entities.
+	entities map[string]map[string]*list.Element
+	// entities with results not persisted.
+	dirty map[string]bool
+	// entities of which persisted results are loaded.
+	loaded map[string]bool
+
+	lock sync.Mutex
+	now  func() time.Time
+}
+
+func newDedupWindow(cfg config.IdempotencyConfig) *dedupWindow {
+	return &dedupWindow{
+		window:   time.Duration(cfg.Window) * time.Second,
+		capacity: cfg.Capacity,
+		results:  make(map[string]*list.Element),
+		order:    list.New(),
+		entities: make(map[string]map[string]*list.Element),
+		dirty:    make(map[string]bool),
+		loaded:   make(map[string]bool),
+		now:      time.Now,
+	}
+}
+
+func dedupKey(entityID, idempotencyKey string) string {
+	return entityID + "/" + idempotencyKey
+}
+
+// payloadDigest returns the digest of the payload of the event.
+func payloadDigest(ev v1.Event) string {
+	var bytes []byte
+	if pe, ok := ev.(*v1.ProtoEvent); ok {
+		bytes, _ = proto.MarshalOptions{Deterministic: true}.Marshal(&v1.ProtoEvent{Data: pe.Data})
+	}
+	sum := sha256.Sum256(bytes)
+	return hex.EncodeToString(sum[:])
+}
+
+// Enabled reports whether results are kept.
+func (w *dedupWindow) Enabled() bool {
+	return w.window > 0 && w.capacity > 0
+}
+
+// Get returns the result of the write of the entity with the idempotency key.
+func (w *dedupWindow) Get(entityID, idempotencyKey string) (*dedupResult, bool) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.evict()
+	elem, ok := w.results[dedupKey(entityID, idempotencyKey)]
+	if !ok {
+		return nil, false
+	}
+
+	res, _ := elem.Value.(*dedupResult)
+	return res, true
+}
+
+// Put keeps the successful write of the entity with the idempotency key.
+func (w *dedupWindow) Put(entityID, idempotencyKey, digest string) {
+	if !w.Enabled() {
+		return
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.put(&dedupResult{entityID: entityID, key: idempotencyKey, digest: digest, at: w.now()})
+	w.dirty[entityID] = true
+	w.evict()
+}
+
+func (w *dedupWindow) put(res *dedupResult) {
+	key := dedupKey(res.entityID, res.key)
+	if elem, ok := w.results[key]; ok {
+		w.remove(elem)
+	}
+
+	elem := w.order.PushBack(res)
+	w.results[key] = elem
+	if _, ok := w.entities[res.entityID]; !ok {
+		w.entities[res.entityID] = make(map[string]*list.Element)
+	}
+	w.entities[res.entityID][res.key] = elem
+
+	for w.order.Len() > w.capacity {
+		w.remove(w.order.Front())
+	}
+}
+
+// Loaded reports whether persisted results of the entity are loaded.
+func (w *dedupWindow) Loaded(entityID string) bool {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return !w.Enabled() || w.loaded[entityID]
+}
+
+// Load keeps persisted results of the entity in the window, results kept already are not replaced.
+func (w *dedupWindow) Load(entityID string, data []byte) error {
+	var entries []dedupEntry
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &entries); nil != err {
+			return errors.Wrap(err, "decode dedup results")
+		}
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.loaded[entityID] = true
+	expired := w.now().Add(-w.window)
+	for _, entry := range entries {
+		at := time.UnixMilli(entry.At)
+		if _, ok := w.results[dedupKey(entityID, entry.Key)]; !ok && at.After(expired) {
+			w.put(&dedupResult{entityID: entityID, key: entry.Key, digest: entry.Digest, at: at})
+		}
+	}
+	return nil
+}
+
+// Encode returns results of the entity to persist, returns false if results of the entity are persisted.
+func (w *dedupWindow) Encode(entityID string) ([]byte, bool, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if !w.dirty[entityID] {
+		return nil, false, nil
+	}
+
+	w.evict()
+	entries := make([]dedupEntry, 0, len(w.entities[entityID]))
+	for _, elem := range w.entities[entityID] {
+		res, _ := elem.Value.(*dedupResult)
+		entries = append(entries, dedupEntry{Key: res.key, Digest: res.digest, At: res.at.UnixMilli()})
+	}
+
+	bytes, err := json.Marshal(entries)
+	if nil != err {
+		return nil, false, errors.Wrap(err, "encode dedup results")
+	}
+	return bytes, true, nil
+}
+
+// Persisted marks results of the entity persisted.
+func (w *dedupWindow) Persisted(entityID string) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	delete(w.dirty, entityID)
+}
+
+// evict removes results out of the window.
+func (w *dedupWindow) evict() {
+	expired := w.now().Add(-w.window)
+	for elem := w.order.Front(); elem != nil; elem = w.order.Front() {
+		if res, _ := elem.Value.(*dedupResult); res.at.After(expired) {
+			return
+		}
+		w.remove(elem)
+	}
+}
+
+func (w *dedupWindow) remove(elem *list.Element) {
+	res, _ := w.order.Remove(elem).(*dedupResult)
+	delete(w.results, dedupKey(res.entityID, res.key))
+	if results := w.entities[res.entityID]; nil != results {
+		delete(results, res.key)
+		if len(results) == 0 {
+			delete(w.entities, res.entityID)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/runtime/mock"
+	"github.com/tkeel-io/tdtl"
+)
+
+type callbackRecorder struct {
+	dispatcherMock
+	events []v1.Event
+}
+
+func (d *callbackRecorder) Dispatch(_ context.Context, ev v1.Event) error {
+	d.events = append(d.events, ev)
+	return nil
+}
+
+func TestDedupWindow(t *testing.T) {
+	now := time.Unix(100, 0)
+	w := newDedupWindow(config.IdempotencyConfig{Window: 60, Capacity: 2})
+	w.now = func() time.Time { return now }
+
+	w.Put("device1", "key1", "digest1")
+	res, ok := w.Get("device1", "key1")
+	assert.True(t, ok)
+	assert.Equal(t, "digest1", res.digest)
+
+	// keyed by entity and key.
+	_, ok = w.Get("device2", "key1")
+	assert.False(t, ok)
+
+	// persisted and loaded per entity.
+	data, dirty, err := w.Encode("device1")
+	assert.Nil(t, err)
+	assert.True(t, dirty)
+	w.Persisted("device1")
+	_, dirty, _ = w.Encode("device1")
+	assert.False(t, dirty)
+
+	loaded := newDedupWindow(config.IdempotencyConfig{Window: 60, Capacity: 2})
+	loaded.now = w.now
+	assert.False(t, loaded.Loaded("device1"))
+	assert.Nil(t, loaded.Load("device1", data))
+	assert.True(t, loaded.Loaded("device1"))
+	res, ok = loaded.Get("device1", "key1")
+	assert.True(t, ok)
+	assert.Equal(t, "digest1", res.digest)
+	// states are not persisted.
+	assert.NotContains(t, string(data), "state")
+
+	// the oldest evicted over capacity.
+	w.Put("device2", "key1", "digest2")
+	w.Put("device3", "key1", "digest3")
+	_, ok = w.Get("device1", "key1")
+	assert.False(t, ok)
+
+	// evicted out of window.
+	now = now.Add(time.Minute)
+	_, ok = w.Get("device3", "key1")
+	assert.False(t, ok)
+	loaded = newDedupWindow(config.IdempotencyConfig{Window: 60, Capacity: 2})
+	loaded.now = w.now
+	assert.Nil(t, loaded.Load("device1", data))
+	_, ok = loaded.Get("device1", "key1")
+	assert.False(t, ok)
+}
+
+func dedupEvent(reqID, key string, patches ...*v1.PatchData) *v1.ProtoEvent {
+	ev := txEvent(reqID, "", "", patches...)
+	ev.SetAttr(v1.MetaIdempotencyKey, key)
+	return ev
+}
+
+func TestRuntime_HandleEventDuplicate(t *testing.T) {
+	dispatcher := &callbackRecorder{}
+	en, err := NewEntity("device1", []byte(`{"id":"device1","properties":{"temp":25}}`))
+	assert.Nil(t, err)
+	rt := &Runtime{
+		dispatcher: dispatcher,
+		entities:   map[string]Entity{"device1": en},
+		dedup:      newDedupWindow(config.IdempotencyConfig{Window: 60, Capacity: 10}),
+	}
+	ev := dedupEvent("req2", "key1", &v1.PatchData{Path: "properties.temp", Operator: "replace", Value: []byte("20")})
+	rt.dedup.Put("device1", "key1", payloadDigest(ev))
+
+	// the duplicate is not executed, and responds the current state.
+	assert.Nil(t, rt.HandleEvent(context.Background(), ev))
+	assert.Len(t, dispatcher.events, 1)
+	resp, _ := dispatcher.events[0].(*v1.ProtoEvent)
+	assert.Equal(t, "req2", resp.Attr(v1.MetaRequestID))
+	assert.Equal(t, `25`, tdtl.New(resp.GetRawData()).Get("properties.temp").String())
+
+	// the key reused with another payload is rejected.
+	ev = dedupEvent("req3", "key1", &v1.PatchData{Path: "properties.temp", Operator: "replace", Value: []byte("30")})
+	assert.Nil(t, rt.HandleEvent(context.Background(), ev))
+	assert.Len(t, dispatcher.events, 2)
+	assert.Equal(t, xerrors.ErrIdempotencyKeyReused.Error(), dispatcher.events[1].Attr(v1.MetaResponseErrCode))
+}
+
+func TestRuntime_HandleEventDuplicatePersisted(t *testing.T) {
+	ctx := context.Background()
+	repo := mock.NewRepo()
+	noop := func(context.Context, Entity, *Feed) error { return nil }
+	newRuntime := func(dispatcher *callbackRecorder) *Runtime {
+		rt := NewRuntime(ctx, EntityResource{StoreHandler: noop, PersistentEntity: noop, FlushHandler: noop, RemoveHandler: noop},
+			"core/1234", dispatcher, repo)
+		rt.dedup = newDedupWindow(config.IdempotencyConfig{Window: 60, Capacity: 10})
+		en, err := NewEntity("device1", []byte(`{"id":"device1","properties":{"temp":20}}`))
+		assert.Nil(t, err)
+		rt.entities["device1"] = en
+		return rt
+	}
+
+	patch := &v1.PatchData{Path: "properties.temp", Operator: "replace", Value: []byte("30")}
+	rt := newRuntime(&callbackRecorder{})
+	assert.Nil(t, rt.HandleEvent(ctx, dedupEvent("req1", "key1", patch)))
+	assert.Equal(t, `30`, rt.entities["device1"].Get("properties.temp").String())
+
+	// duplicates are detected after restarts.
+	dispatcher := &callbackRecorder{}
+	rt = newRuntime(dispatcher)
+	assert.Nil(t, rt.HandleEvent(ctx, dedupEvent("req2", "key1", patch)))
+	assert.Equal(t, `20`, rt.entities["device1"].Get("properties.temp").String())
+	assert.Len(t, dispatcher.events, 1)
+	assert.Equal(t, "", dispatcher.events[0].Attr(v1.MetaResponseErrCode))
+
+	// failed writes are not kept, and retried by duplicates.
+	ev := dedupEvent("req3", "key2", patch)
+	ev.SetEntity("device2")
+	assert.Nil(t, rt.HandleEvent(ctx, ev))
+	assert.NotEqual(t, "", dispatcher.events[1].Attr(v1.MetaResponseErrCode))
+	_, ok := rt.dedup.Get("device2", "key2")
+	assert.False(t, ok)
+}
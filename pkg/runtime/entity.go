@@ -2,12 +2,14 @@ package runtime
 
 import (
 	"context"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/config"
 	xerrors "github.com/tkeel-io/core/pkg/errors"
 	logf "github.com/tkeel-io/core/pkg/logfield"
 	"github.com/tkeel-io/core/pkg/scheme"
@@ -26,11 +28,15 @@ const (
 	FieldVersion     string = "version"
 	FieldLastTime    string = "last_time"
 	FieldTemplate    string = "template_id"
+	FieldTemplateVer string = "template_version"
 	FieldScheme      string = "scheme"
 	FieldDescription string = "description"
 	FieldProperties  string = "properties"
 	FieldRawData     string = "properties.rawData"
 	FieldKeyWords    string = "search_model"
+	FieldDeletedAt   string = "deleted_at"
+	FieldSchema      string = "schema_id"
+	FieldSchemaVer   string = "schema_version"
 	// FieldEntitySource string = "entity_source".
 
 )
@@ -83,7 +89,37 @@ func (e *entity) Handle(ctx context.Context, feed *Feed) *Feed { //nolint
 		switch patch.Op {
 		case xjson.OpAdd:
 			cc.Append(patch.Path, patch.Value)
-		case xjson.OpCopy:
+		case xjson.OpTest:
+			// abort the whole patches if the precondition fails.
+			if !jsonEqual(cc.Get(patch.Path).Raw(), patch.Value.Raw()) {
+				log.L().Warn("patch test failed", logf.Eid(e.id), logf.Path(patch.Path),
+					logf.Value(patch.Value.String()), logf.Event(feed.Event))
+				feed.Err = xerrors.ErrPatchTestFailed
+				feed.Patches = []Patch{}
+				feed.State = e.Raw()
+				return feed
+			}
+		case xjson.OpCopy, xjson.OpMove:
+			// copy without from only clips the property in response.
+			if patch.Op == xjson.OpCopy && patch.From == "" {
+				break
+			}
+
+			value := cc.Get(patch.From)
+			if tdtl.Undefined == value.Type() || tdtl.Null == value.Type() {
+				log.L().Error("patch from property", logf.Eid(e.id), logf.String("from", patch.From),
+					logf.Any("patches", feed.Patches), logf.Event(feed.Event))
+				feed.Err = xerrors.ErrPatchFromInvalid
+				feed.Patches = []Patch{}
+				feed.State = e.Raw()
+				return feed
+			}
+
+			patch.Value = tdtl.New(value.Raw())
+			if patch.Op == xjson.OpMove {
+				cc.Del(patch.From)
+			}
+			cc.Set(patch.Path, patch.Value)
 		case xjson.OpMerge:
 			var err error
 			if patch.Value.Type() != tdtl.Null {
@@ -137,6 +173,7 @@ func (e *entity) Handle(ctx context.Context, feed *Feed) *Feed { //nolint
 		}
 
 		switch patch.Op {
+		case xjson.OpTest:
 		case xjson.OpMerge:
 			patch.Value.Foreach(func(key []byte, value *tdtl.Collect) {
 				changes = append(changes, Patch{
@@ -144,12 +181,47 @@ func (e *entity) Handle(ctx context.Context, feed *Feed) *Feed { //nolint
 					Path: strings.Join([]string{patch.Path, string(key)}, "."),
 				})
 			})
+		case xjson.OpMove:
+			changes = append(changes,
+				Patch{Op: xjson.OpRemove, Path: patch.From, Value: tdtl.New([]byte("null"))},
+				Patch{Op: xjson.OpReplace, Path: patch.Path, Value: patch.Value})
+		case xjson.OpCopy:
+			op := patch.Op
+			if patch.From != "" {
+				op = xjson.OpReplace
+			}
+			changes = append(changes,
+				Patch{Op: op, Path: patch.Path, Value: patch.Value})
 		default:
 			changes = append(changes,
 				Patch{Op: patch.Op, Path: patch.Path, Value: patch.Value})
 		}
 	}
 
+	// entities bound to a schema reject changes violating the schema.
+	if cc.Error() == nil && cc.Get(FieldSchema).String() != "" {
+		if err := validateSchema(cc, changes); nil != err {
+			log.L().Error("update entity, schema validation", logf.Eid(e.id), logf.Error(err),
+				logf.Any("patches", feed.Patches), logf.Event(feed.Event))
+			feed.Err = xerrors.ErrSchemaValidation
+			feed.Patches = []Patch{}
+			feed.State = e.Raw()
+			return feed
+		}
+	}
+
+	// reject changes growing the entity beyond the max size, shrinking changes are accepted.
+	if max := config.Get().State.MaxEntitySize; cc.Error() == nil && max > 0 {
+		if size := len(cc.Raw()); size > max && size > len(e.state.Raw()) {
+			log.L().Error("update entity, entity too large", logf.Eid(e.id),
+				logf.Any("size", size), logf.Any("max", max), logf.Event(feed.Event))
+			feed.Err = xerrors.ErrEntityTooLarge
+			feed.Patches = []Patch{}
+			feed.State = e.Raw()
+			return feed
+		}
+	}
+
 	if cc.Error() == nil {
 		e.state = *cc
 		e.Update()
@@ -166,6 +238,74 @@ func (e *entity) Handle(ctx context.Context, feed *Feed) *Feed { //nolint
 	return feed
 }
 
+// validateSchema validates properties touched by changes against the property configs of the scheme,
+// all configured properties are validated if the scheme or the binding changed.
+func validateSchema(cc *tdtl.Collect, changes []Patch) error {
+	configs := make(map[string]interface{})
+	if err := json.Unmarshal(cc.Get(FieldScheme).Raw(), &configs); nil != err {
+		return errors.Wrap(err, "decode scheme")
+	}
+
+	all := false
+	ids := make(map[string]struct{})
+	for _, change := range changes {
+		switch path := change.Path; {
+		case path == FieldProperties, path == FieldSchema, path == FieldSchemaVer,
+			path == FieldScheme, strings.HasPrefix(path, FieldScheme+"."):
+			all = true
+		case strings.HasPrefix(path, FieldProperties+"."):
+			id := strings.SplitN(strings.TrimPrefix(path, FieldProperties+"."), ".", 2)[0]
+			ids[strings.SplitN(id, "[", 2)[0]] = struct{}{}
+		}
+	}
+
+	if all {
+		for id := range configs {
+			ids[id] = struct{}{}
+		}
+	}
+
+	for id := range ids {
+		cfgRaw, ok := configs[id]
+		if !ok {
+			continue
+		}
+
+		cfg, err := scheme.ParseConfigFrom(cfgRaw)
+		if nil != err {
+			return errors.Wrapf(err, "parse config of property %s", id)
+		}
+
+		var val interface{}
+		cfg.ID = id
+		node := cc.Get(FieldProperties + "." + id)
+		if tdtl.Undefined != node.Type() && tdtl.Null != node.Type() {
+			if err = json.Unmarshal(node.Raw(), &val); nil != err {
+				return errors.Wrapf(err, "decode property %s", id)
+			}
+		}
+		if err = cfg.Validate(val); nil != err {
+			return errors.Wrap(err, "validate property")
+		}
+	}
+	return nil
+}
+
+// jsonEqual reports whether the json values are semantically equal.
+func jsonEqual(a, b []byte) bool {
+	var va, vb interface{}
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// deletedAt returns the unix milliseconds the entity was moved into trash, 0 if not deleted.
+func deletedAt(en Entity) int64 {
+	at, _ := strconv.ParseInt(en.Get(FieldDeletedAt).String(), 10, 64)
+	return at
+}
+
 func (e *entity) Raw() []byte {
 	return e.state.Copy().Raw()
 }
@@ -22,6 +22,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
 	xjson "github.com/tkeel-io/core/pkg/util/json"
 	"github.com/tkeel-io/tdtl"
 )
@@ -130,6 +132,44 @@ func TestEntity_Handle(t *testing.T) {
 	}
 }
 
+func TestEntity_HandleRFC6902(t *testing.T) {
+	ctx := context.Background()
+	en, err := NewEntity("en-123", []byte(`{"properties": {"temp": 20, "metrics": {"cpu": 0.5}}}`))
+	assert.Nil(t, err)
+
+	feed := en.Handle(ctx, &Feed{
+		Event: &v1.ProtoEvent{Metadata: map[string]string{}},
+		Patches: []Patch{
+			{Op: xjson.OpTest, Path: "properties.temp", Value: tdtl.New("20")},
+			{Op: xjson.OpCopy, Path: "properties.temp_bak", From: "properties.temp"},
+			{Op: xjson.OpMove, Path: "properties.cpu", From: "properties.metrics.cpu"},
+		},
+	})
+	assert.Nil(t, feed.Err)
+	cc := tdtl.New(feed.State)
+	assert.JSONEq(t, `{"temp":20,"temp_bak":20,"cpu":0.5,"metrics":{}}`, cc.Get("properties").String())
+	assert.Len(t, feed.Changes, 3)
+	assert.Equal(t, xjson.OpRemove, feed.Changes[1].Op)
+	assert.Equal(t, "properties.metrics.cpu", feed.Changes[1].Path)
+
+	// failed test aborts the whole patches.
+	feed = en.Handle(ctx, &Feed{
+		Event: &v1.ProtoEvent{Metadata: map[string]string{}},
+		Patches: []Patch{
+			{Op: xjson.OpReplace, Path: "properties.temp", Value: tdtl.New("30")},
+			{Op: xjson.OpTest, Path: "properties.metrics", Value: tdtl.New(`{"cpu": 0.5}`)},
+		},
+	})
+	assert.ErrorIs(t, feed.Err, xerrors.ErrPatchTestFailed)
+	assert.Equal(t, "20", en.Get("properties.temp").String())
+
+	feed = en.Handle(ctx, &Feed{
+		Event:   &v1.ProtoEvent{Metadata: map[string]string{}},
+		Patches: []Patch{{Op: xjson.OpMove, Path: "properties.mem", From: "properties.mem_used"}},
+	})
+	assert.ErrorIs(t, feed.Err, xerrors.ErrPatchFromInvalid)
+}
+
 func TestMerge(t *testing.T) {
 	cc := tdtl.New("{}")
 	cc.Merge(tdtl.New([]byte(`{"sss":{"id":"sss","type":"struct","name":"","weight":0,"enabled":true,"enabled_search":true,"enabled_time_series":false,"description":"","define":{"fields":{"aaa":{"id":"aaa","type":"struct","name":"","weight":0,"enabled":true,"enabled_search":true,"enabled_time_series":false,"description":"","define":{"fields":{}},"last_time":0}}},"last_time":0}}`)))
@@ -143,3 +183,54 @@ func TestAdd(t *testing.T) {
 	var v interface{}
 	json.Unmarshal(cc.Raw(), &v)
 }
+
+func TestEntity_Schema(t *testing.T) {
+	ctx := context.Background()
+	en, err := NewEntity("en-123", []byte(`{"schema_id":"sensor","schema_version":1,"last_time":1650000000000,
+		"scheme":{"telemetry":{"type":"struct","define":{"fields":{
+			"temp":{"type":"float","enabled_time_series":true,"define":{"max":100}},
+			"online":{"type":"bool","enabled_time_series":true},
+			"name":{"type":"string","enabled_time_series":true}}}}},
+		"properties":{"telemetry":{"temp":20}}}`))
+	assert.Nil(t, err)
+
+	feed := en.Handle(ctx, &Feed{
+		Event:   &v1.ProtoEvent{Metadata: map[string]string{}},
+		Patches: []Patch{{Op: xjson.OpReplace, Path: "properties.telemetry.temp", Value: tdtl.New("30")}},
+	})
+	assert.Nil(t, feed.Err)
+
+	data := makeSchemaTimeSeries(en, feed, map[string]string{"id": en.ID()},
+		config.SchemaConfig{Measurement: "core", TelemetryPrefix: "telemetry."})
+	assert.Len(t, data, 1)
+	assert.Equal(t, "core", data[0].Measurement)
+	assert.Equal(t, float32(30), data[0].Fields["temp"])
+	assert.Equal(t, en.LastTime()*1e6, data[0].Timestamp)
+
+	// violating patches are rejected as a whole.
+	feed = en.Handle(ctx, &Feed{
+		Event: &v1.ProtoEvent{Metadata: map[string]string{}},
+		Patches: []Patch{
+			{Op: xjson.OpReplace, Path: "properties.telemetry.name", Value: tdtl.New(`"sensor"`)},
+			{Op: xjson.OpReplace, Path: "properties.telemetry.temp", Value: tdtl.New("120")},
+		},
+	})
+	assert.ErrorIs(t, feed.Err, xerrors.ErrSchemaValidation)
+	assert.Equal(t, "30", en.Get("properties.telemetry.temp").String())
+
+	feed = en.Handle(ctx, &Feed{
+		Event:   &v1.ProtoEvent{Metadata: map[string]string{}},
+		Patches: []Patch{{Op: xjson.OpReplace, Path: "properties.telemetry.online", Value: tdtl.New(`"yes"`)}},
+	})
+	assert.ErrorIs(t, feed.Err, xerrors.ErrSchemaValidation)
+
+	// properties not declared by the schema are not validated.
+	feed = en.Handle(ctx, &Feed{
+		Event: &v1.ProtoEvent{Metadata: map[string]string{}},
+		Patches: []Patch{
+			{Op: xjson.OpReplace, Path: "properties.location", Value: tdtl.New(`"room"`)},
+			{Op: xjson.OpReplace, Path: "properties.telemetry.name", Value: tdtl.New(`"sensor"`)},
+		},
+	})
+	assert.Nil(t, feed.Err)
+}
@@ -6,9 +6,10 @@ import (
 	"github.com/tkeel-io/core/pkg/config"
 	"github.com/tkeel-io/core/pkg/repository"
 	"github.com/tkeel-io/core/pkg/repository/dao"
+	_ "github.com/tkeel-io/core/pkg/resource/store/memory"
 )
 
 func NewRepo() repository.IRepository {
-	daoIns, _ := dao.NewMock(context.Background(), config.Metadata{}, config.EtcdConfig{})
+	daoIns, _ := dao.NewMock(context.Background(), config.Metadata{Name: "memory"}, config.EtcdConfig{})
 	return repository.New(daoIns)
 }
@@ -23,6 +23,16 @@ import (
 	"github.com/tkeel-io/core/pkg/util"
 	xkafka "github.com/tkeel-io/core/pkg/util/kafka"
 	"github.com/tkeel-io/kit/log"
+	"go.uber.org/atomic"
+)
+
+// phases of the node, the node is ready only when running.
+const (
+	NodePhaseStarting      = "starting"
+	NodePhaseBootstrapping = "bootstrapping"
+	NodePhaseRunning       = "running"
+	NodePhaseDraining      = "draining"
+	NodePhaseStopped       = "stopped"
 )
 
 type NodeConf struct {
@@ -39,6 +49,7 @@ type Node struct {
 	ctx             context.Context
 	cancel          context.CancelFunc
 	searchModel     []string
+	phase           *atomic.String
 }
 
 func NewNode(ctx context.Context, resourceManager types.ResourceManager, dispatcher dispatch.Dispatcher, searchModel []string) *Node {
@@ -52,6 +63,7 @@ func NewNode(ctx context.Context, resourceManager types.ResourceManager, dispatc
 		runtimes:        make(map[string]*Runtime),
 		queues:          make(map[string]*xkafka.Pubsub),
 		searchModel:     searchModel,
+		phase:           atomic.NewString(NodePhaseStarting),
 	}
 }
 
@@ -75,7 +87,8 @@ func (n *Node) Start(cfg NodeConf) error {
 		// create runtime instance.
 		log.L().Info("create runtime instance",
 			logf.ID(runtimeID), logf.Source(cfg.Sources[index]))
-		entityResouce := EntityResource{PersistentEntity: n.PersistentEntity, FlushHandler: n.FlushEntity, RemoveHandler: n.RemoveEntity}
+		entityResouce := EntityResource{StoreHandler: n.StoreEntity, PersistentEntity: n.PersistentEntity,
+			FlushHandler: n.FlushEntity, RemoveHandler: n.RemoveEntity, PurgeHandler: n.PurgeEntity}
 		runtime := NewRuntime(n.ctx, entityResouce, runtimeID, n.dispatch, n.resourceManager.Repo())
 		n.runtimes[runtimeID] = runtime
 		placement.Global().Append(placement.Info{ID: sourceIns.ID(), Flag: true})
@@ -83,6 +96,7 @@ func (n *Node) Start(cfg NodeConf) error {
 
 	// 2. list resource
 	var elapsed util.ElapsedTime
+	n.phase.Store(NodePhaseBootstrapping)
 	n.listMetadata()
 
 	// 3. watch resource
@@ -95,6 +109,7 @@ func (n *Node) Start(cfg NodeConf) error {
 		}
 	}
 	// watch metadata.
+	n.phase.Store(NodePhaseRunning)
 	log.L().Debug("start node completed", logf.Elapsedms(elapsed.ElapsedMilli()))
 	//
 	//for index := range cfg.Sources {
@@ -146,10 +161,102 @@ func (n *Node) HandleMessage(ctx context.Context, msg *sarama.ConsumerMessage) e
 
 	// load runtime spec.
 	rt := n.runtimes[rid]
-	rt.DeliveredEvent(context.Background(), msg)
+	return errors.Wrap(rt.DeliveredEvent(ctx, msg), "handle message")
+}
+
+// HandleRebalance writes dirty entities of the runtime before its partitions are rebalanced.
+func (n *Node) HandleRebalance(ctx context.Context, topic string) error {
+	rt, has := n.runtimes[topic]
+	if !has {
+		return nil
+	}
+
+	log.L().Info("flush dirty entities before rebalance", logf.ID(topic))
+	if err := rt.FlushDirty(ctx); nil != err {
+		return errors.Wrap(err, "handle rebalance")
+	}
+	return errors.Wrap(n.flushResources(ctx), "handle rebalance")
+}
+
+// Stop shuts down the node in order:
+// 1. stop consuming sources, runtimes are drained and flushed before offsets are committed.
+// 2. stop runtimes, events queued after draining are handled.
+// 3. flush batched writes of resources.
+func (n *Node) Stop(ctx context.Context) error {
+	var elapsed util.ElapsedTime
+	log.L().Info("stop node...")
+	n.phase.Store(NodePhaseDraining)
+	defer n.cancel()
+
+	// 1. stop consuming sources.
+	for id, queue := range n.queues {
+		closed := make(chan error, 1)
+		go func(queue *xkafka.Pubsub) {
+			closed <- queue.Close()
+		}(queue)
+
+		select {
+		case err := <-closed:
+			if nil != err {
+				log.L().Error("close source", logf.ID(id), logf.Error(err))
+			}
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "close source")
+		}
+	}
+
+	// 2. stop runtimes.
+	for id, rt := range n.runtimes {
+		if err := rt.Stop(ctx); nil != err {
+			log.L().Error("stop runtime", logf.ID(id), logf.Error(err))
+			return errors.Wrap(err, "stop runtime")
+		}
+	}
+
+	// 3. flush resources.
+	if err := n.flushResources(ctx); nil != err {
+		return errors.Wrap(err, "stop node")
+	}
+
+	n.phase.Store(NodePhaseStopped)
+	log.L().Info("node stopped", logf.Elapsedms(elapsed.ElapsedMilli()))
 	return nil
 }
 
+// Ready reports whether the node is running, with the phase of the node.
+func (n *Node) Ready() (bool, string) {
+	phase := n.phase.Load()
+	return phase == NodePhaseRunning, phase
+}
+
+// Checks returns health checks of kafka sources of the node by name.
+func (n *Node) Checks() map[string]func(context.Context) error {
+	checks := make(map[string]func(context.Context) error)
+	for id, queue := range n.queues {
+		checks["kafka.source."+id] = queue.Check
+	}
+	return checks
+}
+
+// flushResources writes batched time series, rawdata and states.
+func (n *Node) flushResources(ctx context.Context) error {
+	resources := map[string]interface{}{
+		"time series": n.resourceManager.TSDB(),
+		"rawdata":     n.resourceManager.RawData(),
+	}
+
+	for name, res := range resources {
+		if flusher, ok := res.(interface{ Flush(context.Context) error }); ok {
+			if err := flusher.Flush(ctx); nil != err {
+				log.L().Error("flush resource", logf.Name(name), logf.Error(err))
+				return errors.Wrap(err, "flush "+name)
+			}
+		}
+	}
+
+	return errors.Wrap(n.resourceManager.Repo().FlushEntity(ctx), "flush states")
+}
+
 // initialize runtime environments.
 func (n *Node) listMetadata() {
 	elapsedTime := util.NewElapsed()
@@ -178,7 +285,10 @@ func (n *Node) listMetadata() {
 			for runtimeID, exprIns := range exprInfos {
 				runtime, ok := n.runtimes[runtimeID]
 				if ok {
-					runtime.AppendExpression(*exprIns)
+					if err = runtime.AppendExpression(*exprIns); nil != err {
+						log.L().Error("append expression", logf.Eid(expr.EntityID),
+							logf.Expr(expr.Expression), logf.Mid(expr.Path), logf.Error(err))
+					}
 				}
 			}
 		}
@@ -200,6 +310,18 @@ func (n *Node) listMetadata() {
 			}
 		}
 	})
+	repo.RangeAlarmRule(ctx, n.revision, func(rules []*repository.AlarmRule) {
+		for _, rule := range rules {
+			log.L().Debug("sync alarm rule", logf.ID(rule.ID), logf.Eid(rule.EntityID), logf.Owner(rule.Owner))
+			runtimeInfo := placement.Global().Select(rule.EntityID)
+			if runtime, ok := n.runtimes[runtimeInfo.ID]; ok {
+				if err := runtime.AppendAlarmRule(rule); nil != err {
+					log.L().Error("append alarm rule", logf.ID(rule.ID),
+						logf.Eid(rule.EntityID), logf.Error(err))
+				}
+			}
+		}
+	})
 	log.L().Debug("runtime.Environment initialized", logf.Elapsedms(elapsedTime.ElapsedMilli()))
 }
 
@@ -236,7 +358,10 @@ func (n *Node) watchMetadata() {
 				// delivery expression.
 				for rtID, exprItem := range exprInfos {
 					if rt, has := n.runtimes[rtID]; has {
-						rt.AppendExpression(*exprItem)
+						if err = rt.AppendExpression(*exprItem); nil != err {
+							log.L().Error("append expression", logf.Eid(expr.EntityID),
+								logf.Expr(expr.Expression), logf.Mid(expr.Path), logf.Error(err))
+						}
 					}
 				}
 			default:
@@ -273,6 +398,29 @@ func (n *Node) watchMetadata() {
 				log.L().Error("watch metadata changed, invalid event type")
 			}
 		})
+
+	go repo.WatchAlarmRule(context.Background(), n.revision,
+		func(et dao.EnventType, rule *repository.AlarmRule) {
+			runtimeInfo := placement.Global().Select(rule.EntityID)
+			runtime, ok := n.runtimes[runtimeInfo.ID]
+			if !ok {
+				return
+			}
+
+			switch et {
+			case dao.DELETE:
+				log.L().Debug("sync DELETE alarm rule", logf.ID(rule.ID), logf.Eid(rule.EntityID), logf.Owner(rule.Owner))
+				runtime.RemoveAlarmRule(rule)
+			case dao.PUT:
+				log.L().Debug("sync PUT alarm rule", logf.ID(rule.ID), logf.Eid(rule.EntityID), logf.Owner(rule.Owner))
+				if err := runtime.AppendAlarmRule(rule); nil != err {
+					log.L().Error("append alarm rule", logf.ID(rule.ID),
+						logf.Eid(rule.EntityID), logf.Error(err))
+				}
+			default:
+				log.L().Error("watch metadata changed, invalid event type")
+			}
+		})
 }
 
 func parseExpression(expr repository.Expression, version int) (map[string]*ExpressionInfo, error) {
@@ -25,10 +25,13 @@ import (
 	"github.com/pkg/errors"
 	"github.com/tkeel-io/collectjs"
 	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/config"
 	logf "github.com/tkeel-io/core/pkg/logfield"
 	"github.com/tkeel-io/core/pkg/metrics"
 	"github.com/tkeel-io/core/pkg/resource/rawdata"
 	"github.com/tkeel-io/core/pkg/resource/tseries"
+	"github.com/tkeel-io/core/pkg/scheme"
+	"github.com/tkeel-io/core/pkg/tenant"
 	"github.com/tkeel-io/kit/log"
 	"github.com/tkeel-io/tdtl"
 )
@@ -45,12 +48,7 @@ func (n *Node) PersistentEntity(ctx context.Context, en Entity, feed *Feed) erro
 		templateID = "empty_template_id"
 	}
 
-	// 1. flush state.
-	if err := n.resourceManager.Repo().PutEntity(ctx, en.ID(), en.Raw()); nil != err {
-		log.L().Error("flush entity state storage", logf.Error(err), logf.Eid(en.ID()))
-		return errors.Wrap(err, "flush entity into state storage")
-	}
-
+	// 1. state is written by StoreEntity, writes are coalesced by runtimes.
 	// 2. flush data.
 	// 2.1 flush search global data.
 	globalData, err := n.makeSearchData(en, feed)
@@ -101,11 +99,24 @@ func (n *Node) PersistentEntity(ctx context.Context, en Entity, feed *Feed) erro
 	return nil
 }
 
+// StoreEntity writes the entity state into the state store.
+func (n *Node) StoreEntity(ctx context.Context, en Entity, feed *Feed) error {
+	if err := n.resourceManager.Repo().PutEntity(ctx, en.ID(), en.Raw()); nil != err {
+		log.L().Error("flush entity state storage", logf.Error(err), logf.Eid(en.ID()))
+		return errors.Wrap(err, "flush entity into state storage")
+	}
+	return nil
+}
+
 func (n *Node) makeRawData(ctx context.Context, en Entity) (*rawdata.Request, error) {
 	req := &rawdata.Request{}
 	req.Metadata = make(map[string]string)
 	raw := en.GetProp("rawData")
 
+	if tenantID := tenant.FromState(en.Raw()); tenantID != "" {
+		req.Metadata[tenant.TagKey] = tenantID
+	}
+
 	req.Metadata["path"] = en.GetProp("rawData.path").String()
 	req.Metadata["type"] = en.GetProp("rawData.type").String()
 	req.Metadata["mark"] = en.GetProp("rawData.mark").String()
@@ -148,6 +159,17 @@ func (n *Node) makeTimeSeriesData(ctx context.Context, en Entity, feed *Feed) (*
 		tsCount = 0
 	)
 
+	tags := map[string]string{"id": en.ID()}
+	if tenantID := tenant.FromState(en.Raw()); tenantID != "" {
+		tags[tenant.TagKey] = tenantID
+	}
+
+	// entities bound to a schema write properties with enabled_time_series.
+	if en.Get(FieldSchema).String() != "" {
+		ret.Data = makeSchemaTimeSeries(en, feed, tags, config.Get().Schema)
+		return ret, len(ret.Data), nil
+	}
+
 	needWriteKeys := n.getTimeSeriesKey(feed.Changes)
 	if len(needWriteKeys) == 0 {
 		return ret, tsCount, nil
@@ -167,7 +189,7 @@ func (n *Node) makeTimeSeriesData(ctx context.Context, en Entity, feed *Feed) (*
 					if ts, ok := tsOne["ts"]; ok {
 						tsItem := tseries.TSeriesData{
 							Measurement: "keel",
-							Tags:        map[string]string{"id": en.ID()},
+							Tags:        tags,
 							Fields:      map[string]float32{},
 							Timestamp:   0,
 						}
@@ -206,16 +228,34 @@ func (n *Node) makeSearchData(en Entity, feed *Feed) ([]byte, error) {
 			}
 		}
 	}
+
+	// properties with enabled_search of the bound schema.
+	schemaPaths := schemaEnabledPaths(en, scheme.EnabledFlagSearch)
+	for path := range schemaPaths {
+		writeFlag = writeFlag || changed(feed.Changes, FieldProperties+"."+path)
+	}
+	writeFlag = writeFlag || changed(feed.Changes, FieldSchema) || changed(feed.Changes, FieldSchemaVer)
+
 	if !writeFlag {
 		return nil, errors.New("no need to write")
 	}
 
 	globalData := collectjs.ByteNew([]byte(`{}`))
 	fields := []string{FieldID, FieldType, FieldOwner, FieldSource, FieldTemplate}
+	if len(schemaPaths) > 0 {
+		fields = append(fields, FieldSchema, FieldSchemaVer)
+	}
 	for _, field := range fields {
 		globalData.Set(field, en.Get(field).Raw())
 	}
 
+	for path := range schemaPaths {
+		item := en.GetProp(path)
+		if item.Type() != tdtl.Null && item.Type() != tdtl.Undefined {
+			globalData.Set(path, item.Raw())
+		}
+	}
+
 	/*
 		byt, err := json.Marshal(string(en.Raw()))
 		if err != nil {
@@ -247,28 +287,130 @@ func (n *Node) makeSearchData(en Entity, feed *Feed) ([]byte, error) {
 	return globalData.GetRaw(), nil
 }
 
-func (n *Node) RemoveEntity(ctx context.Context, en Entity, feed *Feed) error {
-	var err error
+// schemaEnabledPaths returns property configs with the flag of entities bound to a schema.
+func schemaEnabledPaths(en Entity, flag int) map[string]*scheme.Config {
+	if en.Get(FieldSchema).String() == "" {
+		return nil
+	}
+
+	configs := make(map[string]interface{})
+	if err := json.Unmarshal(en.Get(FieldScheme).Raw(), &configs); nil != err {
+		log.L().Warn("decode entity scheme", logf.Eid(en.ID()), logf.Error(err))
+		return nil
+	}
 
-	// recover entity state.
-	defer func() {
+	cfgs := make(map[string]*scheme.Config)
+	for id, val := range configs {
+		cfg, err := scheme.ParseConfigFrom(val)
 		if nil != err {
-			if innerErr := n.FlushEntity(ctx, en, feed); nil != innerErr {
-				log.L().Error("remove entity failed, recover entity state failed", logf.Eid(en.ID()),
-					logf.Reason(err.Error()), logf.Error(innerErr), logf.Value(string(en.Raw())))
+			continue
+		}
+		cfg.ID = id
+		cfgs[id] = &cfg
+	}
+	return scheme.EnabledPaths(cfgs, flag)
+}
+
+// changed reports whether changes touch the path, its parents or children.
+func changed(changes []Patch, path string) bool {
+	for _, change := range changes {
+		if change.Path == path ||
+			strings.HasPrefix(path, change.Path+".") ||
+			strings.HasPrefix(change.Path, path+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// makeSchemaTimeSeries returns time series of changed properties with enabled_time_series,
+// values are converted by property types, properties of other types are skipped.
+func makeSchemaTimeSeries(en Entity, feed *Feed, tags map[string]string, cfg config.SchemaConfig) []*tseries.TSeriesData {
+	var data []*tseries.TSeriesData
+	for path, propCfg := range schemaEnabledPaths(en, scheme.EnabledFlagTimeSeries) {
+		if !changed(feed.Changes, FieldProperties+"."+path) {
+			continue
+		}
+
+		var val interface{}
+		if err := json.Unmarshal(en.GetProp(path).Raw(), &val); nil != err {
+			continue
+		}
+
+		timestamp := en.LastTime()
+		if item, ok := val.(map[string]interface{}); ok {
+			if ts, ok := item["ts"].(float64); ok {
+				timestamp = int64(ts)
 			}
+			val = item["value"]
 		}
-	}()
 
-	// 1. 从状态存储中删除（可标记）
+		var field float32
+		switch num := val.(type) {
+		case float64:
+			switch propCfg.Type {
+			case scheme.PropertyTypeInt:
+				field = float32(int64(num))
+			case scheme.PropertyTypeFloat, scheme.PropertyTypeDouble:
+				field = float32(num)
+			default:
+				continue
+			}
+		case bool:
+			if propCfg.Type != scheme.PropertyTypeBool {
+				continue
+			} else if num {
+				field = 1
+			}
+		default:
+			continue
+		}
+
+		data = append(data, &tseries.TSeriesData{
+			Measurement: cfg.Measurement,
+			Tags:        tags,
+			Fields:      map[string]float32{strings.TrimPrefix(path, cfg.TelemetryPrefix): field},
+			Timestamp:   timestamp * 1e6,
+		})
+	}
+	return data
+}
+
+func (n *Node) RemoveEntity(ctx context.Context, en Entity, feed *Feed) error {
+	// 1. 状态存储中保留标记删除的实体.
+	if err := n.resourceManager.Repo().
+		PutEntity(ctx, en.ID(), en.Raw()); nil != err {
+		log.L().Error("mark entity deleted in state storage",
+			logf.Error(err), logf.Eid(en.ID()), logf.Value(string(en.Raw())))
+		return errors.Wrap(err, "mark entity deleted in state storage")
+	}
+
+	// 2. 从搜索中删除, 恢复时重建索引.
+	if err := n.removeSearchData(ctx, en); nil != err {
+		return errors.Wrap(err, "remove entity from state search engine")
+	}
+
+	return nil
+}
+
+func (n *Node) PurgeEntity(ctx context.Context, en Entity, feed *Feed) error {
+	// 1. 从状态存储中删除.
 	if err := n.resourceManager.Repo().
 		DelEntity(ctx, en.ID()); nil != err {
-		log.L().Error("remove entity from state storage",
+		log.L().Error("purge entity from state storage",
 			logf.Error(err), logf.Eid(en.ID()), logf.Value(string(en.Raw())))
-		return errors.Wrap(err, "remove entity from state storage")
+		return errors.Wrap(err, "purge entity from state storage")
+	}
+
+	// 2. 从搜索中删除.
+	if err := n.removeSearchData(ctx, en); nil != err {
+		return errors.Wrap(err, "purge entity from state search engine")
 	}
 
-	// 2. 从搜索中删除（可标记）
+	return nil
+}
+
+func (n *Node) removeSearchData(ctx context.Context, en Entity) error {
 	if _, err := n.resourceManager.Search().
 		DeleteByID(ctx, &v1.DeleteByIDRequest{
 			Id:     en.ID(),
@@ -277,10 +419,8 @@ func (n *Node) RemoveEntity(ctx context.Context, en Entity, feed *Feed) error {
 		}); nil != err {
 		log.L().Error("remove entity from state search engine",
 			logf.Error(err), logf.Eid(en.ID()), logf.Value(string(en.Raw())))
-		return errors.Wrap(err, "remove entity from state search engine")
+		return errors.Wrap(err, "remove search data")
 	}
-
-	// 3. 删除实体相关的 Expression.
 	return nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -13,11 +14,14 @@ import (
 	"github.com/Shopify/sarama"
 	"github.com/pkg/errors"
 	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/config"
 	"github.com/tkeel-io/core/pkg/dispatch"
 	xerrors "github.com/tkeel-io/core/pkg/errors"
 	logf "github.com/tkeel-io/core/pkg/logfield"
 	"github.com/tkeel-io/core/pkg/mapper"
 	"github.com/tkeel-io/core/pkg/mapper/expression"
+	"github.com/tkeel-io/core/pkg/mapper/function"
+	"github.com/tkeel-io/core/pkg/metrics"
 	"github.com/tkeel-io/core/pkg/repository"
 	"github.com/tkeel-io/core/pkg/types"
 	"github.com/tkeel-io/core/pkg/util"
@@ -35,9 +39,15 @@ const (
 type EntityResourceFunc func(context.Context, Entity, *Feed) error
 
 type EntityResource struct {
+	// StoreHandler writes the entity state into the state store.
+	StoreHandler EntityResourceFunc
+	// PersistentEntity writes search, time series and raw data of the changes.
 	PersistentEntity EntityResourceFunc
 	FlushHandler     EntityResourceFunc
-	RemoveHandler    EntityResourceFunc
+	// RemoveHandler keeps the deleted entity in trash.
+	RemoveHandler EntityResourceFunc
+	// PurgeHandler removes the entity in trash permanently.
+	PurgeHandler EntityResourceFunc
 }
 
 type Runtime struct {
@@ -48,11 +58,29 @@ type Runtime struct {
 	entities        map[string]Entity // 存放Runtime的实体.
 	dispatcher      dispatch.Dispatcher
 	expressions     map[string]ExpressionInfo
+	exprMemories    map[string]*function.Memory
 	repository      repository.IRepository
 	entityResourcer EntityResource
+	sandbox         Sandbox
 	// map[entityID][SubscriptionID]Subscription
 	entitySubscriptions map[string]map[string]*repository.Subscription
-	msgs                chan sarama.ConsumerMessage
+	// map[entityID][RuleID]AlarmRuleInfo
+	alarmRules map[string]map[string]*AlarmRuleInfo
+	// map[RuleID]active
+	alarmStates map[string]bool
+	// results of writes with idempotency keys.
+	dedup *dedupWindow
+	// map[entityID]txLock, entities locked by prepared transactions.
+	txLocks map[string]*txLock
+	// entities not loaded known without persisted transaction locks.
+	txUnlocked map[string]bool
+	// writes coalesces state writes of entities.
+	writes *writeBehind
+	// flushes requests writing all dirty entities, entities failed to write are reported.
+	flushes chan chan error
+	msgs    chan sarama.ConsumerMessage
+	// stopped is closed when the event loop exits.
+	stopped chan struct{}
 
 	mlock  sync.RWMutex
 	lock   sync.RWMutex
@@ -67,8 +95,17 @@ func NewRuntime(ctx context.Context, ercFuncs EntityResource, id string, dispatc
 		enCache:             NewCache(repo),
 		entities:            map[string]Entity{},
 		expressions:         map[string]ExpressionInfo{},
+		exprMemories:        map[string]*function.Memory{},
 		entitySubscriptions: make(map[string]map[string]*repository.Subscription),
+		alarmRules:          make(map[string]map[string]*AlarmRuleInfo),
+		alarmStates:         make(map[string]bool),
+		dedup:               newDedupWindow(config.Get().Idempotency),
+		txLocks:             make(map[string]*txLock),
+		txUnlocked:          make(map[string]bool),
+		writes:              newWriteBehind(config.Get().State),
+		flushes:             make(chan chan error),
 		entityResourcer:     ercFuncs,
+		sandbox:             newSandbox(config.Get().Expression),
 		dispatcher:          dispatcher,
 		repository:          repo,
 		subTree:             path.NewRefTree(),
@@ -78,6 +115,7 @@ func NewRuntime(ctx context.Context, ercFuncs EntityResource, id string, dispatc
 		cancel:              cancel,
 		ctx:                 ctx,
 		msgs:                make(chan sarama.ConsumerMessage, 10),
+		stopped:             make(chan struct{}),
 	}
 	go runtime.deliveredEvent()
 	return &runtime
@@ -87,24 +125,188 @@ func (r *Runtime) ID() string {
 	return r.id
 }
 
-func (r *Runtime) DeliveredEvent(ctx context.Context, msg *sarama.ConsumerMessage) {
-	r.msgs <- *msg
+// DeliveredEvent queues the message, an error is returned if the message is not queued,
+// so that it is not marked consumed.
+func (r *Runtime) DeliveredEvent(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	select {
+	case r.msgs <- *msg:
+		return nil
+	case <-r.ctx.Done():
+		log.L().Warn("runtime stopped, drop message", logf.RID(r.id),
+			logf.Partition(msg.Partition), logf.Offset(msg.Offset))
+		return errors.Wrap(xerrors.ErrRuntimeStopped, "deliver event")
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "deliver event")
+	}
 }
 
 func (r *Runtime) deliveredEvent() {
-	for msg := range r.msgs {
-		var err error
-		var ev v1.ProtoEvent
-		if err = v1.Unmarshal(msg.Value, &ev); nil != err {
-			log.L().Error("decode Event", logf.Error(err),
-				logf.Message(string(msg.Value)), logf.RID(r.id))
+	defer close(r.stopped)
+	// dirty entities are checked twice in the flush interval.
+	ticker := time.NewTicker(r.writes.Delay() / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case msg := <-r.msgs:
+			r.deliverMessage(msg)
+			if !r.writes.Enabled() {
+				r.persistExprMemories(r.ctx)
+			}
+		case <-ticker.C:
+			r.flushEntities(r.ctx, r.writes.Due(false))
+			r.persistExprMemories(r.ctx)
+		case done := <-r.flushes:
+			// queued events are handled before flushing.
+			for len(r.msgs) > 0 {
+				r.deliverMessage(<-r.msgs)
+			}
+			r.flushEntities(r.ctx, r.writes.Due(true))
+			r.persistExprMemories(r.ctx)
+			if dirty := r.writes.Dirty(); dirty > 0 {
+				done <- errors.Errorf("%d dirty entities not written", dirty)
+			}
+			close(done)
+		case <-r.ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runtime) deliverMessage(msg sarama.ConsumerMessage) {
+	var ev v1.ProtoEvent
+	if err := v1.Unmarshal(msg.Value, &ev); nil != err {
+		log.L().Error("decode Event", logf.Error(err),
+			logf.Message(string(msg.Value)), logf.RID(r.id))
+		return
+	}
+
+	r.HandleEvent(context.Background(), &ev)
+}
+
+// FlushDirty writes states of all dirty entities through the event loop,
+// it is called on shutdown and before partitions of the runtime are rebalanced,
+// an error is returned if any entity is still dirty.
+func (r *Runtime) FlushDirty(ctx context.Context) error {
+	done := make(chan error, 1)
+	select {
+	case r.flushes <- done:
+	case <-r.ctx.Done():
+		return errors.Wrap(r.ctx.Err(), "flush dirty entities")
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "flush dirty entities")
+	}
+
+	select {
+	case err := <-done:
+		return errors.Wrap(err, "flush dirty entities")
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "flush dirty entities")
+	}
+}
+
+// Stop drains queued events and writes dirty entities, then stops the event loop.
+func (r *Runtime) Stop(ctx context.Context) error {
+	err := r.FlushDirty(ctx)
+	r.cancel()
+
+	select {
+	case <-r.stopped:
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "stop runtime")
+	}
+
+	if len(r.msgs) > 0 {
+		log.L().Warn("runtime stopped with queued events", logf.RID(r.id), logf.Any("count", len(r.msgs)))
+	}
+	return errors.Wrap(err, "stop runtime")
+}
+
+// flushEntities writes states of the entities.
+func (r *Runtime) flushEntities(ctx context.Context, entityIDs []string) {
+	if len(entityIDs) == 0 {
+		return
+	}
+
+	log.L().Debug("flush dirty entities", logf.RID(r.id), logf.Any("count", len(entityIDs)))
+	for _, entityID := range entityIDs {
+		en, ok := r.entities[entityID]
+		if !ok {
+			// entity has been deleted.
+			continue
+		}
+		if err := r.storeEntity(ctx, en, nil); nil != err {
+			log.L().Error("flush dirty entity", logf.RID(r.id), logf.Eid(entityID), logf.Error(err))
 			continue
 		}
+		r.persistDedup(ctx, entityID)
+	}
+}
+
+// storeEntity writes the state of the entity, an entity written by another node is stale,
+// it is evicted and reloaded from the state store by its next event.
+// other failed writes mark the entity dirty, so that they are retried by the next flush.
+func (r *Runtime) storeEntity(ctx context.Context, en Entity, feed *Feed) error {
+	err := r.entityResourcer.StoreHandler(ctx, en, feed)
+	switch {
+	case nil == err:
+	case errors.Is(err, xerrors.ErrEtagMismatch):
+		log.L().Warn("entity written by another node, evict", logf.RID(r.id), logf.Eid(en.ID()))
+		r.writes.Clean(en.ID())
+		r.lock.Lock()
+		delete(r.entities, en.ID())
+		r.lock.Unlock()
+		delete(r.txUnlocked, en.ID())
+	default:
+		r.writes.Retry(en.ID())
+	}
+	return errors.Wrap(err, "store entity")
+}
+
+// currentState returns the state of the entity responded to duplicates, deleted entities respond empty states.
+func (r *Runtime) currentState(entityID string) []byte {
+	if en, err := r.LoadEntity(entityID); nil == err {
+		return en.Raw()
+	}
+	return []byte(`{}`)
+}
+
+// loadDedup loads persisted results of writes with idempotency keys of the entity once.
+func (r *Runtime) loadDedup(ctx context.Context, entityID string) {
+	if nil == r.repository || r.dedup.Loaded(entityID) {
+		return
+	}
 
-		r.HandleEvent(context.Background(), &ev)
+	data, err := r.repository.GetDedupState(ctx, entityID)
+	if nil != err && !errors.Is(err, xerrors.ErrResourceNotFound) {
+		log.L().Error("load idempotency results", logf.RID(r.id), logf.Eid(entityID), logf.Error(err))
+		return
+	}
+
+	if err = r.dedup.Load(entityID, data); nil != err {
+		log.L().Error("load idempotency results", logf.RID(r.id), logf.Eid(entityID), logf.Error(err))
 	}
 }
 
+// persistDedup writes results of writes with idempotency keys of the entity.
+func (r *Runtime) persistDedup(ctx context.Context, entityID string) {
+	if nil == r.repository {
+		return
+	}
+
+	data, dirty, err := r.dedup.Encode(entityID)
+	if nil != err || !dirty {
+		return
+	}
+
+	if err = r.repository.PutDedupState(ctx, entityID, data); nil != err {
+		// retried on the next write of the entity.
+		log.L().Error("persist idempotency results", logf.RID(r.id), logf.Eid(entityID), logf.Error(err))
+		return
+	}
+	r.dedup.Persisted(entityID)
+}
+
 type FeedLog struct {
 	Old *Feed
 	New *Feed
@@ -114,8 +316,43 @@ func (r *Runtime) HandleEvent(ctx context.Context, event v1.Event) error {
 	log.L().Debug("handle event", logf.RID(r.id),
 		logf.Event(event), logf.EvID(event.ID()))
 
+	// transaction phases and events of locked entities.
+	if r.handleTransaction(ctx, event) {
+		return nil
+	}
+
+	// duplicate writes return the original result.
+	var digest string
+	idempotencyKey := event.Attr(v1.MetaIdempotencyKey)
+	if idempotencyKey != "" {
+		r.loadDedup(ctx, event.Entity())
+		digest = payloadDigest(event)
+		if res, ok := r.dedup.Get(event.Entity(), idempotencyKey); ok {
+			if res.digest != digest {
+				log.L().Warn("idempotency key reused with another payload", logf.RID(r.id),
+					logf.ID(event.ID()), logf.Eid(event.Entity()), logf.Key(idempotencyKey))
+				r.handleCallback(ctx, &Feed{Err: xerrors.ErrIdempotencyKeyReused, Event: event, EntityID: event.Entity()})
+				return nil
+			}
+
+			log.L().Info("duplicate event, respond current state", logf.RID(r.id),
+				logf.ID(event.ID()), logf.Eid(event.Entity()), logf.Key(idempotencyKey))
+			r.handleCallback(ctx, &Feed{Event: event, State: r.currentState(event.Entity()), EntityID: event.Entity()})
+			return nil
+		}
+	}
+
 	execer, feed := r.PrepareEvent(ctx, event)
 	newFeed := execer.Exec(ctx, feed)
+	// failed writes are retried by duplicates.
+	if idempotencyKey != "" && nil == newFeed.Err {
+		r.dedup.Put(event.Entity(), idempotencyKey, digest)
+		// results are written after the state of the entity, not atomically, duplicates re-execute
+		// if the runtime stops in between. results of deleted entities are not persisted.
+		if _, ok := r.entities[event.Entity()]; ok && !r.writes.Pending(event.Entity()) {
+			r.persistDedup(ctx, event.Entity())
+		}
+	}
 
 	// call callback once.
 	r.handleCallback(ctx, newFeed)
@@ -159,14 +396,8 @@ func (r *Runtime) PrepareEvent(ctx context.Context, ev v1.Event) (*Execer, *Feed
 			preFuncs: []Handler{
 				&handlerImpl{fn: r.handleRawData},
 			}, // 新增了 Patches
-			execFunc: state,
-			postFuncs: []Handler{
-				&handlerImpl{fn: r.handleTentacle},   // 无变化
-				&handlerImpl{fn: r.handleComputed},   // 无变化
-				&handlerImpl{fn: r.handlePersistent}, // 无变化
-				&handlerImpl{fn: r.handleSubscribe},  //
-				&handlerImpl{fn: r.handleTemplate},
-			},
+			execFunc:  state,
+			postFuncs: r.patchedFuncs(r.handlePersistent),
 		} //
 
 		return execer, &Feed{
@@ -210,6 +441,23 @@ func (r *Runtime) PrepareEvent(ctx context.Context, ev v1.Event) (*Execer, *Feed
 	}
 }
 
+// patchedFuncs returns handlers of entities changed by patches, states are persisted by persist.
+func (r *Runtime) patchedFuncs(persist func(context.Context, *Feed) *Feed) []Handler {
+	return []Handler{
+		&handlerImpl{fn: r.handleTentacle},  // 无变化
+		&handlerImpl{fn: r.handleComputed},  // 无变化
+		&handlerImpl{fn: persist},           // 无变化
+		&handlerImpl{fn: r.handleSubscribe}, //
+		&handlerImpl{fn: r.handleAlarm},
+		&handlerImpl{fn: r.handleTemplate},
+	}
+}
+
+func templateVersion(en Entity) int64 {
+	version, _ := strconv.ParseInt(en.Get(FieldTemplateVer).String(), 10, 64)
+	return version
+}
+
 func (r *Runtime) loadTemplate(tid string) (tdtl.Node, error) {
 	if strings.TrimSpace(tid) == "" {
 		return tdtl.New(`{}`), nil
@@ -275,7 +523,10 @@ func (r *Runtime) prepareSystemEvent(ctx context.Context, event v1.Event) (*Exec
 
 		var scheme tdtl.Node
 		templateID := state.Get(FieldTemplate).String()
-		if scheme, err = r.loadTemplate(templateID); nil != err {
+		if state.Get(FieldSchema).String() != "" || templateVersion(state) > 0 {
+			// scheme already bound by the template version or schema.
+			scheme = state.Get(FieldScheme)
+		} else if scheme, err = r.loadTemplate(templateID); nil != err {
 			log.L().Error("load template", logf.Error(err),
 				logf.Eid(ev.Entity()), logf.Template(templateID))
 			return execer, &Feed{
@@ -308,25 +559,27 @@ func (r *Runtime) prepareSystemEvent(ctx context.Context, event v1.Event) (*Exec
 		state, err := r.LoadEntity(ev.Entity())
 		if nil != err {
 			state = DefaultEntity(ev.Entity())
-			if errors.Is(err, xerrors.ErrEntityNotFound) {
+			if errors.Is(err, xerrors.ErrEntityNotFound) || errors.Is(err, xerrors.ErrResourceNotFound) {
 				// TODO: if entity not exists.
 				return &Execer{
-						state:    state,
-						execFunc: state,
-					}, &Feed{
-						Event:    ev,
-						State:    state.Raw(),
-						EntityID: ev.Entity(),
-					}
+					state:    state,
+					execFunc: state,
+				}, &Feed{
+					Event:    ev,
+					State:    state.Raw(),
+					EntityID: ev.Entity(),
+				}
 			}
 			log.L().Error("delete entity", logf.Eid(ev.Entity()),
 				logf.Value(string(action.GetData())), logf.Error(err))
 		}
 
+		// mark the entity deleted, and keep it in trash.
 		execer := &Execer{
 			state:    state,
 			execFunc: state,
-			preFuncs: []Handler{
+			preFuncs: []Handler{},
+			postFuncs: []Handler{
 				&handlerImpl{fn: func(ctx context.Context, feed *Feed) *Feed {
 					if innerErr := r.entityResourcer.RemoveHandler(ctx, state, feed); nil != innerErr {
 						log.L().Error("delete entity failure", logf.Eid(ev.Entity()),
@@ -340,8 +593,6 @@ func (r *Runtime) prepareSystemEvent(ctx context.Context, event v1.Event) (*Exec
 
 					return feed
 				}},
-			},
-			postFuncs: []Handler{
 				&handlerImpl{fn: r.handleTentacle},
 				&handlerImpl{fn: func(_ context.Context, feed *Feed) *Feed {
 					log.L().Info("delete entity successed", logf.Eid(ev.Entity()),
@@ -351,6 +602,120 @@ func (r *Runtime) prepareSystemEvent(ctx context.Context, event v1.Event) (*Exec
 			},
 		}
 
+		return execer, &Feed{
+			Err:      err,
+			Event:    ev,
+			State:    state.Raw(),
+			EntityID: ev.Entity(),
+			Patches: []Patch{{
+				Op:    xjson.OpReplace,
+				Path:  FieldDeletedAt,
+				Value: tdtl.NewInt64(time.Now().UnixNano() / int64(time.Millisecond)),
+			}},
+		}
+	case v1.OpRestore:
+		state, err := r.loadTrashEntity(ev.Entity())
+		if nil != err {
+			log.L().Error("restore entity", logf.Eid(ev.Entity()), logf.Error(err))
+			state = DefaultEntity(ev.Entity())
+		}
+
+		execer := &Execer{
+			state:    state,
+			execFunc: state,
+			preFuncs: []Handler{},
+			postFuncs: []Handler{
+				&handlerImpl{fn: func(_ context.Context, feed *Feed) *Feed {
+					// entity back to runtime, persisted and indexed again.
+					r.entities[state.ID()] = state
+					log.L().Info("restore entity successed", logf.Eid(ev.Entity()),
+						logf.ID(ev.ID()), logf.Header(ev.Attributes()))
+					return feed
+				}},
+			},
+		}
+
+		return execer, &Feed{
+			Err:      err,
+			Event:    ev,
+			State:    state.Raw(),
+			EntityID: ev.Entity(),
+			Patches: []Patch{{
+				Op:    xjson.OpRemove,
+				Path:  FieldDeletedAt,
+				Value: tdtl.New([]byte("null")),
+			}},
+		}
+	case v1.OpImport:
+		state, err := NewEntity(ev.Entity(), action.GetData())
+		if nil != err {
+			log.L().Error("import entity", logf.Eid(ev.Entity()), logf.Error(err))
+			state = DefaultEntity(ev.Entity())
+		}
+
+		execer := &Execer{
+			state:    state,
+			execFunc: state,
+			preFuncs: []Handler{},
+			postFuncs: []Handler{
+				&handlerImpl{fn: func(ctx context.Context, feed *Feed) *Feed {
+					// the imported state replaces the state held by the runtime.
+					r.writes.Clean(state.ID())
+					if deletedAt(state) == 0 {
+						r.entities[state.ID()] = state
+						return feed
+					}
+
+					// entities in trash are stored only.
+					delete(r.entities, state.ID())
+					if innerErr := r.entityResourcer.StoreHandler(ctx, state, feed); nil != innerErr {
+						log.L().Error("import entity in trash", logf.Eid(ev.Entity()),
+							logf.Error(innerErr), logf.ID(ev.ID()), logf.Header(ev.Attributes()))
+						feed.Err = innerErr
+					}
+					return feed
+				}},
+				&handlerImpl{fn: func(_ context.Context, feed *Feed) *Feed {
+					log.L().Info("import entity successed", logf.Eid(ev.Entity()),
+						logf.ID(ev.ID()), logf.Header(ev.Attributes()))
+					return feed
+				}},
+			},
+		}
+
+		return execer, &Feed{
+			Err:      err,
+			Event:    ev,
+			State:    state.Raw(),
+			EntityID: ev.Entity(),
+			Patches:  []Patch{},
+		}
+	case v1.OpPurge:
+		state, err := r.loadTrashEntity(ev.Entity())
+		if nil != err {
+			log.L().Error("purge entity", logf.Eid(ev.Entity()), logf.Error(err))
+			state = DefaultEntity(ev.Entity())
+		}
+
+		execer := &Execer{
+			state:    state,
+			execFunc: state,
+			preFuncs: []Handler{},
+			postFuncs: []Handler{
+				&handlerImpl{fn: func(ctx context.Context, feed *Feed) *Feed {
+					if innerErr := r.entityResourcer.PurgeHandler(ctx, state, feed); nil != innerErr {
+						log.L().Error("purge entity failure", logf.Eid(ev.Entity()),
+							logf.Error(innerErr), logf.ID(ev.ID()), logf.Header(ev.Attributes()))
+						feed.Err = innerErr
+						return feed
+					}
+					log.L().Info("purge entity successed", logf.Eid(ev.Entity()),
+						logf.ID(ev.ID()), logf.Header(ev.Attributes()))
+					return feed
+				}},
+			},
+		}
+
 		return execer, &Feed{
 			Err:      err,
 			Event:    ev,
@@ -359,26 +724,35 @@ func (r *Runtime) prepareSystemEvent(ctx context.Context, event v1.Event) (*Exec
 		}
 	default:
 		return &Execer{
-				state:    DefaultEntity(ev.Entity()),
-				preFuncs: []Handler{},
-				execFunc: DefaultEntity(ev.Entity()),
-				postFuncs: []Handler{
-					&handlerImpl{fn: func(_ context.Context, feed *Feed) *Feed {
-						log.L().Error("event type not support", logf.Eid(ev.Entity()),
-							logf.ID(ev.ID()), logf.Header(ev.Attributes()))
-						return feed
-					}},
-				},
-			}, &Feed{
-				Event:    ev,
-				EntityID: ev.Entity(),
-				Err:      xerrors.ErrInternal,
-			}
+			state:    DefaultEntity(ev.Entity()),
+			preFuncs: []Handler{},
+			execFunc: DefaultEntity(ev.Entity()),
+			postFuncs: []Handler{
+				&handlerImpl{fn: func(_ context.Context, feed *Feed) *Feed {
+					log.L().Error("event type not support", logf.Eid(ev.Entity()),
+						logf.ID(ev.ID()), logf.Header(ev.Attributes()))
+					return feed
+				}},
+			},
+		}, &Feed{
+			Event:    ev,
+			EntityID: ev.Entity(),
+			Err:      xerrors.ErrInternal,
+		}
 	}
 }
 
 func (r *Runtime) handleComputed(ctx context.Context, feed *Feed) *Feed {
 	log.L().Debug("handle computed", logf.Eid(feed.EntityID))
+	// check propagation depth, break expression loops across runtimes.
+	depth := eventDepth(feed.Event) + 1
+	if r.sandbox.exceedDepth(depth) {
+		log.L().Warn("expression propagation exceed max depth, drop it",
+			logf.Eid(feed.EntityID), logf.Int("depth", depth), logf.Header(feed.Event.Attributes()))
+		metrics.CollectorExprRejected.WithLabelValues(metrics.ExprRejectDepth).Inc()
+		return feed
+	}
+
 	// 1. 检查 ret.path 和 订阅列表.
 	entityID := feed.EntityID
 	expressions := make(map[string]ExpressionInfo)
@@ -410,7 +784,7 @@ func (r *Runtime) handleComputed(ctx context.Context, feed *Feed) *Feed {
 		result, err := r.evalExpression(ctx, expr.Expression)
 		if nil != err {
 			log.L().Error("eval expression",
-				logf.Eid(entityID), logf.Mid(id),
+				logf.Eid(entityID), logf.Mid(id), logf.Error(err),
 				logf.Expr(expr.Expression.Expression))
 			continue
 		} else if nil == result {
@@ -439,6 +813,7 @@ func (r *Runtime) handleComputed(ctx context.Context, feed *Feed) *Feed {
 				v1.MetaBorn:        "handleComputed",
 				v1.MetaPartitionID: r.ID(),
 				v1.MetaEntityID:    target,
+				v1.MetaTTL:         strconv.Itoa(depth),
 			},
 			Data: &v1.ProtoEvent_Patches{
 				Patches: &v1.PatchDatas{
@@ -451,6 +826,14 @@ func (r *Runtime) handleComputed(ctx context.Context, feed *Feed) *Feed {
 	return feed
 }
 
+// allowReference reports whether the expression may read the entity.
+func (r *Runtime) allowReference(expr repository.Expression, state Entity) bool {
+	if config.Get().Auth.CrossTenantExpression || expr.Owner == "" {
+		return true
+	}
+	return state.Owner() == "" || state.Owner() == expr.Owner
+}
+
 func (r *Runtime) evalExpression(ctx context.Context, expr repository.Expression) (tdtl.Node, error) {
 	var (
 		err      error
@@ -464,6 +847,14 @@ func (r *Runtime) evalExpression(ctx context.Context, expr repository.Expression
 		return nil, xerrors.ErrExpressionNotFound
 	}
 
+	// check step budget.
+	if r.sandbox.exceedInputs(len(exprInfo.evalEndpoints)) {
+		log.L().Error("expression exceed max inputs", logf.ID(expr.ID),
+			logf.Eid(expr.EntityID), logf.Count(int64(len(exprInfo.evalEndpoints))))
+		metrics.CollectorExprRejected.WithLabelValues(metrics.ExprRejectCost).Inc()
+		return nil, xerrors.ErrExpressionCostExceeded
+	}
+
 	in := make(map[string]tdtl.Node)
 	for _, item := range exprInfo.evalEndpoints {
 		// watchKey = entityID，propertyKey
@@ -471,14 +862,20 @@ func (r *Runtime) evalExpression(ctx context.Context, expr repository.Expression
 
 		var state Entity
 		// get value from entities.
-		if state, has = r.entities[watchKey.EntityID]; has {
-			in[item.path] = state.Get(watchKey.PropertyKey)
-			continue
+		if state, has = r.entities[watchKey.EntityID]; !has {
+			// get value from cache.
+			if state, err = r.enCache.Load(ctx, watchKey.EntityID); nil != err {
+				continue
+			}
 		}
-		// get value from cache.
-		if state, err = r.enCache.Load(ctx, watchKey.EntityID); nil == err {
-			in[item.path] = state.Get(watchKey.PropertyKey)
+
+		// deny references to entities of other tenants.
+		if !r.allowReference(expr, state) {
+			log.L().Warn("expression refers entity of other tenant", logf.ID(expr.ID),
+				logf.Eid(expr.EntityID), logf.Owner(expr.Owner), logf.Path(item.path))
+			continue
 		}
+		in[item.path] = state.Get(watchKey.PropertyKey)
 	}
 
 	// ignore empty input.
@@ -488,17 +885,19 @@ func (r *Runtime) evalExpression(ctx context.Context, expr repository.Expression
 		return nil, nil
 	}
 
-	exprIns, err := expression.NewExpr(exprInfo.Expression.Expression, nil)
+	exprIns, err := expression.NewExpr(exprInfo.Expression.Expression,
+		function.Funcs(r.funcContext(expr)))
 	if nil != err {
 		log.L().Error("parse expression",
 			logf.Eid(expr.EntityID), logf.Error(err))
 		return nil, errors.Wrap(err, "parse expression")
 	}
 
-	// eval expression.
+	// eval expression within time budget.
 	var out tdtl.Node
-	if out, err = exprIns.Eval(ctx, in); nil != err {
-		log.L().Error("eval expression", logf.Input(in),
+	out, err = r.evalWithBudget(ctx, exprIns, expr.Owner, in)
+	if nil != err {
+		log.L().Error("eval expression", logf.Input(in), logf.Error(err),
 			logf.ID(expr.ID), logf.Eid(expr.EntityID), logf.Output(out.String()))
 		return nil, errors.Wrap(err, "eval expression")
 	}
@@ -516,6 +915,133 @@ func (r *Runtime) evalExpression(ctx context.Context, expr repository.Expression
 	return out, nil
 }
 
+// evalWithBudget evaluates the expression within the time budget, latencies are observed by owner.
+func (r *Runtime) evalWithBudget(ctx context.Context, exprIns expression.IExpression, owner string, in map[string]tdtl.Node) (tdtl.Node, error) {
+	if r.sandbox.EvalTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.sandbox.EvalTimeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	out, err := exprIns.Eval(ctx, in)
+	status := metrics.ExprStatusOK
+	if errors.Is(err, context.DeadlineExceeded) {
+		status = metrics.ExprStatusTimeout
+		err = xerrors.ErrExpressionEvalTimeout
+	} else if nil != err {
+		status = metrics.ExprStatusError
+	}
+
+	metrics.CollectorExprEvalDuration.
+		WithLabelValues(owner, status).Observe(time.Since(start).Seconds())
+	return out, err
+}
+
+// funcContext returns context of extension functions for the expression.
+func (r *Runtime) funcContext(expr repository.Expression) *function.Context {
+	return &function.Context{
+		ExprID: expr.ID,
+		Memory: r.exprMemory(expr.ID),
+		Lookup: func(entityID, propertyKey string) tdtl.Node {
+			return r.lookupProperty(expr, entityID, propertyKey)
+		},
+	}
+}
+
+func (r *Runtime) exprMemory(exprID string) *function.Memory {
+	r.mlock.RLock()
+	memory, has := r.exprMemories[exprID]
+	r.mlock.RUnlock()
+	if has {
+		return memory
+	}
+
+	// restore states from state store.
+	memory = function.NewMemory()
+	if nil != r.repository {
+		bytes, err := r.repository.GetExprState(context.TODO(), exprID)
+		if nil == err {
+			err = memory.Decode(bytes)
+		}
+		if nil != err && !errors.Is(err, xerrors.ErrResourceNotFound) {
+			log.L().Warn("restore expression state", logf.ID(exprID), logf.Error(err))
+		}
+	}
+
+	r.mlock.Lock()
+	defer r.mlock.Unlock()
+	if nil == r.exprMemories {
+		r.exprMemories = make(map[string]*function.Memory)
+	}
+	if exists, has := r.exprMemories[exprID]; has {
+		return exists
+	}
+	r.exprMemories[exprID] = memory
+	return memory
+}
+
+// persistExprMemories saves changed states of stateful functions, so windows survive restarts,
+// states changed by evaluations of events are written together with dirty entities.
+func (r *Runtime) persistExprMemories(ctx context.Context) {
+	if nil == r.repository {
+		return
+	}
+
+	r.mlock.RLock()
+	memories := make(map[string]*function.Memory)
+	for exprID, memory := range r.exprMemories {
+		if memory.Dirty() {
+			memories[exprID] = memory
+		}
+	}
+	r.mlock.RUnlock()
+
+	for exprID, memory := range memories {
+		bytes, err := memory.Encode()
+		if nil == err {
+			err = r.repository.PutExprState(ctx, exprID, bytes)
+		}
+		if nil != err {
+			log.L().Error("persist expression state", logf.ID(exprID), logf.Error(err))
+		}
+	}
+}
+
+// resetExprMemory releases states of stateful functions of the expression.
+func (r *Runtime) resetExprMemory(exprID string) {
+	r.mlock.Lock()
+	delete(r.exprMemories, exprID)
+	r.mlock.Unlock()
+	if nil != r.repository {
+		if err := r.repository.DelExprState(context.TODO(), exprID); nil != err {
+			log.L().Warn("remove expression state", logf.ID(exprID), logf.Error(err))
+		}
+	}
+}
+
+// lookupProperty returns the property of the entity, references to entities of other tenants are null.
+func (r *Runtime) lookupProperty(expr repository.Expression, entityID, propertyKey string) tdtl.Node {
+	r.lock.RLock()
+	state, has := r.entities[entityID]
+	r.lock.RUnlock()
+	if !has {
+		var err error
+		if state, err = r.enCache.Load(context.TODO(), entityID); nil != err {
+			log.L().Warn("lookup entity property", logf.Eid(entityID),
+				logf.Path(propertyKey), logf.Reason(err.Error()))
+			return tdtl.UNDEFINED_RESULT
+		}
+	}
+
+	if !r.allowReference(expr, state) {
+		log.L().Warn("expression looks up entity of other tenant", logf.ID(expr.ID),
+			logf.Eid(entityID), logf.Owner(expr.Owner), logf.Path(propertyKey))
+		return tdtl.NULL_RESULT
+	}
+	return state.Get(propertyKey)
+}
+
 func mergePath(subPath, changePath string) string {
 	// subPath format: entity_id.property_key
 	watchKey := mapper.NewWatchKey(subPath)
@@ -593,6 +1119,7 @@ func (r *Runtime) handleTentacle(ctx context.Context, feed *Feed) *Feed {
 				v1.MetaBorn:        "handleTentacle",
 				v1.MetaPartitionID: runtimeID,
 				v1.MetaSender:      entityID,
+				v1.MetaTTL:         strconv.Itoa(eventDepth(feed.Event)),
 			},
 			Data: &v1.ProtoEvent_Patches{
 				Patches: &v1.PatchDatas{
@@ -658,8 +1185,29 @@ func (r *Runtime) handlePersistent(ctx context.Context, feed *Feed) *Feed {
 	en, ok := r.entities[feed.EntityID]
 	if !ok {
 		// entity has been deleted.
+		r.writes.Clean(feed.EntityID)
+		return feed
+	}
+
+	// states changed by system events are written through, others are coalesced.
+	var err error
+	if nil != feed.Event && feed.Event.Type() == v1.ETSystem {
+		r.writes.Clean(feed.EntityID)
+		err = r.storeEntity(ctx, en, feed)
+	} else if r.writes.Mark(feed.EntityID, feed.Changes) {
+		err = r.storeEntity(ctx, en, feed)
+	}
+
+	switch {
+	case errors.Is(err, xerrors.ErrEtagMismatch):
+		// changes of the evicted entity are lost.
+		feed.Err = err
 		return feed
+	case nil != err:
+		log.L().Error("store entity, retry on the next flush", logf.RID(r.id),
+			logf.Eid(feed.EntityID), logf.Error(err))
 	}
+
 	r.entityResourcer.PersistentEntity(ctx, en, feed)
 	return feed
 }
@@ -674,6 +1222,11 @@ func (r *Runtime) handleFlush(ctx context.Context, feed *Feed) *Feed {
 
 func (r *Runtime) handleTemplate(ctx context.Context, feed *Feed) *Feed {
 	log.L().Debug("handle template", logf.Eid(feed.EntityID))
+	// template resources are applied by api manager.
+	if version, _ := strconv.ParseInt(tdtl.New(feed.State).Get(FieldTemplateVer).String(), 10, 64); version > 0 {
+		return feed
+	}
+
 	for index := range feed.Changes {
 		if FieldTemplate == feed.Changes[index].Path {
 			log.Info("entity template changed", logf.Eid(feed.EntityID),
@@ -830,11 +1383,20 @@ func (r *Runtime) handleRawData(ctx context.Context, feed *Feed) *Feed {
 	return feed
 }
 
-func (r *Runtime) AppendExpression(exprInfo ExpressionInfo) {
+func (r *Runtime) AppendExpression(exprInfo ExpressionInfo) error {
 	log.L().Debug("append expression into runtime",
 		logf.ID(exprInfo.ID), logf.Eid(exprInfo.EntityID),
 		logf.Owner(exprInfo.Owner), logf.Expr(exprInfo.Expression.Expression))
 
+	// reject expression which makes expression graph cyclic.
+	if chain := r.detectCycle(&exprInfo); len(chain) > 0 {
+		log.L().Error("append expression, cycle detected",
+			logf.ID(exprInfo.ID), logf.Eid(exprInfo.EntityID),
+			logf.Expr(exprInfo.Expression.Expression), logf.Any("chain", chain))
+		metrics.CollectorExprRejected.WithLabelValues(metrics.ExprRejectCycle).Inc()
+		return xerrors.ErrExpressionCycle
+	}
+
 	// remove expression if exists.
 	if exprOld, exists := r.getExpr(exprInfo.ID); exists {
 		// remove sub-endpoint from sub-tree.
@@ -846,6 +1408,11 @@ func (r *Runtime) AppendExpression(exprInfo ExpressionInfo) {
 		for _, item := range exprOld.evalEndpoints {
 			r.evalTree.Remove(item.WildcardPath(), &item)
 		}
+
+		// states of the replaced expression are meaningless to the new one.
+		if exprOld.Expression.Expression != exprInfo.Expression.Expression {
+			r.resetExprMemory(exprInfo.ID)
+		}
 	}
 
 	// cache expression info.
@@ -861,6 +1428,7 @@ func (r *Runtime) AppendExpression(exprInfo ExpressionInfo) {
 	}
 
 	r.initializeExpression(context.TODO(), exprInfo)
+	return nil
 }
 
 func (r *Runtime) RemoveExpression(exprID string) {
@@ -879,6 +1447,9 @@ func (r *Runtime) RemoveExpression(exprID string) {
 		for _, item := range exprInfo.evalEndpoints {
 			r.evalTree.Remove(item.WildcardPath(), &item)
 		}
+
+		// release states of stateful functions.
+		r.resetExprMemory(exprID)
 	}
 }
 
@@ -1012,6 +1583,11 @@ func (r *Runtime) LoadEntity(id string) (Entity, error) {
 		return nil, errors.Wrap(err, "create entity instance")
 	}
 
+	// entities in trash are invisible.
+	if deletedAt(en) > 0 {
+		return nil, xerrors.ErrEntityNotFound
+	}
+
 	info := placement.Global().Select(id)
 	if info.ID == r.ID() {
 		r.lock.Lock()
@@ -1021,6 +1597,24 @@ func (r *Runtime) LoadEntity(id string) (Entity, error) {
 	return en, nil
 }
 
+// loadTrashEntity loads the entity in trash from state storage.
+func (r *Runtime) loadTrashEntity(id string) (Entity, error) {
+	jsonData, err := r.repository.GetEntity(context.TODO(), id)
+	if nil != err {
+		log.L().Warn("load trash entity from state storage",
+			logf.Eid(id), logf.Reason(err.Error()))
+		return nil, errors.Wrap(err, "load trash entity")
+	}
+
+	en, err := NewEntity(id, jsonData)
+	if nil != err {
+		return nil, errors.Wrap(err, "create entity instance")
+	} else if _, loaded := r.entities[id]; loaded || deletedAt(en) == 0 {
+		return nil, xerrors.ErrEntityNotInTrash
+	}
+	return en, nil
+}
+
 func conv(patches []*v1.PatchData) []Patch {
 	res := make([]Patch, 0)
 	for _, patch := range patches {
@@ -1028,6 +1622,7 @@ func conv(patches []*v1.PatchData) []Patch {
 			Op:    xjson.NewPatchOp(patch.Operator),
 			Path:  patch.Path,
 			Value: tdtl.New(patch.Value),
+			From:  patch.From,
 		})
 	}
 	return res
@@ -20,10 +20,16 @@ import (
 	"context"
 	"testing"
 
+	"github.com/Shopify/sarama"
 	"github.com/stretchr/testify/assert"
 	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
 	"github.com/tkeel-io/core/pkg/placement"
 	"github.com/tkeel-io/core/pkg/repository"
+	_ "github.com/tkeel-io/core/pkg/resource/store/memory"
+	"github.com/tkeel-io/core/pkg/runtime/mock"
+	"github.com/tkeel-io/core/pkg/types"
 	tkeelJson "github.com/tkeel-io/core/pkg/util/json"
 	"github.com/tkeel-io/core/pkg/util/path"
 	"github.com/tkeel-io/tdtl"
@@ -228,3 +234,215 @@ func TestRuntime_AppendExpression(t *testing.T) {
 	rt.RemoveExpression(ex2.ID)
 	t.Log(rt)
 }
+
+func TestRuntime_evalExpressionFunctions(t *testing.T) {
+	rt := newSandboxRuntime(Sandbox{})
+	en, err := NewEntity("dev-a", []byte(state))
+	assert.Nil(t, err)
+	rt.enCache = NewCacheMock(map[string]Entity{"dev-a": en})
+
+	assert.Nil(t, appendExpr(rt, "expr-b", "dev-b", "properties.b",
+		"delta(dev-a.properties.telemetry.src1) + lookup('dev-a', 'properties.telemetry.src2')"))
+	exprInfo, has := rt.getExpr("expr-b")
+	assert.True(t, has)
+
+	// no previous value.
+	_, err = rt.evalExpression(context.Background(), exprInfo.Expression)
+	assert.NotNil(t, err)
+
+	out, err := rt.evalExpression(context.Background(), exprInfo.Expression)
+	assert.Nil(t, err)
+	assert.Equal(t, "123", out.String())
+
+	rt.RemoveExpression("expr-b")
+	assert.Len(t, rt.exprMemories, 0)
+}
+
+func TestRuntime_lookupPropertyTenant(t *testing.T) {
+	rt := newSandboxRuntime(Sandbox{})
+	en, err := NewEntity("dev-a", []byte(`{"id":"dev-a","owner":"tenant1","properties":{"temp":20}}`))
+	assert.Nil(t, err)
+	rt.enCache = NewCacheMock(map[string]Entity{"dev-a": en})
+
+	out := rt.lookupProperty(repository.Expression{ID: "expr-a", Owner: "tenant1"}, "dev-a", "properties.temp")
+	assert.Equal(t, "20", out.String())
+
+	// entities of other tenants are not looked up.
+	out = rt.lookupProperty(repository.Expression{ID: "expr-b", Owner: "tenant2"}, "dev-a", "properties.temp")
+	assert.Equal(t, tdtl.NULL_RESULT, out)
+}
+
+func TestRuntime_exprMemoryRestore(t *testing.T) {
+	repo := mock.NewRepo()
+	newRuntime := func() *Runtime {
+		rt := newSandboxRuntime(Sandbox{})
+		en, err := NewEntity("dev-a", []byte(state))
+		assert.Nil(t, err)
+		rt.repository = repo
+		rt.enCache = NewCacheMock(map[string]Entity{"dev-a": en})
+		assert.Nil(t, appendExpr(rt, "expr-b", "dev-b", "properties.b",
+			"count_over(dev-a.properties.telemetry.src1, 60) + delta(dev-a.properties.telemetry.src1)"))
+		return rt
+	}
+
+	rt := newRuntime()
+	exprInfo, _ := rt.getExpr("expr-b")
+	_, err := rt.evalExpression(context.Background(), exprInfo.Expression)
+	assert.NotNil(t, err)
+	rt.persistExprMemories(context.Background())
+
+	// states restored from state store after restart.
+	rt = newRuntime()
+	out, err := rt.evalExpression(context.Background(), exprInfo.Expression)
+	assert.Nil(t, err)
+	assert.Equal(t, "0", out.String())
+	rt.persistExprMemories(context.Background())
+
+	// states are reset when the expression is replaced.
+	assert.Nil(t, appendExpr(rt, "expr-b", "dev-b", "properties.b",
+		"delta(dev-a.properties.telemetry.src1)"))
+	assert.Len(t, rt.exprMemories, 0)
+	_, err = repo.GetExprState(context.Background(), "expr-b")
+	assert.NotNil(t, err)
+
+	// states removed with expression.
+	rt.RemoveExpression("expr-b")
+	_, err = repo.GetExprState(context.Background(), "expr-b")
+	assert.NotNil(t, err)
+}
+
+func sysEvent(reqID string, op v1.SystemOp) *v1.ProtoEvent {
+	ev := &v1.ProtoEvent{
+		Id:       reqID,
+		Callback: "http://localhost/v1/respond",
+		Metadata: map[string]string{
+			v1.MetaEntityID:  "device1",
+			v1.MetaRequestID: reqID,
+		},
+		Data: &v1.ProtoEvent_SystemData{SystemData: &v1.SystemData{Operator: string(op)}},
+	}
+	ev.SetType(v1.ETSystem)
+	return ev
+}
+
+func TestRuntime_SoftDelete(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{
+		ID:   "core/1234",
+		Flag: true,
+	})
+
+	ctx := context.Background()
+	repo := mock.NewRepo()
+	put := func(ctx context.Context, en Entity, _ *Feed) error { return repo.PutEntity(ctx, en.ID(), en.Raw()) }
+	del := func(ctx context.Context, en Entity, _ *Feed) error { return repo.DelEntity(ctx, en.ID()) }
+	noop := func(context.Context, Entity, *Feed) error { return nil }
+	dispatcher := &callbackRecorder{}
+	rt := NewRuntime(ctx, EntityResource{StoreHandler: put, PersistentEntity: noop, FlushHandler: noop,
+		RemoveHandler: put, PurgeHandler: del}, "core/1234", dispatcher, repo)
+	assert.Nil(t, repo.PutEntity(ctx, "device1", []byte(`{"id":"device1","properties":{"temp":20}}`)))
+	status := func(index int) string {
+		return dispatcher.events[index].Attr(v1.MetaResponseStatus)
+	}
+
+	// deleted entities are kept in trash, and invisible.
+	_, err := rt.LoadEntity("device1")
+	assert.Nil(t, err)
+	rt.HandleEvent(ctx, sysEvent("req1", v1.OpDelete))
+	assert.Equal(t, string(types.StatusOK), status(0))
+	assert.NotContains(t, rt.entities, "device1")
+	_, err = rt.LoadEntity("device1")
+	assert.ErrorIs(t, err, xerrors.ErrEntityNotFound)
+	bytes, err := repo.GetEntity(ctx, "device1")
+	assert.Nil(t, err)
+	assert.NotEqual(t, "", tdtl.New(bytes).Get(FieldDeletedAt).String())
+
+	// restore brings the entity back.
+	rt.HandleEvent(ctx, sysEvent("req2", v1.OpRestore))
+	assert.Equal(t, string(types.StatusOK), status(1))
+	en, err := rt.LoadEntity("device1")
+	assert.Nil(t, err)
+	assert.Equal(t, "20", en.Get("properties.temp").String())
+	assert.Equal(t, int64(0), deletedAt(en))
+	rt.HandleEvent(ctx, sysEvent("req3", v1.OpRestore))
+	assert.Equal(t, xerrors.ErrEntityNotInTrash.Error(), dispatcher.events[2].Attr(v1.MetaResponseErrCode))
+
+	// only entities in trash are purged.
+	rt.HandleEvent(ctx, sysEvent("req4", v1.OpPurge))
+	assert.Equal(t, xerrors.ErrEntityNotInTrash.Error(), dispatcher.events[3].Attr(v1.MetaResponseErrCode))
+	rt.HandleEvent(ctx, sysEvent("req5", v1.OpDelete))
+	rt.HandleEvent(ctx, sysEvent("req6", v1.OpPurge))
+	assert.Equal(t, string(types.StatusOK), status(5))
+	_, err = repo.GetEntity(ctx, "device1")
+	assert.NotNil(t, err)
+}
+
+func TestRuntime_Import(t *testing.T) {
+	ctx := context.Background()
+	repo := mock.NewRepo()
+	put := func(ctx context.Context, en Entity, _ *Feed) error { return repo.PutEntity(ctx, en.ID(), en.Raw()) }
+	noop := func(context.Context, Entity, *Feed) error { return nil }
+	dispatcher := &callbackRecorder{}
+	rt := NewRuntime(ctx, EntityResource{StoreHandler: put, PersistentEntity: noop, FlushHandler: noop,
+		RemoveHandler: put, PurgeHandler: noop}, "core/1234", dispatcher, repo)
+	en, err := NewEntity("device1", []byte(`{"id":"device1","properties":{"temp":20}}`))
+	assert.Nil(t, err)
+	rt.entities["device1"] = en
+
+	// the imported state replaces the state held by the runtime.
+	ev := sysEvent("req1", v1.OpImport)
+	ev.GetSystemData().Data = []byte(`{"id":"device1","properties":{"temp":30}}`)
+	rt.HandleEvent(ctx, ev)
+	assert.Equal(t, string(types.StatusOK), dispatcher.events[0].Attr(v1.MetaResponseStatus))
+	assert.Equal(t, "30", rt.entities["device1"].Get("properties.temp").String())
+	bytes, err := repo.GetEntity(ctx, "device1")
+	assert.Nil(t, err)
+	assert.Equal(t, "30", tdtl.New(bytes).Get("properties.temp").String())
+
+	// entities in trash are stored only.
+	ev = sysEvent("req2", v1.OpImport)
+	ev.GetSystemData().Data = []byte(`{"id":"device1","deleted_at":100,"properties":{"temp":40}}`)
+	rt.HandleEvent(ctx, ev)
+	assert.NotContains(t, rt.entities, "device1")
+	bytes, err = repo.GetEntity(ctx, "device1")
+	assert.Nil(t, err)
+	assert.Equal(t, "40", tdtl.New(bytes).Get("properties.temp").String())
+}
+
+func TestRuntime_Stop(t *testing.T) {
+	ctx := context.Background()
+	stored := 0
+	store := func(context.Context, Entity, *Feed) error {
+		stored++
+		return nil
+	}
+	noop := func(context.Context, Entity, *Feed) error { return nil }
+	rt := NewRuntime(ctx, EntityResource{StoreHandler: store, PersistentEntity: noop, FlushHandler: noop,
+		RemoveHandler: noop, PurgeHandler: noop}, "core/1234", &callbackRecorder{}, mock.NewRepo())
+
+	assert.Nil(t, rt.FlushDirty(ctx))
+	rt.writes = newWriteBehind(config.StateConfig{FlushInterval: 60000, FlushDirty: 100})
+	en, err := NewEntity("device1", []byte(`{"id":"device1","properties":{"temp":20}}`))
+	assert.Nil(t, err)
+	rt.entities["device1"] = en
+
+	bytes, err := v1.Marshal(txEvent("req", "", "",
+		&v1.PatchData{Path: "properties.temp", Operator: "replace", Value: []byte("30")}))
+	assert.Nil(t, err)
+	for index := 0; index < 5; index++ {
+		assert.Nil(t, rt.DeliveredEvent(ctx, &sarama.ConsumerMessage{Value: bytes}))
+	}
+
+	// queued events are handled and dirty entities are written on stop.
+	assert.Nil(t, rt.Stop(ctx))
+	assert.Equal(t, 1, stored)
+	assert.Equal(t, `30`, rt.entities["device1"].Get("properties.temp").String())
+
+	// delivering does not block once stopped, dropped messages are not consumed.
+	for index := 0; index < 20; index++ {
+		if err = rt.DeliveredEvent(ctx, &sarama.ConsumerMessage{Value: bytes}); nil != err {
+			break
+		}
+	}
+	assert.ErrorIs(t, err, xerrors.ErrRuntimeStopped)
+}
@@ -0,0 +1,114 @@
+package runtime
+
+import (
+	"strconv"
+	"time"
+
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/config"
+	"github.com/tkeel-io/core/pkg/util/path"
+)
+
+/*
+
+expression sandbox:
+	1. cycle detection when expression mounted.
+	2. time & step budget for each evaluation, time is checked before calls of extension functions.
+	3. propagation depth carried by event metadata(MetaTTL).
+
+*/
+
+// Sandbox limits the resources expressions may consume, zero value means unlimited.
+type Sandbox struct {
+	EvalTimeout time.Duration
+	MaxInputs   int
+	MaxDepth    int
+}
+
+func newSandbox(cfg config.ExpressionConfig) Sandbox {
+	return Sandbox{
+		EvalTimeout: time.Duration(cfg.EvalTimeout) * time.Millisecond,
+		MaxInputs:   cfg.MaxInputs,
+		MaxDepth:    cfg.MaxDepth,
+	}
+}
+
+// exceedDepth check propagation depth of the next hop.
+func (s Sandbox) exceedDepth(depth int) bool {
+	return s.MaxDepth > 0 && depth > s.MaxDepth
+}
+
+// exceedInputs check step budget of the evaluation.
+func (s Sandbox) exceedInputs(n int) bool {
+	return s.MaxInputs > 0 && n > s.MaxInputs
+}
+
+// eventDepth returns the propagation depth carried by the event.
+func eventDepth(ev v1.Event) int {
+	if nil == ev || nil == ev.Attributes() {
+		return 0
+	}
+
+	depth, err := strconv.Atoi(ev.Attr(v1.MetaTTL))
+	if nil != err || depth < 0 {
+		return 0
+	}
+	return depth
+}
+
+// exprTarget returns the watch key of the expression output.
+func exprTarget(expr *ExpressionInfo) string {
+	return path.FmtWatchKey(expr.EntityID, expr.Path)
+}
+
+// detectCycle returns the expression chain which makes exprInfo cyclic, the result is empty if acyclic.
+func (r *Runtime) detectCycle(exprInfo *ExpressionInfo) []string {
+	if len(exprInfo.evalEndpoints) == 0 {
+		return nil
+	}
+
+	r.mlock.RLock()
+	defer r.mlock.RUnlock()
+
+	type step struct {
+		target string
+		chain  []string
+	}
+
+	reachSource := func(target string) bool {
+		for _, item := range exprInfo.evalEndpoints {
+			if path.Overlap(item.path, target) {
+				return true
+			}
+		}
+		return false
+	}
+
+	visited := map[string]bool{exprInfo.ID: true}
+	queue := []step{{target: exprTarget(exprInfo), chain: []string{exprInfo.ID}}}
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+		if reachSource(curr.target) {
+			return curr.chain
+		}
+
+		for id := range r.expressions {
+			expr := r.expressions[id]
+			if visited[id] || len(expr.evalEndpoints) == 0 {
+				continue
+			}
+
+			for _, item := range expr.evalEndpoints {
+				if path.Overlap(item.path, curr.target) {
+					visited[id] = true
+					chain := append(append([]string{}, curr.chain...), id)
+					queue = append(queue, step{target: exprTarget(&expr), chain: chain})
+					break
+				}
+			}
+		}
+	}
+
+	return nil
+}
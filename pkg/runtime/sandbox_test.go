@@ -0,0 +1,147 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/repository"
+	tkeelJson "github.com/tkeel-io/core/pkg/util/json"
+	"github.com/tkeel-io/core/pkg/util/path"
+	"github.com/tkeel-io/tdtl"
+)
+
+func newSandboxRuntime(sandbox Sandbox) *Runtime {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{
+		ID:   "core/1234",
+		Flag: true,
+	})
+
+	return &Runtime{
+		dispatcher:  &dispatcherMock{},
+		enCache:     NewCacheMock(map[string]Entity{}),
+		entities:    map[string]Entity{},
+		expressions: map[string]ExpressionInfo{},
+		subTree:     path.NewRefTree(),
+		evalTree:    path.New(),
+		sandbox:     sandbox,
+		alarmRules:  map[string]map[string]*AlarmRuleInfo{},
+		alarmStates: map[string]bool{},
+	}
+}
+
+func appendExpr(rt *Runtime, id, entityID, propertyKey, exprRaw string) error {
+	exprInfos, err := parseExpression(repository.Expression{
+		ID:         id,
+		Path:       propertyKey,
+		Type:       repository.ExprTypeEval,
+		EntityID:   entityID,
+		Expression: exprRaw,
+	}, 1)
+	if nil != err {
+		return err
+	}
+
+	for _, exprInfo := range exprInfos {
+		if err = rt.AppendExpression(*exprInfo); nil != err {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestRuntime_detectCycle(t *testing.T) {
+	rt := newSandboxRuntime(Sandbox{})
+
+	// A -> B -> C.
+	assert.Nil(t, appendExpr(rt, "expr-b", "dev-b", "properties.b", "dev-a.properties.a"))
+	assert.Nil(t, appendExpr(rt, "expr-c", "dev-c", "properties.c", "dev-b.properties.b + 1"))
+
+	// C -> A makes cycle.
+	err := appendExpr(rt, "expr-a", "dev-a", "properties.a", "dev-c.properties.c")
+	assert.ErrorIs(t, err, xerrors.ErrExpressionCycle)
+	_, has := rt.getExpr("expr-a")
+	assert.False(t, has)
+
+	// C -> A.x is ok.
+	assert.Nil(t, appendExpr(rt, "expr-ax", "dev-a", "properties.x", "dev-c.properties.c"))
+
+	// self reference.
+	err = appendExpr(rt, "expr-d", "dev-d", "properties.d", "dev-d.properties.d * 2")
+	assert.ErrorIs(t, err, xerrors.ErrExpressionCycle)
+
+	// wildcard source.
+	err = appendExpr(rt, "expr-e", "dev-a", "properties.a", "dev-c.*")
+	assert.ErrorIs(t, err, xerrors.ErrExpressionCycle)
+}
+
+func TestRuntime_handleComputedDepth(t *testing.T) {
+	rt := newSandboxRuntime(Sandbox{MaxDepth: 3})
+	en, err := NewEntity("dev-a", []byte(state))
+	assert.Nil(t, err)
+	rt.enCache = NewCacheMock(map[string]Entity{"dev-a": en})
+	assert.Nil(t, appendExpr(rt, "expr-b", "dev-b", "properties.b", "dev-a.properties.telemetry.src1"))
+
+	ev := &v1.ProtoEvent{Metadata: map[string]string{}}
+	ev.SetTTL(3)
+	assert.Equal(t, 3, eventDepth(ev))
+	assert.Equal(t, 0, eventDepth(&v1.ProtoEvent{}))
+
+	dispatcher := &dispatcherRecorder{}
+	rt.dispatcher = dispatcher
+	feed := &Feed{
+		Event:    ev,
+		EntityID: "dev-a",
+		Changes: []Patch{{
+			Op:    tkeelJson.OpReplace,
+			Path:  "properties.telemetry.src1",
+			Value: tdtl.New(123),
+		}},
+	}
+
+	// drop exceeded event.
+	rt.handleComputed(context.Background(), feed)
+	assert.Len(t, dispatcher.events, 0)
+
+	// next hop carries depth.
+	ev.SetTTL(1)
+	rt.handleComputed(context.Background(), feed)
+	assert.Len(t, dispatcher.events, 1)
+	assert.Equal(t, 2, eventDepth(dispatcher.events[0]))
+}
+
+func TestRuntime_evalExpressionBudget(t *testing.T) {
+	rt := newSandboxRuntime(Sandbox{MaxInputs: 1})
+	en, err := NewEntity("dev-a", []byte(state))
+	assert.Nil(t, err)
+	rt.enCache = NewCacheMock(map[string]Entity{"dev-a": en})
+
+	assert.Nil(t, appendExpr(rt, "expr-b", "dev-b", "properties.b",
+		"dev-a.properties.telemetry.src1 + dev-a.properties.telemetry.src2"))
+	exprInfo, has := rt.getExpr("expr-b")
+	assert.True(t, has)
+	_, err = rt.evalExpression(context.Background(), exprInfo.Expression)
+	assert.ErrorIs(t, err, xerrors.ErrExpressionCostExceeded)
+
+	rt.sandbox.MaxInputs = 2
+	out, err := rt.evalExpression(context.Background(), exprInfo.Expression)
+	assert.Nil(t, err)
+	assert.Equal(t, "246", out.String())
+}
+
+type dispatcherRecorder struct {
+	events []v1.Event
+}
+
+func (d *dispatcherRecorder) DispatchToLog(ctx context.Context, bytes []byte) error {
+	return nil
+}
+
+func (d *dispatcherRecorder) Dispatch(ctx context.Context, event v1.Event) error {
+	d.events = append(d.events, event)
+	return nil
+}
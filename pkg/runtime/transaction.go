@@ -0,0 +1,414 @@
+package runtime
+
+import (
+	"context"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/tdtl"
+
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/util"
+	xjson "github.com/tkeel-io/core/pkg/util/json"
+	"github.com/tkeel-io/kit/log"
+)
+
+const (
+	defaultTxLockTimeout = 15 * time.Second
+	// txRetryInterval is the interval expired locks retry to resolve the decision.
+	txRetryInterval = time.Second
+	// txUnlockedCapacity is the max number of entities remembered without persisted locks.
+	txUnlockedCapacity = 1 << 16
+
+	// txExpire is the phase delivered by the runtime to itself on lock expiry.
+	txExpire v1.TxPhase = "expire"
+)
+
+// txLock locks an entity prepared by a transaction until the decision of the transaction is applied,
+// events of the entity arriving in the meantime are deferred. locks are persisted with the prepared state,
+// deferred events are appended to the persisted lock, so that the decision is applied after restarts of the runtime.
+type txLock struct {
+	txID    string
+	expires time.Time
+	timer   *time.Timer
+	// prepared is the entity with patches of the transaction applied, and changes the applied changes.
+	prepared Entity
+	changes  []Patch
+	deferred []v1.Event
+	// persisted is the number of deferred events persisted.
+	persisted int
+}
+
+func txLockTimeout(cfg config.TransactionConfig) time.Duration {
+	if cfg.LockTimeout <= 0 {
+		return defaultTxLockTimeout
+	}
+	return time.Duration(cfg.LockTimeout) * time.Millisecond
+}
+
+// handleTransaction handles phases of transactions and defers events of locked entities,
+// it returns false if the event should be handled as usual.
+func (r *Runtime) handleTransaction(ctx context.Context, event v1.Event) bool {
+	entityID := event.Entity()
+	txID := event.Attr(v1.MetaTxID)
+	phase := v1.TxPhase(event.Attr(v1.MetaTxPhase))
+	if phase == "" && event.Type() != v1.ETEntity && event.Type() != v1.ETSystem {
+		return false
+	}
+
+	lock := r.txLockOf(ctx, entityID)
+	switch phase {
+	case v1.TxPrepare:
+		r.prepareTransaction(ctx, event, lock)
+	case v1.TxCommit:
+		if lock == nil || lock.txID != txID {
+			// the coordinator commits once the decision is recorded, the decision has been applied on lock expiry.
+			log.L().Info("commit transaction, decision applied", logf.RID(r.id), logf.Eid(entityID), logf.ID(txID))
+			r.respondState(ctx, event)
+			return true
+		}
+
+		log.L().Info("commit transaction", logf.RID(r.id), logf.Eid(entityID), logf.ID(txID))
+		r.commitTransaction(ctx, event, lock)
+	case v1.TxAbort:
+		log.L().Info("abort transaction", logf.RID(r.id), logf.Eid(entityID), logf.ID(txID))
+		if lock != nil && lock.txID == txID {
+			r.handleDeferred(ctx, r.unlockEntity(ctx, entityID))
+		}
+		r.handleCallback(ctx, &Feed{Event: event, EntityID: entityID, State: []byte(`{}`)})
+	case txExpire:
+		if lock != nil && lock.txID == txID {
+			r.resolveTransaction(ctx, event, lock)
+		}
+	default:
+		if lock == nil {
+			return false
+		}
+		log.L().Debug("entity locked by transaction, defer event", logf.RID(r.id),
+			logf.Eid(entityID), logf.ID(event.ID()), logf.String("tx", lock.txID))
+		lock.deferred = append(lock.deferred, event)
+		if err := r.persistDeferred(ctx, entityID, lock); nil != err {
+			log.L().Error("defer event, persist transaction lock", logf.RID(r.id),
+				logf.Eid(entityID), logf.ID(event.ID()), logf.Error(err))
+		}
+	}
+	return true
+}
+
+// prepareTransaction checks patches of the transaction against the entity, and locks the entity with
+// the prepared state if they apply. entities locked by another transaction fail fast, so that transactions
+// never wait for each other.
+func (r *Runtime) prepareTransaction(ctx context.Context, event v1.Event, lock *txLock) {
+	entityID := event.Entity()
+	txID := event.Attr(v1.MetaTxID)
+	if lock != nil && lock.txID != txID {
+		log.L().Warn("prepare transaction, entity locked", logf.RID(r.id), logf.Eid(entityID),
+			logf.ID(txID), logf.String("holder", lock.txID))
+		r.handleCallback(ctx, &Feed{Err: xerrors.ErrEntityLocked, Event: event, EntityID: entityID})
+		return
+	} else if lock != nil {
+		// duplicate prepare.
+		r.handleCallback(ctx, &Feed{Event: event, EntityID: entityID, State: lock.prepared.Raw()})
+		return
+	}
+
+	state, err := r.LoadEntity(entityID)
+	if nil != err {
+		log.L().Error("prepare transaction, load entity", logf.RID(r.id),
+			logf.Eid(entityID), logf.ID(txID), logf.Error(err))
+		r.handleCallback(ctx, &Feed{Err: xerrors.ErrEntityNotFound, Event: event, EntityID: entityID})
+		return
+	}
+
+	// apply patches to a copy of the entity, the entity changes on commit.
+	e, _ := event.(v1.PatchEvent)
+	preview, err := NewEntity(entityID, state.Raw())
+	if nil != err {
+		r.handleCallback(ctx, &Feed{Err: err, Event: event, EntityID: entityID})
+		return
+	}
+	feed := preview.Handle(ctx, &Feed{
+		Event:    event,
+		State:    state.Raw(),
+		EntityID: entityID,
+		Patches:  conv(e.Patches()),
+	})
+
+	if nil == feed.Err {
+		lock = &txLock{
+			txID:     txID,
+			expires:  time.Now().Add(txLockTimeout(config.Get().Transaction)),
+			prepared: preview,
+			changes:  feed.Changes,
+		}
+
+		// participants acknowledge prepare once the lock is durable.
+		if err = r.persistLock(ctx, entityID, lock); nil != err {
+			log.L().Error("prepare transaction, persist lock", logf.RID(r.id),
+				logf.Eid(entityID), logf.ID(txID), logf.Error(err))
+			feed.Err = err
+		} else {
+			log.L().Info("prepare transaction, lock entity", logf.RID(r.id), logf.Eid(entityID), logf.ID(txID))
+			r.lockEntity(entityID, lock)
+		}
+	}
+	r.handleCallback(ctx, feed)
+}
+
+// commitTransaction applies the prepared state of the transaction, then handles deferred events.
+// the committed state is written through, the lock is kept if the write fails, so that
+// the decision is applied again on redelivery or lock expiry.
+func (r *Runtime) commitTransaction(ctx context.Context, event v1.Event, lock *txLock) {
+	entityID := event.Entity()
+	if err := r.entityResourcer.StoreHandler(ctx, lock.prepared, nil); nil != err {
+		log.L().Error("commit transaction, store entity", logf.RID(r.id), logf.Eid(entityID),
+			logf.ID(lock.txID), logf.Error(err))
+		r.handleCallback(ctx, &Feed{Err: err, Event: event, EntityID: entityID})
+		r.retryLock(entityID, lock)
+		return
+	}
+
+	r.writes.Clean(entityID)
+	execer := &Execer{
+		state: lock.prepared,
+		execFunc: &handlerImpl{fn: func(_ context.Context, feed *Feed) *Feed {
+			r.lock.Lock()
+			r.entities[entityID] = lock.prepared
+			r.lock.Unlock()
+
+			feed.State = lock.prepared.Raw()
+			feed.Changes = lock.changes
+			feed.Patches = []Patch{}
+			return feed
+		}},
+		postFuncs: r.patchedFuncs(func(ctx context.Context, feed *Feed) *Feed {
+			r.entityResourcer.PersistentEntity(ctx, lock.prepared, feed)
+			return feed
+		}),
+	}
+
+	feed := execer.Exec(ctx, &Feed{Event: event, EntityID: entityID, State: lock.prepared.Raw()})
+	if nil != feed.Err {
+		log.L().Error("commit transaction", logf.RID(r.id), logf.Eid(entityID),
+			logf.ID(lock.txID), logf.Error(feed.Err))
+	}
+
+	r.handleCallback(ctx, feed)
+	r.handleDeferred(ctx, r.unlockEntity(ctx, entityID))
+}
+
+// resolveTransaction applies the decision of the transaction whose lock expired,
+// the transaction is aborted unless the coordinator has decided.
+func (r *Runtime) resolveTransaction(ctx context.Context, event v1.Event, lock *txLock) {
+	entityID := event.Entity()
+	decided, err := r.repository.DecideTransaction(ctx, &repository.TxDecision{
+		ID:        lock.txID,
+		Decision:  repository.TxDecisionAbort,
+		Entities:  []string{entityID},
+		DecidedAt: time.Now().UnixMilli(),
+	})
+	if nil != err {
+		log.L().Error("resolve expired transaction", logf.RID(r.id),
+			logf.Eid(entityID), logf.ID(lock.txID), logf.Error(err))
+		r.retryLock(entityID, lock)
+		return
+	}
+
+	if decided.Decision == repository.TxDecisionCommit {
+		log.L().Warn("transaction lock expired, apply commit", logf.RID(r.id), logf.Eid(entityID), logf.ID(lock.txID))
+		r.commitTransaction(ctx, event, lock)
+		return
+	}
+
+	log.L().Warn("transaction lock expired, abort", logf.RID(r.id), logf.Eid(entityID), logf.ID(lock.txID))
+	r.handleDeferred(ctx, r.unlockEntity(ctx, entityID))
+}
+
+// respondState responds the current state of the entity.
+func (r *Runtime) respondState(ctx context.Context, event v1.Event) {
+	state, err := r.LoadEntity(event.Entity())
+	if nil != err {
+		r.handleCallback(ctx, &Feed{Err: xerrors.ErrEntityNotFound, Event: event, EntityID: event.Entity()})
+		return
+	}
+	r.handleCallback(ctx, &Feed{Event: event, EntityID: event.Entity(), State: state.Raw()})
+}
+
+// lockEntity holds the lock until the decision is applied or the lock expires.
+func (r *Runtime) lockEntity(entityID string, lock *txLock) {
+	delete(r.txUnlocked, entityID)
+	r.txLocks[entityID] = lock
+	lock.timer = time.AfterFunc(time.Until(lock.expires), func() {
+		r.expireLock(entityID, lock.txID)
+	})
+}
+
+// retryLock resolves the transaction again later.
+func (r *Runtime) retryLock(entityID string, lock *txLock) {
+	lock.timer.Stop()
+	lock.timer = time.AfterFunc(txRetryInterval, func() {
+		r.expireLock(entityID, lock.txID)
+	})
+}
+
+// unlockEntity releases the lock of the entity.
+func (r *Runtime) unlockEntity(ctx context.Context, entityID string) *txLock {
+	lock := r.txLocks[entityID]
+	if lock == nil {
+		return nil
+	}
+
+	lock.timer.Stop()
+	delete(r.txLocks, entityID)
+	if nil != r.repository {
+		if err := r.repository.DelTxLock(ctx, entityID, lock.txID, lock.persisted); nil != err {
+			log.L().Error("unlock entity, remove transaction lock", logf.RID(r.id),
+				logf.Eid(entityID), logf.ID(lock.txID), logf.Error(err))
+		}
+	}
+	return lock
+}
+
+// txLockOf returns the lock of the entity, locks persisted before restarts are restored
+// when the entity is not loaded, entities without persisted locks are remembered until evicted.
+func (r *Runtime) txLockOf(ctx context.Context, entityID string) *txLock {
+	if lock, ok := r.txLocks[entityID]; ok || nil == r.repository {
+		return lock
+	} else if _, loaded := r.entities[entityID]; loaded {
+		return nil
+	} else if r.txUnlocked[entityID] {
+		return nil
+	}
+
+	stored, err := r.repository.GetTxLock(ctx, entityID)
+	if nil != err {
+		if !errors.Is(err, xerrors.ErrResourceNotFound) {
+			log.L().Error("restore transaction lock", logf.RID(r.id), logf.Eid(entityID), logf.Error(err))
+			return nil
+		}
+		if nil == r.txUnlocked || len(r.txUnlocked) >= txUnlockedCapacity {
+			r.txUnlocked = make(map[string]bool)
+		}
+		r.txUnlocked[entityID] = true
+		return nil
+	}
+
+	lock, err := decodeLock(entityID, stored)
+	if nil != err {
+		log.L().Error("restore transaction lock", logf.RID(r.id), logf.Eid(entityID), logf.Error(err))
+		return nil
+	}
+
+	log.L().Info("restore transaction lock", logf.RID(r.id), logf.Eid(entityID), logf.ID(lock.txID))
+	r.lockEntity(entityID, lock)
+	return lock
+}
+
+// persistLock writes the lock with the prepared state, deferred events are appended by persistDeferred.
+func (r *Runtime) persistLock(ctx context.Context, entityID string, lock *txLock) error {
+	if nil == r.repository {
+		return nil
+	}
+
+	stored := &repository.TxLock{
+		EntityID: entityID,
+		TxID:     lock.txID,
+		Expires:  lock.expires.UnixMilli(),
+		Prepared: lock.prepared.Raw(),
+	}
+	for _, change := range lock.changes {
+		stored.Changes = append(stored.Changes, &repository.TxChange{
+			Op: change.Op.String(), Path: change.Path, Value: change.Value.Raw()})
+	}
+
+	return errors.Wrap(r.repository.PutTxLock(ctx, stored), "persist transaction lock")
+}
+
+// persistDeferred appends deferred events not persisted yet to the persisted lock in order,
+// events failed to persist are retried with the next deferred event.
+func (r *Runtime) persistDeferred(ctx context.Context, entityID string, lock *txLock) error {
+	if nil == r.repository {
+		return nil
+	}
+
+	for ; lock.persisted < len(lock.deferred); lock.persisted++ {
+		bytes, err := v1.Marshal(lock.deferred[lock.persisted])
+		if nil != err {
+			return errors.Wrap(err, "encode deferred event")
+		}
+		if err = r.repository.AppendTxDeferred(ctx, entityID, lock.txID, lock.persisted, bytes); nil != err {
+			return errors.Wrap(err, "persist deferred event")
+		}
+	}
+	return nil
+}
+
+func decodeLock(entityID string, stored *repository.TxLock) (*txLock, error) {
+	prepared, err := NewEntity(entityID, stored.Prepared)
+	if nil != err {
+		return nil, errors.Wrap(err, "decode prepared state")
+	}
+
+	lock := &txLock{
+		txID:     stored.TxID,
+		expires:  time.UnixMilli(stored.Expires),
+		prepared: prepared,
+	}
+	for _, change := range stored.Changes {
+		lock.changes = append(lock.changes, Patch{
+			Op: xjson.NewPatchOp(change.Op), Path: change.Path, Value: tdtl.New(change.Value)})
+	}
+	for _, bytes := range stored.Deferred {
+		var ev v1.ProtoEvent
+		if err = v1.Unmarshal(bytes, &ev); nil != err {
+			return nil, errors.Wrap(err, "decode deferred event")
+		}
+		lock.deferred = append(lock.deferred, &ev)
+	}
+	lock.persisted = len(lock.deferred)
+	return lock, nil
+}
+
+// handleDeferred handles events deferred by the released lock in order.
+func (r *Runtime) handleDeferred(ctx context.Context, lock *txLock) {
+	if lock == nil {
+		return
+	}
+	for _, event := range lock.deferred {
+		r.HandleEvent(ctx, event)
+	}
+}
+
+// expireLock resolves the transaction through the event loop of the runtime,
+// in case the coordinator never delivers the decision.
+func (r *Runtime) expireLock(entityID, txID string) {
+	ev := &v1.ProtoEvent{
+		Id:        util.IG().EvID(),
+		Timestamp: time.Now().UnixNano(),
+		Metadata: map[string]string{
+			v1.MetaEntityID: entityID,
+			v1.MetaTxID:     txID,
+			v1.MetaTxPhase:  string(txExpire),
+		},
+		Data: &v1.ProtoEvent_Patches{Patches: &v1.PatchDatas{}},
+	}
+	ev.SetType(v1.ETEntity)
+
+	bytes, err := v1.Marshal(ev)
+	if nil != err {
+		log.L().Error("expire transaction lock", logf.RID(r.id),
+			logf.Eid(entityID), logf.ID(txID), logf.Error(err))
+		return
+	}
+
+	log.L().Warn("transaction lock expired", logf.RID(r.id), logf.Eid(entityID), logf.ID(txID))
+	select {
+	case r.msgs <- sarama.ConsumerMessage{Value: bytes}:
+	case <-r.ctx.Done():
+	}
+}
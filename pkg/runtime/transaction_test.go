@@ -0,0 +1,184 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/runtime/mock"
+	"github.com/tkeel-io/core/pkg/types"
+	"github.com/tkeel-io/tdtl"
+)
+
+func txEvent(reqID, txID string, phase v1.TxPhase, patches ...*v1.PatchData) *v1.ProtoEvent {
+	ev := &v1.ProtoEvent{
+		Id:       reqID,
+		Callback: "http://localhost/v1/respond",
+		Metadata: map[string]string{
+			v1.MetaEntityID:  "device1",
+			v1.MetaRequestID: reqID,
+			v1.MetaTxID:      txID,
+			v1.MetaTxPhase:   string(phase),
+		},
+		Data: &v1.ProtoEvent_Patches{Patches: &v1.PatchDatas{Patches: patches}},
+	}
+	ev.SetType(v1.ETEntity)
+	return ev
+}
+
+func TestRuntime_HandleTransaction(t *testing.T) {
+	ctx := context.Background()
+	noop := func(context.Context, Entity, *Feed) error { return nil }
+	dispatcher := &callbackRecorder{}
+	rt := NewRuntime(ctx, EntityResource{StoreHandler: noop, PersistentEntity: noop, FlushHandler: noop, RemoveHandler: noop},
+		"core/1234", dispatcher, mock.NewRepo())
+	en, err := NewEntity("device1", []byte(`{"id":"device1","properties":{"temp":20}}`))
+	assert.Nil(t, err)
+	rt.entities["device1"] = en
+
+	patches := []*v1.PatchData{
+		{Path: "properties.temp", Operator: "test", Value: []byte("20")},
+		{Path: "properties.temp", Operator: "replace", Value: []byte("30")},
+	}
+	status := func(index int) string {
+		return dispatcher.events[index].Attr(v1.MetaResponseStatus)
+	}
+
+	// prepare locks the entity without changing it.
+	rt.HandleEvent(ctx, txEvent("req1", "tx1", v1.TxPrepare, patches...))
+	assert.Equal(t, string(types.StatusOK), status(0))
+	assert.Equal(t, "30", tdtl.New(dispatcher.events[0].(*v1.ProtoEvent).GetRawData()).Get("properties.temp").String())
+	assert.Equal(t, "20", en.Get("properties.temp").String())
+
+	// other transactions fail fast, other writes are deferred.
+	rt.HandleEvent(ctx, txEvent("req2", "tx2", v1.TxPrepare, patches...))
+	assert.Equal(t, xerrors.ErrEntityLocked.Error(), dispatcher.events[1].Attr(v1.MetaResponseErrCode))
+	rt.HandleEvent(ctx, txEvent("req3", "", "", &v1.PatchData{
+		Path: "properties.temp", Operator: "replace", Value: []byte("40")}))
+	assert.Len(t, dispatcher.events, 2)
+
+	// commit applies patches, then deferred writes.
+	rt.HandleEvent(ctx, txEvent("req4", "tx1", v1.TxCommit, patches...))
+	assert.Len(t, dispatcher.events, 4)
+	assert.Equal(t, "req4", dispatcher.events[2].Attr(v1.MetaRequestID))
+	assert.Equal(t, string(types.StatusOK), status(2))
+	assert.Equal(t, "req3", dispatcher.events[3].Attr(v1.MetaRequestID))
+	assert.Equal(t, "40", rt.entities["device1"].Get("properties.temp").String())
+	assert.Empty(t, rt.txLocks)
+
+	// failed preconditions do not lock the entity.
+	rt.HandleEvent(ctx, txEvent("req5", "tx3", v1.TxPrepare, patches...))
+	assert.Equal(t, xerrors.ErrPatchTestFailed.Error(), dispatcher.events[4].Attr(v1.MetaResponseErrCode))
+	assert.Empty(t, rt.txLocks)
+
+	// abort releases the lock.
+	rt.HandleEvent(ctx, txEvent("req6", "tx4", v1.TxPrepare))
+	assert.Len(t, rt.txLocks, 1)
+	rt.HandleEvent(ctx, txEvent("req7", "tx4", v1.TxAbort))
+	assert.Equal(t, string(types.StatusOK), status(6))
+	assert.Empty(t, rt.txLocks)
+}
+
+// decidedRepo returns the decision for all transactions.
+type decidedRepo struct {
+	repository.IRepository
+	decision string
+}
+
+func (r *decidedRepo) DecideTransaction(_ context.Context, decision *repository.TxDecision) (*repository.TxDecision, error) {
+	return &repository.TxDecision{ID: decision.ID, Decision: r.decision}, nil
+}
+
+func TestRuntime_TransactionRecovery(t *testing.T) {
+	ctx := context.Background()
+	repo := &decidedRepo{IRepository: mock.NewRepo(), decision: repository.TxDecisionCommit}
+	stored := make(map[string]string)
+	store := func(_ context.Context, en Entity, _ *Feed) error {
+		stored[en.ID()] = en.Get("properties.temp").String()
+		return nil
+	}
+	noop := func(context.Context, Entity, *Feed) error { return nil }
+	newRuntime := func(dispatcher *callbackRecorder) *Runtime {
+		return NewRuntime(ctx, EntityResource{StoreHandler: store, PersistentEntity: noop, FlushHandler: noop, RemoveHandler: noop},
+			"core/1234", dispatcher, repo)
+	}
+	replace := func(value string) *v1.PatchData {
+		return &v1.PatchData{Path: "properties.temp", Operator: "replace", Value: []byte(value)}
+	}
+
+	dispatcher := &callbackRecorder{}
+	rt := newRuntime(dispatcher)
+	en, err := NewEntity("device1", []byte(`{"id":"device1","properties":{"temp":20}}`))
+	assert.Nil(t, err)
+	rt.entities["device1"] = en
+	rt.HandleEvent(ctx, txEvent("req1", "tx1", v1.TxPrepare, replace("30")))
+	rt.HandleEvent(ctx, txEvent("req2", "", "", replace("40")))
+	assert.Len(t, dispatcher.events, 1)
+	rt.txLocks["device1"].timer.Stop()
+
+	// the lock with the prepared state and deferred events is restored after restarts.
+	dispatcher = &callbackRecorder{}
+	rt = newRuntime(dispatcher)
+	rt.HandleEvent(ctx, txEvent("req3", "tx1", v1.TxCommit))
+	assert.Len(t, dispatcher.events, 2)
+	assert.Equal(t, string(types.StatusOK), dispatcher.events[0].Attr(v1.MetaResponseStatus))
+	assert.Equal(t, "30", tdtl.New(dispatcher.events[0].(*v1.ProtoEvent).GetRawData()).Get("properties.temp").String())
+	assert.Equal(t, "req2", dispatcher.events[1].Attr(v1.MetaRequestID))
+	assert.Equal(t, "40", stored["device1"])
+	assert.Equal(t, "40", rt.entities["device1"].Get("properties.temp").String())
+	assert.Empty(t, rt.txLocks)
+	_, err = repo.GetTxLock(ctx, "device1")
+	assert.ErrorIs(t, err, xerrors.ErrResourceNotFound)
+
+	// expired locks apply the recorded decision, without patches re-run.
+	rt.HandleEvent(ctx, txEvent("req4", "tx2", v1.TxPrepare, replace("50")))
+	rt.HandleEvent(ctx, txEvent("req5", "", "", replace("60")))
+	rt.HandleEvent(ctx, txEvent("", "tx2", txExpire))
+	assert.Equal(t, "60", rt.entities["device1"].Get("properties.temp").String())
+	assert.Equal(t, "60", stored["device1"])
+	assert.Empty(t, rt.txLocks)
+
+	// commits delivered after the decision applied respond the current state.
+	rt.HandleEvent(ctx, txEvent("req6", "tx2", v1.TxCommit, replace("70")))
+	resp := dispatcher.events[len(dispatcher.events)-1]
+	assert.Equal(t, "req6", resp.Attr(v1.MetaRequestID))
+	assert.Equal(t, string(types.StatusOK), resp.Attr(v1.MetaResponseStatus))
+	assert.Equal(t, "60", rt.entities["device1"].Get("properties.temp").String())
+
+	// expired locks abort transactions not decided.
+	repo.decision = repository.TxDecisionAbort
+	rt.HandleEvent(ctx, txEvent("req7", "tx3", v1.TxPrepare, replace("80")))
+	rt.HandleEvent(ctx, txEvent("", "tx3", txExpire))
+	assert.Equal(t, "60", rt.entities["device1"].Get("properties.temp").String())
+	assert.Empty(t, rt.txLocks)
+}
+
+type lockCounterRepo struct {
+	repository.IRepository
+	gets int
+}
+
+func (r *lockCounterRepo) GetTxLock(ctx context.Context, entityID string) (*repository.TxLock, error) {
+	r.gets++
+	return r.IRepository.GetTxLock(ctx, entityID)
+}
+
+func TestRuntime_txLockOfUnlocked(t *testing.T) {
+	ctx := context.Background()
+	repo := &lockCounterRepo{IRepository: mock.NewRepo()}
+	rt := NewRuntime(ctx, EntityResource{}, "core/1234", &callbackRecorder{}, repo)
+
+	// entities without persisted locks are read once.
+	assert.Nil(t, rt.txLockOf(ctx, "device9"))
+	assert.Nil(t, rt.txLockOf(ctx, "device9"))
+	assert.Equal(t, 1, repo.gets)
+
+	// locked entities are not remembered unlocked.
+	rt.lockEntity("device9", &txLock{txID: "tx1"})
+	defer rt.txLocks["device9"].timer.Stop()
+	assert.NotNil(t, rt.txLockOf(ctx, "device9"))
+	assert.Empty(t, rt.txUnlocked)
+}
@@ -16,6 +16,8 @@ type Patch struct {
 	Op    xjson.PatchOp
 	Path  string
 	Value *tdtl.Collect
+	// From is the source path of move and copy.
+	From string
 }
 
 type EntityAttr interface {
@@ -0,0 +1,120 @@
+package runtime
+
+import (
+	"sort"
+	"time"
+
+	"github.com/tkeel-io/core/pkg/config"
+)
+
+// retryInterval is the delay of retrying failed writes if writes are not coalesced.
+const retryInterval = time.Second
+
+// dirtyEntity is an entity whose changes are not written to the state store.
+type dirtyEntity struct {
+	count int
+	since time.Time
+}
+
+// writeBehind coalesces state writes of entities, a dirty entity is written when it has been
+// dirty for the flush interval, when its changes reach the dirty threshold, or when a critical path changed.
+// failed writes are marked dirty again and retried by the next flush.
+// it is used by the event loop of the runtime only.
+type writeBehind struct {
+	interval time.Duration
+	maxDirty int
+	critical []string
+	dirty    map[string]*dirtyEntity
+
+	now func() time.Time
+}
+
+func newWriteBehind(cfg config.StateConfig) *writeBehind {
+	return &writeBehind{
+		interval: time.Duration(cfg.FlushInterval) * time.Millisecond,
+		maxDirty: cfg.FlushDirty,
+		critical: cfg.CriticalPaths,
+		dirty:    make(map[string]*dirtyEntity),
+		now:      time.Now,
+	}
+}
+
+// Enabled reports whether writes are coalesced.
+func (w *writeBehind) Enabled() bool {
+	return w.interval > 0
+}
+
+// Delay returns the time a dirty entity waits before written.
+func (w *writeBehind) Delay() time.Duration {
+	if w.Enabled() {
+		return w.interval
+	}
+	return retryInterval
+}
+
+// Mark records changes of the entity, returns true if the entity should be written now.
+func (w *writeBehind) Mark(entityID string, changes []Patch) bool {
+	if !w.Enabled() {
+		return true
+	} else if len(changes) == 0 {
+		return false
+	}
+
+	d, ok := w.dirty[entityID]
+	if !ok {
+		d = &dirtyEntity{since: w.now()}
+		w.dirty[entityID] = d
+	}
+
+	d.count++
+	if w.maxDirty > 0 && d.count >= w.maxDirty {
+		delete(w.dirty, entityID)
+		return true
+	}
+
+	for _, path := range w.critical {
+		if changed(changes, path) {
+			delete(w.dirty, entityID)
+			return true
+		}
+	}
+	return false
+}
+
+// Retry marks the entity dirty after its write failed.
+func (w *writeBehind) Retry(entityID string) {
+	if _, ok := w.dirty[entityID]; !ok {
+		w.dirty[entityID] = &dirtyEntity{count: 1, since: w.now()}
+	}
+}
+
+// Clean forgets the entity, whose state is written or removed by others.
+func (w *writeBehind) Clean(entityID string) {
+	delete(w.dirty, entityID)
+}
+
+// Pending reports whether changes of the entity are not written.
+func (w *writeBehind) Pending(entityID string) bool {
+	_, ok := w.dirty[entityID]
+	return ok
+}
+
+// Due returns and forgets entities dirty for the flush interval, or all dirty entities if all.
+func (w *writeBehind) Due(all bool) []string {
+	now := w.now()
+	entityIDs := make([]string, 0)
+	for entityID, d := range w.dirty {
+		if all || now.Sub(d.since) >= w.Delay() {
+			entityIDs = append(entityIDs, entityID)
+			delete(w.dirty, entityID)
+		}
+	}
+
+	sort.Strings(entityIDs)
+	return entityIDs
+}
+
+// Dirty returns the number of dirty entities.
+func (w *writeBehind) Dirty() int {
+	return len(w.dirty)
+}
@@ -0,0 +1,145 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/runtime/mock"
+	xjson "github.com/tkeel-io/core/pkg/util/json"
+)
+
+func TestWriteBehind(t *testing.T) {
+	now := time.Unix(100, 0)
+	w := newWriteBehind(config.StateConfig{FlushInterval: 1000, FlushDirty: 3,
+		CriticalPaths: []string{"properties.sysField"}})
+	w.now = func() time.Time { return now }
+
+	changes := []Patch{{Op: xjson.OpReplace, Path: "properties.temp"}}
+	assert.False(t, w.Mark("device1", changes))
+	assert.False(t, w.Mark("device1", changes))
+	assert.False(t, w.Mark("device1", nil))
+	assert.True(t, w.Mark("device1", changes))
+	assert.Equal(t, 0, w.Dirty())
+
+	// critical paths are written immediately.
+	assert.False(t, w.Mark("device2", changes))
+	assert.True(t, w.Mark("device2", []Patch{{Op: xjson.OpReplace, Path: "properties.sysField._status"}}))
+
+	assert.False(t, w.Mark("device3", changes))
+	now = now.Add(500 * time.Millisecond)
+	assert.False(t, w.Mark("device4", changes))
+	assert.Empty(t, w.Due(false))
+	now = now.Add(500 * time.Millisecond)
+	assert.Equal(t, []string{"device3"}, w.Due(false))
+	assert.Equal(t, []string{"device4"}, w.Due(true))
+
+	// failed writes are dirty again.
+	w.Retry("device5")
+	assert.Empty(t, w.Due(false))
+	now = now.Add(time.Second)
+	assert.Equal(t, []string{"device5"}, w.Due(false))
+
+	// writes through if disabled, failed writes are retried after a second.
+	w = newWriteBehind(config.StateConfig{})
+	assert.True(t, w.Mark("device1", nil))
+	assert.Equal(t, retryInterval, w.Delay())
+}
+
+func TestRuntime_FlushDirty(t *testing.T) {
+	ctx := context.Background()
+	stored := make(map[string]int)
+	store := func(_ context.Context, en Entity, _ *Feed) error {
+		stored[en.ID()]++
+		return nil
+	}
+	noop := func(context.Context, Entity, *Feed) error { return nil }
+	rt := NewRuntime(ctx, EntityResource{StoreHandler: store, PersistentEntity: noop, FlushHandler: noop,
+		RemoveHandler: noop, PurgeHandler: noop}, "core/1234", &callbackRecorder{}, mock.NewRepo())
+
+	// the event loop has started once a flush is done.
+	assert.Nil(t, rt.FlushDirty(ctx))
+	rt.writes = newWriteBehind(config.StateConfig{FlushInterval: 60000, FlushDirty: 100})
+
+	en, err := NewEntity("device1", []byte(`{"id":"device1","properties":{"temp":20}}`))
+	assert.Nil(t, err)
+	rt.entities["device1"] = en
+	for index := 0; index < 10; index++ {
+		rt.HandleEvent(ctx, txEvent("req", "", "",
+			&v1.PatchData{Path: "properties.temp", Operator: "replace", Value: []byte("30")}))
+	}
+	assert.Equal(t, 0, stored["device1"])
+	assert.Equal(t, 1, rt.writes.Dirty())
+
+	assert.Nil(t, rt.FlushDirty(ctx))
+	assert.Equal(t, 1, stored["device1"])
+	assert.Equal(t, 0, rt.writes.Dirty())
+}
+
+func TestRuntime_FlushConflict(t *testing.T) {
+	ctx := context.Background()
+	store := func(context.Context, Entity, *Feed) error { return xerrors.ErrEtagMismatch }
+	noop := func(context.Context, Entity, *Feed) error { return nil }
+	rt := NewRuntime(ctx, EntityResource{StoreHandler: store, PersistentEntity: noop, FlushHandler: noop,
+		RemoveHandler: noop, PurgeHandler: noop}, "core/1234", &callbackRecorder{}, mock.NewRepo())
+	assert.Nil(t, rt.FlushDirty(ctx))
+	rt.writes = newWriteBehind(config.StateConfig{FlushInterval: 60000, FlushDirty: 100})
+
+	en, err := NewEntity("device1", []byte(`{"id":"device1","properties":{"temp":20}}`))
+	assert.Nil(t, err)
+	rt.entities["device1"] = en
+	rt.HandleEvent(ctx, txEvent("req", "", "",
+		&v1.PatchData{Path: "properties.temp", Operator: "replace", Value: []byte("30")}))
+
+	// the entity written by another node is evicted, and reloaded by its next event.
+	assert.Nil(t, rt.FlushDirty(ctx))
+	assert.NotContains(t, rt.entities, "device1")
+	assert.Equal(t, 0, rt.writes.Dirty())
+}
+
+func TestRuntime_FlushRetry(t *testing.T) {
+	ctx := context.Background()
+	var failures int
+	stored := make(map[string]int)
+	store := func(_ context.Context, en Entity, _ *Feed) error {
+		if failures > 0 {
+			failures--
+			return errors.New("state store unavailable")
+		}
+		stored[en.ID()]++
+		return nil
+	}
+	noop := func(context.Context, Entity, *Feed) error { return nil }
+	rt := NewRuntime(ctx, EntityResource{StoreHandler: store, PersistentEntity: noop, FlushHandler: noop,
+		RemoveHandler: noop, PurgeHandler: noop}, "core/1234", &callbackRecorder{}, mock.NewRepo())
+	assert.Nil(t, rt.FlushDirty(ctx))
+	rt.writes = newWriteBehind(config.StateConfig{FlushInterval: 60000, FlushDirty: 100})
+
+	en, err := NewEntity("device1", []byte(`{"id":"device1","properties":{"temp":20}}`))
+	assert.Nil(t, err)
+	rt.entities["device1"] = en
+	rt.HandleEvent(ctx, txEvent("req", "", "",
+		&v1.PatchData{Path: "properties.temp", Operator: "replace", Value: []byte("30")}))
+
+	// failed writes stay dirty and are retried.
+	failures = 1
+	assert.NotNil(t, rt.FlushDirty(ctx))
+	assert.Equal(t, 1, rt.writes.Dirty())
+	assert.Nil(t, rt.FlushDirty(ctx))
+	assert.Equal(t, 1, stored["device1"])
+	assert.Equal(t, 0, rt.writes.Dirty())
+
+	// failed writes through are retried too.
+	rt.writes = newWriteBehind(config.StateConfig{})
+	failures = 1
+	rt.HandleEvent(ctx, txEvent("req", "", "",
+		&v1.PatchData{Path: "properties.temp", Operator: "replace", Value: []byte("40")}))
+	assert.Equal(t, 1, rt.writes.Dirty())
+	assert.Nil(t, rt.FlushDirty(ctx))
+	assert.Equal(t, 2, stored["device1"])
+}
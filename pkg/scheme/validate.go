@@ -0,0 +1,243 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheme
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+)
+
+const (
+	DefineFieldMax = "max"
+	DefineFieldMin = "min"
+)
+
+// ParseStrict parses property configs, returns error on any invalid config.
+func ParseStrict(bytes []byte) (map[string]*Config, error) {
+	configs := make(map[string]interface{})
+	if err := json.Unmarshal(bytes, &configs); nil != err {
+		return nil, errors.Wrap(xerrors.ErrSchemaInvalid, err.Error())
+	}
+
+	cfgs := make(map[string]*Config)
+	for key, val := range configs {
+		cfg, err := ParseConfigFrom(val)
+		if nil != err {
+			return nil, errors.Wrapf(xerrors.ErrSchemaInvalid, "property %s: %s", key, err.Error())
+		}
+		cfg.ID = key
+		cfgs[key] = &cfg
+	}
+	return cfgs, nil
+}
+
+// Validate checks the json decoded value against the config, null values are accepted.
+func (cfg *Config) Validate(val interface{}) error {
+	if nil == val {
+		return nil
+	}
+
+	switch cfg.Type {
+	case PropertyTypeInt:
+		num, ok := val.(float64)
+		if !ok || num != math.Trunc(num) {
+			return fmt.Errorf("%s: expect int, got %v", cfg.ID, val)
+		}
+		return cfg.validateRange(num)
+	case PropertyTypeFloat, PropertyTypeDouble:
+		num, ok := val.(float64)
+		if !ok {
+			return fmt.Errorf("%s: expect %s, got %v", cfg.ID, cfg.Type, val)
+		}
+		return cfg.validateRange(num)
+	case PropertyTypeBool:
+		if _, ok := val.(bool); !ok {
+			return fmt.Errorf("%s: expect bool, got %v", cfg.ID, val)
+		}
+	case PropertyTypeString:
+		if _, ok := val.(string); !ok {
+			return fmt.Errorf("%s: expect string, got %v", cfg.ID, val)
+		}
+	case PropertyTypeEnum:
+		switch val.(type) {
+		case string, float64:
+		default:
+			return fmt.Errorf("%s: expect enum, got %v", cfg.ID, val)
+		}
+	case PropertyTypeArray:
+		items, ok := val.([]interface{})
+		if !ok {
+			return fmt.Errorf("%s: expect array, got %v", cfg.ID, val)
+		}
+
+		define := cfg.arrayDefine()
+		if define.Length > 0 && len(items) > define.Length {
+			return fmt.Errorf("%s: array length %d exceeds %d", cfg.ID, len(items), define.Length)
+		}
+		for index, item := range items {
+			elem := define.ElemType
+			elem.ID = fmt.Sprintf("%s[%d]", cfg.ID, index)
+			if elem.Type == "" {
+				continue
+			}
+			if err := elem.Validate(item); nil != err {
+				return err
+			}
+		}
+	case PropertyTypeStruct:
+		fields, ok := val.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: expect struct, got %v", cfg.ID, val)
+		}
+
+		for id, field := range cfg.structFields() {
+			field.ID = cfg.ID + "." + id
+			if err := field.Validate(fields[id]); nil != err {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (cfg *Config) validateRange(num float64) error {
+	if max, ok := toFloat(cfg.Define[DefineFieldMax]); ok && num > max {
+		return fmt.Errorf("%s: %v exceeds max %v", cfg.ID, num, max)
+	} else if min, ok := toFloat(cfg.Define[DefineFieldMin]); ok && num < min {
+		return fmt.Errorf("%s: %v below min %v", cfg.ID, num, min)
+	}
+	return nil
+}
+
+// arrayDefine returns the array define of parsed config.
+func (cfg *Config) arrayDefine() DefineArray {
+	length, _ := toFloat(cfg.Define[DefineFieldArrayLength])
+	etype, _ := cfg.Define[DefineFieldArrayElemCfg].(Config)
+	return DefineArray{Length: int(length), ElemType: etype}
+}
+
+// structFields returns fields of parsed struct config.
+func (cfg *Config) structFields() map[string]Config {
+	fields, _ := cfg.Define[DefineFieldStructFields].(map[string]Config)
+	return fields
+}
+
+func toFloat(val interface{}) (float64, bool) {
+	switch num := val.(type) {
+	case int:
+		return float64(num), true
+	case int64:
+		return float64(num), true
+	case float64:
+		return num, true
+	}
+	return 0, false
+}
+
+// EnabledPaths returns configs with enabled_search or enabled_time_series flag by property path,
+// fields of struct properties are included.
+func EnabledPaths(cfgs map[string]*Config, flag int) map[string]*Config {
+	paths := make(map[string]*Config)
+	for id, cfg := range cfgs {
+		enabledPaths(paths, id, cfg, flag)
+	}
+	return paths
+}
+
+func enabledPaths(paths map[string]*Config, path string, cfg *Config, flag int) {
+	if (flag == EnabledFlagSearch && cfg.EnabledSearch) ||
+		(flag == EnabledFlagTimeSeries && cfg.EnabledTimeSeries) {
+		paths[path] = cfg
+	}
+
+	if cfg.Type == PropertyTypeStruct {
+		for id, field := range cfg.structFields() {
+			field := field
+			enabledPaths(paths, path+"."+id, &field, flag)
+		}
+	}
+}
+
+// SearchMapping returns the search engine mapping of properties with enabled_search.
+func SearchMapping(cfgs map[string]*Config) map[string]interface{} {
+	root := map[string]interface{}{}
+	paths := EnabledPaths(cfgs, EnabledFlagSearch)
+	keys := make([]string, 0, len(paths))
+	for path := range paths {
+		keys = append(keys, path)
+	}
+	sort.Strings(keys)
+
+	for _, path := range keys {
+		mapping := fieldMapping(paths[path])
+		if nil == mapping {
+			continue
+		}
+
+		// nest the field into properties of its parents.
+		current := root
+		segs := strings.Split(path, ".")
+		for _, seg := range segs[:len(segs)-1] {
+			parent, _ := current[seg].(map[string]interface{})
+			if nil == parent {
+				parent = map[string]interface{}{}
+				current[seg] = parent
+			}
+			props, _ := parent["properties"].(map[string]interface{})
+			if nil == props {
+				props = map[string]interface{}{}
+				parent["properties"] = props
+			}
+			current = props
+		}
+		if _, has := current[segs[len(segs)-1]]; !has {
+			current[segs[len(segs)-1]] = mapping
+		}
+	}
+	return map[string]interface{}{"properties": root}
+}
+
+func fieldMapping(cfg *Config) map[string]interface{} {
+	switch cfg.Type {
+	case PropertyTypeInt:
+		return map[string]interface{}{"type": "long"}
+	case PropertyTypeFloat:
+		return map[string]interface{}{"type": "float"}
+	case PropertyTypeDouble:
+		return map[string]interface{}{"type": "double"}
+	case PropertyTypeBool:
+		return map[string]interface{}{"type": "boolean"}
+	case PropertyTypeString:
+		return map[string]interface{}{"type": "text",
+			"fields": map[string]interface{}{"keyword": map[string]interface{}{"type": "keyword", "ignore_above": 256}}}
+	case PropertyTypeEnum:
+		return map[string]interface{}{"type": "keyword"}
+	case PropertyTypeArray:
+		elem := cfg.arrayDefine().ElemType
+		if elem.Type == "" || elem.Type == PropertyTypeStruct || elem.Type == PropertyTypeArray {
+			return nil
+		}
+		// arrays are mapped by their element type.
+		return fieldMapping(&elem)
+	}
+	return nil
+}
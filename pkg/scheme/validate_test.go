@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheme
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+)
+
+const testSchema = `{
+	"temp": {"type": "float", "enabled_time_series": true, "define": {"max": 100}},
+	"count": {"type": "int"},
+	"tags": {"type": "array", "define": {"length": 2, "elem_type": {"type": "string"}}},
+	"basicInfo": {"type": "struct", "define": {"fields": {
+		"name": {"type": "string", "enabled_search": true},
+		"online": {"type": "bool", "enabled_search": true}
+	}}}
+}`
+
+func TestConfig_Validate(t *testing.T) {
+	cfgs, err := ParseStrict([]byte(testSchema))
+	assert.Nil(t, err)
+
+	tests := []struct {
+		id      string
+		value   interface{}
+		wantErr bool
+	}{
+		{"temp", 36.5, false},
+		{"temp", 120.0, true},
+		{"temp", "36.5", true},
+		{"count", 3.0, false},
+		{"count", 3.5, true},
+		{"tags", []interface{}{"a", "b"}, false},
+		{"tags", []interface{}{"a", "b", "c"}, true},
+		{"tags", []interface{}{"a", 1.0}, true},
+		{"basicInfo", map[string]interface{}{"name": "device", "online": true}, false},
+		{"basicInfo", map[string]interface{}{"name": "device", "online": "yes"}, true},
+		{"basicInfo", map[string]interface{}{"extra": 1.0}, false},
+		{"basicInfo", nil, false},
+	}
+
+	for _, tt := range tests {
+		err := cfgs[tt.id].Validate(tt.value)
+		assert.Equal(t, tt.wantErr, nil != err, "%s: %v", tt.id, tt.value)
+	}
+
+	_, err = ParseStrict([]byte(`{"temp": {"type": "decimal"}}`))
+	assert.ErrorIs(t, err, xerrors.ErrSchemaInvalid)
+}
+
+func TestSearchMapping(t *testing.T) {
+	cfgs, err := ParseStrict([]byte(testSchema))
+	assert.Nil(t, err)
+
+	paths := EnabledPaths(cfgs, EnabledFlagTimeSeries)
+	assert.Len(t, paths, 1)
+	assert.Equal(t, PropertyTypeFloat, paths["temp"].Type)
+
+	mapping := SearchMapping(cfgs)
+	bytes, _ := json.Marshal(mapping)
+	assert.JSONEq(t, `{"properties":{"basicInfo":{"properties":{
+		"name":{"type":"text","fields":{"keyword":{"type":"keyword","ignore_above":256}}},
+		"online":{"type":"boolean"}}}}}`, string(bytes))
+}
@@ -0,0 +1,294 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/auth"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	apim "github.com/tkeel-io/core/pkg/manager"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/util"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/atomic"
+)
+
+type AlarmService struct {
+	pb.UnimplementedAlarmServer
+	ctx        context.Context
+	cancel     context.CancelFunc
+	inited     *atomic.Bool
+	apiManager apim.APIManager
+}
+
+// NewAlarmService returns a new AlarmService.
+func NewAlarmService(ctx context.Context) (*AlarmService, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &AlarmService{
+		ctx:    ctx,
+		cancel: cancel,
+		inited: atomic.NewBool(false),
+	}, nil
+}
+
+func (s *AlarmService) Init(apiManager apim.APIManager) {
+	s.apiManager = apiManager
+	s.inited.Store(true)
+}
+
+func (s *AlarmService) CreateAlarmRule(ctx context.Context, req *pb.CreateAlarmRuleRequest) (out *pb.AlarmRuleResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.ID(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	if req.Id == "" {
+		req.Id = util.UUID("alarm")
+	}
+
+	rule := makeAlarmRule(req.Id, req.Owner, req.EntityId, req.Rule)
+	if rule.Owner, err = authorizeAlarm(ctx, auth.ActionWrite, rule.Owner, rule.EntityID); nil != err {
+		return nil, errors.Wrap(err, "create alarm rule")
+	} else if err = s.apiManager.CreateAlarmRule(ctx, rule); nil != err {
+		log.L().Error("create alarm rule", logf.ID(rule.ID),
+			logf.Eid(rule.EntityID), logf.Owner(rule.Owner), logf.Error(err))
+		return nil, errors.Wrap(err, "create alarm rule")
+	}
+
+	return alarmRuleResponse(rule), nil
+}
+
+func (s *AlarmService) UpdateAlarmRule(ctx context.Context, req *pb.UpdateAlarmRuleRequest) (out *pb.AlarmRuleResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.ID(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	rule := makeAlarmRule(req.Id, req.Owner, req.EntityId, req.Rule)
+	if rule.Owner, err = authorizeAlarm(ctx, auth.ActionWrite, rule.Owner, rule.EntityID); nil != err {
+		return nil, errors.Wrap(err, "update alarm rule")
+	} else if err = s.apiManager.UpdateAlarmRule(ctx, rule); nil != err {
+		log.L().Error("update alarm rule", logf.ID(rule.ID),
+			logf.Eid(rule.EntityID), logf.Owner(rule.Owner), logf.Error(err))
+		return nil, errors.Wrap(err, "update alarm rule")
+	}
+
+	return alarmRuleResponse(rule), nil
+}
+
+func (s *AlarmService) DeleteAlarmRule(ctx context.Context, req *pb.DeleteAlarmRuleRequest) (out *pb.DeleteAlarmRuleResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.ID(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	if req.Owner, err = authorizeAlarm(ctx, auth.ActionWrite, req.Owner, req.EntityId); nil != err {
+		return nil, errors.Wrap(err, "delete alarm rule")
+	}
+
+	rule := &repository.AlarmRule{ID: req.Id, Owner: req.Owner, EntityID: req.EntityId}
+	if err = s.apiManager.DeleteAlarmRule(ctx, rule); nil != err {
+		log.L().Error("delete alarm rule", logf.ID(rule.ID),
+			logf.Eid(rule.EntityID), logf.Owner(rule.Owner), logf.Error(err))
+		return nil, errors.Wrap(err, "delete alarm rule")
+	}
+
+	return &pb.DeleteAlarmRuleResponse{Id: req.Id, Status: "ok"}, nil
+}
+
+func (s *AlarmService) GetAlarmRule(ctx context.Context, req *pb.GetAlarmRuleRequest) (out *pb.AlarmRuleResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.ID(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	if req.Owner, err = authorizeAlarm(ctx, auth.ActionRead, req.Owner, req.EntityId); nil != err {
+		return nil, errors.Wrap(err, "get alarm rule")
+	}
+
+	rule := &repository.AlarmRule{ID: req.Id, Owner: req.Owner, EntityID: req.EntityId}
+	if rule, err = s.apiManager.GetAlarmRule(ctx, rule); nil != err {
+		log.L().Error("get alarm rule", logf.ID(req.Id),
+			logf.Eid(req.EntityId), logf.Owner(req.Owner), logf.Error(err))
+		return nil, errors.Wrap(err, "get alarm rule")
+	}
+
+	return alarmRuleResponse(rule), nil
+}
+
+func (s *AlarmService) ListAlarmRule(ctx context.Context, req *pb.ListAlarmRuleRequest) (out *pb.ListAlarmRuleResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Eid(req.EntityId))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	if req.Owner, err = authorizeAlarm(ctx, auth.ActionRead, req.Owner, req.EntityId); nil != err {
+		return nil, errors.Wrap(err, "list alarm rule")
+	}
+
+	var rules []*repository.AlarmRule
+	if rules, err = s.apiManager.ListAlarmRule(ctx, &repository.ListAlarmRuleReq{
+		Owner: req.Owner, EntityID: req.EntityId}); nil != err {
+		log.L().Error("list alarm rule", logf.Eid(req.EntityId),
+			logf.Owner(req.Owner), logf.Error(err))
+		return nil, errors.Wrap(err, "list alarm rule")
+	}
+
+	out = &pb.ListAlarmRuleResponse{Count: int32(len(rules))}
+	for _, rule := range rules {
+		out.Items = append(out.Items, alarmRuleObject(rule))
+	}
+	return out, nil
+}
+
+func (s *AlarmService) ListAlarm(ctx context.Context, req *pb.ListAlarmRequest) (out *pb.ListAlarmResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Eid(req.EntityId))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	if req.Owner, err = authorizeAlarm(ctx, auth.ActionRead, req.Owner, req.EntityId); nil != err {
+		return nil, errors.Wrap(err, "list alarm")
+	}
+
+	var alarms []*repository.Alarm
+	if alarms, err = s.apiManager.ListAlarm(ctx, &repository.ListAlarmRuleReq{
+		Owner: req.Owner, EntityID: req.EntityId}); nil != err {
+		log.L().Error("list alarm", logf.Eid(req.EntityId),
+			logf.Owner(req.Owner), logf.Error(err))
+		return nil, errors.Wrap(err, "list alarm")
+	}
+
+	out = &pb.ListAlarmResponse{Count: int32(len(alarms))}
+	for _, alarm := range alarms {
+		out.Items = append(out.Items, alarmObject(alarm))
+	}
+	return out, nil
+}
+
+func (s *AlarmService) AckAlarm(ctx context.Context, req *pb.AckAlarmRequest) (out *pb.AlarmObject, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.ID(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	if req.Owner, err = authorizeAlarm(ctx, auth.ActionWrite, req.Owner, req.EntityId); nil != err {
+		return nil, errors.Wrap(err, "ack alarm")
+	}
+
+	operator := req.Operator
+	if operator == "" {
+		operator = req.Owner
+	}
+
+	var alarm *repository.Alarm
+	rule := &repository.AlarmRule{ID: req.Id, Owner: req.Owner, EntityID: req.EntityId}
+	if alarm, err = s.apiManager.AckAlarm(ctx, rule, operator); nil != err {
+		log.L().Error("ack alarm", logf.ID(req.Id),
+			logf.Eid(req.EntityId), logf.Owner(req.Owner), logf.Error(err))
+		return nil, errors.Wrap(err, "ack alarm")
+	}
+
+	return alarmObject(alarm), nil
+}
+
+func (s *AlarmService) ListAlarmHistory(ctx context.Context, req *pb.ListAlarmHistoryRequest) (out *pb.ListAlarmHistoryResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.ID(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	if req.Owner, err = authorizeAlarm(ctx, auth.ActionRead, req.Owner, req.EntityId); nil != err {
+		return nil, errors.Wrap(err, "list alarm history")
+	}
+
+	var records []*repository.AlarmRecord
+	rule := &repository.AlarmRule{ID: req.Id, Owner: req.Owner, EntityID: req.EntityId}
+	if records, err = s.apiManager.ListAlarmHistory(ctx, rule); nil != err {
+		log.L().Error("list alarm history", logf.ID(req.Id),
+			logf.Eid(req.EntityId), logf.Owner(req.Owner), logf.Error(err))
+		return nil, errors.Wrap(err, "list alarm history")
+	}
+
+	out = &pb.ListAlarmHistoryResponse{Count: int32(len(records))}
+	for _, record := range records {
+		out.Items = append(out.Items, &pb.AlarmRecordObject{
+			RuleId:    record.RuleID,
+			Severity:  record.Severity,
+			Status:    record.Status,
+			Operator:  record.Operator,
+			Value:     record.Value,
+			Timestamp: record.Timestamp,
+		})
+	}
+	return out, nil
+}
+
+// authorizeAlarm authorizes the action on alarms of the entity against the stored owner of the entity,
+// returns the authorized owner, which defaults to the tenant of the caller.
+func authorizeAlarm(ctx context.Context, action auth.Action, owner, entityID string) (string, error) {
+	res := &auth.Resource{ID: entityID, Owner: owner}
+	err := authorizeEntity(ctx, action, res)
+	return res.Owner, err
+}
+
+func makeAlarmRule(id, owner, entityID string, obj *pb.AlarmRuleObject) *repository.AlarmRule {
+	rule := &repository.AlarmRule{ID: id, Owner: owner, EntityID: entityID}
+	if nil != obj {
+		rule.Name = obj.Name
+		rule.Severity = obj.Severity
+		rule.Condition = obj.Condition
+		rule.ClearCondition = obj.ClearCondition
+		rule.Description = obj.Description
+		if rule.EntityID == "" {
+			rule.EntityID = obj.EntityId
+		}
+	}
+
+	if rule.Severity == "" {
+		rule.Severity = repository.AlarmSeverityWarning
+	}
+	return rule
+}
+
+func alarmRuleObject(rule *repository.AlarmRule) *pb.AlarmRuleObject {
+	return &pb.AlarmRuleObject{
+		Id:             rule.ID,
+		Name:           rule.Name,
+		Owner:          rule.Owner,
+		EntityId:       rule.EntityID,
+		Severity:       rule.Severity,
+		Condition:      rule.Condition,
+		ClearCondition: rule.ClearCondition,
+		Description:    rule.Description,
+	}
+}
+
+func alarmRuleResponse(rule *repository.AlarmRule) *pb.AlarmRuleResponse {
+	return &pb.AlarmRuleResponse{
+		Id:       rule.ID,
+		Owner:    rule.Owner,
+		EntityId: rule.EntityID,
+		Rule:     alarmRuleObject(rule),
+	}
+}
+
+func alarmObject(alarm *repository.Alarm) *pb.AlarmObject {
+	return &pb.AlarmObject{
+		RuleId:     alarm.RuleID,
+		Name:       alarm.Name,
+		Owner:      alarm.Owner,
+		EntityId:   alarm.EntityID,
+		Severity:   alarm.Severity,
+		Status:     alarm.Status,
+		Acked:      alarm.Acked,
+		AckedBy:    alarm.AckedBy,
+		Value:      alarm.Value,
+		ActiveTime: alarm.ActiveTime,
+		ClearTime:  alarm.ClearTime,
+		AckTime:    alarm.AckTime,
+	}
+}
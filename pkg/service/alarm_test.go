@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/repository"
+)
+
+func Test_CreateAlarmRule(t *testing.T) {
+	as, err := NewAlarmService(context.Background())
+	assert.Nil(t, err)
+
+	as.Init(apiManager)
+	res, err := as.CreateAlarmRule(context.Background(), &pb.CreateAlarmRuleRequest{
+		Owner:    "admin",
+		EntityId: "device123",
+		Rule: &pb.AlarmRuleObject{
+			Name:      "high temperature",
+			Condition: "device123.properties.temp > 80",
+		},
+	})
+
+	assert.Nil(t, err)
+	assert.NotEmpty(t, res.Id)
+	assert.Equal(t, "device123", res.EntityId)
+	assert.Equal(t, repository.AlarmSeverityWarning, res.Rule.Severity)
+}
+
+func Test_AckAlarm(t *testing.T) {
+	as, err := NewAlarmService(context.Background())
+	assert.Nil(t, err)
+
+	_, err = as.AckAlarm(context.Background(), &pb.AckAlarmRequest{Id: "alarm123"})
+	assert.NotNil(t, err)
+
+	as.Init(apiManager)
+	res, err := as.AckAlarm(context.Background(), &pb.AckAlarmRequest{
+		Id:       "alarm123",
+		Owner:    "admin",
+		EntityId: "device123",
+	})
+	assert.Nil(t, err)
+	assert.True(t, res.Acked)
+	assert.Equal(t, "admin", res.AckedBy)
+}
+
+func Test_ListAlarmHistory(t *testing.T) {
+	as, err := NewAlarmService(context.Background())
+	assert.Nil(t, err)
+
+	as.Init(apiManager)
+	res, err := as.ListAlarmHistory(context.Background(), &pb.ListAlarmHistoryRequest{
+		Id:       "alarm123",
+		Owner:    "admin",
+		EntityId: "device123",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), res.Count)
+}
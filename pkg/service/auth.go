@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/auth"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/ratelimit"
+	"github.com/tkeel-io/kit/log"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// OwnerResolver returns the stored owner of the entity, empty if the entity not exists.
+type OwnerResolver func(ctx context.Context, entityID string) (string, error)
+
+var (
+	_authorizer = auth.NewNoop()
+	_ownerOf    = OwnerResolver(func(context.Context, string) (string, error) { return "", nil })
+)
+
+// SetAuthorizer sets the authorizer services check requests with.
+func SetAuthorizer(authorizer auth.Authorizer) {
+	_authorizer = authorizer
+}
+
+// SetOwnerResolver sets the resolver services load stored owners of entities with.
+func SetOwnerResolver(resolver OwnerResolver) {
+	_ownerOf = resolver
+}
+
+// authorize authenticates the caller and checks the action on the entity,
+// the owner of the entity defaults to the tenant of the caller.
+func authorize(ctx context.Context, action auth.Action, en *Entity) error {
+	res := &auth.Resource{Owner: en.Owner, Type: en.Type, ID: en.ID}
+	err := authorizeEntity(ctx, action, res)
+	en.Owner = res.Owner
+	return err
+}
+
+// authorizeEntity checks the action on the entity against the stored owner of the entity,
+// callers claiming an owner other than the stored owner are rejected.
+func authorizeEntity(ctx context.Context, action auth.Action, res *auth.Resource) error {
+	if res.ID != "" {
+		owner, err := _ownerOf(ctx, res.ID)
+		if nil != err {
+			log.L().Error("resolve entity owner", logf.ID(res.ID), logf.Error(err))
+			return errors.Wrap(err, "resolve entity owner")
+		}
+
+		switch {
+		case owner == "":
+		case res.Owner != "" && res.Owner != owner:
+			log.L().Warn("authorize request, owner mismatch", logf.ID(res.ID),
+				logf.Owner(res.Owner), logf.String("stored", owner))
+			return errors.Wrap(xerrors.ErrPermissionDenied, "authorize")
+		default:
+			res.Owner = owner
+		}
+	}
+
+	return authorizeResource(ctx, action, res)
+}
+
+// authorizeResource authenticates the caller and checks the action on the resource,
+// the owner of the resource defaults to the tenant of the caller.
+func authorizeResource(ctx context.Context, action auth.Action, res *auth.Resource) error {
+	principal, err := _authorizer.Authenticate(ctx)
+	if nil != err {
+		log.L().Warn("authenticate request", logf.ID(res.ID), logf.Error(err))
+		return errors.Wrap(err, "authenticate")
+	}
+
+	if res.Owner == "" {
+		res.Owner = principal.Tenant
+	}
+
+	if err = _authorizer.Authorize(ctx, principal, action, res); nil != err {
+		log.L().Warn("authorize request", logf.ID(res.ID), logf.Owner(res.Owner),
+			logf.String("action", action.String()), logf.Error(err))
+		return errors.Wrap(err, "authorize")
+	}
+	return nil
+}
+
+// admit rejects writes of the entity throttled by rate limits.
+func admit(ctx context.Context, api string, en *Entity) error {
+	return errors.Wrap(ratelimit.Admit(ctx, api, en.Owner, en.ID), "admit")
+}
+
+// restrictOwner restricts search results to entities of the owner.
+func restrictOwner(req *pb.SearchRequest, owner string) {
+	req.Owner = owner
+	if owner != "" {
+		req.Condition = append(req.Condition, &pb.SearchCondition{
+			Field: "owner", Operator: "$eq", Value: structpb.NewStringValue(owner)})
+	}
+}
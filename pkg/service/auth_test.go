@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/auth"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type tenantAuthorizer struct {
+	tenant string
+}
+
+func (a *tenantAuthorizer) Authenticate(context.Context) (*auth.Principal, error) {
+	return &auth.Principal{Tenant: a.tenant}, nil
+}
+
+func (a *tenantAuthorizer) Authorize(_ context.Context, principal *auth.Principal, _ auth.Action, res *auth.Resource) error {
+	if res.Owner != principal.Tenant {
+		return xerrors.ErrPermissionDenied
+	}
+	return nil
+}
+
+func Test_authorize(t *testing.T) {
+	SetAuthorizer(&tenantAuthorizer{tenant: "admin"})
+	defer SetAuthorizer(auth.NewNoop())
+
+	_, err := entityService.GetEntity(context.Background(), &pb.GetEntityRequest{
+		Id:    "device123",
+		Owner: "other",
+	})
+	assert.ErrorIs(t, err, xerrors.ErrPermissionDenied)
+
+	// owner defaults to the tenant of the caller.
+	en := &Entity{ID: "device123"}
+	assert.Nil(t, authorize(context.Background(), auth.ActionRead, en))
+	assert.Equal(t, "admin", en.Owner)
+
+	// the stored owner is authorized, not the owner claimed by the caller.
+	SetOwnerResolver(func(_ context.Context, entityID string) (string, error) {
+		if entityID == "device123" {
+			return "other", nil
+		}
+		return "", nil
+	})
+	defer SetOwnerResolver(func(context.Context, string) (string, error) { return "", nil })
+
+	for _, owner := range []string{"admin", ""} {
+		_, err = entityService.GetEntity(context.Background(), &pb.GetEntityRequest{
+			Id: "device123", Owner: owner})
+		assert.ErrorIs(t, err, xerrors.ErrPermissionDenied)
+
+		_, err = entityService.UpdateEntityProps(context.Background(), &pb.UpdateEntityPropsRequest{
+			Id: "device123", Owner: owner, Properties: structpb.NewNullValue()})
+		assert.ErrorIs(t, err, xerrors.ErrPermissionDenied)
+	}
+	assert.ErrorIs(t, authorizeEntity(context.Background(), auth.ActionRead,
+		&auth.Resource{ID: "device123"}), xerrors.ErrPermissionDenied)
+
+	req := &pb.SearchRequest{}
+	restrictOwner(req, en.Owner)
+	assert.Equal(t, "admin", req.Owner)
+	assert.Equal(t, "owner", req.Condition[0].Field)
+}
+
+func Test_authorizeAlarmTemplate(t *testing.T) {
+	SetAuthorizer(&tenantAuthorizer{tenant: "admin"})
+	defer SetAuthorizer(auth.NewNoop())
+
+	as, err := NewAlarmService(context.Background())
+	assert.Nil(t, err)
+	as.Init(apiManager)
+	ts, err := NewTemplateService(context.Background())
+	assert.Nil(t, err)
+	ts.Init(apiManager)
+
+	// alarms and templates of other tenants are denied.
+	_, err = as.ListAlarmRule(context.Background(), &pb.ListAlarmRuleRequest{Owner: "other"})
+	assert.ErrorIs(t, err, xerrors.ErrPermissionDenied)
+	_, err = as.DeleteAlarmRule(context.Background(), &pb.DeleteAlarmRuleRequest{
+		Id: "alarm123", Owner: "other", EntityId: "device123"})
+	assert.ErrorIs(t, err, xerrors.ErrPermissionDenied)
+	_, err = ts.ListTemplate(context.Background(), &pb.ListTemplateRequest{Owner: "other"})
+	assert.ErrorIs(t, err, xerrors.ErrPermissionDenied)
+	_, err = ts.DeleteTemplate(context.Background(), &pb.DeleteTemplateRequest{Id: "tpl123", Owner: "other"})
+	assert.ErrorIs(t, err, xerrors.ErrPermissionDenied)
+
+	// the owner defaults to the tenant of the caller.
+	alarm, err := as.AckAlarm(context.Background(), &pb.AckAlarmRequest{Id: "alarm123", EntityId: "device123"})
+	assert.Nil(t, err)
+	assert.Equal(t, "admin", alarm.AckedBy)
+	_, err = ts.ListTemplate(context.Background(), &pb.ListTemplateRequest{})
+	assert.Nil(t, err)
+
+	// alarms are authorized against the stored owner of the entity.
+	SetOwnerResolver(func(_ context.Context, entityID string) (string, error) {
+		if entityID == "device123" {
+			return "other", nil
+		}
+		return "", nil
+	})
+	defer SetOwnerResolver(func(context.Context, string) (string, error) { return "", nil })
+	_, err = as.AckAlarm(context.Background(), &pb.AckAlarmRequest{Id: "alarm123", EntityId: "device123"})
+	assert.ErrorIs(t, err, xerrors.ErrPermissionDenied)
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/auth"
+	"github.com/tkeel-io/core/pkg/backup"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/atomic"
+)
+
+// backupChunkSize is the size of archive chunks streamed.
+const backupChunkSize = 256 << 10
+
+type BackupService struct {
+	pb.UnimplementedBackupServer
+	ctx     context.Context
+	cancel  context.CancelFunc
+	inited  *atomic.Bool
+	backups *backup.Service
+}
+
+// NewBackupService returns a new BackupService.
+func NewBackupService(ctx context.Context) (*BackupService, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &BackupService{
+		ctx:    ctx,
+		cancel: cancel,
+		inited: atomic.NewBool(false),
+	}, nil
+}
+
+func (s *BackupService) Init(backups *backup.Service) {
+	s.backups = backups
+	s.inited.Store(true)
+}
+
+// CreateBackup responds the archive in one message, archives of large deployments are streamed by StreamBackup.
+func (s *BackupService) CreateBackup(ctx context.Context, req *pb.CreateBackupRequest) (out *pb.CreateBackupResponse, err error) {
+	archive, err := s.export(ctx, req)
+	if nil != err {
+		return nil, errors.Wrap(err, "create backup")
+	}
+	defer archive.Close()
+
+	var buf bytes.Buffer
+	if err = archive.Stream(&buf); nil != err {
+		log.L().Error("create backup", logf.Any("owners", req.Owners),
+			logf.Any("tenants", req.Tenants), logf.Error(err))
+		return nil, errors.Wrap(err, "create backup")
+	}
+
+	return &pb.CreateBackupResponse{
+		Manifest: backupManifest(archive.Manifest),
+		Archive:  buf.Bytes(),
+	}, nil
+}
+
+// StreamBackup streams the archive in chunks, the manifest is in the first chunk.
+func (s *BackupService) StreamBackup(req *pb.CreateBackupRequest, stream pb.Backup_StreamBackupServer) error {
+	archive, err := s.export(stream.Context(), req)
+	if nil != err {
+		return errors.Wrap(err, "stream backup")
+	}
+	defer archive.Close()
+
+	writer := bufio.NewWriterSize(&chunkWriter{send: stream.Send,
+		manifest: backupManifest(archive.Manifest)}, backupChunkSize)
+	if err = archive.Stream(writer); nil == err {
+		err = writer.Flush()
+	}
+	if nil != err {
+		log.L().Error("stream backup", logf.Any("owners", req.Owners),
+			logf.Any("tenants", req.Tenants), logf.Error(err))
+		return errors.Wrap(err, "stream backup")
+	}
+	return nil
+}
+
+// StreamBackupTo streams the archive as the body of http responses.
+func (s *BackupService) StreamBackupTo(ctx context.Context, req *pb.CreateBackupRequest, respond func() io.Writer) error {
+	archive, err := s.export(ctx, req)
+	if nil != err {
+		return errors.Wrap(err, "stream backup")
+	}
+	defer archive.Close()
+
+	if err = archive.Stream(respond()); nil != err {
+		log.L().Error("stream backup", logf.Any("owners", req.Owners),
+			logf.Any("tenants", req.Tenants), logf.Error(err))
+		return errors.Wrap(err, "stream backup")
+	}
+	return nil
+}
+
+// export spools the archive of the request, the archive is removed by Close.
+func (s *BackupService) export(ctx context.Context, req *pb.CreateBackupRequest) (*backup.Archive, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Any("owners", req.Owners))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	filter := &backup.Filter{Owners: req.Owners, Tenants: req.Tenants}
+	if err := authorizeBackup(ctx, filter); nil != err {
+		return nil, err
+	}
+
+	archive, err := s.backups.Export(ctx, filter)
+	if nil != err {
+		log.L().Error("export backup", logf.Any("owners", req.Owners),
+			logf.Any("tenants", req.Tenants), logf.Error(err))
+		return nil, errors.Wrap(err, "export backup")
+	}
+	return archive, nil
+}
+
+// chunkWriter sends each write as a chunk, the manifest is sent with the first chunk.
+type chunkWriter struct {
+	send     func(*pb.BackupChunk) error
+	manifest *pb.BackupManifest
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	chunk := &pb.BackupChunk{Manifest: w.manifest, Data: p}
+	if err := w.send(chunk); nil != err {
+		return 0, errors.Wrap(err, "send backup chunk")
+	}
+	w.manifest = nil
+	return len(p), nil
+}
+
+func (s *BackupService) RestoreBackup(ctx context.Context, req *pb.RestoreBackupRequest) (out *pb.RestoreBackupResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Any("owners", req.Owners))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	filter := &backup.Filter{Owners: req.Owners, Tenants: req.Tenants}
+	if err = authorizeBackup(ctx, filter); nil != err {
+		return nil, errors.Wrap(err, "restore backup")
+	}
+
+	var ret *backup.RestoreResult
+	if ret, err = s.backups.Restore(ctx, bytes.NewReader(req.Archive),
+		backup.RestoreOptions{Filter: filter, Overwrite: req.Overwrite}); nil != err {
+		log.L().Error("restore backup", logf.Any("owners", req.Owners),
+			logf.Any("tenants", req.Tenants), logf.Error(err))
+		return nil, errors.Wrap(err, "restore backup")
+	}
+
+	out = &pb.RestoreBackupResponse{
+		Manifest: backupManifest(ret.Manifest),
+		Restored: make(map[string]int32),
+		Skipped:  make(map[string]int32),
+	}
+	for section, count := range ret.Restored {
+		out.Restored[section] = int32(count)
+	}
+	for section, count := range ret.Skipped {
+		out.Skipped[section] = int32(count)
+	}
+	return out, nil
+}
+
+// authorizeBackup checks the admin action on owners of the filter,
+// a caller of a tenant is restricted to the owner of the tenant.
+func authorizeBackup(ctx context.Context, filter *backup.Filter) error {
+	if len(filter.Owners) == 0 {
+		res := &auth.Resource{}
+		if err := authorizeResource(ctx, auth.ActionAdmin, res); nil != err {
+			return err
+		} else if res.Owner != "" {
+			filter.Owners = []string{res.Owner}
+		}
+		return nil
+	}
+
+	for _, owner := range filter.Owners {
+		if err := authorizeResource(ctx, auth.ActionAdmin, &auth.Resource{Owner: owner}); nil != err {
+			return err
+		}
+	}
+	return nil
+}
+
+func backupManifest(manifest *backup.Manifest) *pb.BackupManifest {
+	out := &pb.BackupManifest{
+		Version:   int32(manifest.Version),
+		CreatedAt: manifest.CreatedAt,
+		Revision:  manifest.Revision,
+		Owners:    manifest.Owners,
+		Tenants:   manifest.Tenants,
+	}
+	for _, section := range manifest.Sections {
+		out.Sections = append(out.Sections, &pb.BackupSection{
+			Name:   section.Name,
+			Count:  int32(section.Count),
+			Size:   section.Size,
+			Sha256: section.SHA256,
+		})
+	}
+	return out
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/auth"
+	"github.com/tkeel-io/core/pkg/config"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/kit/log"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type ConfigService struct {
+	pb.UnimplementedConfigServer
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewConfigService returns a new ConfigService.
+func NewConfigService(ctx context.Context) (*ConfigService, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &ConfigService{
+		ctx:    ctx,
+		cancel: cancel,
+	}, nil
+}
+
+// GetConfig returns the active configuration with secrets masked, the configuration
+// is shared by all tenants, so that admins of any tenant are allowed only.
+func (s *ConfigService) GetConfig(ctx context.Context, req *pb.GetConfigRequest) (*pb.GetConfigResponse, error) {
+	if err := authorizeResource(ctx, auth.ActionAdmin, &auth.Resource{Owner: auth.TenantAny}); nil != err {
+		return nil, errors.Wrap(err, "get config")
+	}
+
+	revision, loadedAt := config.Revision()
+	cfg, err := structpb.NewValue(config.Masked(config.Get()))
+	if nil != err {
+		log.L().Error("convert config", logf.Revision(revision), logf.Error(err))
+		return nil, errors.Wrap(err, "get config")
+	}
+
+	return &pb.GetConfigResponse{
+		Revision: revision,
+		LoadedAt: loadedAt.UnixMilli(),
+		Config:   cfg,
+	}, nil
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core/api/core/v1"
+)
+
+func Test_GetConfig(t *testing.T) {
+	srv, err := NewConfigService(context.Background())
+	assert.Nil(t, err)
+
+	out, err := srv.GetConfig(context.Background(), &pb.GetConfigRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), out.Revision)
+
+	cfg, _ := out.Config.AsInterface().(map[string]interface{})
+	assert.Contains(t, cfg, "components")
+	assert.Contains(t, cfg, "dispatcher")
+}
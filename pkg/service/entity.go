@@ -24,6 +24,7 @@ import (
 
 	"github.com/pkg/errors"
 	pb "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/auth"
 	xerrors "github.com/tkeel-io/core/pkg/errors"
 	logf "github.com/tkeel-io/core/pkg/logfield"
 	apim "github.com/tkeel-io/core/pkg/manager"
@@ -98,7 +99,16 @@ func (s *EntityService) CreateEntity(ctx context.Context, req *pb.CreateEntityRe
 	entity.Type = req.Type
 	entity.Source = req.Source
 	entity.TemplateID = req.From
+	entity.TemplateVersion = req.TemplateVersion
+	entity.SchemaID = strings.TrimSpace(req.SchemaId)
+	entity.SchemaVersion = req.SchemaVersion
 	parseHeaderFrom(ctx, entity)
+	if err = authorize(ctx, auth.ActionWrite, entity); nil != err {
+		return nil, errors.Wrap(err, "create entity")
+	}
+	if err = admit(ctx, "CreateEntity", entity); nil != err {
+		return nil, errors.Wrap(err, "create entity")
+	}
 	properties := req.Properties.AsInterface()
 	switch properties.(type) {
 	case map[string]interface{}:
@@ -116,7 +126,7 @@ func (s *EntityService) CreateEntity(ctx context.Context, req *pb.CreateEntityRe
 	}
 
 	var baseRet *apim.BaseRet
-	if baseRet, err = s.apiManager.CreateEntity(ctx, entity); nil != err {
+	if baseRet, err = s.apiManager.CreateEntity(ctx, entity, writeOptions(ctx)...); nil != err {
 		log.L().Error("create entity failed", logf.Eid(req.Id), logf.Error(err))
 		return out, errors.Wrap(err, "create entity failed")
 	}
@@ -140,6 +150,12 @@ func (s *EntityService) UpdateEntity(ctx context.Context, req *pb.UpdateEntityRe
 	entity.Owner = req.Owner
 	entity.Source = req.Source
 	parseHeaderFrom(ctx, entity)
+	if err = authorize(ctx, auth.ActionWrite, entity); nil != err {
+		return nil, errors.Wrap(err, "update entity")
+	}
+	if err = admit(ctx, "UpdateEntity", entity); nil != err {
+		return nil, errors.Wrap(err, "update entity")
+	}
 	patches := []*pb.PatchData{}
 
 	log.L().Debug("update entity",
@@ -173,11 +189,21 @@ func (s *EntityService) UpdateEntity(ctx context.Context, req *pb.UpdateEntityRe
 
 	if template := strings.TrimSpace(req.TemplateId); len(template) > 0 {
 		entity.TemplateID = template
-		patches = append(patches, &pb.PatchData{
-			Path:     FieldTemplate,
-			Value:    tdtl.NewString(template).Raw(),
-			Operator: xjson.OpReplace.String(),
-		})
+		entity.TemplateVersion = req.TemplateVersion
+		switch err = s.apiManager.UpdateEntityTemplate(ctx, entity); {
+		case nil == err:
+		case errors.Is(err, xerrors.ErrTemplateNotFound) && req.TemplateVersion == 0:
+			// entity as template.
+			patches = append(patches, &pb.PatchData{
+				Path:     FieldTemplate,
+				Value:    tdtl.NewString(template).Raw(),
+				Operator: xjson.OpReplace.String(),
+			})
+		default:
+			log.L().Error("update entity template failed.", logf.Eid(req.Id),
+				logf.Template(template), logf.Error(err))
+			return out, errors.Wrap(err, "update entity failed")
+		}
 	}
 
 	if len(req.Description) > 0 {
@@ -213,7 +239,7 @@ func (s *EntityService) UpdateEntity(ctx context.Context, req *pb.UpdateEntityRe
 	}
 
 	var baseRet *apim.BaseRet
-	if baseRet, _, err = s.apiManager.PatchEntity(ctx, entity, patches); nil != err {
+	if baseRet, _, err = s.apiManager.PatchEntity(ctx, entity, patches, writeOptions(ctx)...); nil != err {
 		log.L().Error("update entity failed.", logf.Eid(req.Id), logf.Error(err))
 		return out, errors.Wrap(err, "update entity failed")
 	}
@@ -236,6 +262,9 @@ func (s *EntityService) GetEntity(ctx context.Context, req *pb.GetEntityRequest)
 	entity.Owner = req.Owner
 	entity.Source = req.Source
 	parseHeaderFrom(ctx, entity)
+	if err = authorize(ctx, auth.ActionRead, entity); nil != err {
+		return nil, errors.Wrap(err, "get entity")
+	}
 
 	var baseRet *apim.BaseRet
 	if baseRet, err = s.apiManager.GetEntity(ctx, entity); nil != err {
@@ -259,6 +288,9 @@ func (s *EntityService) DeleteEntity(ctx context.Context, req *pb.DeleteEntityRe
 	entity.Owner = req.Owner
 	entity.Source = req.Source
 	parseHeaderFrom(ctx, entity)
+	if err = authorize(ctx, auth.ActionAdmin, entity); nil != err {
+		return nil, errors.Wrap(err, "delete entity")
+	}
 
 	// delete entity.
 	if err = s.apiManager.DeleteEntity(ctx, entity); nil != err {
@@ -269,6 +301,56 @@ func (s *EntityService) DeleteEntity(ctx context.Context, req *pb.DeleteEntityRe
 	return &pb.DeleteEntityResponse{Id: req.Id, Status: "ok"}, nil
 }
 
+func (s *EntityService) RestoreEntity(ctx context.Context, req *pb.RestoreEntityRequest) (out *pb.EntityResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Eid(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	entity := new(Entity)
+	entity.ID = req.Id
+	entity.Type = req.Type
+	entity.Owner = req.Owner
+	entity.Source = req.Source
+	parseHeaderFrom(ctx, entity)
+	if err = authorize(ctx, auth.ActionAdmin, entity); nil != err {
+		return nil, errors.Wrap(err, "restore entity")
+	}
+
+	var baseRet *apim.BaseRet
+	if baseRet, err = s.apiManager.RestoreEntity(ctx, entity); nil != err {
+		log.L().Error("restore entity", logf.Error(err), logf.Eid(req.Id))
+		return nil, errors.Wrap(err, "restore entity")
+	}
+
+	out, err = s.makeResponse(baseRet)
+	return out, errors.Wrap(err, "restore entity")
+}
+
+func (s *EntityService) PurgeEntity(ctx context.Context, req *pb.PurgeEntityRequest) (out *pb.PurgeEntityResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Eid(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	entity := new(Entity)
+	entity.ID = req.Id
+	entity.Type = req.Type
+	entity.Owner = req.Owner
+	entity.Source = req.Source
+	parseHeaderFrom(ctx, entity)
+	if err = authorize(ctx, auth.ActionAdmin, entity); nil != err {
+		return nil, errors.Wrap(err, "purge entity")
+	}
+
+	if err = s.apiManager.PurgeEntity(ctx, entity); nil != err {
+		log.L().Error("purge entity", logf.Error(err), logf.Eid(req.Id))
+		return nil, errors.Wrap(err, "purge entity")
+	}
+
+	return &pb.PurgeEntityResponse{Id: req.Id, Status: "ok"}, nil
+}
+
 func (s *EntityService) UpdateEntityProps(ctx context.Context, req *pb.UpdateEntityPropsRequest) (out *pb.EntityResponse, err error) {
 	if !s.inited.Load() {
 		log.L().Warn("service not ready", logf.Eid(req.Id))
@@ -281,6 +363,12 @@ func (s *EntityService) UpdateEntityProps(ctx context.Context, req *pb.UpdateEnt
 	entity.Owner = req.Owner
 	entity.Source = req.Source
 	parseHeaderFrom(ctx, entity)
+	if err = authorize(ctx, auth.ActionWrite, entity); nil != err {
+		return nil, errors.Wrap(err, "update entity props")
+	}
+	if err = admit(ctx, "UpdateEntityProps", entity); nil != err {
+		return nil, errors.Wrap(err, "update entity props")
+	}
 	properties := req.Properties.AsInterface()
 	switch properties.(type) {
 	case map[string]interface{}:
@@ -306,7 +394,7 @@ func (s *EntityService) UpdateEntityProps(ctx context.Context, req *pb.UpdateEnt
 	}}
 
 	var baseRet *apim.BaseRet
-	if baseRet, _, err = s.apiManager.PatchEntity(ctx, entity, patches); nil != err {
+	if baseRet, _, err = s.apiManager.PatchEntity(ctx, entity, patches, writeOptions(ctx)...); nil != err {
 		log.L().Error("update entity properties.", logf.Eid(req.Id), logf.Error(err))
 		return out, errors.Wrap(err, "update entity properties")
 	}
@@ -327,25 +415,110 @@ func (s *EntityService) PatchEntityProps(ctx context.Context, req *pb.PatchEntit
 	entity.Owner = req.Owner
 	entity.Source = req.Source
 	parseHeaderFrom(ctx, entity)
+	if err = authorize(ctx, auth.ActionWrite, entity); nil != err {
+		return nil, errors.Wrap(err, "patch entity props")
+	}
+	if err = admit(ctx, "PatchEntityProps", entity); nil != err {
+		return nil, errors.Wrap(err, "patch entity props")
+	}
+
+	var patches []*pb.PatchData
+	if patches, err = propPatches(req.Id, req.Properties); nil != err {
+		return nil, errors.Wrap(err, "patch entity properties")
+	}
+
+	var rawEntity []byte
+	var baseRet *apim.BaseRet
+	if baseRet, rawEntity, err = s.apiManager.PatchEntity(ctx, entity, patches, writeOptions(ctx)...); nil != err {
+		log.L().Error("patch entity properties.", logf.Eid(req.Id), logf.Error(err))
+		return nil, errors.Wrap(err, "patch entity properties")
+	}
 
+	// clip copy properties.
+	if properties, cpflag, innerErr := CopyFrom(rawEntity, patches...); nil != innerErr {
+		log.L().Warn("patch entity properties.", logf.Eid(req.Id), logf.Reason(innerErr.Error()))
+	} else if cpflag {
+		baseRet.Properties = properties
+	}
+
+	out, err = s.makeResponse(baseRet)
+	return out, errors.Wrap(err, "patch entity properties")
+}
+
+func (s *EntityService) PatchEntityPropsZ(ctx context.Context, req *pb.PatchEntityPropsRequest) (out *pb.EntityResponse, err error) {
+	return s.PatchEntityProps(ctx, req)
+}
+
+// TransactEntities patches properties of multiple entities atomically.
+func (s *EntityService) TransactEntities(ctx context.Context, req *pb.TransactEntitiesRequest) (out *pb.TransactEntitiesResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready")
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	txs := make([]*apim.TxEntity, 0, len(req.Entities))
+	for _, item := range req.Entities {
+		entity := new(Entity)
+		entity.ID = item.Id
+		entity.Type = item.Type
+		entity.Owner = item.Owner
+		entity.Source = item.Source
+		if err = authorize(ctx, auth.ActionWrite, entity); nil != err {
+			return nil, errors.Wrap(err, "transact entities")
+		}
+		if err = admit(ctx, "TransactEntities", entity); nil != err {
+			return nil, errors.Wrap(err, "transact entities")
+		}
+
+		var patches []*pb.PatchData
+		if patches, err = propPatches(item.Id, item.Properties); nil != err {
+			return nil, errors.Wrap(err, "transact entities")
+		}
+		txs = append(txs, &apim.TxEntity{Base: entity, Patches: patches})
+	}
+
+	var result *apim.TxResult
+	if result, err = s.apiManager.TransactEntities(ctx, txs); nil != err {
+		log.L().Error("transact entities", logf.Error(err))
+		return nil, errors.Wrap(err, "transact entities")
+	}
+
+	out = &pb.TransactEntitiesResponse{
+		Id:           result.ID,
+		Status:       result.Status,
+		FailedEntity: result.FailedEntity,
+		Reason:       result.Reason,
+	}
+	for _, baseRet := range result.Entities {
+		var en *pb.EntityResponse
+		if en, err = s.makeResponse(baseRet); nil != err {
+			return nil, errors.Wrap(err, "transact entities")
+		}
+		out.Entities = append(out.Entities, en)
+	}
+	return out, nil
+}
+
+// propPatches decodes patches of entity properties.
+func propPatches(id string, properties *structpb.Value) ([]*pb.PatchData, error) {
 	patches := []*pb.PatchData{}
-	params := req.Properties.AsInterface()
+	params := properties.AsInterface()
 	switch params.(type) {
 	case []interface{}:
-		var data []byte
 		patchData := make([]PatchData, 0)
-		if data, err = json.Marshal(params); nil != err {
-			log.L().Error("patch entity properties.", logf.Eid(req.Id), logf.Error(err))
+		data, err := json.Marshal(params)
+		if nil != err {
+			log.L().Error("patch entity properties.", logf.Eid(id), logf.Error(err))
 			return nil, errors.Wrap(err, "json marshal patch data")
 		} else if err = json.Unmarshal(data, &patchData); nil != err {
-			log.L().Error("patch entity properties.", logf.Eid(req.Id), logf.Error(err))
+			log.L().Error("patch entity properties.", logf.Eid(id), logf.Error(err))
 			return nil, errors.Wrap(err, "json unmarshal patch data")
 		}
 
 		for index := range patchData {
 			var bytes []byte
 			if err = checkPatchData(patchData[index]); nil != err {
-				log.L().Error("patch entity properties.", logf.Eid(req.Id), logf.Error(err))
+				log.L().Error("patch entity properties.", logf.Eid(id), logf.Error(err))
 				return nil, errors.Wrap(err, "patch entity properties")
 			} else if bytes, err = json.Marshal(patchData[index].Value); nil != err {
 				return nil, errors.Wrap(err, "encode property")
@@ -355,33 +528,14 @@ func (s *EntityService) PatchEntityProps(ctx context.Context, req *pb.PatchEntit
 				Path:     propKey(patchData[index].Path),
 				Operator: patchData[index].Operator,
 				Value:    bytes,
+				From:     fromKey(patchData[index].From, propKey),
 			})
 		}
 	default:
-		log.L().Error("patch entity properties.", logf.Eid(req.Id), logf.Error(xerrors.ErrInvalidRequest))
+		log.L().Error("patch entity properties.", logf.Eid(id), logf.Error(xerrors.ErrInvalidRequest))
 		return nil, xerrors.ErrInvalidRequest
 	}
-
-	var rawEntity []byte
-	var baseRet *apim.BaseRet
-	if baseRet, rawEntity, err = s.apiManager.PatchEntity(ctx, entity, patches); nil != err {
-		log.L().Error("patch entity properties.", logf.Eid(req.Id), logf.Error(err))
-		return nil, errors.Wrap(err, "patch entity properties")
-	}
-
-	// clip copy properties.
-	if properties, cpflag, innerErr := CopyFrom(rawEntity, patches...); nil != innerErr {
-		log.L().Warn("patch entity properties.", logf.Eid(req.Id), logf.Reason(err.Error()))
-	} else if cpflag {
-		baseRet.Properties = properties
-	}
-
-	out, err = s.makeResponse(baseRet)
-	return out, errors.Wrap(err, "patch entity properties")
-}
-
-func (s *EntityService) PatchEntityPropsZ(ctx context.Context, req *pb.PatchEntityPropsRequest) (out *pb.EntityResponse, err error) {
-	return s.PatchEntityProps(ctx, req)
+	return patches, nil
 }
 
 func checkPatchData(patchData PatchData) error {
@@ -390,9 +544,30 @@ func checkPatchData(patchData PatchData) error {
 	} else if !xjson.IsValidPath(patchData.Path) {
 		return xerrors.ErrPatchPathInvalid
 	}
+
+	switch patchData.Operator {
+	case xjson.OpMove.String():
+		// a property can not be moved into one of its children.
+		if !xjson.IsValidPath(patchData.From) ||
+			strings.HasPrefix(patchData.Path+".", patchData.From+".") {
+			return xerrors.ErrPatchFromInvalid
+		}
+	case xjson.OpCopy.String():
+		if patchData.From != "" && !xjson.IsValidPath(patchData.From) {
+			return xerrors.ErrPatchFromInvalid
+		}
+	}
 	return nil
 }
 
+// fromKey returns the source key of move and copy, empty if not set.
+func fromKey(from string, keyFn func(string) string) string {
+	if from == "" {
+		return ""
+	}
+	return keyFn(from)
+}
+
 func (s *EntityService) GetEntityProps(ctx context.Context, in *pb.GetEntityPropsRequest) (out *pb.EntityResponse, err error) {
 	if !s.inited.Load() {
 		log.L().Warn("service not ready", logf.Eid(in.Id))
@@ -405,6 +580,9 @@ func (s *EntityService) GetEntityProps(ctx context.Context, in *pb.GetEntityProp
 	entity.Owner = in.Owner
 	entity.Source = in.Source
 	parseHeaderFrom(ctx, entity)
+	if err = authorize(ctx, auth.ActionRead, entity); nil != err {
+		return nil, errors.Wrap(err, "get entity props")
+	}
 
 	var propKeys []string
 	if pidsStr := strings.TrimSpace(in.PropertyKeys); len(pidsStr) > 0 {
@@ -445,6 +623,12 @@ func (s *EntityService) RemoveEntityProps(ctx context.Context, in *pb.RemoveEnti
 	entity.Owner = in.Owner
 	entity.Source = in.Source
 	parseHeaderFrom(ctx, entity)
+	if err = authorize(ctx, auth.ActionWrite, entity); nil != err {
+		return nil, errors.Wrap(err, "remove entity props")
+	}
+	if err = admit(ctx, "RemoveEntityProps", entity); nil != err {
+		return nil, errors.Wrap(err, "remove entity props")
+	}
 
 	var propertyKeys []string
 	if propertyKeys = strings.Split(strings.TrimSpace(in.PropertyKeys), ","); len(propertyKeys) == 0 {
@@ -462,7 +646,7 @@ func (s *EntityService) RemoveEntityProps(ctx context.Context, in *pb.RemoveEnti
 
 	var baseRet *apim.BaseRet
 	// get entity from entity manager.
-	if baseRet, _, err = s.apiManager.PatchEntity(ctx, entity, patches); nil != err {
+	if baseRet, _, err = s.apiManager.PatchEntity(ctx, entity, patches, writeOptions(ctx)...); nil != err {
 		log.L().Error("patch entity failed.", logf.Eid(in.Id), logf.Error(err))
 		return out, errors.Wrap(err, "remove entity properties")
 	}
@@ -484,6 +668,12 @@ func (s *EntityService) UpdateEntityConfigs(ctx context.Context, in *pb.UpdateEn
 	entity.Owner = in.Owner
 	entity.Source = in.Source
 	parseHeaderFrom(ctx, entity)
+	if err = authorize(ctx, auth.ActionWrite, entity); nil != err {
+		return nil, errors.Wrap(err, "update entity configs")
+	}
+	if err = admit(ctx, "UpdateEntityConfigs", entity); nil != err {
+		return nil, errors.Wrap(err, "update entity configs")
+	}
 	param := in.Configs.AsInterface()
 	switch param.(type) {
 	// TODO: 这里在后面调整 API 的时候换成 map[string]interfae{}.
@@ -510,7 +700,7 @@ func (s *EntityService) UpdateEntityConfigs(ctx context.Context, in *pb.UpdateEn
 
 	// set entity configs.
 	var baseRet *apim.BaseRet
-	if baseRet, _, err = s.apiManager.PatchEntity(ctx, entity, patches); nil != err {
+	if baseRet, _, err = s.apiManager.PatchEntity(ctx, entity, patches, writeOptions(ctx)...); nil != err {
 		log.L().Error("update entity scheme", logf.Eid(in.Id), logf.Error(err))
 		return out, errors.Wrap(err, "update entity scheme")
 	}
@@ -531,6 +721,12 @@ func (s *EntityService) PatchEntityConfigs(ctx context.Context, in *pb.PatchEnti
 	entity.Owner = in.Owner
 	entity.Source = in.Source
 	parseHeaderFrom(ctx, entity)
+	if err = authorize(ctx, auth.ActionWrite, entity); nil != err {
+		return nil, errors.Wrap(err, "patch entity configs")
+	}
+	if err = admit(ctx, "PatchEntityConfigs", entity); nil != err {
+		return nil, errors.Wrap(err, "patch entity configs")
+	}
 
 	var patches []*pb.PatchData
 	param := in.Configs.AsInterface()
@@ -549,7 +745,7 @@ func (s *EntityService) PatchEntityConfigs(ctx context.Context, in *pb.PatchEnti
 				return nil, errors.Wrap(err, "patch entity scheme")
 			}
 
-			var cfg scheme.Config
+			var cfg interface{} = scheme.Config{}
 			switch value := patchData[index].Value.(type) {
 			case map[string]interface{}:
 				if cfg, err = scheme.ParseConfigFrom(value); nil != err {
@@ -558,6 +754,11 @@ func (s *EntityService) PatchEntityConfigs(ctx context.Context, in *pb.PatchEnti
 				}
 			}
 
+			// test compares the configuration as it is.
+			if patchData[index].Operator == xjson.OpTest.String() {
+				cfg = patchData[index].Value
+			}
+
 			var bytes []byte
 			if bytes, err = json.Marshal(cfg); nil != err {
 				log.L().Error("json marshal", logf.Error(err), logf.Eid(in.Id))
@@ -567,6 +768,7 @@ func (s *EntityService) PatchEntityConfigs(ctx context.Context, in *pb.PatchEnti
 				Path:     schemeKey(patchData[index].Path),
 				Operator: patchData[index].Operator,
 				Value:    bytes,
+				From:     fromKey(patchData[index].From, schemeKey),
 			})
 		}
 
@@ -580,7 +782,7 @@ func (s *EntityService) PatchEntityConfigs(ctx context.Context, in *pb.PatchEnti
 
 	var rawEntity []byte
 	var baseRet *apim.BaseRet
-	opts := []apim.Option{apim.NewPathConstructorOption(pb.PCScheme)}
+	opts := append([]apim.Option{apim.NewPathConstructorOption(pb.PCScheme)}, writeOptions(ctx)...)
 	if baseRet, rawEntity, err = s.apiManager.PatchEntity(ctx, entity, patches, opts...); nil != err {
 		log.L().Error("patch entity scheme", logf.Eid(in.Id), logf.Error(err))
 		return nil, errors.Wrap(err, "patch entity scheme")
@@ -618,6 +820,9 @@ func (s *EntityService) GetEntityConfigs(ctx context.Context, in *pb.GetEntityCo
 	entity.Owner = in.Owner
 	entity.Source = in.Source
 	parseHeaderFrom(ctx, entity)
+	if err = authorize(ctx, auth.ActionRead, entity); nil != err {
+		return nil, errors.Wrap(err, "get entity configs")
+	}
 
 	// set properties.
 	var propKeys []string
@@ -665,6 +870,12 @@ func (s *EntityService) RemoveEntityConfigs(ctx context.Context, in *pb.RemoveEn
 	entity.Owner = in.Owner
 	entity.Source = in.Source
 	parseHeaderFrom(ctx, entity)
+	if err = authorize(ctx, auth.ActionWrite, entity); nil != err {
+		return nil, errors.Wrap(err, "remove entity configs")
+	}
+	if err = admit(ctx, "RemoveEntityConfigs", entity); nil != err {
+		return nil, errors.Wrap(err, "remove entity configs")
+	}
 
 	// set properties.
 	propertyIDs := strings.Split(in.PropertyKeys, ",")
@@ -677,7 +888,7 @@ func (s *EntityService) RemoveEntityConfigs(ctx context.Context, in *pb.RemoveEn
 	}
 
 	var baseRet *apim.BaseRet
-	if baseRet, _, err = s.apiManager.PatchEntity(ctx, entity, patches); nil != err {
+	if baseRet, _, err = s.apiManager.PatchEntity(ctx, entity, patches, writeOptions(ctx)...); nil != err {
 		log.L().Error("patch entity scheme", logf.Eid(in.Id), logf.Error(err))
 		return nil, errors.Wrap(err, "patch entity scheme")
 	}
@@ -692,13 +903,20 @@ func (s *EntityService) ListEntity(ctx context.Context, req *pb.ListEntityReques
 		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
 	}
 
+	res := &auth.Resource{Owner: req.Owner}
+	if err = authorizeResource(ctx, auth.ActionRead, res); nil != err {
+		return nil, errors.Wrap(err, "list entity")
+	}
+
 	searchReq := &pb.SearchRequest{}
+	searchReq.Source = req.Source
 	searchReq.Query = req.Query
 	searchReq.PageNum = req.PageNum
 	searchReq.PageSize = req.PageSize
 	searchReq.IsDescending = req.IsDescending
 	searchReq.OrderBy = req.OrderBy
 	searchReq.Condition = req.Condition
+	restrictOwner(searchReq, res.Owner)
 
 	// 调用接口改了后去掉
 	if searchReq.OrderBy != "" && searchReq.OrderBy != "id" {
@@ -731,6 +949,13 @@ func (s *EntityService) ListEntity(ctx context.Context, req *pb.ListEntityReques
 			baseRet.Owner = interface2string(kv["owner"])
 			baseRet.Type = interface2string(kv["type"])
 			baseRet.TemplateID = interface2string(kv["template_id"])
+			if version, ok := kv["template_version"].(float64); ok {
+				baseRet.TemplateVersion = int64(version)
+			}
+			baseRet.SchemaID = interface2string(kv["schema_id"])
+			if version, ok := kv["schema_version"].(float64); ok {
+				baseRet.SchemaVersion = int64(version)
+			}
 			baseRet.Properties = make(map[string]interface{})
 			baseRet.Properties["sysField"] = kv["sysField"]
 			baseRet.Properties["basicInfo"] = kv["basicInfo"]
@@ -840,17 +1065,31 @@ func (s *EntityService) makeResponse(base *apim.BaseRet) (out *pb.EntityResponse
 	out.Version = base.Version
 	out.LastTime = base.LastTime
 	out.TemplateId = base.TemplateID
+	out.TemplateVersion = base.TemplateVersion
+	out.SchemaId = base.SchemaID
+	out.SchemaVersion = base.SchemaVersion
 	out.Description = base.Description
+	out.RequestId = base.RequestID
 	return out, nil
 }
 
+// CopyFrom clips values of copy patches from the entity state, nothing is clipped
+// without state, like states of async writes.
 func CopyFrom(raw []byte, patches ...*pb.PatchData) (map[string]interface{}, bool, error) {
 	var cpFlag bool
+	if len(raw) == 0 {
+		return nil, false, nil
+	}
+
 	cc := tdtl.New(raw)
 	result := make(map[string]interface{})
 	for _, patch := range patches {
+		// copy from another property patches the entity, not clips it.
 		switch patch.Operator {
 		case xjson.OpCopy.String():
+			if patch.From != "" {
+				continue
+			}
 			cpFlag = true
 			var val interface{}
 			if ret := cc.Get(patch.Path); ret.Error() != nil {
@@ -880,7 +1119,8 @@ func CopyFrom2(raw []byte, paths ...string) (map[string]interface{}, bool, error
 }
 
 func (s *EntityService) onTemplateChanged(ctx context.Context, en *Entity) error {
-	if en.TemplateID == "" {
+	// template resources are applied by api manager.
+	if en.TemplateID == "" || en.TemplateVersion > 0 {
 		return nil
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/pkg/errors"
 	"github.com/tkeel-io/core/pkg/resource"
 	"github.com/tkeel-io/core/pkg/resource/store"
 )
@@ -62,10 +63,13 @@ func (e *entityHistory) GetLatestEntities(user string) []string {
 	return e.bytes2stringList(item.Value)
 }
 
-func NewEntityHistory(metadata resource.Metadata, maxcount int) EntityHistory {
-	e := &entityHistory{
-		count: maxcount,
+func NewEntityHistory(metadata resource.Metadata, maxcount int) (EntityHistory, error) {
+	storeClient, err := store.NewStore(metadata)
+	if nil != err {
+		return nil, errors.Wrap(err, "new entity history")
 	}
-	e.store = store.NewStore(metadata)
-	return e
+	return &entityHistory{
+		count: maxcount,
+		store: storeClient,
+	}, nil
 }
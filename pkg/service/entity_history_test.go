@@ -19,7 +19,8 @@ func Test_entityHistory_AddEnity(t *testing.T) {
 		entityID string
 	}
 	metadata := resource.Metadata{Name: "memory"}
-	storeTest := store.NewStore(metadata)
+	storeTest, err := store.NewStore(metadata)
+	assert.Nil(t, err)
 	tests := []struct {
 		name   string
 		fields fields
@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
 	apim "github.com/tkeel-io/core/pkg/manager"
 	"github.com/tkeel-io/core/pkg/service/mock"
 	"github.com/tkeel-io/kit/log"
@@ -76,6 +77,37 @@ func Test_PatchEntity(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_TransactEntities(t *testing.T) {
+	properties, err := structpb.NewValue([]interface{}{
+		map[string]interface{}{"path": "group", "operator": "replace", "value": "g2"},
+	})
+	assert.Nil(t, err)
+	out, err := entityService.TransactEntities(context.Background(), &pb.TransactEntitiesRequest{
+		Entities: []*pb.TransactEntity{
+			{Id: "device1", Owner: "admin", Type: "DEVICE", Properties: properties},
+			{Id: "device2", Owner: "admin", Type: "DEVICE", Properties: properties},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, apim.TxCommitted, out.Status)
+	assert.Len(t, out.Entities, 2)
+
+	_, err = entityService.TransactEntities(context.Background(), &pb.TransactEntitiesRequest{
+		Entities: []*pb.TransactEntity{{Id: "device1", Owner: "admin", Properties: structpb.NewStringValue("group")}},
+	})
+	assert.ErrorIs(t, err, xerrors.ErrInvalidRequest)
+}
+
+func Test_checkPatchData(t *testing.T) {
+	assert.Nil(t, checkPatchData(PatchData{Path: "temp", Operator: "test", Value: 20}))
+	assert.Nil(t, checkPatchData(PatchData{Path: "temp", Operator: "copy"}))
+	assert.Nil(t, checkPatchData(PatchData{Path: "temp_bak", Operator: "copy", From: "temp"}))
+	assert.Nil(t, checkPatchData(PatchData{Path: "metrics.temp", Operator: "move", From: "temp"}))
+	assert.ErrorIs(t, checkPatchData(PatchData{Path: "temp", Operator: "move"}), xerrors.ErrPatchFromInvalid)
+	assert.ErrorIs(t, checkPatchData(PatchData{Path: "metrics.cpu", Operator: "move", From: "metrics"}), xerrors.ErrPatchFromInvalid)
+	assert.ErrorIs(t, checkPatchData(PatchData{Path: "temp", Operator: "patch"}), xerrors.ErrJSONPatchReservedOp)
+}
+
 func Test_DeleteEntity(t *testing.T) {
 	_, err := entityService.DeleteEntity(context.Background(), &pb.DeleteEntityRequest{
 		Id:     "device123",
@@ -86,6 +118,28 @@ func Test_DeleteEntity(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_RestoreEntity(t *testing.T) {
+	out, err := entityService.RestoreEntity(context.Background(), &pb.RestoreEntityRequest{
+		Id:     "device123",
+		Owner:  "admin",
+		Type:   "DEVICE",
+		Source: "dm",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "device123", out.Id)
+}
+
+func Test_PurgeEntity(t *testing.T) {
+	out, err := entityService.PurgeEntity(context.Background(), &pb.PurgeEntityRequest{
+		Id:     "device123",
+		Owner:  "admin",
+		Type:   "DEVICE",
+		Source: "dm",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "ok", out.Status)
+}
+
 func Test_GetEntityProps(t *testing.T) {
 	_, err := entityService.GetEntityProps(context.Background(), &pb.GetEntityPropsRequest{
 		Id:           "device123",
@@ -143,6 +197,18 @@ func Test_RemoveMapper(t *testing.T) {
 	t.Log(err)
 }
 
+func Test_EvaluateExpression(t *testing.T) {
+	out, err := entityService.EvaluateExpression(context.Background(), &pb.EvaluateExpressionReq{
+		EntityId:   "device123",
+		Owner:      "admin",
+		Source:     "dm",
+		Path:       "temp",
+		Expression: "device234.temp + 1",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "", out.Error)
+}
+
 func Test_SetConfigs(t *testing.T) {
 	configs := map[string]interface{}{
 		"configs1": []interface{}{
@@ -6,11 +6,14 @@ import (
 
 	"github.com/pkg/errors"
 	pb "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/auth"
 	xerrors "github.com/tkeel-io/core/pkg/errors"
 	logf "github.com/tkeel-io/core/pkg/logfield"
 	apim "github.com/tkeel-io/core/pkg/manager"
 	"github.com/tkeel-io/core/pkg/repository"
 	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func (s *EntityService) AppendExpression(ctx context.Context, req *pb.AppendExpressionReq) (out *pb.AppendExpressionResp, err error) {
@@ -24,6 +27,9 @@ func (s *EntityService) AppendExpression(ctx context.Context, req *pb.AppendExpr
 		Owner:  req.Owner,
 		Source: req.Source}
 	parseHeaderFrom(ctx, &en)
+	if err = authorize(ctx, auth.ActionWrite, &en); nil != err {
+		return nil, errors.Wrap(err, "append expression")
+	}
 
 	log.L().Debug("append expression", logf.Owner(req.Owner),
 		logf.Eid(req.EntityId), logf.Value(req.Expressions))
@@ -39,6 +45,7 @@ func (s *EntityService) AppendExpression(ctx context.Context, req *pb.AppendExpr
 	if err = s.apiManager.AppendExpression(ctx, expressions); nil != err {
 		log.L().Error("append expressions",
 			logf.Eid(req.EntityId), logf.Owner(req.Owner), logf.Error(err))
+		return nil, errors.Wrap(err, "append expression")
 	}
 
 	return &pb.AppendExpressionResp{
@@ -59,6 +66,9 @@ func (s *EntityService) RemoveExpression(ctx context.Context, req *pb.RemoveExpr
 		Owner:  req.Owner,
 		Source: req.Source}
 	parseHeaderFrom(ctx, &en)
+	if err = authorize(ctx, auth.ActionWrite, &en); nil != err {
+		return nil, errors.Wrap(err, "remove expression")
+	}
 
 	log.L().Debug("remove expression", logf.Owner(en.Owner),
 		logf.Eid(en.ID), logf.Path(req.Paths))
@@ -102,6 +112,9 @@ func (s *EntityService) GetExpression(ctx context.Context, in *pb.GetExpressionR
 		Owner:  in.Owner,
 		Source: in.Source}
 	parseHeaderFrom(ctx, &en)
+	if err = authorize(ctx, auth.ActionRead, &en); nil != err {
+		return nil, errors.Wrap(err, "get expression")
+	}
 
 	var expr *repository.Expression
 	if expr, err = s.apiManager.GetExpression(ctx,
@@ -133,6 +146,9 @@ func (s *EntityService) ListExpression(ctx context.Context, in *pb.ListExpressio
 		Owner:  in.Owner,
 		Source: in.Source}
 	parseHeaderFrom(ctx, &en)
+	if err = authorize(ctx, auth.ActionRead, &en); nil != err {
+		return nil, errors.Wrap(err, "list expression")
+	}
 
 	var exprs []*repository.Expression
 	if exprs, err = s.apiManager.ListExpression(ctx,
@@ -159,6 +175,87 @@ func (s *EntityService) ListExpression(ctx context.Context, in *pb.ListExpressio
 	return out, nil
 }
 
+func (s *EntityService) EvaluateExpression(ctx context.Context, in *pb.EvaluateExpressionReq) (out *pb.EvaluateExpressionResp, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Eid(in.EntityId))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	en := Entity{
+		ID:     in.EntityId,
+		Owner:  in.Owner,
+		Source: in.Source}
+	parseHeaderFrom(ctx, &en)
+	if err = authorize(ctx, auth.ActionRead, &en); nil != err {
+		return nil, errors.Wrap(err, "evaluate expression")
+	}
+
+	log.L().Debug("evaluate expression", logf.Owner(en.Owner),
+		logf.Eid(en.ID), logf.Path(in.Path), logf.Expr(in.Expression))
+
+	var path string
+	if strings.TrimSpace(in.Path) != "" {
+		path = propKey(in.Path)
+	}
+
+	var ret *apim.ExprEvalResult
+	if ret, err = s.apiManager.EvaluateExpression(ctx,
+		repository.Expression{
+			Path:       path,
+			Type:       repository.ExprTypeEval,
+			Owner:      en.Owner,
+			EntityID:   en.ID,
+			Expression: in.Expression,
+		}); nil != err {
+		log.L().Error("evaluate expression", logf.Error(err),
+			logf.Eid(en.ID), logf.Owner(en.Owner), logf.Expr(in.Expression))
+		return nil, errors.Wrap(err, "evaluate expression")
+	}
+
+	out = &pb.EvaluateExpressionResp{
+		Owner:      en.Owner,
+		EntityId:   en.ID,
+		Path:       in.Path,
+		Expression: ret.Expression,
+	}
+
+	inputs := make(map[string]interface{})
+	for key, val := range ret.Inputs {
+		inputs[key] = nodeValue(val)
+	}
+
+	if ret.Err != nil {
+		out.Error = ret.Err.Error()
+	}
+	if out.Value, err = structpb.NewValue(nodeValue(ret.Value)); nil != err {
+		log.L().Error("convert expression result", logf.Error(err), logf.Expr(in.Expression))
+		return out, errors.Wrap(err, "convert expression result")
+	} else if out.Inputs, err = structpb.NewValue(inputs); nil != err {
+		log.L().Error("convert expression inputs", logf.Error(err), logf.Expr(in.Expression))
+		return out, errors.Wrap(err, "convert expression inputs")
+	}
+
+	return out, nil
+}
+
+// nodeValue convert tdtl.Node to json value, returns nil if undefined.
+func nodeValue(node tdtl.Node) interface{} {
+	if nil == node {
+		return nil
+	}
+
+	var val interface{}
+	switch node.Type() {
+	case tdtl.Null, tdtl.Undefined:
+		return nil
+	default:
+		if err := json.Unmarshal(node.Raw(), &val); nil != err {
+			return node.String()
+		}
+	}
+	return val
+}
+
 func dao2pbExpression(expr *repository.Expression) *pb.Expression {
 	var path string
 	if expr.Type == repository.ExprTypeEval {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/pkg/errors"
 	pb "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/auth"
 	xerrors "github.com/tkeel-io/core/pkg/errors"
 	logf "github.com/tkeel-io/core/pkg/logfield"
 	"github.com/tkeel-io/core/pkg/mapper"
@@ -23,6 +24,9 @@ func (s *EntityService) AppendMapper(ctx context.Context, req *pb.AppendMapperRe
 	entity.Owner = req.Owner
 	entity.Source = req.Source
 	parseHeaderFrom(ctx, &entity)
+	if err = authorize(ctx, auth.ActionWrite, &entity); nil != err {
+		return nil, errors.Wrap(err, "append mapper")
+	}
 
 	mp := mapper.Mapper{
 		ID:          req.Mapper.Id,
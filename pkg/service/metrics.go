@@ -5,22 +5,17 @@ import (
 	"time"
 
 	go_restful "github.com/emicklei/go-restful"
-	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
-	"github.com/tkeel-io/core/pkg/config"
-	logf "github.com/tkeel-io/core/pkg/logfield"
 	"github.com/tkeel-io/core/pkg/metrics"
-	"github.com/tkeel-io/core/pkg/resource"
-	"github.com/tkeel-io/core/pkg/resource/rawdata"
-	"github.com/tkeel-io/core/pkg/resource/tseries"
-	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/core/pkg/types"
+	"go.uber.org/atomic"
 )
 
 type MetricsService struct {
 	MetricsHandler http.Handler
-	rawdataClient  rawdata.Service
-	tseriesClient  tseries.TimeSerier
+	resources      types.ResourceManager
+	inited         *atomic.Bool
 }
 
 func NewMetricsService(mtrCollectors ...prometheus.Collector) (*MetricsService, error) {
@@ -34,17 +29,20 @@ func NewMetricsService(mtrCollectors ...prometheus.Collector) (*MetricsService,
 			EnableOpenMetrics: false,
 		},
 	)
-	tseriesClient := tseries.NewTimeSerier(config.Get().Components.TimeSeries.Name)
-	if err := tseriesClient.Init(resource.ParseFrom(config.Get().Components.TimeSeries)); nil != err {
-		log.L().Error("initialize time series", logf.Error(err))
-		return nil, errors.Wrap(err, "init ts service")
-	}
-	rawdataClient := rawdata.NewRawDataService(config.Get().Components.Rawdata.Name)
-	if err := rawdataClient.Init(resource.ParseFrom(config.Get().Components.Rawdata)); err != nil {
-		log.L().Error("initialize rawdata server", logf.Error(err))
+
+	return &MetricsService{
+		MetricsHandler: metricHandler,
+		inited:         atomic.NewBool(false),
+	}, nil
+}
+
+// Init sets resources and starts collecting storage metrics of the time series and rawdata instances hourly.
+func (svc *MetricsService) Init(resources types.ResourceManager) {
+	svc.resources = resources
+	if svc.inited.Swap(true) {
+		return
 	}
 
-	svc := &MetricsService{metricHandler, rawdataClient, tseriesClient}
 	go func() {
 		svc.flushMetrics()
 		timer := time.NewTicker(time.Hour)
@@ -52,7 +50,6 @@ func NewMetricsService(mtrCollectors ...prometheus.Collector) (*MetricsService,
 			svc.flushMetrics()
 		}
 	}()
-	return svc, nil
 }
 
 func (svc *MetricsService) Metrics(req *go_restful.Request, resp *go_restful.Response) {
@@ -60,10 +57,10 @@ func (svc *MetricsService) Metrics(req *go_restful.Request, resp *go_restful.Res
 }
 
 func (svc *MetricsService) flushMetrics() {
-	_, storage := svc.tseriesClient.GetMetrics()
+	_, storage := svc.resources.TSDB().GetMetrics()
 	metrics.CollectorTimeseriesStorage.WithLabelValues("admin").Set(storage)
 
-	_, storage, total, used := svc.rawdataClient.GetMetrics()
+	_, storage, total, used := svc.resources.RawData().GetMetrics()
 	metrics.CollectorRawDataStorage.WithLabelValues("admin").Set(storage)
 	metrics.CollectorMsgStorageSpace.WithLabelValues("admin", metrics.SpaceTypeTotal).Set(total)
 	metrics.CollectorMsgStorageSpace.WithLabelValues("admin", metrics.SpaceTypeUsed).Set(used)
@@ -25,7 +25,7 @@ func (m *APIManagerMock) OnRespond(ctx context.Context, resp *holder.Response) {
 }
 
 // CreateEntity create entity.
-func (m *APIManagerMock) CreateEntity(_ context.Context, in *apim.Base) (*apim.BaseRet, error) {
+func (m *APIManagerMock) CreateEntity(_ context.Context, in *apim.Base, _ ...apim.Option) (*apim.BaseRet, error) {
 	return &apim.BaseRet{
 		ID:     in.ID,
 		Type:   in.Type,
@@ -44,11 +44,44 @@ func (m *APIManagerMock) PatchEntity(_ context.Context, in *apim.Base, _ []*v1.P
 	}, nil, nil
 }
 
+func (m *APIManagerMock) TransactEntities(_ context.Context, txs []*apim.TxEntity) (*apim.TxResult, error) {
+	result := &apim.TxResult{ID: "tx-123", Status: apim.TxCommitted}
+	for _, tx := range txs {
+		result.Entities = append(result.Entities, &apim.BaseRet{
+			ID:     tx.Base.ID,
+			Type:   tx.Base.Type,
+			Owner:  tx.Base.Owner,
+			Source: tx.Base.Source,
+		})
+	}
+	return result, nil
+}
+
 // DeleteEntity delete entity.
 func (m *APIManagerMock) DeleteEntity(context.Context, *apim.Base) error {
 	return nil
 }
 
+// RestoreEntity restores entity from trash.
+func (m *APIManagerMock) RestoreEntity(_ context.Context, in *apim.Base) (*apim.BaseRet, error) {
+	return &apim.BaseRet{
+		ID:     in.ID,
+		Type:   in.Type,
+		Owner:  in.Owner,
+		Source: in.Source,
+	}, nil
+}
+
+// PurgeEntity removes entity in trash permanently.
+func (m *APIManagerMock) PurgeEntity(context.Context, *apim.Base) error {
+	return nil
+}
+
+// ImportEntity replaces the state of entity.
+func (m *APIManagerMock) ImportEntity(context.Context, string, []byte) error {
+	return nil
+}
+
 // GetProperties returns entity properties.
 func (m *APIManagerMock) GetEntity(_ context.Context, in *apim.Base) (*apim.BaseRet, error) {
 	return &apim.BaseRet{
@@ -59,6 +92,11 @@ func (m *APIManagerMock) GetEntity(_ context.Context, in *apim.Base) (*apim.Base
 	}, nil
 }
 
+// GetRequestStatus returns status of async write request.
+func (m *APIManagerMock) GetRequestStatus(_ context.Context, reqID string) (*repository.Request, error) {
+	return &repository.Request{ID: reqID, Status: repository.RequestStatusSucceeded}, nil
+}
+
 // AppendMapper append entity mapper.
 func (m *APIManagerMock) AppendMapper(ctx context.Context, mp *mapper.Mapper) error {
 	return nil
@@ -85,6 +123,10 @@ func (m *APIManagerMock) ListExpression(context.Context, *apim.Base) ([]*reposit
 	return nil, nil
 }
 
+func (m *APIManagerMock) EvaluateExpression(context.Context, repository.Expression) (*apim.ExprEvalResult, error) {
+	return &apim.ExprEvalResult{}, nil
+}
+
 func (m *APIManagerMock) CreateSubscription(context.Context, *repository.Subscription) error {
 	return nil
 }
@@ -96,3 +138,112 @@ func (m *APIManagerMock) DeleteSubscription(context.Context, *repository.Subscri
 func (m *APIManagerMock) GetSubscription(context.Context, *repository.Subscription) (*repository.Subscription, error) {
 	return nil, nil
 }
+
+func (m *APIManagerMock) CreateAlarmRule(context.Context, *repository.AlarmRule) error {
+	return nil
+}
+
+func (m *APIManagerMock) UpdateAlarmRule(context.Context, *repository.AlarmRule) error {
+	return nil
+}
+
+func (m *APIManagerMock) DeleteAlarmRule(context.Context, *repository.AlarmRule) error {
+	return nil
+}
+
+func (m *APIManagerMock) GetAlarmRule(_ context.Context, rule *repository.AlarmRule) (*repository.AlarmRule, error) {
+	return rule, nil
+}
+
+func (m *APIManagerMock) ListAlarmRule(_ context.Context, req *repository.ListAlarmRuleReq) ([]*repository.AlarmRule, error) {
+	return []*repository.AlarmRule{{ID: "alarm123", Owner: req.Owner, EntityID: req.EntityID}}, nil
+}
+
+func (m *APIManagerMock) ListAlarm(_ context.Context, req *repository.ListAlarmRuleReq) ([]*repository.Alarm, error) {
+	return []*repository.Alarm{{RuleID: "alarm123", Owner: req.Owner,
+		EntityID: req.EntityID, Status: repository.AlarmStatusActive}}, nil
+}
+
+func (m *APIManagerMock) AckAlarm(_ context.Context, rule *repository.AlarmRule, operator string) (*repository.Alarm, error) {
+	alarm := repository.NewAlarm(rule)
+	alarm.Status, alarm.Acked, alarm.AckedBy = repository.AlarmStatusActive, true, operator
+	return alarm, nil
+}
+
+func (m *APIManagerMock) ListAlarmHistory(_ context.Context, rule *repository.AlarmRule) ([]*repository.AlarmRecord, error) {
+	return []*repository.AlarmRecord{{RuleID: rule.ID, Status: repository.AlarmStatusActive}}, nil
+}
+
+func (m *APIManagerMock) CreateTemplate(_ context.Context, tpl *repository.Template) (*repository.Template, error) {
+	tpl.Version = 1
+	return tpl, nil
+}
+
+func (m *APIManagerMock) GetTemplate(_ context.Context, tpl *repository.Template) (*repository.Template, error) {
+	if tpl.Version == 0 {
+		tpl.Version = 1
+	}
+	return tpl, nil
+}
+
+func (m *APIManagerMock) ListTemplate(_ context.Context, req *repository.ListTemplateReq) ([]*repository.Template, error) {
+	return []*repository.Template{{ID: "tpl123", Owner: req.Owner, Version: 1}}, nil
+}
+
+func (m *APIManagerMock) DeleteTemplate(context.Context, *repository.Template) error {
+	return nil
+}
+
+func (m *APIManagerMock) ResolveTemplate(ctx context.Context, tpl *repository.Template) (*repository.Template, error) {
+	return m.GetTemplate(ctx, tpl)
+}
+
+func (m *APIManagerMock) UpdateEntityTemplate(context.Context, *apim.Base) error {
+	return nil
+}
+
+func (m *APIManagerMock) MigrateTemplate(_ context.Context, req *apim.MigrateTemplateReq) (*repository.TemplateRollout, error) {
+	return &repository.TemplateRollout{ID: "rollout123", Owner: req.Owner, TemplateID: req.ID,
+		FromVersion: req.FromVersion, ToVersion: req.FromVersion + 1, Status: repository.RolloutStatusRunning}, nil
+}
+
+func (m *APIManagerMock) GetTemplateRollout(_ context.Context, rollout *repository.TemplateRollout) (*repository.TemplateRollout, error) {
+	rollout.Status, rollout.Total, rollout.Done = repository.RolloutStatusCompleted, 2, 2
+	return rollout, nil
+}
+
+func (m *APIManagerMock) ListTemplateRollout(_ context.Context, req *repository.ListTemplateRolloutReq) ([]*repository.TemplateRollout, error) {
+	return []*repository.TemplateRollout{{ID: "rollout123", Owner: req.Owner,
+		TemplateID: req.TemplateID, Status: repository.RolloutStatusRunning}}, nil
+}
+
+func (m *APIManagerMock) CreateSchema(_ context.Context, schema *repository.Schema) (*repository.Schema, error) {
+	schema.Version = 1
+	return schema, nil
+}
+
+func (m *APIManagerMock) UpdateSchema(_ context.Context, schema *repository.Schema) (*repository.Schema, error) {
+	schema.Version = 2
+	return schema, nil
+}
+
+func (m *APIManagerMock) GetSchema(_ context.Context, schema *repository.Schema) (*repository.Schema, error) {
+	if schema.Version == 0 {
+		schema.Version = 1
+	}
+	schema.Schema = `{"temp":{"type":"float"}}`
+	return schema, nil
+}
+
+func (m *APIManagerMock) ListSchema(_ context.Context, req *repository.ListSchemaReq) ([]*repository.Schema, error) {
+	return []*repository.Schema{{ID: "schema123", Owner: req.Owner, Version: 1,
+		Schema: `{"name":{"type":"string","enabled_search":true}}`}}, nil
+}
+
+func (m *APIManagerMock) DeleteSchema(context.Context, *repository.Schema) error {
+	return nil
+}
+
+func (m *APIManagerMock) UpdateEntitySchema(context.Context, *apim.Base) error {
+	return nil
+}
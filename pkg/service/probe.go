@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/runtime"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/atomic"
+)
+
+const (
+	probeStatusAlive = "alive"
+
+	// probeCacheTTL is the time results of checking dependencies are reused,
+	// so that frequent probes do not load dependencies.
+	probeCacheTTL = 2 * time.Second
+)
+
+// Checker checks a dependency, returns error if the dependency is unavailable.
+type Checker func(ctx context.Context) error
+
+// Dependency is a dependency checked on readiness probes, unhealthy critical dependencies
+// make the node not ready, others are reported only.
+type Dependency struct {
+	Check    Checker
+	Critical bool
+}
+
+// ReadinessReporter reports whether the node serves events, with the phase of the node.
+type ReadinessReporter interface {
+	Ready() (bool, string)
+}
+
+type ProbeService struct {
+	pb.UnimplementedProbeServer
+	ctx      context.Context
+	cancel   context.CancelFunc
+	inited   *atomic.Bool
+	timeout  time.Duration
+	reporter ReadinessReporter
+	lock     sync.RWMutex
+	deps     map[string]Dependency
+	// checkLock serializes checks, so that concurrent probes share results.
+	checkLock sync.Mutex
+	checkedAt time.Time
+	statuses  []*pb.DependencyStatus
+}
+
+// NewProbeService returns a new ProbeService.
+func NewProbeService(ctx context.Context) (*ProbeService, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &ProbeService{
+		ctx:    ctx,
+		cancel: cancel,
+		inited: atomic.NewBool(false),
+	}, nil
+}
+
+// Init sets the readiness reporter and dependencies by name,
+// each dependency is checked within the timeout.
+func (s *ProbeService) Init(reporter ReadinessReporter, deps map[string]Dependency, timeout time.Duration) {
+	s.timeout = timeout
+	s.reporter = reporter
+	s.SetDependencies(deps)
+	s.inited.Store(true)
+}
+
+// SetDependencies replaces dependencies, dependencies change on config changes.
+func (s *ProbeService) SetDependencies(deps map[string]Dependency) {
+	s.lock.Lock()
+	s.deps = deps
+	s.lock.Unlock()
+
+	s.checkLock.Lock()
+	s.statuses = nil
+	s.checkLock.Unlock()
+}
+
+// Health is kept for compatibility, it is the same as Liveness.
+func (s *ProbeService) Health(ctx context.Context, req *pb.HealthRequest) (*pb.HealthResponse, error) {
+	return &pb.HealthResponse{}, nil
+}
+
+// Liveness responds while the process serves requests, dependencies are not checked,
+// so that pods are not restarted while bootstrapping or when dependencies are down.
+func (s *ProbeService) Liveness(ctx context.Context, req *pb.LivenessRequest) (*pb.LivenessResponse, error) {
+	return &pb.LivenessResponse{Status: probeStatusAlive}, nil
+}
+
+// Readiness checks the node phase and all dependencies, results of dependencies are cached briefly,
+// responds unavailable when not ready unless the request is verbose.
+func (s *ProbeService) Readiness(ctx context.Context, req *pb.ReadinessRequest) (*pb.ReadinessResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready")
+		return s.readiness(req, &pb.ReadinessResponse{Phase: runtime.NodePhaseStarting}, runtime.NodePhaseStarting)
+	}
+
+	ready, phase := s.reporter.Ready()
+	out := &pb.ReadinessResponse{Phase: phase}
+	out.Dependencies = s.check()
+
+	var reasons []string
+	if !ready {
+		reasons = append(reasons, phase)
+	}
+	for _, dep := range out.Dependencies {
+		if dep.Critical && !dep.Healthy {
+			reasons = append(reasons, fmt.Sprintf("%s: %s", dep.Name, dep.Error))
+		}
+	}
+
+	out.Ready = len(reasons) == 0
+	return s.readiness(req, out, strings.Join(reasons, "; "))
+}
+
+func (s *ProbeService) readiness(req *pb.ReadinessRequest, out *pb.ReadinessResponse, reason string) (*pb.ReadinessResponse, error) {
+	if out.Ready || req.Verbose {
+		return out, nil
+	}
+
+	log.L().Warn("core not ready", logf.Reason(reason))
+	return nil, errors.Wrap(xerrors.ErrNotReady.WithMessage("not ready, "+reason), "readiness")
+}
+
+// check returns statuses of dependencies checked within the cache ttl, or checks them concurrently,
+// results are shared by probes, so dependencies are checked within the service context, sorted by name.
+func (s *ProbeService) check() []*pb.DependencyStatus {
+	s.checkLock.Lock()
+	defer s.checkLock.Unlock()
+	if nil != s.statuses && time.Since(s.checkedAt) < probeCacheTTL {
+		return s.statuses
+	}
+
+	s.lock.RLock()
+	deps := s.deps
+	s.lock.RUnlock()
+
+	var wg sync.WaitGroup
+	statuses := make([]*pb.DependencyStatus, 0, len(deps))
+	results := make(chan *pb.DependencyStatus, len(deps))
+	for name, dep := range deps {
+		wg.Add(1)
+		go func(name string, dep Dependency) {
+			defer wg.Done()
+			results <- s.checkOne(s.ctx, name, dep)
+		}(name, dep)
+	}
+
+	wg.Wait()
+	close(results)
+	for status := range results {
+		statuses = append(statuses, status)
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+	s.statuses, s.checkedAt = statuses, time.Now()
+	return statuses
+}
+
+func (s *ProbeService) checkOne(ctx context.Context, name string, dep Dependency) *pb.DependencyStatus {
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	status := &pb.DependencyStatus{Name: name, Healthy: true, Critical: dep.Critical}
+	err := dep.Check(ctx)
+	status.LatencyMs = time.Since(start).Milliseconds()
+	if nil != err {
+		status.Healthy = false
+		status.Error = err.Error()
+		log.L().Warn("dependency unhealthy", logf.Name(name),
+			logf.Elapsedms(status.LatencyMs), logf.Error(err))
+	}
+	return status
+}
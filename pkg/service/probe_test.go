@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/core/pkg/resource"
+	"github.com/tkeel-io/core/pkg/resource/store"
+	_ "github.com/tkeel-io/core/pkg/resource/store/memory"
+	"github.com/tkeel-io/core/pkg/runtime"
+	kerrors "github.com/tkeel-io/kit/errors"
+	"google.golang.org/grpc/codes"
+)
+
+type phaseReporter struct {
+	phase string
+}
+
+func (r *phaseReporter) Ready() (bool, string) {
+	return r.phase == runtime.NodePhaseRunning, r.phase
+}
+
+func Test_Readiness(t *testing.T) {
+	ctx := context.Background()
+	srv, err := NewProbeService(ctx)
+	assert.Nil(t, err)
+
+	// liveness does not depend on initialization.
+	live, err := srv.Liveness(ctx, &pb.LivenessRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, probeStatusAlive, live.Status)
+	_, err = srv.Readiness(ctx, &pb.ReadinessRequest{})
+	assert.ErrorIs(t, err, xerrors.ErrNotReady)
+
+	var storeErr, searchErr error
+	reporter := &phaseReporter{phase: runtime.NodePhaseRunning}
+	deps := map[string]Dependency{
+		"etcd":        {Check: func(context.Context) error { return nil }, Critical: true},
+		"state_store": {Check: func(context.Context) error { return storeErr }, Critical: true},
+		"kafka.source.core0": {Check: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}, Critical: true},
+		"search_engine": {Check: func(context.Context) error { return searchErr }},
+	}
+	srv.Init(reporter, deps, 10*time.Millisecond)
+
+	out, err := srv.Readiness(ctx, &pb.ReadinessRequest{Verbose: true})
+	assert.Nil(t, err)
+	assert.False(t, out.Ready)
+	assert.Len(t, out.Dependencies, 4)
+	assert.Equal(t, "etcd", out.Dependencies[0].Name)
+	assert.True(t, out.Dependencies[0].Healthy)
+	assert.True(t, out.Dependencies[0].Critical)
+	assert.Equal(t, "kafka.source.core0", out.Dependencies[1].Name)
+	assert.False(t, out.Dependencies[1].Healthy)
+	assert.GreaterOrEqual(t, out.Dependencies[1].LatencyMs, int64(10))
+
+	deps["kafka.source.core0"] = Dependency{Check: func(context.Context) error { return nil }, Critical: true}
+	srv.SetDependencies(deps)
+	out, err = srv.Readiness(ctx, &pb.ReadinessRequest{})
+	assert.Nil(t, err)
+	assert.True(t, out.Ready)
+	assert.Equal(t, runtime.NodePhaseRunning, out.Phase)
+
+	// results are cached briefly.
+	storeErr = errors.New("connection refused")
+	_, err = srv.Readiness(ctx, &pb.ReadinessRequest{})
+	assert.Nil(t, err)
+
+	// unhealthy informational dependencies are reported only.
+	storeErr = nil
+	searchErr = errors.New("cluster red")
+	srv.checkedAt = time.Time{}
+	out, err = srv.Readiness(ctx, &pb.ReadinessRequest{})
+	assert.Nil(t, err)
+	assert.True(t, out.Ready)
+	assert.False(t, out.Dependencies[2].Healthy)
+	assert.False(t, out.Dependencies[2].Critical)
+
+	// unhealthy critical dependencies and draining respond unavailable.
+	storeErr = errors.New("connection refused")
+	srv.checkedAt = time.Time{}
+	_, err = srv.Readiness(ctx, &pb.ReadinessRequest{})
+	assert.Equal(t, int32(codes.Unavailable), kerrors.FromError(err).Code)
+	assert.Contains(t, kerrors.FromError(err).Message, "state_store: connection refused")
+
+	storeErr = nil
+	reporter.phase = runtime.NodePhaseDraining
+	_, err = srv.Readiness(ctx, &pb.ReadinessRequest{})
+	assert.Contains(t, kerrors.FromError(err).Message, runtime.NodePhaseDraining)
+}
+
+// notFoundStore reports missing state with errors.ErrEntityNotFound, as the dapr store does.
+type notFoundStore struct {
+	store.Store
+}
+
+func (s *notFoundStore) Get(ctx context.Context, key string) (*store.StateItem, error) {
+	item, err := s.Store.Get(ctx, key)
+	if errors.Is(err, xerrors.ErrResourceNotFound) {
+		return nil, xerrors.ErrEntityNotFound
+	}
+	return item, err
+}
+
+func Test_ReadinessStoreNotFound(t *testing.T) {
+	store.Register("probe.notfound", func(properties map[string]interface{}) (store.Store, error) {
+		s, err := store.NewStore(resource.Metadata{Name: "memory"})
+		return &notFoundStore{Store: s}, err
+	})
+
+	ctx := context.Background()
+	coreDao, err := dao.NewMock(ctx, config.Metadata{Name: "probe.notfound"}, config.EtcdConfig{})
+	assert.Nil(t, err)
+	srv, err := NewProbeService(ctx)
+	assert.Nil(t, err)
+
+	// the probe key is never written, which is healthy.
+	srv.Init(&phaseReporter{phase: runtime.NodePhaseRunning}, map[string]Dependency{
+		"state_store": {Check: coreDao.CheckStore, Critical: true},
+	}, time.Second)
+	out, err := srv.Readiness(ctx, &pb.ReadinessRequest{Verbose: true})
+	assert.Nil(t, err)
+	assert.True(t, out.Ready)
+	assert.True(t, out.Dependencies[0].Healthy)
+}
@@ -7,34 +7,51 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/pkg/errors"
 	pb "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/auth"
 	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
 	logf "github.com/tkeel-io/core/pkg/logfield"
 	"github.com/tkeel-io/core/pkg/resource"
-	"github.com/tkeel-io/core/pkg/resource/rawdata"
+	"github.com/tkeel-io/core/pkg/tenant"
+	"github.com/tkeel-io/core/pkg/types"
 	"github.com/tkeel-io/kit/log"
+	"go.uber.org/atomic"
 )
 
 type RawdataService struct {
 	pb.UnimplementedRawdataServer
-	rawdataClient rawdata.Service
+	resources     types.ResourceManager
 	entityHistory EntityHistory
+	inited        *atomic.Bool
 }
 
 func NewRawdataService() (*RawdataService, error) {
-	rawdataClient := rawdata.NewRawDataService(config.Get().Components.Rawdata.Name)
-	if err := rawdataClient.Init(resource.ParseFrom(config.Get().Components.Rawdata)); err != nil {
-		log.L().Error("initialize rawdata server", logf.Error(err))
+	entityHistory, err := NewEntityHistory(resource.ParseFrom(config.Get().Components.Store), 5)
+	if nil != err {
+		log.L().Error("initialize entity history", logf.Error(err))
+		return nil, errors.Wrap(err, "init rawdata service")
 	}
-
-	entityHistory := NewEntityHistory(resource.ParseFrom(config.Get().Components.Store), 5)
 	return &RawdataService{
-		rawdataClient: rawdataClient,
 		entityHistory: entityHistory,
+		inited:        atomic.NewBool(false),
 	}, nil
 }
 
+// Init sets resources, rawdata are queried from the rawdata instance of resources,
+// which may be replaced on config changes.
+func (s *RawdataService) Init(resources types.ResourceManager) {
+	s.resources = resources
+	s.inited.Store(true)
+}
+
 func (s *RawdataService) GetRawdata(ctx context.Context, req *pb.GetRawdataRequest) (*pb.GetRawdataResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Eid(req.EntityId))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
 	if req.StartTime < (time.Now().Unix() - 3600*24*3) {
 		req.StartTime = time.Now().Unix() - 3600*24*3
 	}
@@ -42,6 +59,10 @@ func (s *RawdataService) GetRawdata(ctx context.Context, req *pb.GetRawdataReque
 	if err := checkParams(req.StartTime, req.EndTime, req.Path); err != nil {
 		return nil, err
 	}
+	resource := &auth.Resource{ID: req.EntityId}
+	if err := authorizeEntity(ctx, auth.ActionRead, resource); nil != err {
+		return nil, errors.Wrap(err, "query rawdata")
+	}
 
 	user := defalutUser
 	h := ctx.Value(contextHTTPHeaderKey)
@@ -52,7 +73,7 @@ func (s *RawdataService) GetRawdata(ctx context.Context, req *pb.GetRawdataReque
 	// 检查user和实体id的合法性
 	log.L().Info("user: ", logf.String("user", user))
 
-	resp, err := s.rawdataClient.Query(ctx, req)
+	resp, err := s.resources.RawData().Query(tenant.WithTenant(ctx, resource.Owner), req)
 	s.entityHistory.AddEnity(user, req.EntityId)
 
 	return resp, err
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/auth"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	apim "github.com/tkeel-io/core/pkg/manager"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/atomic"
+)
+
+type RequestService struct {
+	pb.UnimplementedRequestServer
+	ctx        context.Context
+	cancel     context.CancelFunc
+	inited     *atomic.Bool
+	apiManager apim.APIManager
+}
+
+// NewRequestService returns a new RequestService.
+func NewRequestService(ctx context.Context) (*RequestService, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &RequestService{
+		ctx:    ctx,
+		cancel: cancel,
+		inited: atomic.NewBool(false),
+	}, nil
+}
+
+func (s *RequestService) Init(apiManager apim.APIManager) {
+	s.apiManager = apiManager
+	s.inited.Store(true)
+}
+
+func (s *RequestService) GetRequestStatus(ctx context.Context, req *pb.GetRequestStatusRequest) (out *pb.RequestStatusResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.ReqID(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	var request *repository.Request
+	if request, err = s.apiManager.GetRequestStatus(ctx, req.Id); nil != err {
+		log.L().Error("get request status", logf.ReqID(req.Id), logf.Owner(req.Owner), logf.Error(err))
+		return nil, errors.Wrap(err, "get request status")
+	}
+
+	if err = authorizeResource(ctx, auth.ActionRead,
+		&auth.Resource{Owner: request.Owner, ID: request.EntityID}); nil != err {
+		return nil, errors.Wrap(err, "get request status")
+	}
+
+	return &pb.RequestStatusResponse{
+		Id:        request.ID,
+		Owner:     request.Owner,
+		EntityId:  request.EntityID,
+		Operation: request.Operation,
+		Status:    request.Status,
+		ErrCode:   request.ErrCode,
+		Callback:  request.Callback,
+		CreatedAt: request.CreatedAt,
+		UpdatedAt: request.UpdatedAt,
+	}, nil
+}
+
+// writeOptions returns write options requested by headers, the Async header
+// requests async writes tracked by the returned request id, and the Idempotency-Key
+// header makes retries of a write respond the current state instead of re-executing.
+func writeOptions(ctx context.Context) []apim.Option {
+	var opts []apim.Option
+	header := auth.HeaderFrom(ctx)
+	if async, _ := strconv.ParseBool(header.Get(HeaderAsync)); async {
+		opts = append(opts, apim.NewAsyncOption(header.Get(HeaderCallback)))
+	}
+	if key := header.Get(HeaderIdempotency); key != "" {
+		opts = append(opts, apim.NewIdempotencyOption(key))
+	}
+	return opts
+}
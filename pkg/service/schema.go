@@ -0,0 +1,346 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/auth"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	apim "github.com/tkeel-io/core/pkg/manager"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/scheme"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/atomic"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// SchemaMapper puts search index mappings of schema properties.
+type SchemaMapper interface {
+	PutMapping(ctx context.Context, mapping []byte) error
+}
+
+type SchemaService struct {
+	pb.UnimplementedSchemaServer
+	ctx        context.Context
+	cancel     context.CancelFunc
+	inited     *atomic.Bool
+	apiManager apim.APIManager
+	mapper     SchemaMapper
+}
+
+// NewSchemaService returns a new SchemaService.
+func NewSchemaService(ctx context.Context) (*SchemaService, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &SchemaService{
+		ctx:    ctx,
+		cancel: cancel,
+		inited: atomic.NewBool(false),
+	}, nil
+}
+
+func (s *SchemaService) Init(apiManager apim.APIManager, mapper SchemaMapper) {
+	s.apiManager = apiManager
+	s.mapper = mapper
+	s.inited.Store(true)
+}
+
+func (s *SchemaService) CreateSchema(ctx context.Context, req *pb.CreateSchemaRequest) (out *pb.SchemaResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.ID(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	var schema *repository.Schema
+	if schema, err = s.makeSchema(ctx, auth.ActionWrite, req); nil != err {
+		return nil, errors.Wrap(err, "create schema")
+	}
+
+	if schema, err = s.apiManager.CreateSchema(ctx, schema); nil != err {
+		log.L().Error("create schema", logf.ID(req.Id),
+			logf.Owner(schema.Owner), logf.Error(err))
+		return nil, errors.Wrap(err, "create schema")
+	}
+
+	return schemaResponse(schema)
+}
+
+func (s *SchemaService) UpdateSchema(ctx context.Context, req *pb.CreateSchemaRequest) (out *pb.SchemaResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.ID(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	var schema *repository.Schema
+	if schema, err = s.makeSchema(ctx, auth.ActionWrite, req); nil != err {
+		return nil, errors.Wrap(err, "update schema")
+	}
+
+	if schema, err = s.apiManager.UpdateSchema(ctx, schema); nil != err {
+		log.L().Error("update schema", logf.ID(req.Id),
+			logf.Owner(schema.Owner), logf.Error(err))
+		return nil, errors.Wrap(err, "update schema")
+	}
+
+	return schemaResponse(schema)
+}
+
+func (s *SchemaService) GetSchema(ctx context.Context, req *pb.GetSchemaRequest) (out *pb.SchemaResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.ID(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	res := &auth.Resource{ID: req.Id, Owner: req.Owner}
+	if err = authorizeResource(ctx, auth.ActionRead, res); nil != err {
+		return nil, errors.Wrap(err, "get schema")
+	}
+
+	var schema *repository.Schema
+	if schema, err = s.apiManager.GetSchema(ctx, &repository.Schema{
+		ID: req.Id, Owner: res.Owner, Version: req.Version}); nil != err {
+		log.L().Error("get schema", logf.ID(req.Id),
+			logf.Owner(res.Owner), logf.Version(req.Version), logf.Error(err))
+		return nil, errors.Wrap(err, "get schema")
+	}
+
+	return schemaResponse(schema)
+}
+
+func (s *SchemaService) ListSchema(ctx context.Context, req *pb.ListSchemaRequest) (out *pb.ListSchemaResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Owner(req.Owner))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	res := &auth.Resource{Owner: req.Owner}
+	if err = authorizeResource(ctx, auth.ActionRead, res); nil != err {
+		return nil, errors.Wrap(err, "list schema")
+	}
+
+	return s.listSchema(ctx, &repository.ListSchemaReq{Owner: res.Owner})
+}
+
+func (s *SchemaService) ListSchemaVersion(ctx context.Context, req *pb.GetSchemaRequest) (out *pb.ListSchemaResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.ID(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	res := &auth.Resource{ID: req.Id, Owner: req.Owner}
+	if err = authorizeResource(ctx, auth.ActionRead, res); nil != err {
+		return nil, errors.Wrap(err, "list schema version")
+	}
+
+	return s.listSchema(ctx, &repository.ListSchemaReq{Owner: res.Owner, ID: req.Id})
+}
+
+func (s *SchemaService) listSchema(ctx context.Context, req *repository.ListSchemaReq) (out *pb.ListSchemaResponse, err error) {
+	var schemas []*repository.Schema
+	if schemas, err = s.apiManager.ListSchema(ctx, req); nil != err {
+		log.L().Error("list schema", logf.ID(req.ID),
+			logf.Owner(req.Owner), logf.Error(err))
+		return nil, errors.Wrap(err, "list schema")
+	}
+
+	out = &pb.ListSchemaResponse{Count: int32(len(schemas))}
+	for _, schema := range schemas {
+		var item *pb.SchemaObject
+		if item, err = schemaObject(schema); nil != err {
+			return nil, errors.Wrap(err, "list schema")
+		}
+		out.Items = append(out.Items, item)
+	}
+	return out, nil
+}
+
+func (s *SchemaService) DeleteSchema(ctx context.Context, req *pb.DeleteSchemaRequest) (out *pb.DeleteSchemaResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.ID(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	res := &auth.Resource{ID: req.Id, Owner: req.Owner}
+	if err = authorizeResource(ctx, auth.ActionWrite, res); nil != err {
+		return nil, errors.Wrap(err, "delete schema")
+	}
+
+	if err = s.apiManager.DeleteSchema(ctx, &repository.Schema{
+		ID: req.Id, Owner: res.Owner}); nil != err {
+		log.L().Error("delete schema", logf.ID(req.Id),
+			logf.Owner(res.Owner), logf.Error(err))
+		return nil, errors.Wrap(err, "delete schema")
+	}
+
+	return &pb.DeleteSchemaResponse{Id: req.Id, Status: "ok"}, nil
+}
+
+// BindSchema binds the entity to the schema version, properties of the entity are validated on binding.
+func (s *SchemaService) BindSchema(ctx context.Context, req *pb.BindSchemaRequest) (out *pb.BindSchemaResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Eid(req.EntityId))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	entity := &Entity{
+		ID:            req.EntityId,
+		Owner:         req.Owner,
+		Type:          req.Type,
+		Source:        req.Source,
+		SchemaID:      req.SchemaId,
+		SchemaVersion: req.SchemaVersion,
+	}
+	parseHeaderFrom(ctx, entity)
+	if err = authorize(ctx, auth.ActionWrite, entity); nil != err {
+		return nil, errors.Wrap(err, "bind schema")
+	} else if entity.SchemaID == "" {
+		return nil, errors.Wrap(xerrors.ErrInvalidRequest, "bind schema, schema id empty")
+	}
+
+	if err = s.apiManager.UpdateEntitySchema(ctx, entity); nil != err {
+		log.L().Error("bind schema", logf.Eid(req.EntityId), logf.ID(req.SchemaId),
+			logf.Owner(entity.Owner), logf.Error(err))
+		return nil, errors.Wrap(err, "bind schema")
+	}
+
+	return &pb.BindSchemaResponse{
+		EntityId:      entity.ID,
+		SchemaId:      entity.SchemaID,
+		SchemaVersion: entity.SchemaVersion,
+	}, nil
+}
+
+// makeSchema authorizes the request and returns the schema,
+// search mappings of the schema are put before the schema is saved.
+func (s *SchemaService) makeSchema(ctx context.Context, action auth.Action, req *pb.CreateSchemaRequest) (*repository.Schema, error) {
+	schema := &repository.Schema{ID: req.Id, Owner: req.Owner}
+	if obj := req.Schema; nil != obj {
+		schema.Name = obj.Name
+		schema.Description = obj.Description
+		if schema.ID == "" {
+			schema.ID = obj.Id
+		}
+
+		switch configs := obj.Configs.AsInterface().(type) {
+		case map[string]interface{}:
+			bytes, err := json.Marshal(configs)
+			if nil != err {
+				return nil, errors.Wrap(err, "encode schema configs")
+			}
+			schema.Schema = string(bytes)
+		case nil:
+		default:
+			return nil, errors.Wrap(xerrors.ErrInvalidRequest, "schema configs")
+		}
+	}
+
+	res := &auth.Resource{ID: schema.ID, Owner: schema.Owner}
+	if err := authorizeResource(ctx, action, res); nil != err {
+		return nil, errors.Wrap(err, "authorize schema")
+	} else if schema.Owner = res.Owner; schema.ID == "" {
+		return nil, errors.Wrap(xerrors.ErrInvalidRequest, "schema id empty")
+	}
+
+	cfgs, err := schema.Configs()
+	if nil != err {
+		log.L().Error("invalid schema configs", logf.ID(schema.ID),
+			logf.Owner(schema.Owner), logf.Error(err))
+		return nil, errors.Wrap(err, "parse schema configs")
+	}
+
+	if err = s.putMapping(ctx, schema, cfgs); nil != err {
+		return nil, errors.Wrap(err, "put schema search mapping")
+	}
+	return schema, nil
+}
+
+// PutMappings puts search mappings of all schema versions,
+// which are required by search engines replaced on config changes.
+func (s *SchemaService) PutMappings(ctx context.Context) error {
+	if !s.inited.Load() {
+		return errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	latests, err := s.apiManager.ListSchema(ctx, &repository.ListSchemaReq{})
+	if nil != err {
+		return errors.Wrap(err, "put schema search mappings")
+	}
+
+	for _, latest := range latests {
+		schemas, err := s.apiManager.ListSchema(ctx,
+			&repository.ListSchemaReq{Owner: latest.Owner, ID: latest.ID})
+		if nil != err {
+			return errors.Wrap(err, "put schema search mappings")
+		}
+		for _, schema := range schemas {
+			cfgs, err := schema.Configs()
+			if nil != err {
+				log.L().Warn("invalid schema configs", logf.ID(schema.ID),
+					logf.Owner(schema.Owner), logf.Error(err))
+				continue
+			}
+			if err = s.putMapping(ctx, schema, cfgs); nil != err {
+				return errors.Wrap(err, "put schema search mappings")
+			}
+		}
+	}
+	return nil
+}
+
+// putMapping puts search mappings of the schema configs, conflicting mappings are skipped.
+func (s *SchemaService) putMapping(ctx context.Context, schema *repository.Schema, cfgs map[string]*scheme.Config) error {
+	mapping := scheme.SearchMapping(cfgs)
+	if props, _ := mapping["properties"].(map[string]interface{}); len(props) > 0 && nil != s.mapper {
+		bytes, _ := json.Marshal(mapping)
+		// the entity index is shared by all tenants, fields mapped with other types by other schemas
+		// keep their mappings, which does not fail the schema.
+		switch err := s.mapper.PutMapping(ctx, bytes); {
+		case errors.Is(err, xerrors.ErrSearchMappingConflict):
+			log.L().Warn("schema search mapping conflicts with existing mappings", logf.ID(schema.ID),
+				logf.Owner(schema.Owner), logf.Error(err))
+		case nil != err:
+			log.L().Error("put schema search mapping", logf.ID(schema.ID),
+				logf.Owner(schema.Owner), logf.Error(err))
+			return errors.Wrap(err, "put schema search mapping")
+		}
+	}
+	return nil
+}
+
+func schemaObject(schema *repository.Schema) (out *pb.SchemaObject, err error) {
+	out = &pb.SchemaObject{
+		Id:          schema.ID,
+		Name:        schema.Name,
+		Owner:       schema.Owner,
+		Version:     schema.Version,
+		Description: schema.Description,
+		CreatedAt:   schema.CreatedAt,
+	}
+
+	configs := map[string]interface{}{}
+	if schema.Schema != "" {
+		if err = json.Unmarshal([]byte(schema.Schema), &configs); nil != err {
+			return nil, errors.Wrap(err, "decode schema configs")
+		}
+	}
+	if out.Configs, err = structpb.NewValue(configs); nil != err {
+		return nil, errors.Wrap(err, "convert schema configs")
+	}
+	return out, nil
+}
+
+func schemaResponse(schema *repository.Schema) (*pb.SchemaResponse, error) {
+	obj, err := schemaObject(schema)
+	if nil != err {
+		return nil, errors.Wrap(err, "schema response")
+	}
+
+	return &pb.SchemaResponse{
+		Id:      schema.ID,
+		Owner:   schema.Owner,
+		Version: schema.Version,
+		Schema:  obj,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type mappingRecorder struct {
+	mappings []string
+	err      error
+}
+
+func (m *mappingRecorder) PutMapping(_ context.Context, mapping []byte) error {
+	m.mappings = append(m.mappings, string(mapping))
+	return m.err
+}
+
+func Test_CreateSchema(t *testing.T) {
+	ss, err := NewSchemaService(context.Background())
+	assert.Nil(t, err)
+
+	configs, err := structpb.NewValue(map[string]interface{}{
+		"temp": map[string]interface{}{"type": "float", "enabled_time_series": true},
+		"name": map[string]interface{}{"type": "string", "enabled_search": true},
+	})
+	assert.Nil(t, err)
+
+	req := &pb.CreateSchemaRequest{
+		Id:     "sensor",
+		Owner:  "admin",
+		Schema: &pb.SchemaObject{Name: "sensor", Configs: configs},
+	}
+
+	_, err = ss.CreateSchema(context.Background(), req)
+	assert.NotNil(t, err)
+
+	mapper := &mappingRecorder{}
+	ss.Init(apiManager, mapper)
+	res, err := ss.CreateSchema(context.Background(), req)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), res.Version)
+	assert.Equal(t, "sensor", res.Schema.Name)
+	assert.Contains(t, res.Schema.Configs.GetStructValue().AsMap(), "temp")
+	assert.Len(t, mapper.mappings, 1)
+
+	// conflicting mappings do not fail the schema.
+	mapper.err = errors.Wrap(xerrors.ErrSearchMappingConflict, "mapper [properties.name] cannot be changed")
+	res, err = ss.UpdateSchema(context.Background(), req)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), res.Version)
+
+	mapper.err = errors.New("elasticsearch unavailable")
+	_, err = ss.UpdateSchema(context.Background(), req)
+	assert.NotNil(t, err)
+	mapper.err = nil
+
+	invalid, _ := structpb.NewValue(map[string]interface{}{
+		"temp": map[string]interface{}{"type": "decimal"},
+	})
+	_, err = ss.UpdateSchema(context.Background(), &pb.CreateSchemaRequest{
+		Id: "sensor", Owner: "admin", Schema: &pb.SchemaObject{Configs: invalid}})
+	assert.NotNil(t, err)
+
+	// mappings of all versions are put again for replaced search engines.
+	mapper.mappings = nil
+	assert.Nil(t, ss.PutMappings(context.Background()))
+	assert.Len(t, mapper.mappings, 1)
+}
+
+func Test_BindSchema(t *testing.T) {
+	ss, err := NewSchemaService(context.Background())
+	assert.Nil(t, err)
+
+	ss.Init(apiManager, nil)
+	_, err = ss.BindSchema(context.Background(), &pb.BindSchemaRequest{EntityId: "device123", Owner: "admin"})
+	assert.NotNil(t, err)
+
+	res, err := ss.BindSchema(context.Background(), &pb.BindSchemaRequest{
+		EntityId: "device123",
+		Owner:    "admin",
+		SchemaId: "sensor",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "sensor", res.SchemaId)
+}
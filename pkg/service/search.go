@@ -21,6 +21,7 @@ import (
 
 	"github.com/pkg/errors"
 	pb "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/auth"
 	xerrors "github.com/tkeel-io/core/pkg/errors"
 	"github.com/tkeel-io/kit/log"
 	"go.uber.org/atomic"
@@ -45,6 +46,16 @@ func (s *SearchService) Init(searchClient pb.SearchHTTPServer) {
 }
 
 func (s *SearchService) Index(ctx context.Context, req *pb.IndexObject) (*pb.IndexResponse, error) {
+	res := &auth.Resource{}
+	if obj, ok := req.Obj.AsInterface().(map[string]interface{}); ok {
+		res.ID = interface2string(obj["id"])
+		res.Type = interface2string(obj["type"])
+		res.Owner = interface2string(obj["owner"])
+	}
+	if err := authorizeResource(ctx, auth.ActionAdmin, res); nil != err {
+		return nil, errors.Wrap(err, "index failed")
+	}
+
 	out, err := s.searchClient.Index(ctx, req)
 	if err != nil {
 		return out, errors.Wrap(err, "index failed")
@@ -57,6 +68,12 @@ func (s *SearchService) Search(ctx context.Context, req *pb.SearchRequest) (*pb.
 		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
 	}
 
+	res := &auth.Resource{Owner: req.Owner}
+	if err := authorizeResource(ctx, auth.ActionRead, res); nil != err {
+		return nil, errors.Wrap(err, "search failed")
+	}
+
+	restrictOwner(req, res.Owner)
 	out, err := s.searchClient.Search(ctx, req)
 	if err != nil {
 		return out, errors.Wrap(err, "search failed")
@@ -23,6 +23,7 @@ import (
 
 	"github.com/pkg/errors"
 	pb "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/auth"
 	xerrors "github.com/tkeel-io/core/pkg/errors"
 	logf "github.com/tkeel-io/core/pkg/logfield"
 	apim "github.com/tkeel-io/core/pkg/manager"
@@ -116,6 +117,9 @@ func (s *SubscriptionService) CreateSubscription(ctx context.Context, req *pb.Cr
 	if sub.Source == "" {
 		sub.Source = req.Source
 	}
+	if err = s.authorize(ctx, auth.ActionWrite, sub); nil != err {
+		return out, errors.Wrap(err, "create subscription")
+	}
 
 	err = s.apiManager.CreateSubscription(ctx, sub)
 	out = &pb.SubscriptionResponse{
@@ -155,6 +159,9 @@ func (s *SubscriptionService) UpdateSubscription(ctx context.Context, req *pb.Up
 	if sub.Source == "" {
 		sub.Source = req.Source
 	}
+	if err = s.authorize(ctx, auth.ActionWrite, sub); nil != err {
+		return out, errors.Wrap(err, "update subscription")
+	}
 
 	err = s.apiManager.CreateSubscription(ctx, sub)
 
@@ -168,6 +175,13 @@ func (s *SubscriptionService) UpdateSubscription(ctx context.Context, req *pb.Up
 	return out, errors.Wrap(err, "update subscription")
 }
 
+func (s *SubscriptionService) authorize(ctx context.Context, action auth.Action, sub *repository.Subscription) error {
+	res := &auth.Resource{Owner: sub.Owner, Type: SMTypeSubscription, ID: sub.ID}
+	err := authorizeEntity(ctx, action, res)
+	sub.Owner = res.Owner
+	return err
+}
+
 func makeSubscription(subObj *pb.SubscriptionObject) (*repository.Subscription, error) {
 	sub := new(repository.Subscription)
 	entitySources, err := entitySources(subObj.Filter)
@@ -212,6 +226,10 @@ func (s *SubscriptionService) DeleteSubscription(ctx context.Context, req *pb.De
 	sub.ID = req.Id
 	sub.Owner = req.Owner
 	sub.Source2 = req.Source
+	if err = s.authorize(ctx, auth.ActionWrite, sub); nil != err {
+		return nil, errors.Wrap(err, "delete subscription")
+	}
+
 	sub, err = s.apiManager.GetSubscription(ctx, sub)
 	if err != nil {
 		return nil, errors.Wrap(err, "delete subscription")
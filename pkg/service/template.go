@@ -0,0 +1,353 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/auth"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	apim "github.com/tkeel-io/core/pkg/manager"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/atomic"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type TemplateService struct {
+	pb.UnimplementedTemplateServer
+	ctx        context.Context
+	cancel     context.CancelFunc
+	inited     *atomic.Bool
+	apiManager apim.APIManager
+}
+
+// NewTemplateService returns a new TemplateService.
+func NewTemplateService(ctx context.Context) (*TemplateService, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &TemplateService{
+		ctx:    ctx,
+		cancel: cancel,
+		inited: atomic.NewBool(false),
+	}, nil
+}
+
+func (s *TemplateService) Init(apiManager apim.APIManager) {
+	s.apiManager = apiManager
+	s.inited.Store(true)
+}
+
+func (s *TemplateService) CreateTemplate(ctx context.Context, req *pb.CreateTemplateRequest) (out *pb.TemplateResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Template(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	if req.Owner, err = authorizeTemplate(ctx, auth.ActionWrite, req.Owner, req.Id); nil != err {
+		return nil, errors.Wrap(err, "create template")
+	}
+
+	var tpl *repository.Template
+	if tpl, err = makeTemplate(req.Id, req.Owner, req.Template); nil != err {
+		log.L().Error("create template, invalid params", logf.Template(req.Id),
+			logf.Owner(req.Owner), logf.Error(err))
+		return nil, errors.Wrap(err, "create template")
+	}
+
+	if tpl, err = s.apiManager.CreateTemplate(ctx, tpl); nil != err {
+		log.L().Error("create template", logf.Template(req.Id),
+			logf.Owner(req.Owner), logf.Error(err))
+		return nil, errors.Wrap(err, "create template")
+	}
+
+	return templateResponse(tpl)
+}
+
+func (s *TemplateService) GetTemplate(ctx context.Context, req *pb.GetTemplateRequest) (out *pb.TemplateResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Template(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	if req.Owner, err = authorizeTemplate(ctx, auth.ActionRead, req.Owner, req.Id); nil != err {
+		return nil, errors.Wrap(err, "get template")
+	}
+
+	var tpl *repository.Template
+	if tpl, err = s.apiManager.GetTemplate(ctx, &repository.Template{
+		ID: req.Id, Owner: req.Owner, Version: req.Version}); nil != err {
+		log.L().Error("get template", logf.Template(req.Id),
+			logf.Owner(req.Owner), logf.Version(req.Version), logf.Error(err))
+		return nil, errors.Wrap(err, "get template")
+	}
+
+	return templateResponse(tpl)
+}
+
+func (s *TemplateService) ResolveTemplate(ctx context.Context, req *pb.GetTemplateRequest) (out *pb.TemplateResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Template(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	if req.Owner, err = authorizeTemplate(ctx, auth.ActionRead, req.Owner, req.Id); nil != err {
+		return nil, errors.Wrap(err, "resolve template")
+	}
+
+	var tpl *repository.Template
+	if tpl, err = s.apiManager.ResolveTemplate(ctx, &repository.Template{
+		ID: req.Id, Owner: req.Owner, Version: req.Version}); nil != err {
+		log.L().Error("resolve template", logf.Template(req.Id),
+			logf.Owner(req.Owner), logf.Version(req.Version), logf.Error(err))
+		return nil, errors.Wrap(err, "resolve template")
+	}
+
+	return templateResponse(tpl)
+}
+
+func (s *TemplateService) ListTemplate(ctx context.Context, req *pb.ListTemplateRequest) (out *pb.ListTemplateResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Owner(req.Owner))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	if req.Owner, err = authorizeTemplate(ctx, auth.ActionRead, req.Owner, ""); nil != err {
+		return nil, errors.Wrap(err, "list template")
+	}
+	return s.listTemplate(ctx, &repository.ListTemplateReq{Owner: req.Owner})
+}
+
+func (s *TemplateService) ListTemplateVersion(ctx context.Context, req *pb.GetTemplateRequest) (out *pb.ListTemplateResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Template(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	if req.Owner, err = authorizeTemplate(ctx, auth.ActionRead, req.Owner, req.Id); nil != err {
+		return nil, errors.Wrap(err, "list template version")
+	}
+	return s.listTemplate(ctx, &repository.ListTemplateReq{Owner: req.Owner, ID: req.Id})
+}
+
+func (s *TemplateService) listTemplate(ctx context.Context, req *repository.ListTemplateReq) (out *pb.ListTemplateResponse, err error) {
+	var tpls []*repository.Template
+	if tpls, err = s.apiManager.ListTemplate(ctx, req); nil != err {
+		log.L().Error("list template", logf.Template(req.ID),
+			logf.Owner(req.Owner), logf.Error(err))
+		return nil, errors.Wrap(err, "list template")
+	}
+
+	out = &pb.ListTemplateResponse{Count: int32(len(tpls))}
+	for _, tpl := range tpls {
+		var item *pb.TemplateObject
+		if item, err = templateObject(tpl); nil != err {
+			return nil, errors.Wrap(err, "list template")
+		}
+		out.Items = append(out.Items, item)
+	}
+	return out, nil
+}
+
+func (s *TemplateService) DeleteTemplate(ctx context.Context, req *pb.DeleteTemplateRequest) (out *pb.DeleteTemplateResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Template(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	if req.Owner, err = authorizeTemplate(ctx, auth.ActionWrite, req.Owner, req.Id); nil != err {
+		return nil, errors.Wrap(err, "delete template")
+	} else if err = s.apiManager.DeleteTemplate(ctx, &repository.Template{
+		ID: req.Id, Owner: req.Owner}); nil != err {
+		log.L().Error("delete template", logf.Template(req.Id),
+			logf.Owner(req.Owner), logf.Error(err))
+		return nil, errors.Wrap(err, "delete template")
+	}
+
+	return &pb.DeleteTemplateResponse{Id: req.Id, Status: "ok"}, nil
+}
+
+// MigrateTemplate start a rollout job which upgrades instances of the template.
+func (s *TemplateService) MigrateTemplate(ctx context.Context, req *pb.MigrateTemplateRequest) (out *pb.TemplateRolloutObject, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Template(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	if req.Owner, err = authorizeTemplate(ctx, auth.ActionWrite, req.Owner, req.Id); nil != err {
+		return nil, errors.Wrap(err, "migrate template")
+	}
+
+	var rollout *repository.TemplateRollout
+	if rollout, err = s.apiManager.MigrateTemplate(ctx, &apim.MigrateTemplateReq{
+		ID:          req.Id,
+		Owner:       req.Owner,
+		FromVersion: req.FromVersion,
+		ToVersion:   req.ToVersion,
+	}); nil != err {
+		log.L().Error("migrate template", logf.Template(req.Id),
+			logf.Owner(req.Owner), logf.Error(err))
+		return nil, errors.Wrap(err, "migrate template")
+	}
+
+	return templateRolloutObject(rollout), nil
+}
+
+func (s *TemplateService) GetTemplateRollout(ctx context.Context, req *pb.GetTemplateRolloutRequest) (out *pb.TemplateRolloutObject, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.ID(req.RolloutId))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	if req.Owner, err = authorizeTemplate(ctx, auth.ActionRead, req.Owner, req.Id); nil != err {
+		return nil, errors.Wrap(err, "get template rollout")
+	}
+
+	var rollout *repository.TemplateRollout
+	if rollout, err = s.apiManager.GetTemplateRollout(ctx, &repository.TemplateRollout{
+		ID: req.RolloutId, Owner: req.Owner, TemplateID: req.Id}); nil != err {
+		log.L().Error("get template rollout", logf.ID(req.RolloutId),
+			logf.Template(req.Id), logf.Owner(req.Owner), logf.Error(err))
+		return nil, errors.Wrap(err, "get template rollout")
+	}
+
+	return templateRolloutObject(rollout), nil
+}
+
+func (s *TemplateService) ListTemplateRollout(ctx context.Context, req *pb.ListTemplateRolloutRequest) (out *pb.ListTemplateRolloutResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Template(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	if req.Owner, err = authorizeTemplate(ctx, auth.ActionRead, req.Owner, req.Id); nil != err {
+		return nil, errors.Wrap(err, "list template rollout")
+	}
+
+	var rollouts []*repository.TemplateRollout
+	if rollouts, err = s.apiManager.ListTemplateRollout(ctx, &repository.ListTemplateRolloutReq{
+		Owner: req.Owner, TemplateID: req.Id}); nil != err {
+		log.L().Error("list template rollout", logf.Template(req.Id),
+			logf.Owner(req.Owner), logf.Error(err))
+		return nil, errors.Wrap(err, "list template rollout")
+	}
+
+	out = &pb.ListTemplateRolloutResponse{Count: int32(len(rollouts))}
+	for _, rollout := range rollouts {
+		out.Items = append(out.Items, templateRolloutObject(rollout))
+	}
+	return out, nil
+}
+
+// authorizeTemplate authorizes the action on templates of the owner,
+// returns the authorized owner, which defaults to the tenant of the caller.
+func authorizeTemplate(ctx context.Context, action auth.Action, owner, id string) (string, error) {
+	res := &auth.Resource{ID: id, Owner: owner}
+	err := authorizeResource(ctx, action, res)
+	return res.Owner, err
+}
+
+func makeTemplate(id, owner string, obj *pb.TemplateObject) (*repository.Template, error) {
+	tpl := &repository.Template{ID: id, Owner: owner}
+	if nil == obj {
+		return tpl, nil
+	}
+
+	tpl.Name = obj.Name
+	tpl.Extends = obj.Extends
+	tpl.ExtendsVersion = obj.ExtendsVersion
+	tpl.Description = obj.Description
+	if tpl.ID == "" {
+		tpl.ID = obj.Id
+	}
+
+	switch scheme := obj.Configs.AsInterface().(type) {
+	case map[string]interface{}:
+		tpl.Scheme = scheme
+	case nil:
+	default:
+		return nil, errors.Wrap(xerrors.ErrInvalidRequest, "template configs")
+	}
+
+	switch properties := obj.Properties.AsInterface().(type) {
+	case map[string]interface{}:
+		tpl.Properties = properties
+	case nil:
+	default:
+		return nil, errors.Wrap(xerrors.ErrInvalidRequest, "template properties")
+	}
+
+	for _, expr := range obj.Expressions {
+		tpl.Expressions = append(tpl.Expressions, &repository.TemplateExpression{
+			Path:        expr.Path,
+			Name:        expr.Name,
+			Expression:  expr.Expression,
+			Description: expr.Description,
+		})
+	}
+	return tpl, nil
+}
+
+func templateObject(tpl *repository.Template) (out *pb.TemplateObject, err error) {
+	out = &pb.TemplateObject{
+		Id:             tpl.ID,
+		Name:           tpl.Name,
+		Owner:          tpl.Owner,
+		Version:        tpl.Version,
+		Extends:        tpl.Extends,
+		ExtendsVersion: tpl.ExtendsVersion,
+		Description:    tpl.Description,
+		CreatedAt:      tpl.CreatedAt,
+	}
+
+	if out.Configs, err = structpb.NewValue(tpl.Scheme); nil != err {
+		return nil, errors.Wrap(err, "convert template scheme")
+	} else if out.Properties, err = structpb.NewValue(tpl.Properties); nil != err {
+		return nil, errors.Wrap(err, "convert template properties")
+	}
+
+	for _, expr := range tpl.Expressions {
+		out.Expressions = append(out.Expressions, &pb.TemplateExpression{
+			Path:        expr.Path,
+			Name:        expr.Name,
+			Expression:  expr.Expression,
+			Description: expr.Description,
+		})
+	}
+	return out, nil
+}
+
+func templateResponse(tpl *repository.Template) (*pb.TemplateResponse, error) {
+	obj, err := templateObject(tpl)
+	if nil != err {
+		return nil, errors.Wrap(err, "template response")
+	}
+
+	return &pb.TemplateResponse{
+		Id:       tpl.ID,
+		Owner:    tpl.Owner,
+		Version:  tpl.Version,
+		Template: obj,
+	}, nil
+}
+
+func templateRolloutObject(rollout *repository.TemplateRollout) *pb.TemplateRolloutObject {
+	return &pb.TemplateRolloutObject{
+		Id:             rollout.ID,
+		Owner:          rollout.Owner,
+		TemplateId:     rollout.TemplateID,
+		FromVersion:    rollout.FromVersion,
+		ToVersion:      rollout.ToVersion,
+		Status:         rollout.Status,
+		Total:          int32(rollout.Total),
+		Done:           int32(rollout.Done),
+		Skipped:        int32(rollout.Skipped),
+		Failed:         int32(len(rollout.FailedEntities)),
+		Pending:        int32(rollout.Pending()),
+		FailedEntities: rollout.FailedEntities,
+		Error:          rollout.Error,
+		CreatedAt:      rollout.CreatedAt,
+		UpdatedAt:      rollout.UpdatedAt,
+	}
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/repository"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func Test_CreateTemplate(t *testing.T) {
+	ts, err := NewTemplateService(context.Background())
+	assert.Nil(t, err)
+
+	properties, err := structpb.NewValue(map[string]interface{}{"temp": 20})
+	assert.Nil(t, err)
+
+	ts.Init(apiManager)
+	res, err := ts.CreateTemplate(context.Background(), &pb.CreateTemplateRequest{
+		Id:    "tpl-sensor",
+		Owner: "admin",
+		Template: &pb.TemplateObject{
+			Extends:    "tpl-base",
+			Properties: properties,
+			Expressions: []*pb.TemplateExpression{
+				{Path: "properties.temp_f", Expression: "$self.properties.temp * 1.8 + 32"},
+			},
+		},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), res.Version)
+	assert.Equal(t, "tpl-base", res.Template.Extends)
+	assert.Equal(t, 20.0, res.Template.Properties.GetStructValue().AsMap()["temp"])
+	assert.Len(t, res.Template.Expressions, 1)
+}
+
+func Test_MigrateTemplate(t *testing.T) {
+	ts, err := NewTemplateService(context.Background())
+	assert.Nil(t, err)
+
+	_, err = ts.MigrateTemplate(context.Background(), &pb.MigrateTemplateRequest{Id: "tpl-sensor"})
+	assert.NotNil(t, err)
+
+	ts.Init(apiManager)
+	res, err := ts.MigrateTemplate(context.Background(), &pb.MigrateTemplateRequest{
+		Id:          "tpl-sensor",
+		Owner:       "admin",
+		FromVersion: 1,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), res.ToVersion)
+	assert.Equal(t, repository.RolloutStatusRunning, res.Status)
+
+	rollout, err := ts.GetTemplateRollout(context.Background(), &pb.GetTemplateRolloutRequest{
+		Id:        "tpl-sensor",
+		Owner:     "admin",
+		RolloutId: res.Id,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, repository.RolloutStatusCompleted, rollout.Status)
+	assert.Equal(t, int32(2), rollout.Done)
+	assert.Equal(t, int32(0), rollout.Pending)
+}
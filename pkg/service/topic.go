@@ -25,7 +25,9 @@ import (
 	pb "github.com/tkeel-io/core/api/core/v1"
 	logf "github.com/tkeel-io/core/pkg/logfield"
 	apim "github.com/tkeel-io/core/pkg/manager"
+	"github.com/tkeel-io/core/pkg/ratelimit"
 	"github.com/tkeel-io/core/pkg/resource/pubsub/dapr"
+	"github.com/tkeel-io/core/pkg/tenant"
 	xjson "github.com/tkeel-io/core/pkg/util/json"
 	"github.com/tkeel-io/kit/log"
 	"github.com/tkeel-io/tdtl"
@@ -73,6 +75,17 @@ func (s *TopicService) TopicEventHandler(ctx context.Context, req *pb.TopicEvent
 	}
 
 	cc := tdtl.New(req.RawData)
+	owner, entityID := cc.Get("owner").String(), cc.Get("id").String()
+	if !tenant.AllowMessage(owner) {
+		// over quota, let dapr redeliver the event later.
+		log.L().Warn("tenant msg rate quota exceeded, retry event",
+			logf.String("id", req.Meta.Id), logf.Owner(owner))
+		return &pb.TopicEventResponse{Status: SubscriptionResponseStatusRetry}, nil
+	} else if err = ratelimit.Admit(ctx, "TopicEventHandler", owner, entityID); nil != err {
+		// backpressure, let dapr redeliver the event later.
+		return &pb.TopicEventResponse{Status: SubscriptionResponseStatusRetry}, nil
+	}
+
 	ev := pb.ProtoEvent{
 		Id:        req.Meta.Id,
 		Timestamp: time.Now().UnixNano(),
@@ -80,7 +93,7 @@ func (s *TopicService) TopicEventHandler(ctx context.Context, req *pb.TopicEvent
 
 	ev.SetType(pb.ETEntity)
 	ev.SetAttr(pb.MetaTopic, req.Meta.Topic)
-	ev.SetAttr(pb.MetaEntityID, cc.Get("id").String())
+	ev.SetAttr(pb.MetaEntityID, entityID)
 	ev.SetAttr(pb.MetaOwner, cc.Get("type").String())
 	ev.SetAttr(pb.MetaSource, cc.Get("owner").String())
 	ev.SetAttr(pb.MetaEntityType, cc.Get("source").String())
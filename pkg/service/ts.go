@@ -14,12 +14,14 @@ import (
 
 	"github.com/pkg/errors"
 	pb "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/auth"
 	"github.com/tkeel-io/core/pkg/config"
 	xerrors "github.com/tkeel-io/core/pkg/errors"
 	logf "github.com/tkeel-io/core/pkg/logfield"
 	apim "github.com/tkeel-io/core/pkg/manager"
 	"github.com/tkeel-io/core/pkg/resource"
-	"github.com/tkeel-io/core/pkg/resource/tseries"
+	"github.com/tkeel-io/core/pkg/tenant"
+	"github.com/tkeel-io/core/pkg/types"
 	"go.uber.org/atomic"
 	"google.golang.org/protobuf/types/known/structpb"
 
@@ -30,7 +32,7 @@ var defalutUser = "admin"
 
 type TSService struct {
 	pb.UnimplementedTSServer
-	tseriesClient tseries.TimeSerier
+	resources     types.ResourceManager
 	entityHistory EntityHistory
 	apiManager    apim.APIManager
 	lock          *sync.RWMutex
@@ -38,33 +40,42 @@ type TSService struct {
 }
 
 func NewTSService() (*TSService, error) {
-	tseriesClient := tseries.NewTimeSerier(config.Get().Components.TimeSeries.Name)
-	if err := tseriesClient.Init(resource.ParseFrom(config.Get().Components.TimeSeries)); nil != err {
-		log.L().Error("initialize time series", logf.Error(err))
+	entityHistory, err := NewEntityHistory(resource.ParseFrom(config.Get().Components.Store), 5)
+	if nil != err {
+		log.L().Error("initialize entity history", logf.Error(err))
 		return nil, errors.Wrap(err, "init ts service")
 	}
-	entityHistory := NewEntityHistory(resource.ParseFrom(config.Get().Components.Store), 5)
 
 	return &TSService{
-		tseriesClient: tseriesClient,
 		entityHistory: entityHistory,
 		lock:          new(sync.RWMutex),
 		inited:        atomic.NewBool(false),
 	}, nil
 }
 
-func (s *TSService) Init(apiManager apim.APIManager) {
-	s.inited.Store(true)
+// Init sets the api manager and resources, time series are queried from
+// the time series instance of resources, which may be replaced on config changes.
+func (s *TSService) Init(apiManager apim.APIManager, resources types.ResourceManager) {
 	s.apiManager = apiManager
+	s.resources = resources
+	s.inited.Store(true)
 }
 
 func (s *TSService) GetTSData(ctx context.Context, req *pb.GetTSDataRequest) (*pb.GetTSDataResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Eid(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
 	if req.StartTime < (time.Now().Unix() - 3600*24*3) {
 		req.StartTime = time.Now().Unix() - 3600*24*3
 	}
 	if err := checkParams(req.StartTime, req.EndTime, req.Identifiers); err != nil {
 		return nil, err
 	}
+	resource := &auth.Resource{ID: req.Id}
+	if err := authorizeEntity(ctx, auth.ActionRead, resource); nil != err {
+		return nil, errors.Wrap(err, "query time series data")
+	}
 
 	user := defalutUser
 	h := ctx.Value(contextHTTPHeaderKey)
@@ -89,7 +100,7 @@ func (s *TSService) GetTSData(ctx context.Context, req *pb.GetTSDataRequest) (*p
 		req.PageSize = 0
 	}
 
-	res, err := s.tseriesClient.Query(ctx, req)
+	res, err := s.resources.TSDB().Query(tenant.WithTenant(ctx, resource.Owner), req)
 	if err != nil {
 		return nil, errors.Wrap(err, "query time series data")
 	}
@@ -111,6 +122,10 @@ func checkParams(startTime, endTime int64, identifiers string) error {
 }
 
 func (s *TSService) DownloadTSData(ctx context.Context, req *pb.DownloadTSDataRequest) (*pb.DownloadTSDataResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Eid(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
 	resp := &pb.DownloadTSDataResponse{}
 	if req.StartTime < (time.Now().Unix() - 3600*24*3) {
 		req.StartTime = time.Now().Unix() - 3600*24*3
@@ -122,6 +137,10 @@ func (s *TSService) DownloadTSData(ctx context.Context, req *pb.DownloadTSDataRe
 		resp.Filename = "error.txt"
 		return resp, err
 	}
+	resource := &auth.Resource{ID: req.Id}
+	if err := authorizeEntity(ctx, auth.ActionRead, resource); nil != err {
+		return nil, errors.Wrap(err, "download time series data")
+	}
 
 	var buffer []byte
 	csvBuffer := bytes.NewBuffer(buffer)
@@ -143,7 +162,7 @@ func (s *TSService) DownloadTSData(ctx context.Context, req *pb.DownloadTSDataRe
 			PageSize:    pageSize,
 		}
 
-		res, err := s.tseriesClient.Query(ctx, reqGet)
+		res, err := s.resources.TSDB().Query(tenant.WithTenant(ctx, resource.Owner), reqGet)
 		if err != nil {
 			resp.Data = []byte("error")
 			resp.Length = "5"
@@ -216,6 +235,11 @@ func (s *TSService) GetLatestEntities(ctx context.Context, req *pb.GetLatestEnti
 		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
 	}
 
+	res := &auth.Resource{}
+	if err = authorizeResource(ctx, auth.ActionRead, res); nil != err {
+		return nil, errors.Wrap(err, "get latest entities")
+	}
+
 	resp = &pb.GetLatestEntitiesResponse{}
 	user := defalutUser
 	h := ctx.Value(contextHTTPHeaderKey)
@@ -243,6 +267,9 @@ func (s *TSService) GetLatestEntities(ctx context.Context, req *pb.GetLatestEnti
 		entityBase.ID = v
 		entityBase.Source = "source"
 		entityBase.Owner = user
+		if res.Owner != "" {
+			entityBase.Owner = res.Owner
+		}
 
 		var baseRet *apim.BaseRet
 		if baseRet, err = s.apiManager.GetEntity(ctx, entityBase); nil != err {
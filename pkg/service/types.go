@@ -29,6 +29,9 @@ const (
 	HeaderType        = "Type"
 	HeaderMetadata    = "Metadata"
 	HeaderContentType = "Content-Type"
+	HeaderAsync       = "Async"
+	HeaderCallback    = "Callback"
+	HeaderIdempotency = "Idempotency-Key"
 	QueryType         = "type"
 
 	Plugin = "plugin"
@@ -56,4 +59,6 @@ type PatchData struct {
 	Path     string
 	Operator string
 	Value    interface{}
+	// From is the source path of move and copy.
+	From string
 }
@@ -0,0 +1,66 @@
+package tenant
+
+import (
+	"sync"
+	"time"
+
+	"github.com/tkeel-io/core/pkg/config"
+)
+
+var _msgLimiter = NewMsgLimiter()
+
+// AllowMessage reports whether a message of the tenant is within the msg rate quota of the tenant,
+// messages without tenant are not limited.
+func AllowMessage(tenantID string) bool {
+	if tenantID == "" {
+		return true
+	}
+	return _msgLimiter.Allow(tenantID, config.Get().Tenant.QuotaOf(tenantID).MsgRate)
+}
+
+type msgCounter struct {
+	second int64
+	count  int
+}
+
+// MsgLimiter counts messages of tenants in fixed one second windows.
+type MsgLimiter struct {
+	lock     sync.Mutex
+	counters map[string]*msgCounter
+	now      func() time.Time
+}
+
+func NewMsgLimiter() *MsgLimiter {
+	return &MsgLimiter{
+		counters: make(map[string]*msgCounter),
+		now:      time.Now,
+	}
+}
+
+// Allow counts a message of the tenant, and reports whether the tenant sent
+// no more than limit messages in the current second, not positive limit is unlimited.
+func (l *MsgLimiter) Allow(tenantID string, limit int) bool {
+	if limit <= 0 {
+		return true
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	second := l.now().Unix()
+	counter, ok := l.counters[tenantID]
+	if !ok {
+		counter = &msgCounter{}
+		l.counters[tenantID] = counter
+	}
+
+	if counter.second != second {
+		counter.second, counter.count = second, 0
+	}
+
+	if counter.count >= limit {
+		return false
+	}
+	counter.count++
+	return true
+}
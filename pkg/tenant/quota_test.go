@@ -0,0 +1,23 @@
+package tenant
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMsgLimiter_Allow(t *testing.T) {
+	now := time.Unix(100, 0)
+	limiter := NewMsgLimiter()
+	limiter.now = func() time.Time { return now }
+
+	assert.True(t, limiter.Allow("tenant1", 2))
+	assert.True(t, limiter.Allow("tenant1", 2))
+	assert.False(t, limiter.Allow("tenant1", 2))
+	assert.True(t, limiter.Allow("tenant2", 2))
+	assert.True(t, limiter.Allow("tenant1", 0))
+
+	now = now.Add(time.Second)
+	assert.True(t, limiter.Allow("tenant1", 2))
+}
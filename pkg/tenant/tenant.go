@@ -0,0 +1,40 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/tkeel-io/tdtl"
+)
+
+const (
+	// TagKey is the tag key of tenant in time series and raw data.
+	TagKey = "tenant"
+
+	fieldOwner = "owner"
+)
+
+type tenantKey struct{}
+
+// WithTenant returns a context queries of which are restricted to the tenant.
+func WithTenant(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenantID)
+}
+
+// FromContext returns the tenant queries are restricted to, empty if not restricted.
+func FromContext(ctx context.Context) string {
+	tenantID, _ := ctx.Value(tenantKey{}).(string)
+	return tenantID
+}
+
+// FromState returns the tenant of the entity state, which is the owner of the entity.
+func FromState(raw []byte) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	return tdtl.New(raw).Get(fieldOwner).String()
+}
+
+// Tag returns the tenant tag of time series and raw data.
+func Tag(tenantID string) string {
+	return TagKey + "=" + tenantID
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"sync"
+
 	"github.com/tkeel-io/core/pkg/repository"
 	"github.com/tkeel-io/core/pkg/resource/rawdata"
 	"github.com/tkeel-io/core/pkg/resource/search"
@@ -8,6 +10,7 @@ import (
 )
 
 type resourceManager struct {
+	lock           sync.RWMutex
 	defaultSearch  *search.Service
 	defaultTSDB    tseries.TimeSerier
 	defaultRepo    repository.IRepository
@@ -32,6 +35,8 @@ func (r *resourceManager) Search() *search.Service {
 }
 
 func (r *resourceManager) TSDB() tseries.TimeSerier {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
 	return r.defaultTSDB
 }
 
@@ -40,5 +45,27 @@ func (r *resourceManager) Repo() repository.IRepository {
 }
 
 func (r *resourceManager) RawData() rawdata.Service {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
 	return r.defaultRawData
 }
+
+// SetTSDB replaces the time series instance, writes in flight may still go to the previous one,
+// which should be flushed by the caller.
+func (r *resourceManager) SetTSDB(tseriesClient tseries.TimeSerier) tseries.TimeSerier {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	prev := r.defaultTSDB
+	r.defaultTSDB = tseriesClient
+	return prev
+}
+
+// SetRawData replaces the rawdata instance, writes in flight may still go to the previous one,
+// which should be flushed by the caller.
+func (r *resourceManager) SetRawData(rawdataClient rawdata.Service) rawdata.Service {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	prev := r.defaultRawData
+	r.defaultRawData = rawdataClient
+	return prev
+}
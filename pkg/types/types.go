@@ -15,6 +15,10 @@ type ResourceManager interface {
 	TSDB() tseries.TimeSerier
 	Repo() repository.IRepository
 	RawData() rawdata.Service
+	// SetTSDB replaces the time series instance, returns the previous one.
+	SetTSDB(tseries.TimeSerier) tseries.TimeSerier
+	// SetRawData replaces the rawdata instance, returns the previous one.
+	SetRawData(rawdata.Service) rawdata.Service
 }
 
 type Republisher interface {
@@ -1,11 +1,16 @@
 package dapr
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
 	"sync"
 
 	logf "github.com/tkeel-io/core/pkg/logfield"
 
 	daprSDK "github.com/dapr/go-sdk/client"
+	"github.com/pkg/errors"
 	"github.com/tkeel-io/kit/log"
 )
 
@@ -49,6 +54,36 @@ func (p *daprClientPool) Select() daprSDK.Client {
 	return p.client
 }
 
+// SidecarEnabled reports whether core runs with a dapr sidecar.
+func SidecarEnabled() bool {
+	return os.Getenv("DAPR_HTTP_PORT") != "" || os.Getenv("DAPR_GRPC_PORT") != ""
+}
+
+// Ping checks the health of the dapr sidecar through its healthz endpoint.
+func Ping(ctx context.Context) error {
+	port := os.Getenv("DAPR_HTTP_PORT")
+	if port == "" {
+		port = "3500"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		fmt.Sprintf("http://localhost:%s/v1.0/healthz", port), nil)
+	if nil != err {
+		return errors.Wrap(err, "ping dapr sidecar")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if nil != err {
+		return errors.Wrap(err, "ping dapr sidecar")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return errors.Errorf("dapr sidecar status %d", resp.StatusCode)
+	}
+	return nil
+}
+
 func init() {
 	pool = &daprClientPool{}
 }
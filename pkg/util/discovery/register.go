@@ -27,6 +27,7 @@ func (d *Discovery) Register(ctx context.Context, node Service) error {
 
 	// register node.
 	leaseID = leaseResp.ID
+	d.lease, d.leaseID = lease, leaseID
 	_, err = d.discoveryEnd.Put(ctx, registerKey, registerValue, clientv3.WithLease(leaseID))
 	if err != nil {
 		log.L().Error("register service", logf.Error(err),
@@ -51,7 +52,12 @@ func (d *Discovery) Register(ctx context.Context, node Service) error {
 			case <-ctx.Done():
 				log.L().Info("delete lease", logf.Lease(int64(leaseID)))
 				return
-			case <-leaseMessageCh:
+			case _, ok := <-leaseMessageCh:
+				if !ok {
+					// lease revoked or expired.
+					log.L().Info("lease keepalive stopped", logf.Lease(int64(leaseID)))
+					return
+				}
 				// log.L().Debug("lease keepalive respose", logf.Lease(int64(leaseID)), logf.Cluster(leaseMsg.ClusterId),
 				// 	logf.Member(leaseMsg.MemberId), logf.Revision(uint64(leaseMsg.Revision)), logf.Term(int64(leaseMsg.RaftTerm)))
 			}
@@ -60,3 +66,22 @@ func (d *Discovery) Register(ctx context.Context, node Service) error {
 
 	return errors.Wrap(err, "keep lease alive")
 }
+
+// Deregister revokes the lease of the registered service and closes the client,
+// the service key is deleted with the lease.
+func (d *Discovery) Deregister(ctx context.Context) error {
+	if nil == d.lease {
+		return nil
+	}
+
+	if _, err := d.lease.Revoke(ctx, d.leaseID); nil != err {
+		log.L().Error("revoke lease", logf.Error(err), logf.Lease(int64(d.leaseID)))
+		return errors.Wrap(err, "revoke lease")
+	}
+
+	log.L().Info("deregister service", logf.Lease(int64(d.leaseID)))
+	if err := d.lease.Close(); nil != err {
+		return errors.Wrap(err, "close lease")
+	}
+	return errors.Wrap(d.discoveryEnd.Close(), "close discovery client")
+}
@@ -64,6 +64,9 @@ type Discovery struct {
 	discoveryEnd *clientv3.Client
 	HeartTime    int64
 	Config       Config
+	// lease of the registered service.
+	lease   clientv3.Lease
+	leaseID clientv3.LeaseID
 }
 
 func New(cfg Config) (*Discovery, error) {
@@ -0,0 +1,53 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// FileLock is an exclusive lock of a data directory held by the process.
+type FileLock struct {
+	file *os.File
+}
+
+// LockDir takes the exclusive lock named name in the directory, fails if another process holds it.
+func LockDir(dir, name string) (*FileLock, error) {
+	path := filepath.Join(dir, name)
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o644)
+	if nil != err {
+		return nil, errors.Wrap(err, "open lock file")
+	}
+
+	if err = lockFile(file); nil != err {
+		file.Close()
+		return nil, errors.Wrapf(err, "lock %s, held by another process", path)
+	}
+	return &FileLock{file: file}, nil
+}
+
+// Unlock releases the lock.
+func (l *FileLock) Unlock() error {
+	err := unlockFile(l.file)
+	if closeErr := l.file.Close(); nil == err {
+		err = closeErr
+	}
+	return errors.Wrap(err, "unlock file")
+}
@@ -23,7 +23,7 @@ import (
 type PatchOp int
 
 // reference: https://datatracker.ietf.org/doc/html/rfc6902 .
-// implement [ add, remove, replace ], reversed [ copy, move, test ].
+// implement [ add, remove, replace, copy, move, test ], and merge as extension.
 const (
 	OpUndef PatchOp = iota
 	OpAdd
@@ -79,7 +79,7 @@ func (po PatchOp) String() string {
 
 func IsReversedOp(op string) bool {
 	switch op {
-	case "add", "remove", "replace", "copy":
+	case "add", "remove", "replace", "copy", "move", "test":
 		return false
 	default:
 		return true
@@ -16,6 +16,9 @@ import (
 	"github.com/tkeel-io/kit/log"
 )
 
+// rebalanceTimeout bounds receivers acting on rebalance, within the default rebalance timeout of the group.
+const rebalanceTimeout = 30 * time.Second
+
 type kafkaMetadata struct {
 	Topic   string   `json:"topic" mapstructure:"topic"`
 	Group   string   `json:"group" mapstructure:"group"`
@@ -78,6 +81,9 @@ type Pubsub struct {
 	kafkaConsumer sarama.ConsumerGroup
 	kafkaProducer sarama.SyncProducer
 	kafkaMetadata *kafkaMetadata
+	// cancel stops consuming, consumed is closed once the consumer group is closed.
+	cancel   context.CancelFunc
+	consumed chan struct{}
 }
 
 func (k *Pubsub) ID() string {
@@ -114,6 +120,11 @@ type KafkaReceiver interface { //nolint
 	HandleMessage(context.Context, *sarama.ConsumerMessage) error
 }
 
+// KafkaRebalancer is implemented by receivers which act before claims of the topic are released on rebalance.
+type KafkaRebalancer interface { //nolint
+	HandleRebalance(ctx context.Context, topic string) error
+}
+
 func (k *Pubsub) Received(ctx context.Context, receiver KafkaReceiver) error {
 	c, err := sarama.NewConsumerGroupFromClient(k.kafkaMetadata.Group, k.kafkaClient)
 	if nil != err {
@@ -123,10 +134,13 @@ func (k *Pubsub) Received(ctx context.Context, receiver KafkaReceiver) error {
 	}
 
 	k.kafkaConsumer = c
+	k.consumed = make(chan struct{})
+	ctx, k.cancel = context.WithCancel(ctx)
 	log.L().Debug("start receive", logf.ID(k.id), logf.Topic(k.kafkaMetadata.Topic),
 		logf.Endpoints(k.kafkaMetadata.Brokers), logf.Group(k.kafkaMetadata.Group))
 
 	go func() {
+		defer close(k.consumed)
 		defer func() {
 			log.L().Debug("Closing ConsumerGroup for topics", logf.Topic(k.kafkaMetadata.Topic),
 				logf.ID(k.id), logf.Endpoints(k.kafkaMetadata.Brokers), logf.Group(k.kafkaMetadata.Group))
@@ -141,7 +155,7 @@ func (k *Pubsub) Received(ctx context.Context, receiver KafkaReceiver) error {
 
 		for {
 			// Consume the requested topic.
-			if innerError := k.kafkaConsumer.Consume(ctx, []string{k.kafkaMetadata.Topic}, &kafkaConsumer{receiver: receiver}); innerError != nil {
+			if innerError := k.kafkaConsumer.Consume(ctx, []string{k.kafkaMetadata.Topic}, &kafkaConsumer{receiver: receiver, topic: k.kafkaMetadata.Topic}); innerError != nil {
 				log.L().Error("Error closing consumer group", logf.Error(innerError), logf.Topic(k.kafkaMetadata.Topic),
 					logf.ID(k.id), logf.Endpoints(k.kafkaMetadata.Brokers), logf.Group(k.kafkaMetadata.Group))
 			}
@@ -160,12 +174,38 @@ func (k *Pubsub) Commit(v interface{}) error {
 	return nil
 }
 
+// Check refreshes metadata of the topic, it fails if no broker of the topic is reachable.
+func (k *Pubsub) Check(ctx context.Context) error {
+	refreshed := make(chan error, 1)
+	go func() {
+		refreshed <- k.kafkaClient.RefreshMetadata(k.kafkaMetadata.Topic)
+	}()
+
+	select {
+	case err := <-refreshed:
+		return errors.Wrap(err, "refresh kafka metadata")
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "refresh kafka metadata")
+	}
+}
+
+// Close stops consuming and waits for the consumer group to be closed,
+// receivers are cleaned up before offsets of marked messages are committed.
 func (k *Pubsub) Close() error {
-	log.L().Info("pubsub.noop close", logf.ID(k.id))
-	return nil
+	log.L().Info("pubsub.kafka close", logf.ID(k.id), logf.Topic(k.kafkaMetadata.Topic))
+	if nil != k.cancel {
+		k.cancel()
+		<-k.consumed
+	}
+
+	if err := k.kafkaProducer.Close(); nil != err {
+		log.L().Error("close kafka producer", logf.ID(k.id), logf.Error(err))
+	}
+	return errors.Wrap(k.kafkaClient.Close(), "close kafka client")
 }
 
 type kafkaConsumer struct {
+	topic    string
 	receiver KafkaReceiver
 }
 
@@ -181,6 +221,8 @@ func (consumer *kafkaConsumer) ConsumeClaim(session sarama.ConsumerGroupSession,
 			var innerErr error
 			log.L().Debug("processing kafka message", logf.Topic(msg.Topic),
 				logf.Partition(msg.Partition), logf.Offset(msg.Offset), logf.Key(string(msg.Key)))
+			// messages are marked once queued by the receiver, changes of events not written to the state store
+			// yet are lost if core crashes, which is why coalescing state writes is disabled by default.
 			if innerErr = consumer.receiver.HandleMessage(session.Context(), msg); innerErr == nil {
 				session.MarkMessage(msg, "")
 			}
@@ -204,6 +246,15 @@ func (consumer *kafkaConsumer) ConsumeClaim(session sarama.ConsumerGroupSession,
 }
 
 func (consumer *kafkaConsumer) Cleanup(sarama.ConsumerGroupSession) error {
+	if rebalancer, ok := consumer.receiver.(KafkaRebalancer); ok {
+		// the session context is done on rebalance.
+		ctx, cancel := context.WithTimeout(context.Background(), rebalanceTimeout)
+		defer cancel()
+		if err := rebalancer.HandleRebalance(ctx, consumer.topic); nil != err {
+			log.L().Error("handle rebalance", logf.Topic(consumer.topic), logf.Error(err))
+			return errors.Wrap(err, "handle rebalance")
+		}
+	}
 	return nil
 }
 
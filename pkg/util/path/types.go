@@ -32,6 +32,19 @@ func FmtWatchKey(eid, propertyKey string) string {
 	return eid + "." + propertyKey
 }
 
+// Overlap reports whether changes on one path may affect another.
+func Overlap(a, b string) bool {
+	// trim wildcard suffix, eg: 'iotd-xxx.*' -> 'iotd-xxx'.
+	a = strings.TrimSuffix(strings.TrimSuffix(a, WildcardSome), Separator)
+	b = strings.TrimSuffix(strings.TrimSuffix(b, WildcardSome), Separator)
+	if a == b {
+		return true
+	} else if len(a) > len(b) {
+		a, b = b, a
+	}
+	return strings.HasPrefix(b, a+Separator)
+}
+
 func MergePath(subPath, changePath string) string {
 	subPath = strings.TrimRight(subPath, Separator+WildcardSome)
 	if strings.Contains(subPath, WildcardOne) {
@@ -0,0 +1,22 @@
+package path
+
+import "testing"
+
+func TestOverlap(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"dev.properties.a", "dev.properties.a", true},
+		{"dev.properties", "dev.properties.a", true},
+		{"dev.properties.a.b", "dev.properties.a", true},
+		{"dev.*", "dev.properties.a", true},
+		{"dev.properties.a", "dev.properties.ab", false},
+		{"dev1.properties.a", "dev.properties.a", false},
+	}
+	for _, tt := range tests {
+		if got := Overlap(tt.a, tt.b); got != tt.want {
+			t.Errorf("Overlap(%s, %s) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
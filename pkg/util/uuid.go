@@ -10,6 +10,7 @@ const (
 	defaultEventPrefix        = "ev-"
 	defaultRequestPrefix      = "req-"
 	defaultSubscriptionPrefix = "sub-"
+	defaultTransactionPrefix  = "tx-"
 )
 
 func IG() *idGenerator { //nolint
@@ -45,6 +46,11 @@ func (ig *idGenerator) SubID() string {
 	return UUID(defaultSubscriptionPrefix)
 }
 
+// returns a transaction id.
+func (ig *idGenerator) TxID() string {
+	return UUID(defaultTransactionPrefix)
+}
+
 // generate id with prefix.
 func (ig *idGenerator) With(prefix string) {
 	ig.prefix = prefix